@@ -0,0 +1,74 @@
+package testutil
+
+import (
+	"bytes"
+	"encoding/binary"
+
+	"github.com/d0rvin/winscope-smb/pkg/protocol/dcerpc"
+)
+
+// RPCPipe is a DCE/RPC server on an in-memory pipe. It answers a bind with
+// a bind_ack advertising MaxRecvFrag, or 4280 if 0, and the last fragment
+// of any request with Stub, split into fragments of at most FragSize stub
+// bytes. Requests holds every request fragment written.
+type RPCPipe struct {
+	Stub        []byte
+	FragSize    int
+	MaxRecvFrag uint16
+	Requests    [][]byte
+
+	out bytes.Buffer
+}
+
+func (p *RPCPipe) Read(b []byte) (int, error) {
+	return p.out.Read(b)
+}
+
+func (p *RPCPipe) Write(b []byte) (int, error) {
+	callID := binary.LittleEndian.Uint32(b[12:])
+	switch b[2] {
+	case dcerpc.PacketTypeBind:
+		ack := []byte{
+			0x05, 0x00, dcerpc.PacketTypeBindAck, 0x03, 0x10, 0x00, 0x00, 0x00,
+			0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00,
+			0xb8, 0x10, 0xb8, 0x10, 0x01, 0x00, 0x00, 0x00,
+			0x04, 0x00, '1', '3', '5', 0x00, 0x00, 0x00, // secondary address, then padding
+			0x01, 0x00, 0x00, 0x00,
+			0x00, 0x00, 0x00, 0x00,
+		}
+		ack = append(ack, make([]byte, 20)...)
+		if p.MaxRecvFrag != 0 {
+			binary.LittleEndian.PutUint16(ack[18:], p.MaxRecvFrag)
+		}
+		binary.LittleEndian.PutUint16(ack[8:], uint16(len(ack)))
+		binary.LittleEndian.PutUint32(ack[12:], callID)
+		p.out.Write(ack)
+	case dcerpc.PacketTypeRequest:
+		p.Requests = append(p.Requests, bytes.Clone(b))
+		if b[3]&dcerpc.PacketFlagLastFrag == 0 {
+			break
+		}
+		stub := p.Stub
+		for first := true; first || len(stub) > 0; first = false {
+			chunk := stub[:min(len(stub), p.FragSize)]
+			stub = stub[len(chunk):]
+			flags := uint8(0)
+			if first {
+				flags |= dcerpc.PacketFlagFirstFrag
+			}
+			if len(stub) == 0 {
+				flags |= dcerpc.PacketFlagLastFrag
+			}
+			hdr := []byte{
+				0x05, 0x00, dcerpc.PacketTypeResponse, flags, 0x10, 0x00, 0x00, 0x00,
+				0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00,
+				0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00,
+			}
+			binary.LittleEndian.PutUint16(hdr[8:], uint16(len(hdr)+len(chunk)))
+			binary.LittleEndian.PutUint32(hdr[12:], callID)
+			p.out.Write(hdr)
+			p.out.Write(chunk)
+		}
+	}
+	return len(b), nil
+}
@@ -0,0 +1,69 @@
+package testutil
+
+import (
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/tls"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"math/big"
+	"testing"
+	"time"
+
+	"github.com/d0rvin/winscope-smb/pkg/encoding"
+	"github.com/d0rvin/winscope-smb/pkg/protocol/ntlmssp"
+)
+
+// NewChallenge returns an NTLM CHALLENGE from the Windows build version,
+// e.g. {Major: 10, Build: 20348}, whose target info carries the NetBIOS
+// computer name followed by pairs.
+func NewChallenge(name string, version ntlmssp.Version, pairs ...ntlmssp.AvPair) ntlmssp.Challenge {
+	challenge := ntlmssp.NewChallenge()
+	version.Reserved, version.Revision = make([]byte, 3), 15
+	challenge.Version = &version
+	info := ntlmssp.AvPairSlice{{AvID: ntlmssp.AvNBComputerName, Value: encoding.ToUnicode(name)}}
+	info = append(append(info, pairs...), ntlmssp.AvPair{AvID: ntlmssp.AvEOL})
+	challenge.TargetInfo = &info
+	return challenge
+}
+
+// Challenge returns NewChallenge marshalled.
+func Challenge(t testing.TB, name string, version ntlmssp.Version, pairs ...ntlmssp.AvPair) []byte {
+	t.Helper()
+	return Marshal(t, NewChallenge(name, version, pairs...))
+}
+
+// Marshal encodes v, failing the test if it cannot.
+func Marshal(t testing.TB, v any) []byte {
+	t.Helper()
+	buf, err := encoding.Marshal(v)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return buf
+}
+
+// SelfSignedCert returns a server certificate for name, valid for an hour
+// either side of now.
+func SelfSignedCert(t testing.TB, name string) tls.Certificate {
+	t.Helper()
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	if err != nil {
+		t.Fatal(err)
+	}
+	tmpl := &x509.Certificate{
+		SerialNumber: big.NewInt(1),
+		Subject:      pkix.Name{CommonName: name},
+		DNSNames:     []string{name},
+		NotBefore:    time.Now().Add(-time.Hour),
+		NotAfter:     time.Now().Add(time.Hour),
+		KeyUsage:     x509.KeyUsageDigitalSignature,
+		ExtKeyUsage:  []x509.ExtKeyUsage{x509.ExtKeyUsageServerAuth},
+	}
+	der, err := x509.CreateCertificate(rand.Reader, tmpl, tmpl, &key.PublicKey, key)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return tls.Certificate{Certificate: [][]byte{der}, PrivateKey: key}
+}
@@ -1,21 +1,155 @@
 package main
 
 import (
+	"cmp"
+	"context"
+	"crypto/sha256"
+	"crypto/tls"
+	"encoding/hex"
+	"errors"
 	"flag"
 	"fmt"
+	"net"
+	"net/netip"
 	"os"
+	"slices"
+	"strconv"
+	"strings"
+	"sync"
 	"text/tabwriter"
+	"time"
 
+	"github.com/d0rvin/winscope-smb/pkg/pcap"
+	"github.com/d0rvin/winscope-smb/pkg/probe"
 	"github.com/d0rvin/winscope-smb/pkg/protocol"
+	"github.com/d0rvin/winscope-smb/pkg/protocol/dcerpc"
+	"github.com/d0rvin/winscope-smb/pkg/protocol/dcerpc/srvsvc"
+	"github.com/d0rvin/winscope-smb/pkg/protocol/dcerpc/wkssvc"
+	"github.com/d0rvin/winscope-smb/pkg/protocol/httpntlm"
+	"github.com/d0rvin/winscope-smb/pkg/protocol/mail"
+	"github.com/d0rvin/winscope-smb/pkg/protocol/netbios"
 	"github.com/d0rvin/winscope-smb/pkg/protocol/ntlmssp"
-	v1 "github.com/d0rvin/winscope-smb/pkg/protocol/smb/v1"
 	v2 "github.com/d0rvin/winscope-smb/pkg/protocol/smb/v2"
+	"github.com/d0rvin/winscope-smb/pkg/protocol/transcript"
+	"github.com/d0rvin/winscope-smb/pkg/server"
 )
 
+type result struct {
+	Protocol     string
+	NativeOS     string
+	NativeLanMan string
+	Challenge    *ntlmssp.Challenge
+	TLS          *tls.ConnectionState
+	PostAuth     *postAuthResult
+	Probes       []probe.Result
+	Detection    *probe.Detection
+
+	NodeStatus    *netbios.NodeStatus
+	NodeStatusErr error
+
+	ReverseDNS *reverseDNS
+	SMBPorts   []smbPort
+}
+
+// setSMB records the challenge and details of a successful SMB probe.
+func (res *result) setSMB(r probe.Result) {
+	res.Challenge, res.TLS = r.Challenge, r.TLS
+	res.Protocol, _ = r.Extra.Get("Dialect")
+	res.NativeOS, _ = r.Extra.Get("Native OS")
+	res.NativeLanMan, _ = r.Extra.Get("Native LAN Manager")
+}
+
+// smbPort is the status of one SMB port probed.
+type smbPort struct {
+	Port   uint16
+	Status probe.Status
+}
+
+type reverseDNS struct {
+	Names []string
+	Err   error
+}
+
+const nodeStatusTimeout = 2 * time.Second
+
+type postAuthResult struct {
+	Session       string
+	Shares        []shareCheck
+	ShareList     []srvsvc.ShareInfo1
+	ShareListErr  error
+	ServerInfo    *srvsvc.ServerInfo101
+	ServerInfoErr error
+	WkstaInfo     *wkssvc.WkstaInfo
+	WkstaInfoErr  error
+}
+
+type shareCheck struct {
+	Name string
+	Tree *v2.Tree
+	Err  error
+}
+
+type postAuthOptions struct {
+	Cred       ntlmssp.Credentials
+	Shares     []string
+	ListShares bool
+	ServerInfo bool
+}
+
+func (o postAuthOptions) enabled() bool {
+	return len(o.Shares) > 0 || o.ListShares || o.ServerInfo
+}
+
 func main() {
-	host := flag.String("host", "", "SMB host (required)")
-	port := flag.Uint("port", 445, "SMB port")
-	proxy := flag.String("proxy", "", "Proxy URL, e.g. socks5://127.0.0.1:7897")
+	if len(os.Args) > 1 && os.Args[1] == "pcap" {
+		os.Exit(runPcap(os.Args[2:]))
+	}
+	if len(os.Args) > 1 && os.Args[1] == "honeypot" {
+		os.Exit(runHoneypot(os.Args[2:]))
+	}
+
+	host := flag.String("host", "", "Target host name, IP address or CIDR range, or a comma-separated list of them (required)")
+	port := flag.Uint("port", 445, "Port to probe; the service is detected on non-standard ports (SMB on 445 then 139 if unset)")
+	ipv4 := flag.Bool("4", false, "Scan IPv4 addresses only")
+	ipv6 := flag.Bool("6", false, "Scan IPv6 addresses only")
+	source := flag.String("source", "", "Source IP address for outgoing connections")
+	iface := flag.String("interface", "", "Network interface whose address outgoing connections use")
+	dnsServer := flag.String("dns-server", "", "DNS server to resolve host names through instead of the system resolver, e.g. 10.0.0.1 or 10.0.0.1:53")
+	dnsTimeout := flag.Duration("dns-timeout", 5*time.Second, "Timeout for each DNS query")
+	dnsTCP := flag.Bool("dns-tcp", false, "Query -dns-server over TCP instead of UDP")
+	ptr := flag.Bool("ptr", false, "Look up the PTR records of each scanned address and compare them with the NTLM DNS computer name")
+	retries := flag.Int("retries", 0, "Retries for probes that time out or are reset, e.g. over a lossy WAN link")
+	backoff := flag.Duration("backoff", time.Second, "Wait before the first retry, doubled for each later one")
+	pcapFile := flag.String("pcap", "", "Write the decrypted traffic of every probe to this pcapng file, for Wireshark")
+	recordFile := flag.String("record", "", "Record the traffic of every probe to this transcript file, for -replay")
+	replayFile := flag.String("replay", "", "Answer probes from a transcript file written by -record instead of the network")
+	proxy := flag.String("proxy", "", "Proxy URL or comma-separated chain (socks5, socks4, socks4a, http, https), e.g. socks5://127.0.0.1:7897")
+	netbiosName := flag.String("netbios-name", "", "NetBIOS called name for port 139 (discovered if empty)")
+	nbstat := flag.Bool("nbstat", false, "Query the NetBIOS name table and MAC address over UDP 137")
+	useQUIC := flag.Bool("quic", false, "Use SMB over QUIC (UDP 443, SMBv2 only)")
+	protocols := flag.String("protocols", "", "Comma-separated protocols to probe on their default ports, from "+strings.Join(probe.Names(), ",")+" (SMB if unset)")
+	var httpPorts portList
+	flag.Var(&httpPorts, "http-ports", "Comma-separated HTTP(S) ports to probe for NTLM, e.g. 80,443,5985")
+	var mssqlPorts portList
+	flag.Var(&mssqlPorts, "mssql-ports", "Comma-separated MS-SQL ports to probe over TDS, e.g. 1433")
+	var rdpPorts portList
+	flag.Var(&rdpPorts, "rdp-ports", "Comma-separated RDP ports to probe over CredSSP, e.g. 3389")
+	var ldapPorts portList
+	flag.Var(&ldapPorts, "ldap-ports", "Comma-separated LDAP ports to probe with an NTLM bind, e.g. 389,636")
+	ldapRootDSE := flag.Bool("ldap-rootdse", false, "Read the LDAP rootDSE anonymously before binding")
+	var mailPorts mailPortList
+	flag.Var(&mailPorts, "mail-ports", "Comma-separated SMTP, IMAP and POP3 ports to probe with AUTH NTLM, e.g. 25,587,143,110")
+	var rpcPorts portList
+	flag.Var(&rpcPorts, "rpc-ports", "Comma-separated MSRPC endpoint mapper ports to probe with an NTLM bind, e.g. 135")
+	var telnetPorts portList
+	flag.Var(&telnetPorts, "telnet-ports", "Comma-separated Telnet ports to probe with NTLM authentication, e.g. 23")
+	httpPaths := flag.String("http-paths", strings.Join(httpntlm.DefaultPaths, ","), "Comma-separated paths for the HTTP NTLM probe")
+	shares := flag.String("shares", "", "Comma-separated shares to check over SMBv2, e.g. ADMIN$,C$,IPC$")
+	listShares := flag.Bool("list-shares", false, "List shares over SMBv2 via SRVSVC NetShareEnumAll")
+	serverInfo := flag.Bool("server-info", false, "Query SRVSVC NetServerGetInfo and WKSSVC NetWkstaGetInfo over SMBv2")
+	user := flag.String("user", "", "Username for post-auth probes (anonymous if empty)")
+	password := flag.String("password", "", "Password for post-auth probes")
+	domain := flag.String("domain", "", "Domain for post-auth probes")
 	flag.Parse()
 
 	if *host == "" {
@@ -23,62 +157,613 @@ func main() {
 		flag.Usage()
 		os.Exit(2)
 	}
+	ipVersion := 0
+	switch {
+	case *ipv4 && *ipv6:
+		fmt.Fprintln(os.Stderr, "-4 and -6 are mutually exclusive")
+		flag.Usage()
+		os.Exit(2)
+	case *ipv4:
+		ipVersion = 4
+	case *ipv6:
+		ipVersion = 6
+	}
+	var localAddr netip.Addr
+	if *source != "" {
+		var err error
+		if localAddr, err = netip.ParseAddr(*source); err != nil {
+			fmt.Fprintf(os.Stderr, "invalid -source: %v\n", err)
+			flag.Usage()
+			os.Exit(2)
+		}
+		if *iface != "" {
+			fmt.Fprintln(os.Stderr, "-source and -interface are mutually exclusive")
+			flag.Usage()
+			os.Exit(2)
+		}
+	}
 
-	opts := []protocol.Option{}
-	if *proxy != "" {
-		opts = append(opts, protocol.WithProxy(*proxy))
+	if *replayFile != "" && (*proxy != "" || *useQUIC) {
+		fmt.Fprintln(os.Stderr, "invalid -replay: not supported with -proxy or -quic")
+		flag.Usage()
+		os.Exit(2)
 	}
 
-	cfg := protocol.Config{
-		Host:    *host,
-		Port:    uint16(*port),
-		Options: opts,
+	var probePaths []string
+	for p := range strings.SplitSeq(*httpPaths, ",") {
+		if p = strings.TrimSpace(p); p != "" {
+			probePaths = append(probePaths, p)
+		}
 	}
 
-	if err := runV1(cfg); err != nil {
-		v1Err := err
-		if err := runV2(cfg); err != nil {
-			fmt.Fprintf(os.Stderr, "SMBv1 error: %v\n", v1Err)
-			fmt.Fprintf(os.Stderr, "SMBv2 error: %v\n", err)
+	baseOpts := []protocol.Option{}
+	if *proxy != "" {
+		baseOpts = append(baseOpts, protocol.WithProxy(*proxy))
+	}
+	if localAddr.IsValid() {
+		baseOpts = append(baseOpts, protocol.WithLocalAddr(localAddr))
+	}
+	if *iface != "" {
+		baseOpts = append(baseOpts, protocol.WithInterface(*iface))
+	}
+	if ipVersion != 0 {
+		baseOpts = append(baseOpts, protocol.WithIPVersion(ipVersion))
+	}
+	resolver := &protocol.Resolver{Server: *dnsServer, Timeout: *dnsTimeout, TCP: *dnsTCP}
+	baseOpts = append(baseOpts, protocol.WithResolver(resolver))
+	var capture *os.File
+	var recorder *pcap.Writer
+	if *pcapFile != "" {
+		var err error
+		capture, err = os.Create(*pcapFile)
+		if err == nil {
+			recorder, err = pcap.NewWriter(capture)
+		}
+		if err != nil {
+			fmt.Fprintf(os.Stderr, "Creating -pcap failed: %v\n", err)
 			os.Exit(1)
 		}
+		baseOpts = append(baseOpts, protocol.WithRecorder(recorder))
 	}
+	var transcripts *transcript.Recorder
+	if *recordFile != "" {
+		transcripts = &transcript.Recorder{}
+		baseOpts = append(baseOpts, protocol.WithRecorder(transcripts))
+	}
+	if *replayFile != "" {
+		ts, err := loadTranscripts(*replayFile)
+		if err != nil {
+			fmt.Fprintf(os.Stderr, "Reading -replay failed: %v\n", err)
+			os.Exit(1)
+		}
+		baseOpts = append(baseOpts, protocol.WithDialer(transcript.NewReplayer(ts).Dial))
+	}
+
+	probe.Register(&probe.HTTP{Paths: probePaths})
+	probe.Register(&probe.LDAP{RootDSE: *ldapRootDSE})
+
+	s := &scanner{
+		baseOpts:    baseOpts,
+		quic:        *useQUIC,
+		proxy:       *proxy != "",
+		port:        uint16(*port),
+		smbPorts:    []uint16{445, 139},
+		netbiosName: *netbiosName,
+		nbstat:      *nbstat,
+		resolver:    resolver,
+		ptr:         *ptr,
+		retry:       probe.Retry{Attempts: *retries, Backoff: *backoff},
+	}
+	if *useQUIC {
+		s.smbPorts = []uint16{443}
+	}
+	flag.Visit(func(f *flag.Flag) {
+		if f.Name == "port" {
+			s.portSet = true
+			s.smbPorts = []uint16{uint16(*port)}
+		}
+	})
+
+	for name := range strings.SplitSeq(*protocols, ",") {
+		if name = strings.TrimSpace(name); name == "" {
+			continue
+		}
+		p, ok := probe.Lookup(name)
+		if !ok {
+			fmt.Fprintf(os.Stderr, "invalid -protocols: unknown protocol %q\n", name)
+			flag.Usage()
+			os.Exit(2)
+		}
+		s.protocols = append(s.protocols, p)
+	}
+	for _, t := range []struct {
+		name  string
+		ports []uint16
+	}{
+		{"rdp", rdpPorts},
+		{"ldap", ldapPorts},
+		{"mssql", mssqlPorts},
+		{"rpc", rpcPorts},
+		{"telnet", telnetPorts},
+		{"http", httpPorts},
+	} {
+		p, _ := probe.Lookup(t.name)
+		s.extra.add(p, t.ports)
+	}
+	for _, port := range mailPorts.portList {
+		name, _ := mail.ProtocolForPort(port)
+		p, _ := probe.Lookup(name)
+		s.extra.add(p, []uint16{port})
+	}
+
+	s.postAuth = postAuthOptions{
+		Cred: ntlmssp.Credentials{
+			Domain:   *domain,
+			User:     *user,
+			Password: *password,
+		},
+		ListShares: *listShares,
+		ServerInfo: *serverInfo,
+	}
+	for share := range strings.SplitSeq(*shares, ",") {
+		if share = strings.TrimSpace(share); share != "" {
+			s.postAuth.Shares = append(s.postAuth.Shares, share)
+		}
+	}
+
+	// Names are left to the proxy, which may see a different DNS.
+	ctx := context.Background()
+	targets, err := protocol.ExpandTargets(ctx, resolver, *host, ipVersion, *proxy == "")
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "Resolving -host failed: %v\n", err)
+		os.Exit(1)
+	}
+	s.multi = len(targets) > 1
+	code := 0
+	for _, t := range targets {
+		if s.multi {
+			fmt.Printf("Target: %s\n\n", t)
+		}
+		code = max(code, s.scan(ctx, t))
+	}
+	if capture != nil {
+		err := recorder.Err()
+		if closeErr := capture.Close(); err == nil {
+			err = closeErr
+		}
+		if err != nil {
+			fmt.Fprintf(os.Stderr, "Writing -pcap failed: %v\n", err)
+			code = max(code, 1)
+		}
+	}
+	if transcripts != nil {
+		if err := saveTranscripts(*recordFile, transcripts.Transcripts()); err != nil {
+			fmt.Fprintf(os.Stderr, "Writing -record failed: %v\n", err)
+			code = max(code, 1)
+		}
+	}
+	os.Exit(code)
 }
 
-func runV1(cfg protocol.Config) error {
-	s, err := v1.NewSession(cfg)
+func loadTranscripts(name string) ([]*transcript.Transcript, error) {
+	f, err := os.Open(name)
+	if err != nil {
+		return nil, err
+	}
+	defer f.Close()
+	return transcript.Load(f)
+}
+
+func saveTranscripts(name string, ts []*transcript.Transcript) error {
+	f, err := os.Create(name)
 	if err != nil {
 		return err
 	}
-	defer s.Close()
+	if err := transcript.Save(f, ts); err != nil {
+		_ = f.Close()
+		return err
+	}
+	return f.Close()
+}
 
-	if err := s.Negotiate(); err != nil {
-		return fmt.Errorf("negotiate: %w", err)
+// runPcap reports the NTLM challenges found in capture files with one
+// section per server, as a scan of each would.
+func runPcap(args []string) int {
+	fs := flag.NewFlagSet("pcap", flag.ExitOnError)
+	fs.Usage = func() {
+		fmt.Fprintf(fs.Output(), "Usage: %s pcap <file> [<file>...]\n", os.Args[0])
+		fmt.Fprintln(fs.Output(), "Extract SMB and HTTP NTLM challenges from pcap or pcapng captures without touching the hosts.")
+	}
+	_ = fs.Parse(args)
+	if fs.NArg() == 0 {
+		fs.Usage()
+		return 2
 	}
 
-	sRes, challenge, err := s.SessionSetupAndX()
-	if err != nil {
-		return fmt.Errorf("session setup: %w", err)
+	code := 0
+	results := map[netip.Addr]*result{}
+	var servers []netip.Addr
+	for _, name := range fs.Args() {
+		f, err := os.Open(name)
+		if err != nil {
+			fmt.Fprintf(os.Stderr, "Reading %s failed: %v\n", name, err)
+			code = 1
+			continue
+		}
+		observations, err := probe.ReadCapture(f)
+		_ = f.Close()
+		if err != nil {
+			// Captures are often cut short; keep what was read.
+			fmt.Fprintf(os.Stderr, "Reading %s failed: %v\n", name, err)
+			code = 1
+		}
+		for _, o := range observations {
+			server := o.Server.Addr()
+			res, ok := results[server]
+			if !ok {
+				res = &result{}
+				results[server] = res
+				servers = append(servers, server)
+			}
+			switch {
+			case o.Protocol == "smb":
+				if res.Challenge == nil {
+					res.setSMB(o.Result)
+				}
+			case !slices.ContainsFunc(res.Probes, func(r probe.Result) bool {
+				return r.Protocol == o.Protocol && r.Port == o.Port
+			}):
+				res.Probes = append(res.Probes, o.Result)
+			}
+		}
+	}
+	if len(servers) == 0 {
+		fmt.Fprintln(os.Stderr, "No NTLM challenges found")
+		return 1
 	}
 
-	fmt.Println("SMBv1:")
-	w := tabwriter.NewWriter(os.Stdout, 0, 0, 2, ' ', 0)
-	fmt.Fprintf(w, "\tNative Lan Man:\t%s\n", sRes.NativeLanMan)
-	fmt.Fprintf(w, "\tNative OS:\t%s\n", sRes.NativeOS)
-	fmt.Fprintf(w, "\tWindows Build Version:\t%d.%d.%d\n", challenge.Version.Major, challenge.Version.Minor, challenge.Version.Build)
-	if os, ok := challenge.Version.ParseToOS(); ok {
-		fmt.Fprintf(w, "\tWindows Version:\t%s\n", os)
+	for _, server := range servers {
+		res := results[server]
+		if res.Challenge == nil {
+			res.Protocol, res.Challenge = fallbackChallenge(res)
+		}
+		fmt.Printf("Target: %s\n\n", server)
+		printResult(res)
 	}
+	return code
+}
 
-	printTargetInfo(w, challenge.TargetInfo)
-	_ = w.Flush()
-	challenge.Version.ParseToOS()
-	fmt.Println()
+// runHoneypot answers SMB scanners like a chosen Windows build until it
+// fails, logging one line per connection.
+func runHoneypot(args []string) int {
+	fs := flag.NewFlagSet("honeypot", flag.ExitOnError)
+	profileName := fs.String("profile", "2016", "Windows build to answer like, from the profiles below")
+	listen := fs.String("listen", ":445", "Comma-separated addresses to listen on, e.g. :445,:139")
+	name := fs.String("name", "FS01", "Computer name of the decoy")
+	domain := fs.String("domain", "", "DNS domain of the decoy, e.g. corp.example (a workgroup host if empty)")
+	logFile := fs.String("log", "", "Append connection attempts to this file instead of standard output")
+	idleTimeout := fs.Duration("idle-timeout", 30*time.Second, "Hang up on clients that send nothing for this long (0 waits forever)")
+	fs.Usage = func() {
+		fmt.Fprintf(fs.Output(), "Usage: %s honeypot [flags]\n", os.Args[0])
+		fmt.Fprintln(fs.Output(), "Answer SMB NEGOTIATE and SESSION_SETUP like a Windows build and log every connection attempt.")
+		fs.PrintDefaults()
+		fmt.Fprintln(fs.Output(), "Profiles:")
+		w := tabwriter.NewWriter(fs.Output(), 0, 0, 2, ' ', 0)
+		for _, p := range server.Profiles {
+			fmt.Fprintf(w, "  %s\t%s\n", p.Name, p.Description)
+		}
+		_ = w.Flush()
+	}
+	_ = fs.Parse(args)
+	if fs.NArg() > 0 {
+		fs.Usage()
+		return 2
+	}
+	profile, ok := server.LookupProfile(*profileName)
+	if !ok {
+		fmt.Fprintf(os.Stderr, "invalid -profile: unknown profile %q\n", *profileName)
+		fs.Usage()
+		return 2
+	}
 
-	return nil
+	out := os.Stdout
+	if *logFile != "" {
+		f, err := os.OpenFile(*logFile, os.O_WRONLY|os.O_APPEND|os.O_CREATE, 0o644)
+		if err != nil {
+			fmt.Fprintf(os.Stderr, "Opening -log failed: %v\n", err)
+			return 1
+		}
+		defer f.Close()
+		out = f
+	}
+	var mu sync.Mutex
+	srv := profile.Server(*name, *domain)
+	srv.IdleTimeout = *idleTimeout
+	srv.Log = func(a *server.Attempt) {
+		mu.Lock()
+		defer mu.Unlock()
+		fmt.Fprintln(out, a)
+	}
+
+	errs := make(chan error)
+	for addr := range strings.SplitSeq(*listen, ",") {
+		ln, err := net.Listen("tcp", strings.TrimSpace(addr))
+		if err != nil {
+			fmt.Fprintf(os.Stderr, "Listening failed: %v\n", err)
+			return 1
+		}
+		fmt.Fprintf(os.Stderr, "Answering like %s on %s\n", profile.Description, ln.Addr())
+		go func() { errs <- srv.Serve(ln) }()
+	}
+	fmt.Fprintf(os.Stderr, "Serving failed: %v\n", <-errs)
+	return 1
 }
 
-func runV2(cfg protocol.Config) error {
+// scanner holds the settings shared by every target.
+type scanner struct {
+	baseOpts    []protocol.Option
+	quic        bool
+	proxy       bool
+	port        uint16
+	portSet     bool
+	smbPorts    []uint16
+	protocols   []probe.Prober
+	extra       probePlan
+	netbiosName string
+	nbstat      bool
+	resolver    *protocol.Resolver
+	ptr         bool
+	retry       probe.Retry
+	postAuth    postAuthOptions
+	multi       bool
+}
+
+// scan probes one target and prints its results, returning the exit code.
+func (s *scanner) scan(ctx context.Context, target protocol.Target) int {
+	// Errors name the target when several are scanned.
+	errorf := func(format string, args ...any) {
+		if s.multi {
+			format = target.String() + ": " + format
+		}
+		fmt.Fprintf(os.Stderr, format, args...)
+	}
+
+	baseOpts := slices.Clone(s.baseOpts)
+	if target.Addr.IsValid() {
+		baseOpts = append(baseOpts, protocol.WithAddr(target.Addr))
+	}
+	opts := slices.Clone(baseOpts)
+	if s.quic {
+		// The certificate is reported rather than verified.
+		opts = append(opts, protocol.WithQUIC(&tls.Config{InsecureSkipVerify: true}))
+	}
+
+	res := &result{}
+	var plan probePlan
+	switch {
+	case len(s.protocols) > 0:
+		for _, p := range s.protocols {
+			switch {
+			case p.Name() == "smb":
+				plan.add(p, s.smbPorts)
+			case s.portSet:
+				plan.add(p, []uint16{s.port})
+			default:
+				plan.add(p, p.DefaultPorts())
+			}
+		}
+	case s.portSet && !s.quic:
+		// Probe whatever is conventionally served on the port, or ask the
+		// service itself on a non-standard port.
+		ports := []uint16{s.port}
+		for _, p := range probe.ForPort(s.port) {
+			plan.add(p, ports)
+		}
+		if len(plan) > 0 {
+			break
+		}
+		d, err := probe.Detect(ctx, protocol.Config{Host: target.Host, Port: s.port, Options: baseOpts})
+		if err != nil {
+			errorf("Service detection on port %d failed: %v\n", s.port, err)
+			return 1
+		}
+		res.Detection = d
+		p, _ := probe.Lookup(d.Protocol)
+		plan.add(p, ports, d.Options()...)
+	default:
+		p, _ := probe.Lookup("smb")
+		plan.add(p, s.smbPorts)
+	}
+	for _, t := range s.extra {
+		plan.add(t.Prober, t.Ports, t.Options...)
+	}
+	slices.SortStableFunc(plan, func(a, b probeTarget) int {
+		return cmp.Compare(fallbackRank(a.Prober.Name()), fallbackRank(b.Prober.Name()))
+	})
+
+	// NBSTAT runs alongside the SMB probes; it also answers when 445 is closed.
+	var nodeStatusDone chan struct{}
+	if s.nbstat {
+		nodeStatusDone = make(chan struct{})
+		go func() {
+			defer close(nodeStatusDone)
+			switch {
+			case s.proxy:
+				res.NodeStatusErr = errors.New("not supported through a proxy")
+			case !target.Addr.Is4():
+				res.NodeStatusErr = errors.New("the NetBIOS name service is IPv4 only")
+			default:
+				addr := net.JoinHostPort(target.Addr.String(), fmt.Sprintf("%d", netbios.NameServicePort))
+				res.NodeStatus, res.NodeStatusErr = netbios.QueryNodeStatus(addr, nodeStatusTimeout)
+			}
+		}()
+	}
+
+	if s.ptr {
+		res.ReverseDNS = &reverseDNS{}
+		if target.Addr.IsValid() {
+			res.ReverseDNS.Names, res.ReverseDNS.Err = s.resolver.LookupAddr(ctx, target.Addr)
+		} else {
+			res.ReverseDNS.Err = errors.New("the proxy resolves the host name")
+		}
+	}
+
+	var cfg protocol.Config
+	var errs []string
+	smbProbed := false
+	for _, t := range plan {
+		if t.Prober.Name() != "smb" {
+			// These often expose the same challenge when 445 is filtered.
+			for _, p := range t.Ports {
+				cfg := protocol.Config{Host: target.Host, Port: p, Options: append(slices.Clone(baseOpts), t.Options...)}
+				res.Probes = append(res.Probes, s.retry.Run(ctx, t.Prober, cfg))
+			}
+			continue
+		}
+
+		smbProbed = true
+		for _, p := range t.Ports {
+			cfg = protocol.Config{
+				Host:    target.Host,
+				Port:    p,
+				Options: append(slices.Clone(opts), t.Options...),
+			}
+			if p == 139 && !s.quic {
+				name := s.netbiosName
+				if name == "" && nodeStatusDone != nil {
+					<-nodeStatusDone
+					if res.NodeStatus != nil {
+						name = res.NodeStatus.ComputerName()
+					}
+				}
+				cfg.Options = append(slices.Clone(opts), protocol.WithNetBIOSSession(name))
+			}
+
+			r := s.retry.Run(ctx, t.Prober, cfg)
+			res.SMBPorts = append(res.SMBPorts, smbPort{Port: p, Status: probe.SMBStatus(r.Err)})
+			if r.Err == nil {
+				res.setSMB(r)
+				break
+			}
+			prefix := ""
+			if len(t.Ports) > 1 {
+				prefix = fmt.Sprintf("Port %d ", p)
+			}
+			for line := range strings.SplitSeq(r.Err.Error(), "\n") {
+				errs = append(errs, fmt.Sprintf("%sSMB error: %s", prefix, line))
+			}
+		}
+	}
+	if nodeStatusDone != nil {
+		<-nodeStatusDone
+	}
+
+	smbFailed := res.Challenge == nil
+	if smbFailed {
+		res.Protocol, res.Challenge = fallbackChallenge(res)
+	}
+
+	if smbFailed {
+		for _, e := range errs {
+			errorf("%s\n", e)
+		}
+	}
+	if res.Challenge == nil {
+		if res.NodeStatusErr != nil {
+			errorf("NBSTAT error: %v\n", res.NodeStatusErr)
+		}
+		if res.SMBPorts != nil {
+			printSMBStatus(res)
+		}
+		if res.NodeStatus != nil {
+			printNodeStatus(res)
+		}
+		if res.ReverseDNS != nil {
+			printReverseDNS(res)
+		}
+		if res.Detection != nil {
+			printDetection(res.Detection)
+		}
+		printProbes(res.Probes)
+		return 1
+	}
+
+	var postAuthErr error
+	if s.postAuth.enabled() {
+		switch {
+		case !smbProbed:
+			postAuthErr = errors.New("SMB was not probed")
+		case smbFailed:
+			postAuthErr = errors.New("SMB is unreachable")
+		default:
+			postAuthErr = runPostAuth(cfg, s.postAuth, res)
+		}
+	}
+
+	printResult(res)
+
+	if postAuthErr != nil {
+		errorf("Post-auth error: %v\n", postAuthErr)
+		return 1
+	}
+	return 0
+}
+
+// probeTarget is a prober and the ports to run it on, with any options
+// service detection found necessary.
+type probeTarget struct {
+	Prober  probe.Prober
+	Ports   []uint16
+	Options []protocol.Option
+}
+
+type probePlan []probeTarget
+
+// add merges ports and options into the target for prober, adding one if
+// needed.
+func (plan *probePlan) add(prober probe.Prober, ports []uint16, opts ...protocol.Option) {
+	if len(ports) == 0 {
+		return
+	}
+	i := slices.IndexFunc(*plan, func(t probeTarget) bool {
+		return t.Prober.Name() == prober.Name()
+	})
+	if i < 0 {
+		i = len(*plan)
+		*plan = append(*plan, probeTarget{Prober: prober})
+	}
+	t := &(*plan)[i]
+	t.Options = append(t.Options, opts...)
+	for _, p := range ports {
+		if !slices.Contains(t.Ports, p) {
+			t.Ports = append(t.Ports, p)
+		}
+	}
+}
+
+// fallbackOrder runs SMB first, then the protocols most likely to be
+// reachable when SMB is filtered; other probers run last.
+var fallbackOrder = []string{"smb", "rdp", "ldap", "mssql", "smtp", "imap", "pop3", "rpc", "telnet", "http"}
+
+func fallbackRank(name string) int {
+	if i := slices.Index(fallbackOrder, name); i >= 0 {
+		return i
+	}
+	return len(fallbackOrder)
+}
+
+// fallbackChallenge returns the first challenge from the non-SMB probes,
+// which run in fallbackOrder.
+func fallbackChallenge(res *result) (string, *ntlmssp.Challenge) {
+	for _, r := range res.Probes {
+		if r.Challenge != nil {
+			return protocolTitle(r.Protocol) + " NTLM", r.Challenge
+		}
+	}
+	return res.Protocol, nil
+}
+
+func runPostAuth(cfg protocol.Config, opts postAuthOptions, res *result) error {
 	s, err := v2.NewSession(cfg)
 	if err != nil {
 		return err
@@ -93,18 +778,382 @@ func runV2(cfg protocol.Config) error {
 	if err != nil {
 		return fmt.Errorf("session setup: %w", err)
 	}
+	if err := s.Setup2(challenge, opts.Cred); err != nil {
+		return fmt.Errorf("session setup: %w", err)
+	}
+	defer s.Logoff()
+
+	pa := &postAuthResult{}
+	res.PostAuth = pa
+	switch {
+	case s.IsNull():
+		pa.Session = "anonymous"
+	case s.IsGuest():
+		pa.Session = "guest"
+	default:
+		pa.Session = opts.Cred.User
+	}
+
+	for _, share := range opts.Shares {
+		tree, err := s.TreeConnect(share)
+		pa.Shares = append(pa.Shares, shareCheck{Name: share, Tree: tree, Err: err})
+		if err == nil {
+			_ = s.TreeDisconnect(tree)
+		}
+	}
 
-	fmt.Println("SMBv2:")
+	if !opts.ListShares && !opts.ServerInfo {
+		return nil
+	}
+
+	ipc, err := s.TreeConnect("IPC$")
+	if err != nil {
+		return fmt.Errorf("tree connect IPC$: %w", err)
+	}
+	defer s.TreeDisconnect(ipc)
+
+	err = callPipe(s, ipc, srvsvc.PipeName, srvsvc.SyntaxID, func(c *dcerpc.Client) {
+		if opts.ListShares {
+			pa.ShareList, pa.ShareListErr = srvsvc.NetShareEnumAll(c, cfg.Host)
+		}
+		if opts.ServerInfo {
+			pa.ServerInfo, pa.ServerInfoErr = srvsvc.NetServerGetInfo(c, cfg.Host)
+		}
+	})
+	if err != nil {
+		if opts.ListShares {
+			pa.ShareListErr = err
+		}
+		if opts.ServerInfo {
+			pa.ServerInfoErr = err
+		}
+	}
+
+	if opts.ServerInfo {
+		err := callPipe(s, ipc, wkssvc.PipeName, wkssvc.SyntaxID, func(c *dcerpc.Client) {
+			// Level 102 adds the logged-on user count but needs admin rights.
+			pa.WkstaInfo, pa.WkstaInfoErr = wkssvc.NetWkstaGetInfo(c, cfg.Host, 102)
+			if pa.WkstaInfoErr != nil {
+				pa.WkstaInfo, pa.WkstaInfoErr = wkssvc.NetWkstaGetInfo(c, cfg.Host, 100)
+			}
+		})
+		if err != nil {
+			pa.WkstaInfoErr = err
+		}
+	}
+
+	return nil
+}
+
+func callPipe(s *v2.Session, tree *v2.Tree, name string, syntax dcerpc.SyntaxID, fn func(*dcerpc.Client)) error {
+	pipe, err := s.OpenPipe(tree, name)
+	if err != nil {
+		return fmt.Errorf("open %s: %w", name, err)
+	}
+	defer pipe.Close()
+
+	c := dcerpc.NewClient(pipe)
+	if err := c.Bind(syntax); err != nil {
+		return fmt.Errorf("bind %s: %w", name, err)
+	}
+	fn(c)
+	return nil
+}
+
+func printResult(res *result) {
+	fmt.Printf("%s:\n", res.Protocol)
 	w := tabwriter.NewWriter(os.Stdout, 0, 0, 2, ' ', 0)
-	fmt.Fprintf(w, "\tWindows Build Version:\t%d.%d.%d\n", challenge.Version.Major, challenge.Version.Minor, challenge.Version.Build)
-	if os, ok := challenge.Version.ParseToOS(); ok {
-		fmt.Fprintf(w, "\tWindows Version:\t%s\n", os)
+	if res.Protocol == "SMBv1" {
+		fmt.Fprintf(w, "\tNative Lan Man:\t%s\n", res.NativeLanMan)
+		fmt.Fprintf(w, "\tNative OS:\t%s\n", res.NativeOS)
+	}
+	version := res.Challenge.Version
+	if version != nil {
+		fmt.Fprintf(w, "\tWindows Build Version:\t%d.%d.%d\n", version.Major, version.Minor, version.Build)
+		if os, ok := version.ParseToOS(); ok {
+			fmt.Fprintf(w, "\tWindows Version:\t%s\n", os)
+		}
 	}
-	printTargetInfo(w, challenge.TargetInfo)
+	printTargetInfo(w, res.Challenge.TargetInfo)
 	_ = w.Flush()
 	fmt.Println()
 
-	return nil
+	if res.SMBPorts != nil {
+		printSMBStatus(res)
+	}
+	if res.TLS != nil {
+		printTLS(res.TLS)
+	}
+	if res.NodeStatus != nil || res.NodeStatusErr != nil {
+		printNodeStatus(res)
+	}
+	if res.ReverseDNS != nil {
+		printReverseDNS(res)
+	}
+	if res.Detection != nil {
+		printDetection(res.Detection)
+	}
+	printProbes(res.Probes)
+	if res.PostAuth != nil {
+		printPostAuth(res.PostAuth, version)
+	}
+}
+
+func printPostAuth(pa *postAuthResult, version *ntlmssp.Version) {
+	w := tabwriter.NewWriter(os.Stdout, 0, 0, 2, ' ', 0)
+
+	if pa.ServerInfo != nil || pa.ServerInfoErr != nil || pa.WkstaInfo != nil || pa.WkstaInfoErr != nil {
+		fmt.Println("Server Info:")
+		if info := pa.ServerInfo; info != nil {
+			fmt.Fprintf(w, "\tPlatform:\t%s\n", srvsvc.PlatformName(info.PlatformID))
+			fmt.Fprintf(w, "\tOS Version:\t%d.%d%s\n", info.VersionMajor, info.VersionMinor,
+				versionCrossCheck(info.VersionMajor, info.VersionMinor, version))
+			fmt.Fprintf(w, "\tServer Type:\t%s\n", strings.Join(srvsvc.ServerTypeNames(info.Type), ", "))
+			fmt.Fprintf(w, "\tComment:\t%s\n", info.Comment)
+		} else {
+			fmt.Fprintf(w, "\tServer Info:\tunavailable (%v)\n", pa.ServerInfoErr)
+		}
+		if info := pa.WkstaInfo; info != nil {
+			fmt.Fprintf(w, "\tLAN Group:\t%s\n", info.LanGroup)
+			if info.Level == 102 {
+				fmt.Fprintf(w, "\tLogged-on Users:\t%d\n", info.LoggedOnUsers)
+			}
+		} else {
+			fmt.Fprintf(w, "\tWorkstation Info:\tunavailable (%v)\n", pa.WkstaInfoErr)
+		}
+		_ = w.Flush()
+		fmt.Println()
+	}
+
+	if len(pa.Shares) > 0 || pa.ShareList != nil || pa.ShareListErr != nil {
+		fmt.Println("Shares:")
+		fmt.Fprintf(w, "\tSession:\t%s\n", pa.Session)
+		for _, share := range pa.Shares {
+			if share.Err != nil {
+				fmt.Fprintf(w, "\t%s:\tunreachable (%v)\n", share.Name, share.Err)
+				continue
+			}
+			tree := share.Tree
+			fmt.Fprintf(w, "\t%s:\treachable, type %s, flags 0x%08x, capabilities 0x%08x, maximal access 0x%08x\n",
+				share.Name, shareTypeName(tree.ShareType), tree.ShareFlags, tree.Capabilities, tree.MaximalAccess)
+		}
+		if pa.ShareListErr != nil {
+			fmt.Fprintf(w, "\tShare list:\tunavailable (%v)\n", pa.ShareListErr)
+		}
+		for _, info := range pa.ShareList {
+			fmt.Fprintf(w, "\t%s\t%s\t%s\n", info.Name, info.TypeName(), info.Remark)
+		}
+		_ = w.Flush()
+		fmt.Println()
+	}
+}
+
+func printTLS(state *tls.ConnectionState) {
+	fmt.Println("TLS:")
+	w := tabwriter.NewWriter(os.Stdout, 0, 0, 2, ' ', 0)
+	fmt.Fprintf(w, "\tVersion:\t%s\n", tls.VersionName(state.Version))
+	fmt.Fprintf(w, "\tALPN:\t%s\n", state.NegotiatedProtocol)
+	if len(state.PeerCertificates) > 0 {
+		cert := state.PeerCertificates[0]
+		fingerprint := sha256.Sum256(cert.Raw)
+		fmt.Fprintf(w, "\tSubject:\t%s\n", cert.Subject)
+		fmt.Fprintf(w, "\tIssuer:\t%s\n", cert.Issuer)
+		fmt.Fprintf(w, "\tDNS Names:\t%s\n", strings.Join(cert.DNSNames, ", "))
+		fmt.Fprintf(w, "\tNot Before:\t%s\n", cert.NotBefore.UTC().Format(time.RFC3339))
+		fmt.Fprintf(w, "\tNot After:\t%s\n", cert.NotAfter.UTC().Format(time.RFC3339))
+		fmt.Fprintf(w, "\tSHA-256 Fingerprint:\t%s\n", hex.EncodeToString(fingerprint[:]))
+	}
+	_ = w.Flush()
+	fmt.Println()
+}
+
+// protocolTitles are the section titles of the built-in probers; other
+// protocols are shown upper-cased.
+var protocolTitles = map[string]string{
+	"smb":    "SMB",
+	"http":   "HTTP",
+	"mssql":  "MS-SQL",
+	"rdp":    "RDP",
+	"ldap":   "LDAP",
+	"smtp":   "SMTP",
+	"imap":   "IMAP",
+	"pop3":   "POP3",
+	"rpc":    "MSRPC",
+	"telnet": "Telnet",
+}
+
+func protocolTitle(name string) string {
+	if title, ok := protocolTitles[name]; ok {
+		return title
+	}
+	return strings.ToUpper(name)
+}
+
+// printSMBStatus prints the status of the host, that of its best SMB
+// port, and of each port probed.
+func printSMBStatus(res *result) {
+	fmt.Println("SMB Status:")
+	w := tabwriter.NewWriter(os.Stdout, 0, 0, 2, ' ', 0)
+	var statuses []probe.Status
+	for _, p := range res.SMBPorts {
+		statuses = append(statuses, p.Status)
+	}
+	fmt.Fprintf(w, "\tHost:\t%s\n", probe.HostStatus(statuses...))
+	for _, p := range res.SMBPorts {
+		fmt.Fprintf(w, "\tPort %d:\t%s\n", p.Port, p.Status)
+	}
+	_ = w.Flush()
+	fmt.Println()
+}
+
+func printDetection(d *probe.Detection) {
+	fmt.Println("Service Detection:")
+	w := tabwriter.NewWriter(os.Stdout, 0, 0, 2, ' ', 0)
+	fmt.Fprintf(w, "\tPort %d:\t%s\n", d.Port, protocolTitle(d.Protocol))
+	switch {
+	case d.TLS:
+		fmt.Fprintf(w, "\tTransport:\tTLS\n")
+	case d.NetBIOS:
+		fmt.Fprintf(w, "\tTransport:\tNetBIOS session\n")
+	}
+	if d.Banner != "" {
+		fmt.Fprintf(w, "\tBanner:\t%s\n", d.Banner)
+	}
+	_ = w.Flush()
+	fmt.Println()
+}
+
+// printProbes prints one section per protocol, in the order the probes ran.
+func printProbes(results []probe.Result) {
+	var names []string
+	for _, r := range results {
+		if !slices.Contains(names, r.Protocol) {
+			names = append(names, r.Protocol)
+		}
+	}
+	for _, name := range names {
+		fmt.Printf("%s:\n", protocolTitle(name))
+		w := tabwriter.NewWriter(os.Stdout, 0, 0, 2, ' ', 0)
+		for _, r := range results {
+			if r.Protocol != name {
+				continue
+			}
+			if r.Challenge == nil && len(r.Extra) == 0 {
+				fmt.Fprintf(w, "\tPort %d:\tunavailable (%v)\n", r.Port, r.Err)
+				continue
+			}
+			fmt.Fprintf(w, "\tPort %d:\n", r.Port)
+			for _, f := range r.Extra {
+				fmt.Fprintf(w, "\t%s:\t%s\n", f.Name, f.Value)
+			}
+			if r.TLS != nil && len(r.TLS.PeerCertificates) > 0 {
+				fmt.Fprintf(w, "\tCertificate Subject:\t%s\n", r.TLS.PeerCertificates[0].Subject)
+			}
+			if r.Err != nil {
+				fmt.Fprintf(w, "\tNTLM:\tunavailable (%v)\n", r.Err)
+				continue
+			}
+			if v := r.Challenge.Version; v != nil {
+				fmt.Fprintf(w, "\tNTLM Build Version:\t%d.%d.%d\n", v.Major, v.Minor, v.Build)
+			}
+			if detail := r.Challenge.TargetInfo; detail != nil {
+				fmt.Fprintf(w, "\tNB Computer Name:\t%s\n", detail.Parse().NBComputerName)
+			}
+		}
+		_ = w.Flush()
+		fmt.Println()
+	}
+}
+
+func printNodeStatus(res *result) {
+	fmt.Println("NetBIOS Names:")
+	w := tabwriter.NewWriter(os.Stdout, 0, 0, 2, ' ', 0)
+	status := res.NodeStatus
+	if status == nil {
+		fmt.Fprintf(w, "\tNBSTAT:\tunavailable (%v)\n", res.NodeStatusErr)
+		_ = w.Flush()
+		fmt.Println()
+		return
+	}
+
+	var detail ntlmssp.AvDetail
+	if res.Challenge != nil && res.Challenge.TargetInfo != nil {
+		detail = *res.Challenge.TargetInfo.Parse()
+	}
+	fmt.Fprintf(w, "\tComputer Name:\t%s%s\n", status.ComputerName(), nameCrossCheck(status.ComputerName(), detail.NBComputerName))
+	fmt.Fprintf(w, "\tDomain Name:\t%s%s\n", status.DomainName(), nameCrossCheck(status.DomainName(), detail.NBDomainName))
+	if status.MAC != nil {
+		fmt.Fprintf(w, "\tMAC Address:\t%s\n", status.MAC)
+	}
+	for _, e := range status.Names {
+		kind := "UNIQUE"
+		if e.IsGroup() {
+			kind = "GROUP"
+		}
+		fmt.Fprintf(w, "\t%s\t%s\t%s\n", e, kind, e.Description())
+	}
+	_ = w.Flush()
+	fmt.Println()
+}
+
+// printReverseDNS lists the PTR names of the scanned address. A name that
+// differs from the NTLM DNS computer name often means stale DNS or NAT.
+func printReverseDNS(res *result) {
+	fmt.Println("Reverse DNS:")
+	w := tabwriter.NewWriter(os.Stdout, 0, 0, 2, ' ', 0)
+	var dnsName string
+	if res.Challenge != nil && res.Challenge.TargetInfo != nil {
+		dnsName = res.Challenge.TargetInfo.Parse().DNSComputerName
+	}
+	rdns := res.ReverseDNS
+	switch {
+	case rdns.Err != nil:
+		fmt.Fprintf(w, "\tPTR:\tunavailable (%v)\n", rdns.Err)
+	case len(rdns.Names) == 0:
+		fmt.Fprintf(w, "\tPTR:\tnone\n")
+	}
+	for _, name := range rdns.Names {
+		fmt.Fprintf(w, "\tPTR:\t%s%s\n", name, nameCrossCheck(name, dnsName))
+	}
+	_ = w.Flush()
+	fmt.Println()
+}
+
+// nameCrossCheck compares a name found another way, from the NBSTAT table
+// or a PTR record, with the matching name in the NTLM target info.
+func nameCrossCheck(name, ntlmName string) string {
+	if ntlmName == "" || name == "" {
+		return ""
+	}
+	if strings.EqualFold(name, ntlmName) {
+		return " (matches NTLM)"
+	}
+	return fmt.Sprintf(" (NTLM reports %s)", ntlmName)
+}
+
+// versionCrossCheck compares the OS version reported over SRVSVC with the
+// one in the NTLM challenge.
+func versionCrossCheck(major, minor uint32, version *ntlmssp.Version) string {
+	if version == nil {
+		return ""
+	}
+	if uint32(version.Major) == major && uint32(version.Minor) == minor {
+		return " (matches NTLM)"
+	}
+	return fmt.Sprintf(" (NTLM reports %d.%d)", version.Major, version.Minor)
+}
+
+func shareTypeName(shareType uint8) string {
+	switch shareType {
+	case v2.ShareTypeDisk:
+		return "disk"
+	case v2.ShareTypePipe:
+		return "pipe"
+	case v2.ShareTypePrint:
+		return "print"
+	default:
+		return fmt.Sprintf("0x%02x", shareType)
+	}
 }
 
 func printTargetInfo(w *tabwriter.Writer, targetInfo *ntlmssp.AvPairSlice) {
@@ -120,3 +1169,56 @@ func printTargetInfo(w *tabwriter.Writer, targetInfo *ntlmssp.AvPairSlice) {
 	fmt.Fprintf(w, "\tDNS Tree Name:\t%s\n", detail.DNSTreeName)
 	fmt.Fprintf(w, "\tTarget Name:\t%s\n", detail.TargetName)
 }
+
+// parsePorts parses a comma-separated port list; an empty list is allowed.
+func parsePorts(list string) ([]uint16, error) {
+	var ports []uint16
+	for p := range strings.SplitSeq(list, ",") {
+		if p = strings.TrimSpace(p); p == "" {
+			continue
+		}
+		n, err := strconv.ParseUint(p, 10, 16)
+		if err != nil || n == 0 {
+			return nil, fmt.Errorf("invalid port %q", p)
+		}
+		ports = append(ports, uint16(n))
+	}
+	return ports, nil
+}
+
+// portList is a flag.Value of a comma-separated port list.
+type portList []uint16
+
+func (l portList) String() string {
+	ports := make([]string, len(l))
+	for i, p := range l {
+		ports[i] = strconv.Itoa(int(p))
+	}
+	return strings.Join(ports, ",")
+}
+
+func (l *portList) Set(list string) error {
+	ports, err := parsePorts(list)
+	if err != nil {
+		return err
+	}
+	*l = ports
+	return nil
+}
+
+// mailPortList is a portList of ports with a known mail protocol.
+type mailPortList struct{ portList }
+
+func (l *mailPortList) Set(list string) error {
+	var ports portList
+	if err := ports.Set(list); err != nil {
+		return err
+	}
+	for _, p := range ports {
+		if _, ok := mail.ProtocolForPort(p); !ok {
+			return fmt.Errorf("no mail protocol known for port %d", p)
+		}
+	}
+	l.portList = ports
+	return nil
+}
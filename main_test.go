@@ -0,0 +1,171 @@
+package main
+
+import (
+	"net"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/d0rvin/winscope-smb/pkg/protocol/ntlmssp"
+	v2 "github.com/d0rvin/winscope-smb/pkg/protocol/smb/v2"
+	"github.com/d0rvin/winscope-smb/pkg/server"
+)
+
+// TestMain runs main instead of the tests when the binary is re-executed
+// by winscope.
+func TestMain(m *testing.M) {
+	if os.Getenv("WINSCOPE_SMB_MAIN") == "1" {
+		main()
+		os.Exit(0)
+	}
+	os.Exit(m.Run())
+}
+
+// winscope runs the command line with args and returns its output and
+// exit code.
+func winscope(t *testing.T, args ...string) (string, int) {
+	t.Helper()
+	cmd := exec.Command(os.Args[0], args...)
+	cmd.Env = append(os.Environ(), "WINSCOPE_SMB_MAIN=1")
+	out, err := cmd.CombinedOutput()
+	if exit, ok := err.(*exec.ExitError); ok {
+		return string(out), exit.ExitCode()
+	}
+	if err != nil {
+		t.Fatal(err)
+	}
+	return string(out), 0
+}
+
+// serve runs a server for Windows Server 2022 on a loopback port until the
+// test ends.
+func serve(t *testing.T, smb1 bool) string {
+	t.Helper()
+	srv := &server.Server{
+		SMB1:         smb1,
+		NativeOS:     "Windows Server 2003 3790 Service Pack 2",
+		NativeLanMan: "Windows Server 2003 5.2",
+		Dialects:     []uint16{v2.DialectSmb_2_1, v2.DialectSmb_3_0_2, v2.DialectSmb_3_1_1},
+		SecurityMode: v2.SecurityModeSigningEnabled,
+		Version:      ntlmssp.Version{Major: 10, Build: 20348},
+		TargetName:   "CORP",
+		AvPairs: ntlmssp.NewAvPairs(ntlmssp.AvDetail{
+			NBDomainName:    "CORP",
+			NBComputerName:  "WEB01",
+			DNSDomainName:   "corp.example",
+			DNSComputerName: "web01.corp.example",
+			Time:            time.Now(),
+		}),
+	}
+	if !smb1 {
+		srv.NativeOS, srv.NativeLanMan = "", ""
+	}
+	ln, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		t.Fatal(err)
+	}
+	t.Cleanup(func() { ln.Close() })
+	go srv.Serve(ln)
+	return strconv.Itoa(ln.Addr().(*net.TCPAddr).Port)
+}
+
+func TestSMB(t *testing.T) {
+	port := serve(t, false)
+	out, code := winscope(t, "-host", "127.0.0.1", "-protocols", "smb", "-port", port)
+	assert.Equal(t, 0, code, out)
+	assert.Regexp(t, `Windows Build Version:\s+10\.0\.20348`, out)
+	assert.Regexp(t, `NB Computer Name:\s+WEB01`, out)
+	assert.Regexp(t, `DNS Computer Name:\s+web01\.corp\.example`, out)
+	assert.NotContains(t, out, "Native OS:")
+}
+
+func TestSMB1(t *testing.T) {
+	port := serve(t, true)
+	out, code := winscope(t, "-host", "127.0.0.1", "-protocols", "smb", "-port", port)
+	assert.Equal(t, 0, code, out)
+	assert.Regexp(t, `Native OS:\s+Windows Server 2003 3790 Service Pack 2`, out)
+	assert.Regexp(t, `NB Computer Name:\s+WEB01`, out)
+}
+
+func TestDetect(t *testing.T) {
+	if testing.Short() {
+		t.Skip("waits for a banner")
+	}
+	port := serve(t, false)
+	out, code := winscope(t, "-host", "127.0.0.1", "-port", port)
+	assert.Equal(t, 0, code, out)
+	assert.Regexp(t, `NB Computer Name:\s+WEB01`, out)
+}
+
+func TestRecordReplay(t *testing.T) {
+	port := serve(t, false)
+	file := filepath.Join(t.TempDir(), "scan.json")
+	recorded, code := winscope(t, "-host", "127.0.0.1", "-protocols", "smb", "-port", port, "-record", file)
+	if !assert.Equal(t, 0, code, recorded) {
+		return
+	}
+
+	// The same scan, answered from the transcript alone.
+	out, code := winscope(t, "-host", "127.0.0.1", "-protocols", "smb", "-port", "1", "-replay", file)
+	assert.NotEqual(t, 0, code, out)
+	out, code = winscope(t, "-host", "127.0.0.1", "-protocols", "smb", "-port", port, "-replay", file)
+	assert.Equal(t, 0, code, out)
+	assert.Regexp(t, `NB Computer Name:\s+WEB01`, out)
+}
+
+func TestPcap(t *testing.T) {
+	port := serve(t, false)
+	file := filepath.Join(t.TempDir(), "scan.pcapng")
+	out, code := winscope(t, "-host", "127.0.0.1", "-protocols", "smb", "-port", port, "-pcap", file)
+	if !assert.Equal(t, 0, code, out) {
+		return
+	}
+	out, code = winscope(t, "pcap", file)
+	assert.Equal(t, 0, code, out)
+	assert.Regexp(t, `NB Computer Name:\s+WEB01`, out)
+}
+
+func TestHoneypot(t *testing.T) {
+	ln, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		t.Fatal(err)
+	}
+	addr := ln.Addr().String()
+	ln.Close()
+	log := filepath.Join(t.TempDir(), "honeypot.log")
+
+	cmd := exec.Command(os.Args[0], "honeypot", "-profile", "2012r2-dc", "-name", "dc01", "-domain", "corp.example", "-listen", addr, "-log", log)
+	cmd.Env = append(os.Environ(), "WINSCOPE_SMB_MAIN=1")
+	if err := cmd.Start(); err != nil {
+		t.Fatal(err)
+	}
+	defer cmd.Wait()
+	defer cmd.Process.Kill()
+	for range 100 {
+		if c, err := net.Dial("tcp", addr); err == nil {
+			c.Close()
+			break
+		}
+		time.Sleep(10 * time.Millisecond)
+	}
+
+	_, port, _ := net.SplitHostPort(addr)
+	out, code := winscope(t, "-host", "127.0.0.1", "-protocols", "smb", "-port", port)
+	assert.Equal(t, 0, code, out)
+	assert.Regexp(t, `Native OS:\s+Windows Server 2012 R2 Standard 9600`, out)
+	assert.Regexp(t, `Windows Build Version:\s+6\.3\.9600`, out)
+	assert.Regexp(t, `DNS Computer Name:\s+dc01\.corp\.example`, out)
+
+	// The readiness check above is logged too, without any fields.
+	assert.Eventually(t, func() bool {
+		buf, _ := os.ReadFile(log)
+		return strings.Contains(string(buf), ` smb1="NT LM 0.12" ntlm-flags=0x`) &&
+			strings.Contains(string(buf), ` software="winscope-smb"`)
+	}, time.Second, 10*time.Millisecond)
+}
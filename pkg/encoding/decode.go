@@ -114,12 +114,8 @@ func unmarshalUint16(buf []byte, meta *Metadata) (any, error) {
 	if err := binary.Read(bytes.NewBuffer(buf), binary.LittleEndian, &ret); err != nil {
 		return nil, err
 	}
-	if meta.Tags.Has("len") {
-		ref, err := meta.Tags.GetString("len")
-		if err != nil {
-			return nil, err
-		}
-		meta.Lens[ref] = int(ret)
+	if err := recordLenAndOffset(int(ret), meta); err != nil {
+		return nil, err
 	}
 	meta.CurrOffset += binary.Size(ret)
 	return ret, nil
@@ -130,15 +126,29 @@ func unmarshalUint32(buf []byte, meta *Metadata) (any, error) {
 	if err := binary.Read(bytes.NewBuffer(buf), binary.LittleEndian, &ret); err != nil {
 		return nil, err
 	}
+	if err := recordLenAndOffset(int(ret), meta); err != nil {
+		return nil, err
+	}
+	meta.CurrOffset += binary.Size(ret)
+	return ret, nil
+}
+
+func recordLenAndOffset(val int, meta *Metadata) error {
+	if meta.Tags.Has(TagLen) {
+		ref, err := meta.Tags.GetString(TagLen)
+		if err != nil {
+			return err
+		}
+		meta.Lens[ref] = val
+	}
 	if meta.Tags.Has(TagOffset) {
 		ref, err := meta.Tags.GetString(TagOffset)
 		if err != nil {
-			return nil, err
+			return err
 		}
-		meta.Offsets[ref] = int(ret)
+		meta.Offsets[ref] = val
 	}
-	meta.CurrOffset += binary.Size(ret)
-	return ret, nil
+	return nil
 }
 
 func unmarshalUint64(buf []byte, meta *Metadata) (any, error) {
@@ -192,7 +202,12 @@ func unmarshalStruct(buf []byte, typev reflect.Type, valuev reflect.Value, meta
 		if err != nil {
 			return nil, err
 		}
-		valuev.Field(i).Set(reflect.ValueOf(data))
+		dv := reflect.ValueOf(data)
+		if dv.Type() != field.Type && dv.Kind() == field.Type.Kind() && dv.CanConvert(field.Type) {
+			// Named integer types, e.g. ntstatus.Status.
+			dv = dv.Convert(field.Type)
+		}
+		valuev.Field(i).Set(dv)
 	}
 
 	result := reflect.Indirect(reflect.ValueOf(v)).Interface()
@@ -6,6 +6,7 @@ import (
 
 	"github.com/d0rvin/winscope-smb/pkg/protocol/gss"
 	"github.com/d0rvin/winscope-smb/pkg/protocol/ntlmssp"
+	"github.com/d0rvin/winscope-smb/pkg/protocol/ntstatus"
 
 	"github.com/d0rvin/winscope-smb/pkg/encoding"
 	"github.com/stretchr/testify/assert"
@@ -314,6 +315,18 @@ func TestUnmarshal_Struct(t *testing.T) {
 	}
 }
 
+type TestDecodeStructWithNamedUint struct {
+	A uint8
+	S ntstatus.Status
+}
+
+func TestUnmarshal_StructWithNamedUint(t *testing.T) {
+	var got TestDecodeStructWithNamedUint
+	err := encoding.Unmarshal([]byte{0x01, 0x22, 0x00, 0x00, 0xc0}, &got)
+	assert.NoError(t, err)
+	assert.Equal(t, TestDecodeStructWithNamedUint{A: 0x01, S: ntstatus.AccessDenied}, got)
+}
+
 type TestDecodeStructWithFixedSlice struct {
 	A uint8
 	B []byte `smb:"fixed:4"`
@@ -387,6 +400,55 @@ func TestUnmarshal_StructWithLenField(t *testing.T) {
 	}
 }
 
+type TestDecodeStructWithOffsetField struct {
+	DataOffset uint16 `smb:"offset:Data"`
+	DataLen    uint32 `smb:"len:Data"`
+	Data       []byte
+}
+
+func TestUnmarshal_StructWithOffsetField(t *testing.T) {
+	tests := []struct {
+		name    string
+		buf     []byte
+		want    TestDecodeStructWithOffsetField
+		wantErr bool
+	}{
+		{
+			name: "adjacent",
+			buf:  []byte{0x06, 0x00, 0x02, 0x00, 0x00, 0x00, 0x01, 0x02},
+			want: TestDecodeStructWithOffsetField{
+				Data: []byte{0x01, 0x02},
+			},
+			wantErr: false,
+		},
+		{
+			name: "padded",
+			buf:  []byte{0x08, 0x00, 0x02, 0x00, 0x00, 0x00, 0x00, 0x00, 0x01, 0x02},
+			want: TestDecodeStructWithOffsetField{
+				Data: []byte{0x01, 0x02},
+			},
+			wantErr: false,
+		},
+		{
+			name:    "out of bounds",
+			buf:     []byte{0x08, 0x00, 0x04, 0x00, 0x00, 0x00, 0x00, 0x00, 0x01, 0x02},
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var got TestDecodeStructWithOffsetField
+			err := encoding.Unmarshal(tt.buf, &got)
+			if tt.wantErr {
+				assert.Error(t, err)
+			} else {
+				assert.NoError(t, err)
+				assert.Equal(t, tt.want.Data, got.Data)
+			}
+		})
+	}
+}
+
 type TestDecodeCustomMarshal struct {
 	Value uint16
 }
@@ -35,6 +35,8 @@ func marshal(v any, meta *Metadata) ([]byte, error) {
 		return marshalUint32(valuev, meta)
 	case reflect.Uint64:
 		return marshalUint64(valuev)
+	case reflect.String:
+		return marshalString(valuev)
 	default:
 		return nil, fmt.Errorf("marshal not implemented for kind: %s", typev.Kind())
 	}
@@ -107,10 +109,7 @@ func marshalSlice(typev reflect.Type, v any) ([]byte, error) {
 }
 
 func marshalUint8(valuev reflect.Value) ([]byte, error) {
-	val, ok := valuev.Interface().(uint8)
-	if !ok {
-		return nil, fmt.Errorf("invalid type for uint8: %T", valuev.Interface())
-	}
+	val := uint8(valuev.Uint())
 	w := bytes.NewBuffer(nil)
 	if err := binary.Write(w, binary.LittleEndian, val); err != nil {
 		return nil, err
@@ -119,10 +118,7 @@ func marshalUint8(valuev reflect.Value) ([]byte, error) {
 }
 
 func marshalUint16(valuev reflect.Value, meta *Metadata) ([]byte, error) {
-	data, ok := valuev.Interface().(uint16)
-	if !ok {
-		return nil, fmt.Errorf("invalid type for uint16: %T", valuev.Interface())
-	}
+	data := uint16(valuev.Uint())
 	if meta != nil {
 		if meta.Tags.Has("len") {
 			fieldName, err := meta.Tags.GetString("len")
@@ -155,10 +151,7 @@ func marshalUint16(valuev reflect.Value, meta *Metadata) ([]byte, error) {
 }
 
 func marshalUint32(valuev reflect.Value, meta *Metadata) ([]byte, error) {
-	data, ok := valuev.Interface().(uint32)
-	if !ok {
-		return nil, fmt.Errorf("invalid type for uint32: %T", valuev.Interface())
-	}
+	data := uint32(valuev.Uint())
 	if meta != nil {
 		if meta.Tags.Has("len") {
 			fieldName, err := meta.Tags.GetString("len")
@@ -191,10 +184,7 @@ func marshalUint32(valuev reflect.Value, meta *Metadata) ([]byte, error) {
 }
 
 func marshalUint64(valuev reflect.Value) ([]byte, error) {
-	val, ok := valuev.Interface().(uint64)
-	if !ok {
-		return nil, fmt.Errorf("invalid type for uint64: %T", valuev.Interface())
-	}
+	val := uint64(valuev.Uint())
 	w := bytes.NewBuffer(nil)
 	if err := binary.Write(w, binary.LittleEndian, val); err != nil {
 		return nil, err
@@ -202,6 +192,12 @@ func marshalUint64(valuev reflect.Value) ([]byte, error) {
 	return w.Bytes(), nil
 }
 
+// marshalString writes a NUL-terminated OEM string, as unmarshalString
+// reads it.
+func marshalString(valuev reflect.Value) ([]byte, error) {
+	return append([]byte(valuev.String()), 0), nil
+}
+
 func getOffsetByFieldName(fieldName string, meta *Metadata) (int, error) {
 	if meta == nil || meta.Tags == nil || meta.Parent == nil || meta.Lens == nil {
 		return 0, errors.New("cannot determine field offset. missing required metadata")
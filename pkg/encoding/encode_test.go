@@ -7,6 +7,7 @@ import (
 
 	"github.com/d0rvin/winscope-smb/pkg/protocol/gss"
 	"github.com/d0rvin/winscope-smb/pkg/protocol/ntlmssp"
+	"github.com/d0rvin/winscope-smb/pkg/protocol/ntstatus"
 
 	"github.com/d0rvin/winscope-smb/pkg/encoding"
 	"github.com/stretchr/testify/assert"
@@ -168,6 +169,39 @@ func TestMarshal_Uint64(t *testing.T) {
 	}
 }
 
+func TestMarshal_String(t *testing.T) {
+	tests := []struct {
+		name    string
+		v       string
+		want    []byte
+		wantErr bool
+	}{
+		{
+			name:    "empty",
+			v:       "",
+			want:    []byte{0x00},
+			wantErr: false,
+		},
+		{
+			name:    "oem",
+			v:       "Samba",
+			want:    []byte{'S', 'a', 'm', 'b', 'a', 0x00},
+			wantErr: false,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := encoding.Marshal(tt.v)
+			if tt.wantErr {
+				assert.Error(t, err)
+			} else {
+				assert.NoError(t, err)
+				assert.Equal(t, tt.want, got)
+			}
+		})
+	}
+}
+
 func TestMarshal_Slice_Uint8(t *testing.T) {
 	tests := []struct {
 		name    string
@@ -289,6 +323,17 @@ func TestMarshal_Struct(t *testing.T) {
 	}
 }
 
+type TestStructWithNamedUint struct {
+	A uint8
+	S ntstatus.Status
+}
+
+func TestMarshal_StructWithNamedUint(t *testing.T) {
+	got, err := encoding.Marshal(TestStructWithNamedUint{A: 0x01, S: ntstatus.AccessDenied})
+	assert.NoError(t, err)
+	assert.Equal(t, []byte{0x01, 0x22, 0x00, 0x00, 0xc0}, got)
+}
+
 type TestStructWithFixedSlice struct {
 	A uint8
 	B []byte `smb:"fixed:4"`
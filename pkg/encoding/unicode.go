@@ -0,0 +1,23 @@
+package encoding
+
+import (
+	"encoding/binary"
+	"unicode/utf16"
+)
+
+func ToUnicode(s string) []byte {
+	codes := utf16.Encode([]rune(s))
+	buf := make([]byte, len(codes)*2)
+	for i, code := range codes {
+		binary.LittleEndian.PutUint16(buf[i*2:], code)
+	}
+	return buf
+}
+
+func FromUnicode(buf []byte) string {
+	codes := make([]uint16, len(buf)/2)
+	for i := range codes {
+		codes[i] = binary.LittleEndian.Uint16(buf[i*2:])
+	}
+	return string(utf16.Decode(codes))
+}
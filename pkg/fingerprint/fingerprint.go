@@ -0,0 +1,168 @@
+package fingerprint
+
+import (
+	"fmt"
+	"slices"
+
+	"github.com/d0rvin/winscope-smb/pkg/protocol/ntlmssp"
+	v1 "github.com/d0rvin/winscope-smb/pkg/protocol/smb/v1"
+	v2 "github.com/d0rvin/winscope-smb/pkg/protocol/smb/v2"
+)
+
+// Client is what an SMB client sent before authenticating: its SMBv1 and
+// SMB2 NEGOTIATE requests and its NTLM NEGOTIATE message, any of which may
+// be missing, e.g. because the server hung up first.
+type Client struct {
+	SMB1 *v1.NegotiateReq
+	SMB2 *v2.NegotiateReq311
+	NTLM *ntlmssp.Negotiate
+	// NTLMVersion is the version in the NTLM NEGOTIATE, if any.
+	NTLMVersion *ntlmssp.Version
+}
+
+// SMB1Dialects returns the dialects of the SMBv1 NEGOTIATE, if any.
+func (c *Client) SMB1Dialects() []string {
+	if c.SMB1 == nil {
+		return nil
+	}
+	return c.SMB1.DialectStrings()
+}
+
+// Match is the client software a signature recognised.
+type Match struct {
+	// Name is the software, e.g. "Windows", "Samba" or "impacket".
+	Name string
+	// Detail narrows it down where the messages allow, e.g. to a Windows
+	// version.
+	Detail string
+}
+
+func (m Match) String() string {
+	if m.Detail == "" {
+		return m.Name
+	}
+	return fmt.Sprintf("%s (%s)", m.Name, m.Detail)
+}
+
+// Signature recognises one client. Match returns the detail to report, if
+// any, and whether c is that client.
+type Signature struct {
+	Name  string
+	Match func(c *Client) (string, bool)
+}
+
+// Signatures are tried in order by Classify. Tools come before Windows,
+// whose SMBv1 dialect list several of them copy.
+var Signatures = []Signature{
+	{Name: "winscope-smb", Match: winscopeSMB},
+	{Name: "nmap", Match: nmap},
+	{Name: "Samba", Match: samba},
+	{Name: "impacket", Match: impacket},
+	{Name: "CrackMapExec/NetExec", Match: crackMapExec},
+	{Name: "Windows", Match: windows},
+}
+
+// Classify returns the first of Signatures that recognises c.
+func Classify(c *Client) (Match, bool) {
+	for _, s := range Signatures {
+		if detail, ok := s.Match(c); ok {
+			return Match{Name: s.Name, Detail: detail}, true
+		}
+	}
+	return Match{}, false
+}
+
+var (
+	// windowsXPDialects are offered by Windows 2000, XP and Server 2003;
+	// Vista, 7 and Server 2008 add SMB 2.002 and SMB 2.???.
+	windowsXPDialects = []string{
+		"PC NETWORK PROGRAM 1.0",
+		"LANMAN1.0",
+		"Windows for Workgroups 3.1a",
+		"LM1.2X002",
+		"LANMAN2.1",
+		"NT LM 0.12",
+	}
+	windowsVistaDialects = append(slices.Clip(windowsXPDialects), v1.DialectSmb2002, v1.DialectSmb2Unknown)
+)
+
+// nmapGUID is the client GUID of the SMB2 NEGOTIATE sent by nmap scripts.
+const nmapGUID = "1234567890123456"
+
+// winscopeSMB matches this tool: an SMBv1 NEGOTIATE from PID 0xc744
+// offering NT LM 0.12 alone, an SMB2 NEGOTIATE with a zero client GUID
+// offering SMB 2.1 or, over QUIC, 3.1.1 alone, or an NTLM NEGOTIATE that
+// claims an OEM domain but names none.
+func winscopeSMB(c *Client) (string, bool) {
+	if c.SMB1 != nil && c.SMB1.PIDLow == 0xc744 && slices.Equal(c.SMB1Dialects(), []string{string(v1.DialectSmb1)}) {
+		return "", true
+	}
+	if c.SMB2 != nil && !slices.ContainsFunc(c.SMB2.ClientGuid, func(b byte) bool { return b != 0 }) &&
+		(slices.Equal(c.SMB2.Dialects, []uint16{v2.DialectSmb_2_1}) || slices.Equal(c.SMB2.Dialects, []uint16{v2.DialectSmb_3_1_1})) {
+		return "", true
+	}
+	if c.NTLM != nil && c.NTLM.NegotiateFlags == ntlmssp.NewNegotiate("", "").NegotiateFlags && len(c.NTLM.DomainName) == 0 {
+		return "", true
+	}
+	return "", false
+}
+
+func nmap(c *Client) (string, bool) {
+	return "", c.SMB2 != nil && string(c.SMB2.ClientGuid) == nmapGUID
+}
+
+// samba matches the "Samba" SMBv1 dialect and the NTLM version 6.1.0 that
+// Samba's client claims.
+func samba(c *Client) (string, bool) {
+	if slices.Contains(c.SMB1Dialects(), "Samba") {
+		return "smbclient or libsmbclient", true
+	}
+	if v := c.NTLMVersion; v != nil && v.Major == 6 && v.Minor == 1 && v.Build == 0 {
+		return "smbclient or libsmbclient", true
+	}
+	return "", false
+}
+
+// impacket matches the client GUID impacket draws from ASCII letters.
+func impacket(c *Client) (string, bool) {
+	if c.SMB2 == nil || len(c.SMB2.ClientGuid) != 16 {
+		return "", false
+	}
+	for _, b := range c.SMB2.ClientGuid {
+		if (b < 'a' || b > 'z') && (b < 'A' || b > 'Z') {
+			return "", false
+		}
+	}
+	return "", true
+}
+
+// crackMapExec matches impacket's SMBv1 client, which offers NT LM 0.12
+// alone and sends no NTLM version. CrackMapExec and NetExec connect with
+// it first to read the OS strings of SMBv1 servers.
+func crackMapExec(c *Client) (string, bool) {
+	if !slices.Equal(c.SMB1Dialects(), []string{string(v1.DialectSmb1)}) {
+		return "", false
+	}
+	if c.NTLM != nil && c.NTLM.NegotiateFlags&ntlmssp.FlgNegVersion != 0 {
+		return "", false
+	}
+	return "", true
+}
+
+// windows matches the NTLM version Windows sends, which names its build,
+// or the SMBv1 dialect lists of older releases.
+func windows(c *Client) (string, bool) {
+	if v := c.NTLMVersion; v != nil && v.Build != 0 {
+		if os, ok := v.ParseToOS(); ok {
+			return fmt.Sprintf("%s, %d.%d.%d", os, v.Major, v.Minor, v.Build), true
+		}
+		return fmt.Sprintf("%d.%d.%d", v.Major, v.Minor, v.Build), true
+	}
+	switch dialects := c.SMB1Dialects(); {
+	case slices.Equal(dialects, windowsXPDialects):
+		return "2000, XP or Server 2003", true
+	case slices.Equal(dialects, windowsVistaDialects):
+		return "Vista, 7 or Server 2008", true
+	}
+	return "", false
+}
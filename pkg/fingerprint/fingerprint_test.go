@@ -0,0 +1,122 @@
+package fingerprint_test
+
+import (
+	"encoding/binary"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/d0rvin/winscope-smb/pkg/encoding"
+	"github.com/d0rvin/winscope-smb/pkg/fingerprint"
+	"github.com/d0rvin/winscope-smb/pkg/protocol/ntlmssp"
+	v1 "github.com/d0rvin/winscope-smb/pkg/protocol/smb/v1"
+	v2 "github.com/d0rvin/winscope-smb/pkg/protocol/smb/v2"
+)
+
+// smb1 returns a parsed SMBv1 NEGOTIATE offering dialects from pid.
+func smb1(t *testing.T, pid uint16, dialects ...string) *v1.NegotiateReq {
+	t.Helper()
+	req := v1.NewNegotiateReq()
+	req.PIDLow = pid
+	req.Dialects = nil
+	for _, d := range dialects {
+		req.Dialects = append(append(append(req.Dialects, 0x02), d...), 0)
+	}
+	req.ByteCount = uint16(len(req.Dialects))
+	buf, err := encoding.Marshal(&req)
+	if err != nil {
+		t.Fatal(err)
+	}
+	neg, err := v1.ParseNegotiateReq(buf)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return neg
+}
+
+// smb2 returns a parsed SMB2 NEGOTIATE with the client GUID guid offering
+// dialects.
+func smb2(t *testing.T, guid string, dialects ...uint16) *v2.NegotiateReq311 {
+	t.Helper()
+	req := v2.NewNegotiateReq(0)
+	req.ClientGuid = []byte(guid)
+	req.DialectCount = uint16(len(dialects))
+	req.Dialects = dialects
+	buf, err := encoding.Marshal(&req)
+	if err != nil {
+		t.Fatal(err)
+	}
+	neg, err := v2.ParseNegotiateReq(buf)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return neg
+}
+
+// ntlm returns a parsed NTLM NEGOTIATE with flags and, if major is not 0,
+// a version.
+func ntlm(t *testing.T, flags uint32, major, minor uint8, build uint16) (*ntlmssp.Negotiate, *ntlmssp.Version) {
+	t.Helper()
+	buf := []byte(ntlmssp.Signature)
+	buf = binary.LittleEndian.AppendUint32(buf, ntlmssp.TypeNtLmNegotiate)
+	if major != 0 {
+		flags |= ntlmssp.FlgNegVersion
+	}
+	buf = binary.LittleEndian.AppendUint32(buf, flags)
+	buf = append(buf, make([]byte, 16)...)
+	if major != 0 {
+		buf = binary.LittleEndian.AppendUint16(append(buf, major, minor), build)
+		buf = append(buf, 0, 0, 0, 15)
+	}
+	neg, version, err := ntlmssp.ParseNegotiate(buf)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return neg, version
+}
+
+func TestClassify(t *testing.T) {
+	windowsXP := []string{"PC NETWORK PROGRAM 1.0", "LANMAN1.0", "Windows for Workgroups 3.1a", "LM1.2X002", "LANMAN2.1", "NT LM 0.12"}
+	random := "\x8e\x1d\x93\x52\x0b\x71\x4c\x4e\xa6\x3c\x12\x5f\x9e\x07\xd2\x31"
+	windows10, windows10Version := ntlm(t, 0xe2088297, 10, 0, 19045)
+	samba, sambaVersion := ntlm(t, 0x62088215, 6, 1, 0)
+	impacket, _ := ntlm(t, 0xe0888215, 0, 0, 0)
+	own := ntlmssp.NewNegotiate("", "")
+
+	for _, tt := range []struct {
+		name   string
+		client fingerprint.Client
+		want   string
+	}{
+		{"own SMBv1", fingerprint.Client{SMB1: smb1(t, 0xc744, "NT LM 0.12")}, "winscope-smb"},
+		{"own SMB2", fingerprint.Client{SMB2: smb2(t, string(make([]byte, 16)), v2.DialectSmb_2_1)}, "winscope-smb"},
+		{"own NTLM", fingerprint.Client{NTLM: &own}, "winscope-smb"},
+		{"nmap", fingerprint.Client{SMB2: smb2(t, "1234567890123456", v2.DialectSmb_2_0_2)}, "nmap"},
+		{"smbclient dialects", fingerprint.Client{SMB1: smb1(t, 1, "PC NETWORK PROGRAM 1.0", "Samba", "NT LM 0.12", "SMB 2.002", "SMB 2.???")}, "Samba (smbclient or libsmbclient)"},
+		{"smbclient NTLM", fingerprint.Client{
+			SMB2: smb2(t, random, v2.DialectSmb_2_0_2, v2.DialectSmb_2_1, v2.DialectSmb_3_0, v2.DialectSmb_3_0_2, v2.DialectSmb_3_1_1),
+			NTLM: samba, NTLMVersion: sambaVersion,
+		}, "Samba (smbclient or libsmbclient)"},
+		{"impacket", fingerprint.Client{
+			SMB2: smb2(t, "qWeRtYuIoPaSdFgH", v2.DialectSmb_2_0_2, v2.DialectSmb_2_1, v2.DialectSmb_3_0),
+			NTLM: impacket,
+		}, "impacket"},
+		{"CrackMapExec", fingerprint.Client{SMB1: smb1(t, 0x3412, "NT LM 0.12"), NTLM: impacket}, "CrackMapExec/NetExec"},
+		{"Windows 10", fingerprint.Client{
+			SMB1: smb1(t, 0xfeff, "NT LM 0.12", "SMB 2.002", "SMB 2.???"),
+			NTLM: windows10, NTLMVersion: windows10Version,
+		}, "Windows (Windows 10, Version 22H2, 10.0.19045)"},
+		{"Windows XP", fingerprint.Client{SMB1: smb1(t, 0xfeff, windowsXP...)}, "Windows (2000, XP or Server 2003)"},
+		{"Windows 7", fingerprint.Client{SMB1: smb1(t, 0xfeff, append(windowsXP, "SMB 2.002", "SMB 2.???")...)}, "Windows (Vista, 7 or Server 2008)"},
+	} {
+		t.Run(tt.name, func(t *testing.T) {
+			m, ok := fingerprint.Classify(&tt.client)
+			assert.True(t, ok)
+			assert.Equal(t, tt.want, m.String())
+		})
+	}
+
+	// An SMB2 NEGOTIATE alone says little.
+	_, ok := fingerprint.Classify(&fingerprint.Client{SMB2: smb2(t, random, v2.DialectSmb_2_1, v2.DialectSmb_3_0)})
+	assert.False(t, ok)
+}
@@ -0,0 +1,202 @@
+package pcap
+
+import (
+	"net/netip"
+	"time"
+)
+
+// DefaultMaxStreamBytes is the payload an Assembler keeps for each
+// direction of a connection by default. Authentication happens in the
+// first few messages, so this is plenty while keeping memory bounded on
+// large captures.
+const DefaultMaxStreamBytes = 256 << 10
+
+// Stream is the reassembled payload of a TCP connection.
+type Stream struct {
+	Client, Server netip.AddrPort
+	Start          time.Time
+	// ClientData and ServerData are what each side sent, in order, up to
+	// the assembler's limit and to the first gap that was never filled.
+	ClientData, ServerData []byte
+}
+
+// Assembler reassembles the TCP streams of a capture from its segments. It
+// handles retransmissions and reordering but not, for simplicity, data
+// never captured: a stream stops at its first unfilled gap.
+type Assembler struct {
+	// MaxBytes bounds the payload kept for each direction; 0 means
+	// DefaultMaxStreamBytes.
+	MaxBytes int
+	// Done is called with each stream when it closes or is flushed.
+	Done func(*Stream)
+
+	flows map[flowKey]*flow
+	order []flowKey
+}
+
+// flowKey names a connection irrespective of direction.
+type flowKey struct {
+	a, b netip.AddrPort
+}
+
+func keyOf(src, dst netip.AddrPort) flowKey {
+	if src.Compare(dst) > 0 {
+		src, dst = dst, src
+	}
+	return flowKey{src, dst}
+}
+
+type flow struct {
+	stream         Stream
+	client, server half
+}
+
+// half is one direction of a connection.
+type half struct {
+	started bool
+	next    uint32
+	data    []byte
+	pending map[uint32][]byte
+	fin     bool
+}
+
+// Add feeds a segment to the assembler.
+func (a *Assembler) Add(seg *Segment) {
+	if a.flows == nil {
+		a.flows = map[flowKey]*flow{}
+	}
+	key := keyOf(seg.Src, seg.Dst)
+	f := a.flows[key]
+	if f != nil && seg.SYN && !seg.ACK && f.client.started && seg.Seq+1 != f.client.next {
+		// The 4-tuple is reused for a new connection.
+		a.finish(key, f)
+		f = nil
+	}
+	if f == nil {
+		// A segment without data or SYN after the connection closed
+		// starts nothing.
+		if !seg.SYN && len(seg.Payload) == 0 {
+			return
+		}
+		f = &flow{stream: Stream{Client: seg.Src, Server: seg.Dst, Start: seg.Time}}
+		switch {
+		case seg.SYN && seg.ACK:
+			f.stream.Client, f.stream.Server = seg.Dst, seg.Src
+		case seg.SYN:
+		case seg.Src.Port() < seg.Dst.Port():
+			// Mid-stream: the lower port is most likely the service.
+			f.stream.Client, f.stream.Server = seg.Dst, seg.Src
+		}
+		a.flows[key] = f
+		a.order = append(a.order, key)
+	}
+
+	h := &f.server
+	if seg.Src == f.stream.Client {
+		h = &f.client
+	}
+	h.add(seg, a.limit())
+	if seg.RST || f.client.fin && f.server.fin {
+		a.finish(key, f)
+	}
+}
+
+func (a *Assembler) limit() int {
+	if a.MaxBytes > 0 {
+		return a.MaxBytes
+	}
+	return DefaultMaxStreamBytes
+}
+
+// Flush hands every open stream to Done, in the order they started.
+func (a *Assembler) Flush() {
+	order := a.order
+	a.order = nil
+	for _, key := range order {
+		if f, ok := a.flows[key]; ok {
+			a.finish(key, f)
+		}
+	}
+}
+
+func (a *Assembler) finish(key flowKey, f *flow) {
+	delete(a.flows, key)
+	if len(a.order) > 2*len(a.flows)+1024 {
+		// Forget closed streams so that long captures don't grow order.
+		open := a.order[:0]
+		for _, k := range a.order {
+			if _, ok := a.flows[k]; ok {
+				open = append(open, k)
+			}
+		}
+		a.order = open
+	}
+	f.stream.ClientData, f.stream.ServerData = f.client.data, f.server.data
+	if a.Done != nil && (len(f.stream.ClientData) > 0 || len(f.stream.ServerData) > 0) {
+		a.Done(&f.stream)
+	}
+}
+
+func (h *half) add(seg *Segment, limit int) {
+	if !h.started {
+		h.started = true
+		h.next = seg.Seq
+		if seg.SYN {
+			h.next++
+		}
+	}
+	seq := seg.Seq
+	if seg.SYN {
+		seq++
+	}
+	if seg.FIN {
+		h.fin = true
+	}
+	if len(seg.Payload) == 0 || len(h.data) >= limit {
+		return
+	}
+
+	diff := int32(seq - h.next)
+	switch {
+	case diff > 0:
+		// Out of order: keep it until the gap is filled, within the
+		// limit.
+		if int(diff) < limit && h.pending[seq] == nil {
+			if h.pending == nil {
+				h.pending = map[uint32][]byte{}
+			}
+			h.pending[seq] = append([]byte(nil), seg.Payload...)
+		}
+		return
+	case int(-diff) >= len(seg.Payload):
+		// A retransmission of data already seen.
+		return
+	}
+	h.append(seg.Payload[-diff:], limit)
+
+	for len(h.pending) > 0 && len(h.data) < limit {
+		progress := false
+		for seq, data := range h.pending {
+			diff := int32(seq - h.next)
+			if diff > 0 {
+				continue
+			}
+			delete(h.pending, seq)
+			if int(-diff) < len(data) {
+				h.append(data[-diff:], limit)
+			}
+			progress = true
+		}
+		if !progress {
+			break
+		}
+	}
+}
+
+func (h *half) append(data []byte, limit int) {
+	h.next += uint32(len(data))
+	if room := limit - len(h.data); len(data) > room {
+		data = data[:room]
+	}
+	h.data = append(h.data, data...)
+}
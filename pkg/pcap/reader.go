@@ -0,0 +1,243 @@
+package pcap
+
+import (
+	"bufio"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"io"
+	"math"
+	"math/bits"
+	"time"
+)
+
+// LinkType is the link-layer header type of captured packets, as numbered
+// by tcpdump.org.
+type LinkType uint16
+
+const (
+	LinkTypeNull      LinkType = 0
+	LinkTypeEthernet  LinkType = 1
+	LinkTypeRaw       LinkType = 101
+	LinkTypeLoop      LinkType = 108
+	LinkTypeLinuxSLL  LinkType = 113
+	LinkTypeIPv4      LinkType = 228
+	LinkTypeIPv6      LinkType = 229
+	LinkTypeLinuxSLL2 LinkType = 276
+)
+
+const (
+	magicMicroseconds = 0xa1b2c3d4
+	magicNanoseconds  = 0xa1b23c4d
+
+	blockSimplePacket = 0x00000003
+
+	optionEnd     = 0
+	optionTSResol = 9
+
+	// maxBlock bounds the memory a corrupt length can make the reader
+	// allocate.
+	maxBlock = 16 << 20
+)
+
+// Packet is a captured packet.
+type Packet struct {
+	Time     time.Time
+	LinkType LinkType
+	Data     []byte
+}
+
+// Reader reads the packets of a pcap or pcapng capture, in either byte
+// order.
+type Reader struct {
+	r     *bufio.Reader
+	order binary.ByteOrder
+	ng    bool
+
+	// pcap
+	linkType LinkType
+	nanos    bool
+
+	// pcapng, per interface of the current section
+	ifaces []iface
+}
+
+type iface struct {
+	linkType LinkType
+	// units is the number of timestamp units per second.
+	units uint64
+}
+
+// NewReader reads the file header of a pcap or pcapng capture.
+func NewReader(r io.Reader) (*Reader, error) {
+	pr := &Reader{r: bufio.NewReaderSize(r, 1<<16)}
+	magic, err := pr.r.Peek(4)
+	if err != nil {
+		return nil, fmt.Errorf("reading capture header: %w", err)
+	}
+	if binary.LittleEndian.Uint32(magic) == blockSectionHeader {
+		pr.ng = true
+		return pr, nil
+	}
+
+	hdr := make([]byte, 24)
+	if _, err := io.ReadFull(pr.r, hdr); err != nil {
+		return nil, fmt.Errorf("reading capture header: %w", err)
+	}
+	for _, order := range []binary.ByteOrder{binary.LittleEndian, binary.BigEndian} {
+		switch order.Uint32(hdr) {
+		case magicMicroseconds:
+			pr.order = order
+		case magicNanoseconds:
+			pr.order, pr.nanos = order, true
+		}
+	}
+	if pr.order == nil {
+		return nil, errors.New("not a pcap or pcapng file")
+	}
+	// The upper bits may carry FCS information.
+	pr.linkType = LinkType(pr.order.Uint32(hdr[20:]))
+	return pr, nil
+}
+
+// Next returns the next packet, or io.EOF at the end of the capture.
+func (r *Reader) Next() (*Packet, error) {
+	if !r.ng {
+		return r.nextPcap()
+	}
+	for {
+		p, err := r.nextBlock()
+		if p != nil || err != nil {
+			return p, err
+		}
+	}
+}
+
+func (r *Reader) nextPcap() (*Packet, error) {
+	hdr := make([]byte, 16)
+	if _, err := io.ReadFull(r.r, hdr); err != nil {
+		if err == io.ErrUnexpectedEOF {
+			return nil, errors.New("truncated packet header")
+		}
+		return nil, err
+	}
+	n := r.order.Uint32(hdr[8:])
+	if n > maxBlock {
+		return nil, fmt.Errorf("packet length %d too large", n)
+	}
+	data := make([]byte, n)
+	if _, err := io.ReadFull(r.r, data); err != nil {
+		return nil, errors.New("truncated packet")
+	}
+	sec, frac := int64(r.order.Uint32(hdr)), int64(r.order.Uint32(hdr[4:]))
+	if !r.nanos {
+		frac *= 1000
+	}
+	return &Packet{Time: time.Unix(sec, frac), LinkType: r.linkType, Data: data}, nil
+}
+
+// nextBlock reads a pcapng block, returning a packet if it holds one.
+func (r *Reader) nextBlock() (*Packet, error) {
+	hdr, err := r.r.Peek(12)
+	switch {
+	case err == io.EOF && len(hdr) == 0:
+		return nil, io.EOF
+	case err != nil:
+		return nil, errors.New("truncated block header")
+	}
+	typ := binary.LittleEndian.Uint32(hdr)
+	if typ == blockSectionHeader {
+		// The byte order magic follows the length.
+		switch uint32(byteOrderMagic) {
+		case binary.LittleEndian.Uint32(hdr[8:]):
+			r.order = binary.LittleEndian
+		case binary.BigEndian.Uint32(hdr[8:]):
+			r.order = binary.BigEndian
+		default:
+			return nil, errors.New("invalid pcapng byte order magic")
+		}
+		r.ifaces = nil
+	} else if r.order == nil {
+		return nil, errors.New("pcapng block before section header")
+	}
+	typ = r.order.Uint32(hdr)
+	total := r.order.Uint32(hdr[4:])
+	if total < 12 || total%4 != 0 || total > maxBlock {
+		return nil, fmt.Errorf("invalid pcapng block length %d", total)
+	}
+	block := make([]byte, total)
+	if _, err := io.ReadFull(r.r, block); err != nil {
+		return nil, errors.New("truncated block")
+	}
+	body := block[8 : total-4]
+
+	switch typ {
+	case blockInterfaceDescription:
+		if len(body) < 8 {
+			return nil, errors.New("short interface description block")
+		}
+		ifc := iface{linkType: LinkType(r.order.Uint16(body)), units: 1e6}
+		r.options(body[8:], func(code uint16, value []byte) {
+			if code == optionTSResol && len(value) == 1 {
+				ifc.units = resolution(value[0])
+			}
+		})
+		r.ifaces = append(r.ifaces, ifc)
+	case blockEnhancedPacket:
+		if len(body) < 20 {
+			return nil, errors.New("short enhanced packet block")
+		}
+		id, n := r.order.Uint32(body), r.order.Uint32(body[12:])
+		if int(id) >= len(r.ifaces) {
+			return nil, fmt.Errorf("packet on undeclared interface %d", id)
+		}
+		if uint64(n) > uint64(len(body)-20) {
+			return nil, errors.New("enhanced packet block overflow")
+		}
+		ifc := r.ifaces[id]
+		ts := uint64(r.order.Uint32(body[4:]))<<32 | uint64(r.order.Uint32(body[8:]))
+		return &Packet{Time: ifc.time(ts), LinkType: ifc.linkType, Data: body[20 : 20+n]}, nil
+	case blockSimplePacket:
+		if len(r.ifaces) == 0 || len(body) < 4 {
+			return nil, errors.New("invalid simple packet block")
+		}
+		n := min(int(r.order.Uint32(body)), len(body)-4)
+		return &Packet{LinkType: r.ifaces[0].linkType, Data: body[4 : 4+n]}, nil
+	}
+	return nil, nil
+}
+
+// options calls fn for each option in a block's options field.
+func (r *Reader) options(buf []byte, fn func(code uint16, value []byte)) {
+	for len(buf) >= 4 {
+		code, n := r.order.Uint16(buf), int(r.order.Uint16(buf[2:]))
+		if code == optionEnd || 4+n > len(buf) {
+			return
+		}
+		fn(code, buf[4:4+n])
+		buf = buf[4+(n+3)&^3:]
+	}
+}
+
+// resolution decodes if_tsresol: a negative power of ten, or of two if the
+// top bit is set.
+func resolution(v byte) uint64 {
+	base, exp := uint64(10), v
+	if v&0x80 != 0 {
+		base, exp = 2, v&0x7f
+	}
+	units := uint64(1)
+	for range exp {
+		if units > math.MaxUint64/base {
+			break
+		}
+		units *= base
+	}
+	return units
+}
+
+func (i iface) time(ts uint64) time.Time {
+	hi, lo := bits.Mul64(ts%i.units, 1e9)
+	nsec, _ := bits.Div64(hi, lo, i.units)
+	return time.Unix(int64(ts/i.units), int64(nsec))
+}
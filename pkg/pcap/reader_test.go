@@ -0,0 +1,149 @@
+package pcap_test
+
+import (
+	"bytes"
+	"encoding/binary"
+	"io"
+	"net/netip"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/d0rvin/winscope-smb/pkg/pcap"
+)
+
+// streams reassembles every TCP stream of a capture.
+func streams(t *testing.T, capture []byte) []*pcap.Stream {
+	t.Helper()
+	r, err := pcap.NewReader(bytes.NewReader(capture))
+	if err != nil {
+		t.Fatal(err)
+	}
+	var out []*pcap.Stream
+	a := &pcap.Assembler{Done: func(s *pcap.Stream) { out = append(out, s) }}
+	for {
+		p, err := r.Next()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			t.Fatal(err)
+		}
+		if seg, ok := pcap.DecodeTCP(p); ok {
+			a.Add(seg)
+		}
+	}
+	a.Flush()
+	return out
+}
+
+func TestReaderRoundTrip(t *testing.T) {
+	var buf bytes.Buffer
+	w, err := pcap.NewWriter(&buf)
+	if err != nil {
+		t.Fatal(err)
+	}
+	client := netip.MustParseAddrPort("[2001:db8::1]:50000")
+	server := netip.MustParseAddrPort("[2001:db8::2]:445")
+	s := w.Stream(client, server)
+	s.Sent([]byte("request"))
+	s.Received([]byte("response, "))
+	s.Received([]byte("in two segments"))
+	s.Close()
+	// A second connection left open.
+	w.Stream(netip.MustParseAddrPort("192.0.2.1:50001"), netip.MustParseAddrPort("192.0.2.2:80")).Sent([]byte("GET"))
+
+	out := streams(t, buf.Bytes())
+	if !assert.Len(t, out, 2) {
+		return
+	}
+	assert.Equal(t, client, out[0].Client)
+	assert.Equal(t, server, out[0].Server)
+	assert.Equal(t, "request", string(out[0].ClientData))
+	assert.Equal(t, "response, in two segments", string(out[0].ServerData))
+	assert.WithinDuration(t, time.Now(), out[0].Start, time.Minute)
+	assert.Equal(t, "GET", string(out[1].ClientData))
+	assert.Equal(t, uint16(80), out[1].Server.Port())
+}
+
+// rawPacket builds an IPv4 TCP packet for LINKTYPE_RAW.
+func rawPacket(src, dst netip.AddrPort, seq uint32, flags byte, payload string) []byte {
+	p := make([]byte, 40, 40+len(payload))
+	p[0] = 0x45
+	binary.BigEndian.PutUint16(p[2:], uint16(40+len(payload)))
+	p[9] = 6
+	copy(p[12:], src.Addr().AsSlice())
+	copy(p[16:], dst.Addr().AsSlice())
+	binary.BigEndian.PutUint16(p[20:], src.Port())
+	binary.BigEndian.PutUint16(p[22:], dst.Port())
+	binary.BigEndian.PutUint32(p[24:], seq)
+	p[32] = 5 << 4
+	p[33] = flags
+	return append(p, payload...)
+}
+
+func TestReaderPcapReassembly(t *testing.T) {
+	client := netip.MustParseAddrPort("10.0.0.1:49152")
+	server := netip.MustParseAddrPort("10.0.0.2:80")
+	packets := [][]byte{
+		// Mid-stream: no handshake was captured.
+		rawPacket(client, server, 100, 0x18, "GET / "),
+		rawPacket(server, client, 900, 0x18, "HTTP/1.1 "),
+		// Reordered, then retransmitted with overlap.
+		rawPacket(client, server, 112, 0x18, ".1\r\n"),
+		rawPacket(client, server, 106, 0x18, "HTTP/1"),
+		rawPacket(client, server, 106, 0x18, "HTTP/1.1"),
+		rawPacket(server, client, 909, 0x18, "200 OK"),
+		// Not TCP.
+		{0x45, 0, 0, 20, 0, 0, 0, 0, 64, 17, 0, 0, 10, 0, 0, 1, 10, 0, 0, 2},
+		rawPacket(client, server, 116, 0x04, ""),
+	}
+
+	// Big-endian, nanosecond resolution.
+	var buf bytes.Buffer
+	hdr := make([]byte, 24)
+	binary.BigEndian.PutUint32(hdr, 0xa1b23c4d)
+	binary.BigEndian.PutUint16(hdr[4:], 2)
+	binary.BigEndian.PutUint16(hdr[6:], 4)
+	binary.BigEndian.PutUint32(hdr[16:], 65535)
+	binary.BigEndian.PutUint32(hdr[20:], uint32(pcap.LinkTypeRaw))
+	buf.Write(hdr)
+	for i, p := range packets {
+		rec := make([]byte, 16)
+		binary.BigEndian.PutUint32(rec, 1700000000)
+		binary.BigEndian.PutUint32(rec[4:], uint32(i))
+		binary.BigEndian.PutUint32(rec[8:], uint32(len(p)))
+		binary.BigEndian.PutUint32(rec[12:], uint32(len(p)))
+		buf.Write(rec)
+		buf.Write(p)
+	}
+
+	out := streams(t, buf.Bytes())
+	if !assert.Len(t, out, 1) {
+		return
+	}
+	s := out[0]
+	// The higher port is taken for the client.
+	assert.Equal(t, client, s.Client)
+	assert.Equal(t, "GET / HTTP/1.1\r\n", string(s.ClientData))
+	assert.Equal(t, "HTTP/1.1 200 OK", string(s.ServerData))
+	assert.Equal(t, time.Unix(1700000000, 0), s.Start)
+}
+
+func TestReaderInvalid(t *testing.T) {
+	_, err := pcap.NewReader(bytes.NewReader(make([]byte, 24)))
+	assert.EqualError(t, err, "not a pcap or pcapng file")
+
+	var buf bytes.Buffer
+	if _, err := pcap.NewWriter(&buf); err != nil {
+		t.Fatal(err)
+	}
+	buf.Write([]byte{6, 0, 0, 0, 0xff, 0xff, 0xff, 0x7f})
+	r, err := pcap.NewReader(&buf)
+	if !assert.NoError(t, err) {
+		return
+	}
+	_, err = r.Next()
+	assert.Error(t, err)
+}
@@ -0,0 +1,147 @@
+package pcap
+
+import (
+	"encoding/binary"
+	"net/netip"
+	"time"
+)
+
+const (
+	etherTypeIPv4 = 0x0800
+	etherTypeIPv6 = 0x86dd
+	etherTypeVLAN = 0x8100
+	etherTypeQinQ = 0x88a8
+
+	protocolTCP = 6
+)
+
+// Segment is a TCP segment decoded from a captured packet.
+type Segment struct {
+	Time     time.Time
+	Src, Dst netip.AddrPort
+	Seq      uint32
+	SYN      bool
+	ACK      bool
+	FIN      bool
+	RST      bool
+	Payload  []byte
+}
+
+// DecodeTCP decodes the TCP segment carried by p, reporting false for
+// anything else, including IP fragments.
+func DecodeTCP(p *Packet) (*Segment, bool) {
+	etherType, ip, ok := network(p.LinkType, p.Data)
+	if !ok {
+		return nil, false
+	}
+	var src, dst netip.Addr
+	var tcp []byte
+	switch etherType {
+	case etherTypeIPv4:
+		if len(ip) < 20 || ip[0]>>4 != 4 {
+			return nil, false
+		}
+		ihl, total := int(ip[0]&0x0f)*4, int(binary.BigEndian.Uint16(ip[2:]))
+		// More fragments, or a fragment offset.
+		if ip[9] != protocolTCP || binary.BigEndian.Uint16(ip[6:])&0x3fff != 0 || ihl < 20 || total < ihl || total > len(ip) {
+			return nil, false
+		}
+		src, dst = netip.AddrFrom4([4]byte(ip[12:16])), netip.AddrFrom4([4]byte(ip[16:20]))
+		tcp = ip[ihl:total]
+	case etherTypeIPv6:
+		if len(ip) < 40 || ip[0]>>4 != 6 {
+			return nil, false
+		}
+		total := 40 + int(binary.BigEndian.Uint16(ip[4:]))
+		if total > len(ip) {
+			return nil, false
+		}
+		src, dst = netip.AddrFrom16([16]byte(ip[8:24])), netip.AddrFrom16([16]byte(ip[24:40]))
+		next, rest := ip[6], ip[40:total]
+		// Skip the hop-by-hop, routing and destination options headers.
+		for next == 0 || next == 43 || next == 60 {
+			if len(rest) < 8 || len(rest) < (int(rest[1])+1)*8 {
+				return nil, false
+			}
+			next, rest = rest[0], rest[(int(rest[1])+1)*8:]
+		}
+		if next != protocolTCP {
+			return nil, false
+		}
+		tcp = rest
+	default:
+		return nil, false
+	}
+
+	if len(tcp) < 20 {
+		return nil, false
+	}
+	offset := int(tcp[12]>>4) * 4
+	if offset < 20 || offset > len(tcp) {
+		return nil, false
+	}
+	flags := tcp[13]
+	return &Segment{
+		Time:    p.Time,
+		Src:     netip.AddrPortFrom(src, binary.BigEndian.Uint16(tcp)),
+		Dst:     netip.AddrPortFrom(dst, binary.BigEndian.Uint16(tcp[2:])),
+		Seq:     binary.BigEndian.Uint32(tcp[4:]),
+		FIN:     flags&flagFIN != 0,
+		SYN:     flags&flagSYN != 0,
+		RST:     flags&flagRST != 0,
+		ACK:     flags&flagACK != 0,
+		Payload: tcp[offset:],
+	}, true
+}
+
+// network strips the link-layer header, returning the EtherType of the
+// network-layer packet.
+func network(link LinkType, data []byte) (uint16, []byte, bool) {
+	switch link {
+	case LinkTypeEthernet:
+		if len(data) < 14 {
+			return 0, nil, false
+		}
+		etherType, data := binary.BigEndian.Uint16(data[12:]), data[14:]
+		for etherType == etherTypeVLAN || etherType == etherTypeQinQ {
+			if len(data) < 4 {
+				return 0, nil, false
+			}
+			etherType, data = binary.BigEndian.Uint16(data[2:]), data[4:]
+		}
+		return etherType, data, true
+	case LinkTypeNull, LinkTypeLoop:
+		// The address family is in the capturing host's byte order for
+		// NULL and big-endian for LOOP; the IP version tells them apart.
+		if len(data) < 4 {
+			return 0, nil, false
+		}
+		return ipVersion(data[4:])
+	case LinkTypeRaw, LinkTypeIPv4, LinkTypeIPv6:
+		return ipVersion(data)
+	case LinkTypeLinuxSLL:
+		if len(data) < 16 {
+			return 0, nil, false
+		}
+		return binary.BigEndian.Uint16(data[14:]), data[16:], true
+	case LinkTypeLinuxSLL2:
+		if len(data) < 20 {
+			return 0, nil, false
+		}
+		return binary.BigEndian.Uint16(data), data[20:], true
+	}
+	return 0, nil, false
+}
+
+func ipVersion(data []byte) (uint16, []byte, bool) {
+	if len(data) == 0 {
+		return 0, nil, false
+	}
+	switch data[0] >> 4 {
+	case 4:
+		return etherTypeIPv4, data, true
+	case 6:
+		return etherTypeIPv6, data, true
+	}
+	return 0, nil, false
+}
@@ -0,0 +1,255 @@
+package pcap
+
+import (
+	"encoding/binary"
+	"io"
+	"net/netip"
+	"sync"
+	"time"
+
+	"github.com/d0rvin/winscope-smb/pkg/protocol"
+)
+
+const (
+	blockSectionHeader        = 0x0a0d0d0a
+	blockInterfaceDescription = 0x00000001
+	blockEnhancedPacket       = 0x00000006
+	byteOrderMagic            = 0x1a2b3c4d
+	linkTypeEthernet          = 1
+
+	// maxSegment keeps the synthesized IP packets below 64 KiB.
+	maxSegment = 65000
+)
+
+// TCP flags.
+const (
+	flagFIN = 0x01
+	flagSYN = 0x02
+	flagRST = 0x04
+	flagPSH = 0x08
+	flagACK = 0x10
+)
+
+var (
+	clientMAC = [6]byte{0x02, 0, 0, 0, 0, 0x01}
+	serverMAC = [6]byte{0x02, 0, 0, 0, 0, 0x02}
+)
+
+// Writer writes a pcapng capture of recorded connections. Each connection
+// becomes a TCP stream on an Ethernet interface, with synthesized headers,
+// handshake and teardown around the recorded payload. A Writer is a
+// protocol.Recorder and is safe for concurrent use.
+type Writer struct {
+	mu  sync.Mutex
+	w   io.Writer
+	err error
+}
+
+// NewWriter writes the pcapng section and interface headers to w.
+func NewWriter(w io.Writer) (*Writer, error) {
+	pw := &Writer{w: w}
+	shb := make([]byte, 16)
+	binary.LittleEndian.PutUint32(shb, byteOrderMagic)
+	binary.LittleEndian.PutUint16(shb[4:], 1) // version 1.0
+	binary.LittleEndian.PutUint64(shb[8:], ^uint64(0))
+	pw.block(blockSectionHeader, shb)
+
+	idb := make([]byte, 8)
+	binary.LittleEndian.PutUint16(idb, linkTypeEthernet)
+	pw.block(blockInterfaceDescription, idb)
+	if pw.err != nil {
+		return nil, pw.err
+	}
+	return pw, nil
+}
+
+// Err returns the first error writing the capture.
+func (w *Writer) Err() error {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+	return w.err
+}
+
+// Stream implements protocol.Recorder.
+func (w *Writer) Stream(local, remote netip.AddrPort) protocol.RecordStream {
+	// Both ends must share an IP version; unknown addresses become the
+	// unspecified address.
+	la, ra := local.Addr(), remote.Addr()
+	switch {
+	case !la.IsValid() && !ra.IsValid():
+		la, ra = netip.IPv4Unspecified(), netip.IPv4Unspecified()
+	case !la.IsValid():
+		la = unspecified(ra)
+	case !ra.IsValid():
+		ra = unspecified(la)
+	case la.Is4() != ra.Is4():
+		la, ra = netip.AddrFrom16(la.As16()), netip.AddrFrom16(ra.As16())
+	}
+	local, remote = netip.AddrPortFrom(la, local.Port()), netip.AddrPortFrom(ra, remote.Port())
+
+	s := &stream{w: w, client: local, server: remote}
+	// Arbitrary but reproducible initial sequence numbers.
+	s.seq[0] = uint32(local.Port())<<16 | 0x1000
+	s.seq[1] = uint32(remote.Port())<<16 | 0x2000
+	s.send(false, flagSYN, nil)
+	s.send(true, flagSYN|flagACK, nil)
+	s.send(false, flagACK, nil)
+	return s
+}
+
+func unspecified(like netip.Addr) netip.Addr {
+	if like.Is4() {
+		return netip.IPv4Unspecified()
+	}
+	return netip.IPv6Unspecified()
+}
+
+// block writes a pcapng block with the given body, padded to 32 bits.
+func (w *Writer) block(typ uint32, body []byte) {
+	if w.err != nil {
+		return
+	}
+	pad := -len(body) & 3
+	total := uint32(12 + len(body) + pad)
+	buf := make([]byte, 0, total)
+	buf = binary.LittleEndian.AppendUint32(buf, typ)
+	buf = binary.LittleEndian.AppendUint32(buf, total)
+	buf = append(buf, body...)
+	buf = append(buf, make([]byte, pad)...)
+	buf = binary.LittleEndian.AppendUint32(buf, total)
+	_, w.err = w.w.Write(buf)
+}
+
+func (w *Writer) packet(t time.Time, frame []byte) {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+	us := uint64(t.UnixMicro())
+	body := make([]byte, 20, 20+len(frame))
+	binary.LittleEndian.PutUint32(body[4:], uint32(us>>32))
+	binary.LittleEndian.PutUint32(body[8:], uint32(us))
+	binary.LittleEndian.PutUint32(body[12:], uint32(len(frame)))
+	binary.LittleEndian.PutUint32(body[16:], uint32(len(frame)))
+	w.block(blockEnhancedPacket, append(body, frame...))
+}
+
+// stream synthesizes the TCP segments of one connection. Index 0 of seq is
+// the client (local) side, 1 the server.
+type stream struct {
+	w              *Writer
+	client, server netip.AddrPort
+	mu             sync.Mutex
+	seq            [2]uint32
+	closed         bool
+}
+
+func (s *stream) Sent(data []byte)     { s.data(false, data) }
+func (s *stream) Received(data []byte) { s.data(true, data) }
+
+func (s *stream) data(fromServer bool, data []byte) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	for len(data) > 0 {
+		n := min(len(data), maxSegment)
+		s.send(fromServer, flagPSH|flagACK, data[:n])
+		data = data[n:]
+	}
+}
+
+func (s *stream) Close() {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if s.closed {
+		return
+	}
+	s.closed = true
+	s.send(false, flagFIN|flagACK, nil)
+	s.send(true, flagFIN|flagACK, nil)
+	s.send(false, flagACK, nil)
+}
+
+func (s *stream) send(fromServer bool, flags byte, payload []byte) {
+	src, dst := s.client, s.server
+	srcMAC, dstMAC := clientMAC, serverMAC
+	from, to := 0, 1
+	if fromServer {
+		src, dst = dst, src
+		srcMAC, dstMAC = dstMAC, srcMAC
+		from, to = 1, 0
+	}
+	var ack uint32
+	if flags&flagACK != 0 {
+		ack = s.seq[to]
+	}
+	tcp := tcpSegment(src, dst, s.seq[from], ack, flags, payload)
+	s.seq[from] += uint32(len(payload))
+	if flags&(flagSYN|flagFIN) != 0 {
+		s.seq[from]++
+	}
+	s.w.packet(time.Now(), ethernet(srcMAC, dstMAC, src.Addr(), dst.Addr(), tcp))
+}
+
+func tcpSegment(src, dst netip.AddrPort, seq, ack uint32, flags byte, payload []byte) []byte {
+	seg := make([]byte, 20, 20+len(payload))
+	binary.BigEndian.PutUint16(seg, src.Port())
+	binary.BigEndian.PutUint16(seg[2:], dst.Port())
+	binary.BigEndian.PutUint32(seg[4:], seq)
+	binary.BigEndian.PutUint32(seg[8:], ack)
+	seg[12] = 5 << 4
+	seg[13] = flags
+	binary.BigEndian.PutUint16(seg[14:], 0xffff)
+	seg = append(seg, payload...)
+
+	// The checksum covers a pseudo header of the IP addresses, protocol
+	// and TCP length.
+	pseudo := append(src.Addr().AsSlice(), dst.Addr().AsSlice()...)
+	pseudo = binary.BigEndian.AppendUint32(pseudo, uint32(len(seg)))
+	pseudo = binary.BigEndian.AppendUint32(pseudo, 6)
+	binary.BigEndian.PutUint16(seg[16:], checksum(pseudo, seg))
+	return seg
+}
+
+func ethernet(srcMAC, dstMAC [6]byte, src, dst netip.Addr, tcp []byte) []byte {
+	frame := append(dstMAC[:], srcMAC[:]...)
+	if src.Is4() {
+		frame = binary.BigEndian.AppendUint16(frame, 0x0800)
+		ip := make([]byte, 20)
+		ip[0] = 0x45
+		binary.BigEndian.PutUint16(ip[2:], uint16(20+len(tcp)))
+		binary.BigEndian.PutUint16(ip[6:], 0x4000) // don't fragment
+		ip[8] = 64
+		ip[9] = 6
+		copy(ip[12:], src.AsSlice())
+		copy(ip[16:], dst.AsSlice())
+		binary.BigEndian.PutUint16(ip[10:], checksum(ip))
+		frame = append(frame, ip...)
+	} else {
+		frame = binary.BigEndian.AppendUint16(frame, 0x86dd)
+		ip := make([]byte, 40)
+		ip[0] = 0x60
+		binary.BigEndian.PutUint16(ip[4:], uint16(len(tcp)))
+		ip[6] = 6
+		ip[7] = 64
+		copy(ip[8:], src.AsSlice())
+		copy(ip[24:], dst.AsSlice())
+		frame = append(frame, ip...)
+	}
+	return append(frame, tcp...)
+}
+
+// checksum is the Internet checksum of the concatenated buffers, each of
+// which but the last must have an even length.
+func checksum(bufs ...[]byte) uint16 {
+	var sum uint32
+	for _, b := range bufs {
+		for i := 0; i+1 < len(b); i += 2 {
+			sum += uint32(b[i])<<8 | uint32(b[i+1])
+		}
+		if len(b)%2 == 1 {
+			sum += uint32(b[len(b)-1]) << 8
+		}
+	}
+	for sum > 0xffff {
+		sum = sum&0xffff + sum>>16
+	}
+	return ^uint16(sum)
+}
@@ -0,0 +1,107 @@
+package pcap_test
+
+import (
+	"bytes"
+	"encoding/binary"
+	"io"
+	"net"
+	"strconv"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/d0rvin/winscope-smb/pkg/pcap"
+	"github.com/d0rvin/winscope-smb/pkg/protocol"
+)
+
+// frames returns the packets of the Enhanced Packet Blocks in a capture.
+func frames(t *testing.T, capture []byte) [][]byte {
+	t.Helper()
+	var out [][]byte
+	for len(capture) > 0 {
+		if len(capture) < 12 {
+			t.Fatalf("truncated block")
+		}
+		typ := binary.LittleEndian.Uint32(capture)
+		total := binary.LittleEndian.Uint32(capture[4:])
+		if total%4 != 0 || int(total) > len(capture) || binary.LittleEndian.Uint32(capture[total-4:]) != total {
+			t.Fatalf("bad block length %d", total)
+		}
+		if typ == 6 {
+			n := binary.LittleEndian.Uint32(capture[20:])
+			out = append(out, capture[28:28+n])
+		}
+		capture = capture[total:]
+	}
+	return out
+}
+
+func TestWriter(t *testing.T) {
+	ln, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer ln.Close()
+	go func() {
+		conn, err := ln.Accept()
+		if err != nil {
+			return
+		}
+		defer conn.Close()
+		_, _ = io.Copy(conn, conn)
+	}()
+	_, portStr, _ := net.SplitHostPort(ln.Addr().String())
+	port, _ := strconv.Atoi(portStr)
+
+	var buf bytes.Buffer
+	w, err := pcap.NewWriter(&buf)
+	if !assert.NoError(t, err) {
+		return
+	}
+	c, err := protocol.NewConnection("127.0.0.1", uint16(port), protocol.WithRecorder(w))
+	if !assert.NoError(t, err) || !assert.NoError(t, c.Dial("tcp")) {
+		return
+	}
+	_, err = c.Write([]byte("hello"))
+	assert.NoError(t, err)
+	reply := make([]byte, 5)
+	_, err = io.ReadFull(c, reply)
+	assert.NoError(t, err)
+	assert.NoError(t, c.Close())
+	assert.NoError(t, w.Err())
+
+	assert.Equal(t, []byte{0x0a, 0x0d, 0x0d, 0x0a}, buf.Bytes()[:4])
+	packets := frames(t, buf.Bytes())
+	// Handshake, request, reply and teardown.
+	if !assert.Len(t, packets, 8) {
+		return
+	}
+	flags := make([]byte, len(packets))
+	for i, p := range packets {
+		assert.Equal(t, []byte{0x08, 0x00}, p[12:14], "IPv4 ethertype")
+		ip := p[14:34]
+		assert.Equal(t, []byte{127, 0, 0, 1}, ip[16:20])
+		assert.Equal(t, uint16(0xffff), onesSum(ip), "IP header checksum")
+		flags[i] = p[34+13]
+	}
+	assert.Equal(t, []byte{0x02, 0x12, 0x10, 0x18, 0x18, 0x11, 0x11, 0x10}, flags)
+
+	request, response := packets[3][34:], packets[4][34:]
+	assert.Equal(t, uint16(port), binary.BigEndian.Uint16(request[2:]))
+	assert.Equal(t, uint16(port), binary.BigEndian.Uint16(response[0:]))
+	assert.Equal(t, "hello", string(request[20:]))
+	assert.Equal(t, "hello", string(response[20:]))
+	// The reply acknowledges the request.
+	assert.Equal(t, binary.BigEndian.Uint32(request[4:])+5, binary.BigEndian.Uint32(response[8:]))
+}
+
+func onesSum(b []byte) uint16 {
+	var sum uint32
+	for i := 0; i+1 < len(b); i += 2 {
+		sum += uint32(binary.BigEndian.Uint16(b[i:]))
+	}
+	for sum > 0xffff {
+		sum = sum&0xffff + sum>>16
+	}
+	return uint16(sum)
+}
@@ -0,0 +1,382 @@
+package probe
+
+import (
+	"context"
+	"crypto/tls"
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/d0rvin/winscope-smb/pkg/protocol"
+	"github.com/d0rvin/winscope-smb/pkg/protocol/dcerpc"
+	"github.com/d0rvin/winscope-smb/pkg/protocol/dcerpc/epm"
+	"github.com/d0rvin/winscope-smb/pkg/protocol/httpntlm"
+	"github.com/d0rvin/winscope-smb/pkg/protocol/ldap"
+	"github.com/d0rvin/winscope-smb/pkg/protocol/mail"
+	"github.com/d0rvin/winscope-smb/pkg/protocol/ntlmssp"
+	"github.com/d0rvin/winscope-smb/pkg/protocol/rdp"
+	v1 "github.com/d0rvin/winscope-smb/pkg/protocol/smb/v1"
+	v2 "github.com/d0rvin/winscope-smb/pkg/protocol/smb/v2"
+	"github.com/d0rvin/winscope-smb/pkg/protocol/tds"
+	"github.com/d0rvin/winscope-smb/pkg/protocol/telnet"
+)
+
+func init() {
+	Register(SMB{})
+	Register(&HTTP{Paths: httpntlm.DefaultPaths})
+	Register(MSSQL{})
+	Register(RDP{})
+	Register(&LDAP{})
+	Register(Mail{Protocol: mail.ProtocolSMTP})
+	Register(Mail{Protocol: mail.ProtocolIMAP})
+	Register(Mail{Protocol: mail.ProtocolPOP3})
+	Register(RPC{})
+	Register(Telnet{})
+}
+
+// insecureTLS reports the certificate rather than verifying it, since only
+// the NTLM challenge is of interest.
+func insecureTLS() protocol.Option {
+	return protocol.WithTLS(&tls.Config{InsecureSkipVerify: true})
+}
+
+// SMB tries SMBv1 and then SMBv2 on a fresh connection. Over QUIC only
+// SMBv2 is attempted, since SMB over QUIC requires SMB 3.1.1.
+type SMB struct{}
+
+func (SMB) Name() string { return "smb" }
+
+func (SMB) DefaultPorts() []uint16 { return []uint16{445, 139} }
+
+// ConnOptions sets up an NBSS session on port 139, with the called name
+// derived from the host.
+func (SMB) ConnOptions(port uint16) []protocol.Option {
+	if port == 139 {
+		return []protocol.Option{protocol.WithNetBIOSSession("")}
+	}
+	return nil
+}
+
+// Probe reports the protocol in the "Dialect" field and, for SMBv1, the
+// "Native OS" and "Native LAN Manager" strings. On failure the SMBv1 and
+// SMBv2 errors are joined.
+func (SMB) Probe(ctx context.Context, conn *protocol.Connection) (*ntlmssp.Challenge, Extra, error) {
+	var v1Err error
+	if !conn.QUIC {
+		s := v1.NewSessionConn(conn)
+		if v1Err = s.Negotiate(); v1Err != nil {
+			v1Err = fmt.Errorf("SMBv1: negotiate: %w", v1Err)
+		} else if res, challenge, err := s.SessionSetupAndX(); err != nil {
+			v1Err = fmt.Errorf("SMBv1: session setup: %w", err)
+		} else {
+			return challenge, Extra{
+				{"Dialect", "SMBv1"},
+				{"Native OS", res.NativeOS},
+				{"Native LAN Manager", res.NativeLanMan},
+			}, nil
+		}
+		if err := ctx.Err(); err != nil {
+			return nil, nil, errors.Join(v1Err, err)
+		}
+		_ = conn.Close()
+		if err := conn.Dial("tcp"); err != nil {
+			return nil, nil, errors.Join(v1Err, fmt.Errorf("SMBv2: %w", err))
+		}
+	}
+
+	s := v2.NewSessionConn(conn)
+	if err := s.Negotiate(); err != nil {
+		return nil, nil, errors.Join(v1Err, fmt.Errorf("SMBv2: negotiate: %w", err))
+	}
+	challenge, err := s.Setup1()
+	if err != nil {
+		return nil, nil, errors.Join(v1Err, fmt.Errorf("SMBv2: session setup: %w", err))
+	}
+	dialect := "SMBv2"
+	if conn.QUIC {
+		d := s.Dialect()
+		dialect = fmt.Sprintf("SMB %d.%d.%d over QUIC", d>>8, d>>4&0xf, d&0xf)
+	}
+	return challenge, Extra{{"Dialect", dialect}}, nil
+}
+
+// HTTP sends an NTLM NEGOTIATE to each of Paths until one answers with a
+// challenge.
+type HTTP struct {
+	Paths []string
+}
+
+func (*HTTP) Name() string { return "http" }
+
+func (*HTTP) DefaultPorts() []uint16 { return []uint16{80, 443, 5985, 5986} }
+
+func (*HTTP) ConnOptions(port uint16) []protocol.Option {
+	if httpntlm.IsTLSPort(port) {
+		return []protocol.Option{insecureTLS()}
+	}
+	return nil
+}
+
+// Probe reports the last URL that answered when no path yields a challenge.
+func (h *HTTP) Probe(ctx context.Context, conn *protocol.Connection) (*ntlmssp.Challenge, Extra, error) {
+	paths := h.Paths
+	if len(paths) == 0 {
+		paths = httpntlm.DefaultPaths
+	}
+	c := httpntlm.NewClientConn(conn)
+	defer c.Close()
+
+	var last httpntlm.Result
+	for i, path := range paths {
+		if err := ctx.Err(); err != nil {
+			return nil, httpExtra(last), err
+		}
+		res := c.Probe(path)
+		if res.Challenge != nil {
+			return res.Challenge, httpExtra(res), nil
+		}
+		if i == 0 || res.StatusCode != 0 {
+			last = res
+		}
+	}
+	return nil, httpExtra(last), last.Err
+}
+
+func httpExtra(res httpntlm.Result) Extra {
+	if res.StatusCode == 0 {
+		return nil
+	}
+	extra := Extra{
+		{"URL", res.URL},
+		{"Status", fmt.Sprintf("HTTP %d", res.StatusCode)},
+	}
+	if res.Server != "" {
+		extra = append(extra, Field{"Server", res.Server})
+	}
+	if len(res.Schemes) > 0 {
+		extra = append(extra, Field{"Schemes", strings.Join(res.Schemes, ", ")})
+	}
+	if res.Scheme != "" {
+		extra = append(extra, Field{"Scheme", res.Scheme})
+	}
+	return extra
+}
+
+// MSSQL exchanges PRELOGIN and sends a LOGIN7 with an SSPI NEGOTIATE.
+type MSSQL struct{}
+
+func (MSSQL) Name() string { return "mssql" }
+
+func (MSSQL) DefaultPorts() []uint16 { return []uint16{tds.DefaultPort} }
+
+func (MSSQL) Probe(ctx context.Context, conn *protocol.Connection) (*ntlmssp.Challenge, Extra, error) {
+	s := tds.NewSessionConn(conn)
+	prelogin, err := s.Prelogin()
+	if err != nil {
+		return nil, nil, fmt.Errorf("prelogin: %w", err)
+	}
+
+	version := prelogin.Version.String()
+	if product, ok := prelogin.Version.ProductName(); ok {
+		version += " (" + product + ")"
+	}
+	extra := Extra{{"SQL Server Version", version}}
+	if prelogin.Instance != "" {
+		extra = append(extra, Field{"Instance", prelogin.Instance})
+	}
+	extra = append(extra, Field{"Encryption", tds.EncryptionName(prelogin.Encryption)})
+	if err := ctx.Err(); err != nil {
+		return nil, extra, err
+	}
+
+	challenge, err := s.Login()
+	// The TLS handshake runs inside TDS packets, so the connection
+	// itself does not see it.
+	if state, ok := s.ConnectionState(); ok && len(state.PeerCertificates) > 0 {
+		extra = append(extra, Field{"Certificate Subject", state.PeerCertificates[0].Subject.String()})
+	}
+	if err != nil {
+		return nil, extra, fmt.Errorf("login: %w", err)
+	}
+	return challenge, extra, nil
+}
+
+// RDP negotiates TLS and CredSSP and reads the challenge from the first
+// TSRequest.
+type RDP struct{}
+
+func (RDP) Name() string { return "rdp" }
+
+func (RDP) DefaultPorts() []uint16 { return []uint16{rdp.DefaultPort} }
+
+func (RDP) Probe(ctx context.Context, conn *protocol.Connection) (*ntlmssp.Challenge, Extra, error) {
+	s := rdp.NewSessionConn(conn)
+	selected, err := s.Negotiate()
+	if err != nil {
+		return nil, nil, fmt.Errorf("negotiate: %w", err)
+	}
+	extra := Extra{{"Security Protocol", rdp.ProtocolName(selected)}}
+	if err := ctx.Err(); err != nil {
+		return nil, extra, err
+	}
+	challenge, err := s.Challenge()
+	if err != nil {
+		return nil, extra, fmt.Errorf("CredSSP: %w", err)
+	}
+	return challenge, extra, nil
+}
+
+// LDAP binds with GSS-SPNEGO and falls back to a Sicily NTLM bind, which
+// older domain controllers and AD LDS answer instead. With RootDSE set the
+// rootDSE is read anonymously first.
+type LDAP struct {
+	RootDSE bool
+}
+
+func (*LDAP) Name() string { return "ldap" }
+
+func (*LDAP) DefaultPorts() []uint16 { return []uint16{ldap.DefaultPort, ldap.TLSPort, 3268, 3269} }
+
+func (*LDAP) ConnOptions(port uint16) []protocol.Option {
+	if ldap.IsTLSPort(port) {
+		return []protocol.Option{insecureTLS()}
+	}
+	return nil
+}
+
+func (l *LDAP) Probe(ctx context.Context, conn *protocol.Connection) (*ntlmssp.Challenge, Extra, error) {
+	s := ldap.NewSessionConn(conn)
+	var extra Extra
+	if l.RootDSE {
+		if dse, err := s.RootDSE(); err != nil {
+			extra = Extra{{"RootDSE", fmt.Sprintf("unavailable (%v)", err)}}
+		} else {
+			extra = rootDSEExtra(dse)
+		}
+	}
+
+	var spnegoErr error
+	for _, mech := range []string{ldap.MechanismSPNEGO, ldap.MechanismSicily} {
+		if err := ctx.Err(); err != nil {
+			return nil, extra, err
+		}
+		challenge, err := s.Bind(mech)
+		if err == nil {
+			return challenge, append(extra, Field{"Bind Mechanism", mech}), nil
+		}
+		if spnegoErr == nil {
+			spnegoErr = err
+			continue
+		}
+		return nil, extra, fmt.Errorf("%s bind: %v; %s bind: %w", ldap.MechanismSPNEGO, spnegoErr, ldap.MechanismSicily, err)
+	}
+	return nil, extra, spnegoErr
+}
+
+func rootDSEExtra(dse *ldap.RootDSE) Extra {
+	role := "LDAP server"
+	if dse.IsDomainController() {
+		role = "Domain Controller"
+		if dse.IsGlobalCatalogReady {
+			role += ", Global Catalog"
+		}
+	}
+	extra := Extra{
+		{"Role", role},
+		{"DNS Host Name", dse.DNSHostName},
+		{"Naming Context", dse.DefaultNamingContext},
+	}
+	for _, level := range []struct {
+		name  string
+		value int
+	}{
+		{"Domain Functional Level", dse.DomainFunctionality},
+		{"Forest Functional Level", dse.ForestFunctionality},
+		{"DC Functional Level", dse.DomainControllerFunctionality},
+	} {
+		if level.value >= 0 {
+			extra = append(extra, Field{level.name, ldap.FunctionalLevelName(level.value)})
+		}
+	}
+	return extra
+}
+
+// Mail runs AUTH NTLM over SMTP, IMAP or POP3, upgrading with STARTTLS
+// first when the server offers it.
+type Mail struct {
+	// Protocol is mail.ProtocolSMTP, mail.ProtocolIMAP or mail.ProtocolPOP3.
+	Protocol string
+}
+
+func (m Mail) Name() string { return strings.ToLower(m.Protocol) }
+
+func (m Mail) DefaultPorts() []uint16 {
+	switch m.Protocol {
+	case mail.ProtocolSMTP:
+		return []uint16{25, 587, 465}
+	case mail.ProtocolIMAP:
+		return []uint16{143, 993}
+	case mail.ProtocolPOP3:
+		return []uint16{110, 995}
+	}
+	return nil
+}
+
+func (Mail) ConnOptions(port uint16) []protocol.Option {
+	if mail.IsTLSPort(port) {
+		return []protocol.Option{insecureTLS()}
+	}
+	return nil
+}
+
+// Probe reports the banner and whether TLS was STARTTLS, implicit or none.
+func (m Mail) Probe(ctx context.Context, conn *protocol.Connection) (*ntlmssp.Challenge, Extra, error) {
+	_, implicit := conn.ConnectionState()
+	s, err := mail.NewSessionConn(conn, m.Protocol)
+	if err != nil {
+		return nil, nil, err
+	}
+	extra := Extra{{"Banner", s.Banner()}}
+
+	upgraded, err := s.StartTLS()
+	switch {
+	case upgraded:
+		extra = append(extra, Field{"TLS", "STARTTLS"})
+	case implicit:
+		extra = append(extra, Field{"TLS", "implicit"})
+	default:
+		extra = append(extra, Field{"TLS", "none"})
+	}
+	if err != nil {
+		return nil, extra, fmt.Errorf("STARTTLS: %w", err)
+	}
+	if err := ctx.Err(); err != nil {
+		return nil, extra, err
+	}
+	challenge, err := s.Challenge()
+	return challenge, extra, err
+}
+
+// RPC binds to the endpoint mapper with NTLM authentication.
+type RPC struct{}
+
+func (RPC) Name() string { return "rpc" }
+
+func (RPC) DefaultPorts() []uint16 { return []uint16{epm.DefaultPort} }
+
+func (RPC) Probe(ctx context.Context, conn *protocol.Connection) (*ntlmssp.Challenge, Extra, error) {
+	challenge, err := epm.Challenge(dcerpc.NewClient(conn))
+	return challenge, nil, err
+}
+
+// Telnet answers option negotiation and runs MS-TNAP NTLM authentication.
+type Telnet struct{}
+
+func (Telnet) Name() string { return "telnet" }
+
+func (Telnet) DefaultPorts() []uint16 { return []uint16{telnet.DefaultPort} }
+
+func (Telnet) Probe(ctx context.Context, conn *protocol.Connection) (*ntlmssp.Challenge, Extra, error) {
+	challenge, err := telnet.NewSessionConn(conn).Challenge()
+	return challenge, nil, err
+}
@@ -0,0 +1,248 @@
+package probe
+
+import (
+	"bytes"
+	"context"
+	"crypto/tls"
+	"errors"
+	"fmt"
+	"io"
+	"net"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/d0rvin/winscope-smb/pkg/encoding"
+	"github.com/d0rvin/winscope-smb/pkg/protocol"
+	"github.com/d0rvin/winscope-smb/pkg/protocol/rdp"
+	"github.com/d0rvin/winscope-smb/pkg/protocol/smb/common"
+	v2 "github.com/d0rvin/winscope-smb/pkg/protocol/smb/v2"
+)
+
+// DetectTimeout bounds the wait for a banner and for each reply during
+// Detect.
+var DetectTimeout = 2 * time.Second
+
+// Detection describes the service Detect found on a port.
+type Detection struct {
+	Port uint16
+	// Protocol is the name of the registered prober for the service.
+	Protocol string
+	// TLS is set when the service expects a TLS handshake first.
+	TLS bool
+	// NetBIOS is set when the service expects an NBSS session request.
+	NetBIOS bool
+	// Banner is the first line of a text greeting.
+	Banner string
+}
+
+// Options returns the connection options the prober needs on the port,
+// whatever its own ConnOptions assume for that port number.
+func (d *Detection) Options() []protocol.Option {
+	var opts []protocol.Option
+	if d.TLS {
+		opts = append(opts, insecureTLS())
+	}
+	if d.NetBIOS {
+		opts = append(opts, protocol.WithNetBIOSSession(""))
+	}
+	return opts
+}
+
+// Detect guesses the service on cfg.Port when the port number says
+// nothing. It reads any server-first banner (SMTP, IMAP, POP3, Telnet),
+// then tries a TLS ClientHello and, inside TLS, checks for a banner and
+// sends an HTTP request. Otherwise it falls back to an SMB2 NEGOTIATE, an
+// RDP X.224 Connection Request and an HTTP request, classifying the replies
+// (SMB, RDP, HTTP, LDAP). The result always names a registered prober.
+func Detect(ctx context.Context, cfg protocol.Config) (*Detection, error) {
+	opts := append(slices.Clone(cfg.Options), protocol.WithReadTimeout(DetectTimeout))
+	dial := func() (*protocol.Connection, error) {
+		c, err := protocol.NewConnection(cfg.Host, cfg.Port, opts...)
+		if err != nil {
+			return nil, err
+		}
+		if err := c.Dial("tcp"); err != nil {
+			_ = c.Close()
+			return nil, err
+		}
+		return c, nil
+	}
+
+	c, err := dial()
+	if err != nil {
+		return nil, err
+	}
+	d, err := detectConn(ctx, c, false)
+	if d != nil || err != nil {
+		_ = c.Close()
+		return d, err
+	}
+
+	// StartTLS closes the connection when the handshake fails.
+	if err := c.StartTLS(&tls.Config{InsecureSkipVerify: true}); err == nil {
+		d, err := detectConn(ctx, c, true)
+		_ = c.Close()
+		if d != nil || err != nil {
+			return d, err
+		}
+		return nil, errors.New("unrecognized service inside TLS")
+	}
+
+	for _, req := range clientProbes {
+		if err := ctx.Err(); err != nil {
+			return nil, err
+		}
+		c, err := dial()
+		if err != nil {
+			return nil, err
+		}
+		d, err := exchange(c, req)
+		_ = c.Close()
+		if d != nil || err != nil {
+			return d, err
+		}
+	}
+	return nil, errors.New("unrecognized service")
+}
+
+// detectConn checks c for a banner and, inside TLS, for the reply to an
+// HTTP request. It returns nil without error when c stayed silent.
+func detectConn(ctx context.Context, c *protocol.Connection, inTLS bool) (*Detection, error) {
+	banner, err := readReply(c)
+	if err != nil {
+		if inTLS && errors.Is(err, io.EOF) {
+			return nil, nil
+		}
+		return nil, fmt.Errorf("waiting for banner: %w", err)
+	}
+	if banner != nil {
+		name, ok := classifyBanner(banner)
+		if !ok {
+			return nil, fmt.Errorf("unrecognized banner %q", truncate(banner))
+		}
+		line := ""
+		if name != "telnet" {
+			line, _, _ = strings.Cut(string(banner), "\n")
+		}
+		return newDetection(c.Port, name, inTLS, false, strings.TrimSpace(line))
+	}
+	if !inTLS {
+		return nil, ctx.Err()
+	}
+	if err := ctx.Err(); err != nil {
+		return nil, err
+	}
+	return exchange(c, httpRequest)
+}
+
+func smb2Negotiate() ([]byte, error) {
+	buf, err := encoding.Marshal(v2.NewNegotiateReq(0))
+	if err != nil {
+		return nil, err
+	}
+	var msg bytes.Buffer
+	err = common.SendNetBIOSMessage(&msg, buf)
+	return msg.Bytes(), err
+}
+
+func x224Request() ([]byte, error) {
+	return rdp.MarshalConnectionRequest(rdp.ProtocolSSL | rdp.ProtocolHybrid), nil
+}
+
+func httpRequest() ([]byte, error) {
+	return []byte("GET / HTTP/1.0\r\n\r\n"), nil
+}
+
+// clientProbes are the requests sent to client-first services, each on a
+// new connection, in order.
+var clientProbes = []func() ([]byte, error){smb2Negotiate, x224Request, httpRequest}
+
+// exchange sends one probe and classifies the reply. It returns nil without
+// error when the server stays silent or hangs up.
+func exchange(c *protocol.Connection, req func() ([]byte, error)) (*Detection, error) {
+	buf, err := req()
+	if err != nil {
+		return nil, err
+	}
+	if _, err := c.Write(buf); err != nil {
+		return nil, err
+	}
+	reply, err := readReply(c)
+	if err != nil || reply == nil {
+		return nil, nil
+	}
+	_, inTLS := c.ConnectionState()
+	name, netbios, ok := classifyReply(reply)
+	if !ok {
+		return nil, fmt.Errorf("unrecognized reply %q", truncate(reply))
+	}
+	return newDetection(c.Port, name, inTLS, netbios, "")
+}
+
+// readReply returns the first bytes the server sends, or nil if it sends
+// nothing within the read timeout.
+func readReply(c *protocol.Connection) ([]byte, error) {
+	buf := make([]byte, 512)
+	n, err := c.Read(buf)
+	if n > 0 {
+		return buf[:n], nil
+	}
+	var netErr net.Error
+	if errors.As(err, &netErr) && netErr.Timeout() {
+		return nil, nil
+	}
+	if err == nil {
+		err = io.EOF
+	}
+	return nil, err
+}
+
+func classifyBanner(banner []byte) (string, bool) {
+	text := string(banner)
+	switch {
+	case banner[0] == 0xff: // IAC
+		return "telnet", true
+	case strings.HasPrefix(text, "220") && !strings.Contains(strings.ToUpper(text), "FTP"):
+		return "smtp", true
+	case strings.HasPrefix(text, "* OK"), strings.HasPrefix(text, "* PREAUTH"):
+		return "imap", true
+	case strings.HasPrefix(text, "+OK"):
+		return "pop3", true
+	}
+	return "", false
+}
+
+// classifyReply recognises replies to clientProbes. Servers that do not
+// speak the probed protocol still give themselves away: HTTP answers with
+// a 400 status line and Active Directory with an LDAP notice of
+// disconnection.
+func classifyReply(reply []byte) (name string, netbios, ok bool) {
+	switch {
+	case len(reply) >= 8 && (string(reply[4:8]) == v2.ProtocolSmb2 || string(reply[4:8]) == "\xffSMB"):
+		return "smb", false, true
+	case reply[0] == 0x83: // NBSS negative session response
+		return "smb", true, true
+	case bytes.HasPrefix(reply, []byte("HTTP/")):
+		return "http", false, true
+	case reply[0] == 0x30: // BER SEQUENCE
+		return "ldap", false, true
+	case len(reply) >= 2 && reply[0] == 0x03 && reply[1] == 0x00: // TPKT
+		return "rdp", false, true
+	}
+	return "", false, false
+}
+
+func newDetection(port uint16, name string, inTLS, netbios bool, banner string) (*Detection, error) {
+	if _, ok := Lookup(name); !ok {
+		return nil, fmt.Errorf("detected %s but no prober is registered for it", name)
+	}
+	return &Detection{Port: port, Protocol: name, TLS: inTLS, NetBIOS: netbios, Banner: banner}, nil
+}
+
+func truncate(buf []byte) []byte {
+	if len(buf) > 32 {
+		return buf[:32]
+	}
+	return buf
+}
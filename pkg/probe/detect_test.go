@@ -0,0 +1,174 @@
+package probe_test
+
+import (
+	"context"
+	"crypto/tls"
+	"io"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/d0rvin/winscope-smb/internal/testutil"
+	"github.com/d0rvin/winscope-smb/pkg/probe"
+	"github.com/d0rvin/winscope-smb/pkg/protocol/telnet"
+)
+
+// serve runs handle for every connection accepted on a fresh listener.
+func serve(t *testing.T, ln net.Listener, handle func(net.Conn)) string {
+	t.Helper()
+	t.Cleanup(func() { _ = ln.Close() })
+	go func() {
+		for {
+			conn, err := ln.Accept()
+			if err != nil {
+				return
+			}
+			go func() {
+				defer conn.Close()
+				handle(conn)
+			}()
+		}
+	}()
+	return ln.Addr().String()
+}
+
+func listen(t *testing.T) net.Listener {
+	t.Helper()
+	ln, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		t.Fatal(err)
+	}
+	return ln
+}
+
+// greeter sends a banner and drains the connection.
+func greeter(banner string) func(net.Conn) {
+	return func(conn net.Conn) {
+		_, _ = conn.Write([]byte(banner))
+		_, _ = io.Copy(io.Discard, conn)
+	}
+}
+
+// smbServer answers anything that looks like an SMB2 NEGOTIATE with an
+// SMB2 header and drops everything else, as Windows does.
+func smbServer(conn net.Conn) {
+	buf := make([]byte, 512)
+	n, err := conn.Read(buf)
+	if err != nil || n < 8 || string(buf[4:8]) != "\xfeSMB" {
+		return
+	}
+	reply := append([]byte{0x00, 0x00, 0x00, 0x40}, "\xfeSMB"...)
+	_, _ = conn.Write(append(reply, make([]byte, 60)...))
+}
+
+// rdpServer only answers a TPKT-framed X.224 Connection Request.
+func rdpServer(conn net.Conn) {
+	buf := make([]byte, 512)
+	n, err := conn.Read(buf)
+	if err != nil || n < 2 || buf[0] != 0x03 {
+		return
+	}
+	_, _ = conn.Write([]byte{0x03, 0x00, 0x00, 0x0b, 0x06, 0xd0, 0x00, 0x00, 0x12, 0x34, 0x00})
+}
+
+func TestDetect(t *testing.T) {
+	old := probe.DetectTimeout
+	probe.DetectTimeout = 200 * time.Millisecond
+	defer func() { probe.DetectTimeout = old }()
+
+	cert := testutil.SelfSignedCert(t, "detect.corp.example")
+	tlsListen := func(t *testing.T) net.Listener {
+		return tls.NewListener(listen(t), &tls.Config{Certificates: []tls.Certificate{cert}})
+	}
+	handler := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusUnauthorized)
+	})
+
+	tests := []struct {
+		name     string
+		addr     func(t *testing.T) string
+		protocol string
+		tls      bool
+		banner   string
+	}{
+		{
+			name:     "smtp",
+			addr:     func(t *testing.T) string { return serve(t, listen(t), greeter("220 mail01 ESMTP ready\r\n")) },
+			protocol: "smtp",
+			banner:   "220 mail01 ESMTP ready",
+		},
+		{
+			name: "telnet",
+			addr: func(t *testing.T) string {
+				return serve(t, listen(t), greeter(string([]byte{telnet.IAC, telnet.DO, telnet.OptionAuthentication})))
+			},
+			protocol: "telnet",
+		},
+		{
+			name:     "imaps",
+			addr:     func(t *testing.T) string { return serve(t, tlsListen(t), greeter("* OK IMAP4 ready\r\n")) },
+			protocol: "imap",
+			tls:      true,
+			banner:   "* OK IMAP4 ready",
+		},
+		{
+			name: "http",
+			addr: func(t *testing.T) string {
+				srv := httptest.NewServer(handler)
+				t.Cleanup(srv.Close)
+				return srv.Listener.Addr().String()
+			},
+			protocol: "http",
+		},
+		{
+			name: "https",
+			addr: func(t *testing.T) string {
+				srv := httptest.NewTLSServer(handler)
+				t.Cleanup(srv.Close)
+				return srv.Listener.Addr().String()
+			},
+			protocol: "http",
+			tls:      true,
+		},
+		{
+			name:     "smb",
+			addr:     func(t *testing.T) string { return serve(t, listen(t), smbServer) },
+			protocol: "smb",
+		},
+		{
+			name:     "rdp",
+			addr:     func(t *testing.T) string { return serve(t, listen(t), rdpServer) },
+			protocol: "rdp",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			d, err := probe.Detect(context.Background(), config(t, tt.addr(t)))
+			if !assert.NoError(t, err) {
+				return
+			}
+			assert.Equal(t, tt.protocol, d.Protocol)
+			assert.Equal(t, tt.tls, d.TLS)
+			assert.Equal(t, tt.banner, d.Banner)
+			assert.False(t, d.NetBIOS)
+		})
+	}
+}
+
+func TestDetectUnknown(t *testing.T) {
+	old := probe.DetectTimeout
+	probe.DetectTimeout = 200 * time.Millisecond
+	defer func() { probe.DetectTimeout = old }()
+
+	addr := serve(t, listen(t), greeter("SSH-2.0-OpenSSH_9.6\r\n"))
+	_, err := probe.Detect(context.Background(), config(t, addr))
+	assert.ErrorContains(t, err, "unrecognized banner")
+
+	addr = serve(t, listen(t), func(net.Conn) {})
+	_, err = probe.Detect(context.Background(), config(t, addr))
+	assert.Error(t, err)
+}
@@ -0,0 +1,164 @@
+package probe
+
+import (
+	"bufio"
+	"bytes"
+	"encoding/binary"
+	"io"
+	"net/http"
+	"net/netip"
+	"slices"
+	"time"
+
+	"github.com/d0rvin/winscope-smb/pkg/pcap"
+	"github.com/d0rvin/winscope-smb/pkg/protocol/httpntlm"
+	"github.com/d0rvin/winscope-smb/pkg/protocol/ntlmssp"
+	v1 "github.com/d0rvin/winscope-smb/pkg/protocol/smb/v1"
+	v2 "github.com/d0rvin/winscope-smb/pkg/protocol/smb/v2"
+)
+
+// Observation is a result recovered from captured traffic rather than by
+// probing. Its Result is what the smb or http prober reports for the same
+// exchange.
+type Observation struct {
+	Client, Server netip.AddrPort
+	Time           time.Time
+	Result
+}
+
+// ReadCapture reassembles the TCP streams of a pcap or pcapng capture and
+// returns the NTLM challenges found in them, in the order the streams
+// started. A capture cut short returns what was read with the error.
+func ReadCapture(r io.Reader) ([]Observation, error) {
+	pr, err := pcap.NewReader(r)
+	if err != nil {
+		return nil, err
+	}
+	var obs []Observation
+	a := &pcap.Assembler{Done: func(s *pcap.Stream) {
+		if o, ok := Observe(s); ok {
+			obs = append(obs, o)
+		}
+	}}
+	for {
+		p, err := pr.Next()
+		if err != nil {
+			a.Flush()
+			// Streams are reported as they close.
+			slices.SortStableFunc(obs, func(a, b Observation) int { return a.Time.Compare(b.Time) })
+			if err == io.EOF {
+				err = nil
+			}
+			return obs, err
+		}
+		if seg, ok := pcap.DecodeTCP(p); ok {
+			a.Add(seg)
+		}
+	}
+}
+
+// Observe decodes the NTLM challenge of an SMB1 or SMB2 session setup over
+// NetBIOS framing, or of HTTP NTLM or Negotiate authentication, in a
+// reassembled stream.
+func Observe(s *pcap.Stream) (Observation, bool) {
+	o := Observation{Client: s.Client, Server: s.Server, Time: s.Start}
+	o.Port = s.Server.Port()
+	var ok bool
+	if bytes.HasPrefix(s.ServerData, []byte("HTTP/")) {
+		o.Protocol = "http"
+		o.Challenge, o.Extra, ok = observeHTTP(s)
+	} else {
+		o.Protocol = "smb"
+		o.Challenge, o.Extra, ok = observeSMB(s.ServerData)
+	}
+	return o, ok
+}
+
+func observeSMB(data []byte) (*ntlmssp.Challenge, Extra, bool) {
+	for len(data) >= 4 {
+		// NBSS on 139 has a 17-bit length, direct TCP on 445 a 24-bit
+		// one; the flag bits between them are zero.
+		typ, n := data[0], int(data[1])<<16|int(binary.BigEndian.Uint16(data[2:]))
+		if len(data) < 4+n {
+			return nil, nil, false
+		}
+		msg := data[4 : 4+n]
+		data = data[4+n:]
+		if typ != 0 || len(msg) < 4 {
+			// Session requests, responses and keep-alives.
+			continue
+		}
+
+		switch string(msg[:4]) {
+		case v1.ProtocolSmb:
+			if len(msg) < 5 || msg[4] != v1.CommandSessionSetUpAndX {
+				continue
+			}
+			res, challenge, err := v1.ParseSessionSetupAndXRes(msg)
+			if err != nil {
+				continue
+			}
+			return challenge, Extra{
+				{"Dialect", "SMBv1"},
+				{"Native OS", res.NativeOS},
+				{"Native LAN Manager", res.NativeLanMan},
+			}, true
+		case v2.ProtocolSmb2:
+			// Compounded responses are chained by NextCommand.
+			for len(msg) >= 64 {
+				next := binary.LittleEndian.Uint32(msg[20:])
+				one := msg
+				if next != 0 && int(next) <= len(msg) {
+					one = msg[:next]
+				}
+				if binary.LittleEndian.Uint16(msg[12:]) == v2.CommandSessionSetup {
+					if _, challenge, err := v2.ParseSessionSetup1Res(one); err == nil {
+						return challenge, Extra{{"Dialect", "SMBv2"}}, true
+					}
+				}
+				if next == 0 || int(next) > len(msg) {
+					break
+				}
+				msg = msg[next:]
+			}
+		default:
+			// Not SMB, or an encrypted SMB3 transform.
+			if string(msg[:4]) != "\xfdSMB" {
+				return nil, nil, false
+			}
+		}
+	}
+	return nil, nil, false
+}
+
+func observeHTTP(s *pcap.Stream) (*ntlmssp.Challenge, Extra, bool) {
+	requests := bufio.NewReader(bytes.NewReader(s.ClientData))
+	responses := bufio.NewReader(bytes.NewReader(s.ServerData))
+	for {
+		// Pair responses with requests for the URL; a capture may lack
+		// the client side.
+		req, err := http.ReadRequest(requests)
+		if err == nil {
+			_, _ = io.Copy(io.Discard, req.Body)
+		} else {
+			req = nil
+		}
+		resp, err := http.ReadResponse(responses, req)
+		if err != nil {
+			return nil, nil, false
+		}
+		_, bodyErr := io.Copy(io.Discard, resp.Body)
+
+		res := httpntlm.Result{URL: "http://" + s.Server.String()}
+		if req != nil {
+			res.URL = "http://" + req.Host + req.URL.RequestURI()
+		}
+		if res.ReadResponse(resp) && res.Challenge != nil {
+			return res.Challenge, httpExtra(res), true
+		}
+		if bodyErr != nil {
+			// The rest of the stream was not captured.
+			return nil, nil, false
+		}
+	}
+}
@@ -0,0 +1,100 @@
+package probe_test
+
+import (
+	"bytes"
+	"encoding/base64"
+	"encoding/binary"
+	"net/netip"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/d0rvin/winscope-smb/internal/testutil"
+	"github.com/d0rvin/winscope-smb/pkg/encoding"
+	"github.com/d0rvin/winscope-smb/pkg/pcap"
+	"github.com/d0rvin/winscope-smb/pkg/probe"
+	"github.com/d0rvin/winscope-smb/pkg/protocol/ntlmssp"
+	"github.com/d0rvin/winscope-smb/pkg/protocol/ntstatus"
+	v2 "github.com/d0rvin/winscope-smb/pkg/protocol/smb/v2"
+)
+
+// nbss frames msg as a NetBIOS session message.
+func nbss(msg []byte) []byte {
+	return append(binary.BigEndian.AppendUint32(nil, uint32(len(msg))), msg...)
+}
+
+func smb2SessionSetup(t *testing.T) []byte {
+	t.Helper()
+	res, err := v2.NewSessionSetup1Res()
+	if err != nil {
+		t.Fatal(err)
+	}
+	res.ProtocolID = []byte(v2.ProtocolSmb2)
+	res.Header.StructureSize = 64
+	res.Command = v2.CommandSessionSetup
+	res.Status = ntstatus.MoreProcessingRequired
+	res.Header.Flags = v2.FlagsServerToRedir
+	res.Signature = make([]byte, 16)
+	res.StructureSize = 9
+	res.SecurityBlob.ResponseToken = testutil.Challenge(t, "WEB01", ntlmssp.Version{Major: 10, Build: 20348})
+	buf, err := encoding.Marshal(&res)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return buf
+}
+
+func TestReadCapture(t *testing.T) {
+	var buf bytes.Buffer
+	w, err := pcap.NewWriter(&buf)
+	if err != nil {
+		t.Fatal(err)
+	}
+	client := netip.MustParseAddrPort("192.0.2.100:50000")
+
+	// An HTTP exchange that only ends with the capture.
+	web := netip.MustParseAddrPort("192.0.2.20:80")
+	h := w.Stream(netip.MustParseAddrPort("192.0.2.100:50001"), web)
+	h.Sent([]byte("GET /ews/ HTTP/1.1\r\nHost: mail.corp.example\r\nAuthorization: NTLM TlRMTVNTUAABAAAA\r\n\r\n"))
+	h.Received([]byte("HTTP/1.1 401 Unauthorized\r\nServer: Microsoft-IIS/10.0\r\n"))
+	h.Received([]byte("WWW-Authenticate: NTLM " + base64.StdEncoding.EncodeToString(testutil.Challenge(t, "WEB01", ntlmssp.Version{Major: 10, Build: 20348})) + "\r\nContent-Length: 0\r\n\r\n"))
+
+	// A stream that is neither SMB nor HTTP.
+	other := w.Stream(client, netip.MustParseAddrPort("192.0.2.30:22"))
+	other.Received([]byte("SSH-2.0-OpenSSH_9.6\r\n"))
+	other.Close()
+
+	smb := netip.MustParseAddrPort("192.0.2.10:445")
+	s := w.Stream(client, smb)
+	s.Received(nbss(smb2SessionSetup(t)))
+	s.Close()
+
+	obs, err := probe.ReadCapture(&buf)
+	if !assert.NoError(t, err) || !assert.Len(t, obs, 2) {
+		return
+	}
+	// The streams may start within the same microsecond.
+	if obs[0].Protocol != "http" {
+		obs[0], obs[1] = obs[1], obs[0]
+	}
+
+	assert.Equal(t, "http", obs[0].Protocol)
+	assert.Equal(t, web, obs[0].Server)
+	assert.Equal(t, uint16(80), obs[0].Port)
+	url, _ := obs[0].Extra.Get("URL")
+	assert.Equal(t, "http://mail.corp.example/ews/", url)
+	server, _ := obs[0].Extra.Get("Server")
+	assert.Equal(t, "Microsoft-IIS/10.0", server)
+	if assert.NotNil(t, obs[0].Challenge) {
+		assert.Equal(t, uint16(20348), obs[0].Challenge.Version.Build)
+	}
+
+	assert.Equal(t, "smb", obs[1].Protocol)
+	assert.Equal(t, smb, obs[1].Server)
+	assert.Equal(t, client, obs[1].Client)
+	dialect, _ := obs[1].Extra.Get("Dialect")
+	assert.Equal(t, "SMBv2", dialect)
+	if assert.NotNil(t, obs[1].Challenge) {
+		assert.Equal(t, "WEB01", obs[1].Challenge.TargetInfo.Parse().NBComputerName)
+	}
+}
@@ -0,0 +1,143 @@
+package probe
+
+import (
+	"context"
+	"crypto/tls"
+	"slices"
+	"strings"
+	"sync"
+
+	"github.com/d0rvin/winscope-smb/pkg/protocol"
+	"github.com/d0rvin/winscope-smb/pkg/protocol/ntlmssp"
+)
+
+// Prober retrieves an NTLM CHALLENGE over one protocol.
+type Prober interface {
+	// Name is the lower-case protocol name the prober is registered under.
+	Name() string
+	// DefaultPorts are the ports the protocol is conventionally served on.
+	DefaultPorts() []uint16
+	// Probe runs the protocol exchange on conn, which is already dialed,
+	// up to the NTLM CHALLENGE. Extra may be returned alongside an error
+	// when the server answered but did not hand out a challenge. Each read
+	// and write is bounded by the connection timeouts; ctx should be
+	// checked between round trips.
+	Probe(ctx context.Context, conn *protocol.Connection) (*ntlmssp.Challenge, Extra, error)
+}
+
+// ConnOptioner is implemented by probers that need connection options on
+// some ports, such as implicit TLS or an NBSS session.
+type ConnOptioner interface {
+	ConnOptions(port uint16) []protocol.Option
+}
+
+// Field is a protocol-specific detail reported next to the challenge.
+type Field struct {
+	Name  string
+	Value string
+}
+
+// Extra lists protocol-specific details in display order.
+type Extra []Field
+
+// Get returns the value of the first field called name.
+func (e Extra) Get(name string) (string, bool) {
+	for _, f := range e {
+		if f.Name == name {
+			return f.Value, true
+		}
+	}
+	return "", false
+}
+
+// Result is the outcome of running a prober against one port.
+type Result struct {
+	Protocol  string
+	Port      uint16
+	Challenge *ntlmssp.Challenge
+	Extra     Extra
+	// TLS is set when the connection was encrypted by the end of the probe.
+	TLS *tls.ConnectionState
+	Err error
+}
+
+var (
+	mu      sync.RWMutex
+	probers = make(map[string]Prober)
+)
+
+// Register makes p available under p.Name(). Registering a name again
+// replaces the earlier prober, so built-in probers can be reconfigured or
+// overridden.
+func Register(p Prober) {
+	mu.Lock()
+	defer mu.Unlock()
+	probers[strings.ToLower(p.Name())] = p
+}
+
+// Lookup returns the prober registered under name, ignoring case.
+func Lookup(name string) (Prober, bool) {
+	mu.RLock()
+	defer mu.RUnlock()
+	p, ok := probers[strings.ToLower(name)]
+	return p, ok
+}
+
+// Names returns the registered protocol names in sorted order.
+func Names() []string {
+	mu.RLock()
+	defer mu.RUnlock()
+	names := make([]string, 0, len(probers))
+	for name := range probers {
+		names = append(names, name)
+	}
+	slices.Sort(names)
+	return names
+}
+
+// ForPort returns the probers listing port among their default ports,
+// sorted by name.
+func ForPort(port uint16) []Prober {
+	var matched []Prober
+	for _, name := range Names() {
+		p, ok := Lookup(name)
+		if ok && slices.Contains(p.DefaultPorts(), port) {
+			matched = append(matched, p)
+		}
+	}
+	return matched
+}
+
+// Run dials cfg and runs p on the connection. The prober's connection
+// options come first, so cfg.Options can override them. Errors are
+// classified with protocol.Classify.
+func Run(ctx context.Context, p Prober, cfg protocol.Config) Result {
+	res := Result{Protocol: p.Name(), Port: cfg.Port}
+	if res.Err = ctx.Err(); res.Err != nil {
+		return res
+	}
+
+	var opts []protocol.Option
+	if o, ok := p.(ConnOptioner); ok {
+		opts = o.ConnOptions(cfg.Port)
+	}
+	opts = append(opts, cfg.Options...)
+	c, err := protocol.NewConnection(cfg.Host, cfg.Port, opts...)
+	if err != nil {
+		res.Err = err
+		return res
+	}
+	if err := c.Dial("tcp"); err != nil {
+		_ = c.Close()
+		res.Err = err
+		return res
+	}
+	defer c.Close()
+
+	res.Challenge, res.Extra, err = p.Probe(ctx, c)
+	res.Err = protocol.Classify(err)
+	if state, ok := c.ConnectionState(); ok {
+		res.TLS = &state
+	}
+	return res
+}
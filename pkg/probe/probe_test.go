@@ -0,0 +1,169 @@
+package probe_test
+
+import (
+	"bufio"
+	"context"
+	"crypto/tls"
+	"encoding/base64"
+	"errors"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/d0rvin/winscope-smb/internal/testutil"
+	"github.com/d0rvin/winscope-smb/pkg/probe"
+	"github.com/d0rvin/winscope-smb/pkg/protocol"
+	"github.com/d0rvin/winscope-smb/pkg/protocol/ntlmssp"
+)
+
+func config(t *testing.T, addr string, opts ...protocol.Option) protocol.Config {
+	t.Helper()
+	host, portStr, _ := net.SplitHostPort(addr)
+	port, _ := strconv.Atoi(portStr)
+	return protocol.Config{Host: host, Port: uint16(port), Options: opts}
+}
+
+// lineProber is an in-house style prober: it reads a greeting line and
+// reports it, then fails since the protocol carries no NTLM.
+type lineProber struct{}
+
+func (lineProber) Name() string { return "line" }
+
+func (lineProber) DefaultPorts() []uint16 { return []uint16{7777} }
+
+func (lineProber) ConnOptions(port uint16) []protocol.Option {
+	return []protocol.Option{protocol.WithReadTimeout(time.Second)}
+}
+
+func (lineProber) Probe(ctx context.Context, conn *protocol.Connection) (*ntlmssp.Challenge, probe.Extra, error) {
+	line, err := bufio.NewReader(conn).ReadString('\n')
+	if err != nil {
+		return nil, nil, err
+	}
+	extra := probe.Extra{
+		{Name: "Greeting", Value: strings.TrimSpace(line)},
+		{Name: "Read Timeout", Value: conn.ReadTimeout.String()},
+	}
+	return nil, extra, errors.New("no NTLM")
+}
+
+func TestRegistry(t *testing.T) {
+	for _, name := range []string{"smb", "http", "mssql", "rdp", "ldap", "smtp", "imap", "pop3", "rpc", "telnet"} {
+		_, ok := probe.Lookup(name)
+		assert.True(t, ok, name)
+	}
+	p, ok := probe.Lookup("RDP")
+	if assert.True(t, ok) {
+		assert.Equal(t, "rdp", p.Name())
+	}
+	_, ok = probe.Lookup("gopher")
+	assert.False(t, ok)
+
+	names := func(ps []probe.Prober) []string {
+		var out []string
+		for _, p := range ps {
+			out = append(out, p.Name())
+		}
+		return out
+	}
+	assert.Equal(t, []string{"smb"}, names(probe.ForPort(139)))
+	assert.Equal(t, []string{"imap"}, names(probe.ForPort(993)))
+	assert.Equal(t, []string{"http"}, names(probe.ForPort(5985)))
+	assert.Empty(t, probe.ForPort(7777))
+
+	probe.Register(lineProber{})
+	assert.Contains(t, probe.Names(), "line")
+	assert.Equal(t, []string{"line"}, names(probe.ForPort(7777)))
+}
+
+func TestRun(t *testing.T) {
+	ln, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer ln.Close()
+	go func() {
+		for {
+			conn, err := ln.Accept()
+			if err != nil {
+				return
+			}
+			_, _ = conn.Write([]byte("220 hello\r\n"))
+			_ = conn.Close()
+		}
+	}()
+
+	res := probe.Run(context.Background(), lineProber{}, config(t, ln.Addr().String()))
+	assert.Equal(t, "line", res.Protocol)
+	assert.EqualError(t, res.Err, "no NTLM")
+	greeting, _ := res.Extra.Get("Greeting")
+	assert.Equal(t, "220 hello", greeting)
+	timeout, _ := res.Extra.Get("Read Timeout")
+	assert.Equal(t, "1s", timeout)
+
+	// Caller options take precedence over the prober's.
+	res = probe.Run(context.Background(), lineProber{}, config(t, ln.Addr().String(), protocol.WithReadTimeout(3*time.Second)))
+	timeout, _ = res.Extra.Get("Read Timeout")
+	assert.Equal(t, "3s", timeout)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	res = probe.Run(ctx, lineProber{}, config(t, ln.Addr().String()))
+	assert.ErrorIs(t, res.Err, context.Canceled)
+	assert.Nil(t, res.Extra)
+}
+
+func TestHTTP(t *testing.T) {
+	token := "NTLM " + base64.StdEncoding.EncodeToString(testutil.Challenge(t, "WEB01", ntlmssp.Version{Major: 10, Build: 20348}))
+	handler := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Server", "Microsoft-IIS/10.0")
+		if r.URL.Path == "/ews/" {
+			w.Header().Set("WWW-Authenticate", token)
+		} else {
+			w.Header().Add("WWW-Authenticate", "Basic realm=\"web01\"")
+		}
+		w.WriteHeader(http.StatusUnauthorized)
+	})
+
+	for _, useTLS := range []bool{false, true} {
+		t.Run("tls="+strconv.FormatBool(useTLS), func(t *testing.T) {
+			var srv *httptest.Server
+			var opts []protocol.Option
+			if useTLS {
+				srv = httptest.NewTLSServer(handler)
+				opts = append(opts, protocol.WithTLS(&tls.Config{InsecureSkipVerify: true}))
+			} else {
+				srv = httptest.NewServer(handler)
+			}
+			defer srv.Close()
+
+			p := &probe.HTTP{Paths: []string{"/", "/ews/"}}
+			res := probe.Run(context.Background(), p, config(t, srv.Listener.Addr().String(), opts...))
+			if !assert.NoError(t, res.Err) {
+				return
+			}
+			assert.Equal(t, uint16(20348), res.Challenge.Version.Build)
+			assert.Equal(t, "WEB01", res.Challenge.TargetInfo.Parse().NBComputerName)
+			url, _ := res.Extra.Get("URL")
+			assert.True(t, strings.HasSuffix(url, "/ews/"), url)
+			assert.Equal(t, useTLS, strings.HasPrefix(url, "https://"), url)
+			server, _ := res.Extra.Get("Server")
+			assert.Equal(t, "Microsoft-IIS/10.0", server)
+			assert.Equal(t, useTLS, res.TLS != nil)
+		})
+	}
+
+	srv := httptest.NewServer(handler)
+	defer srv.Close()
+	res := probe.Run(context.Background(), &probe.HTTP{Paths: []string{"/"}}, config(t, srv.Listener.Addr().String()))
+	assert.Nil(t, res.Challenge)
+	assert.Error(t, res.Err)
+	schemes, _ := res.Extra.Get("Schemes")
+	assert.Equal(t, "Basic", schemes)
+}
@@ -0,0 +1,116 @@
+package probe
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/d0rvin/winscope-smb/pkg/protocol"
+)
+
+// Status summarises what an SMB probe learned about a port.
+type Status string
+
+const (
+	// StatusOpen means an NTLM challenge was read.
+	StatusOpen Status = "open"
+	// StatusClosed means the connection was refused.
+	StatusClosed Status = "closed"
+	// StatusFiltered means connecting or reading timed out, or the
+	// connection was reset before the server answered.
+	StatusFiltered Status = "filtered"
+	// StatusNotSMB means the server answered with something other than SMB.
+	StatusNotSMB Status = "not-smb"
+	// StatusSMBNoNTLM means the server speaks SMB but returned no NTLM
+	// challenge: it does not offer NTLM, failed with an NT status or sent
+	// a reply that could not be decoded.
+	StatusSMBNoNTLM Status = "smb-no-ntlm"
+	// StatusError covers any other failure, such as a DNS error.
+	StatusError Status = "error"
+)
+
+// statusOrder ranks statuses from the most to the least informative, so
+// that a host is reported by its best port.
+var statusOrder = []Status{StatusOpen, StatusSMBNoNTLM, StatusNotSMB, StatusError, StatusFiltered, StatusClosed}
+
+// SMBStatus classifies the error of an SMB probe. The SMB prober joins the
+// errors of its SMBv1 and SMBv2 attempts, so the most informative one wins.
+func SMBStatus(err error) Status {
+	var status *protocol.ErrNTStatus
+	var decode *protocol.ErrDecode
+	switch {
+	case err == nil:
+		return StatusOpen
+	case errors.Is(err, protocol.ErrNoNTLM), errors.As(err, &status), errors.As(err, &decode):
+		return StatusSMBNoNTLM
+	case errors.Is(err, protocol.ErrNotSMB):
+		return StatusNotSMB
+	case protocol.Transient(err):
+		return StatusFiltered
+	case errors.Is(err, protocol.ErrConnRefused):
+		return StatusClosed
+	}
+	return StatusError
+}
+
+// HostStatus returns the best of the statuses of a host's ports.
+func HostStatus(statuses ...Status) Status {
+	best := StatusError
+	for i, s := range statuses {
+		if i == 0 || rank(s) < rank(best) {
+			best = s
+		}
+	}
+	return best
+}
+
+func rank(s Status) int {
+	for i, o := range statusOrder {
+		if o == s {
+			return i
+		}
+	}
+	return len(statusOrder)
+}
+
+// answered reports whether err shows that the server replied, which makes
+// retrying pointless.
+func answered(err error) bool {
+	var status *protocol.ErrNTStatus
+	var decode *protocol.ErrDecode
+	return errors.Is(err, protocol.ErrNoNTLM) || errors.Is(err, protocol.ErrNotSMB) ||
+		errors.As(err, &status) || errors.As(err, &decode)
+}
+
+// Retry re-runs probes that fail with a transient error (see
+// protocol.Transient) as long as the server has not otherwise answered.
+// It waits Backoff before the first retry and twice as long before each
+// later one, up to MaxBackoff if set.
+type Retry struct {
+	// Attempts is the number of retries after the first try.
+	Attempts   int
+	Backoff    time.Duration
+	MaxBackoff time.Duration
+}
+
+// Run is Run with up to r.Attempts retries.
+func (r Retry) Run(ctx context.Context, p Prober, cfg protocol.Config) Result {
+	backoff := r.Backoff
+	for attempt := 0; ; attempt++ {
+		res := Run(ctx, p, cfg)
+		if attempt >= r.Attempts || res.Err == nil || !protocol.Transient(res.Err) || answered(res.Err) {
+			return res
+		}
+		timer := time.NewTimer(backoff)
+		select {
+		case <-ctx.Done():
+			timer.Stop()
+			return res
+		case <-timer.C:
+		}
+		backoff *= 2
+		if r.MaxBackoff > 0 {
+			backoff = min(backoff, r.MaxBackoff)
+		}
+	}
+}
@@ -0,0 +1,87 @@
+package probe_test
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/d0rvin/winscope-smb/pkg/probe"
+	"github.com/d0rvin/winscope-smb/pkg/protocol"
+	"github.com/d0rvin/winscope-smb/pkg/protocol/ntlmssp"
+)
+
+func TestSMBStatus(t *testing.T) {
+	timeout := protocol.Classify(&net.OpError{Op: "read", Err: errTimeout{}})
+	tests := []struct {
+		err  error
+		want probe.Status
+	}{
+		{nil, probe.StatusOpen},
+		{fmt.Errorf("dial: %w", protocol.ErrConnRefused), probe.StatusClosed},
+		{timeout, probe.StatusFiltered},
+		{fmt.Errorf("SMBv2: %w", protocol.ErrNotSMB), probe.StatusNotSMB},
+		// SMBv1 is reset on hosts that disabled it; SMBv2 still answers.
+		{errors.Join(fmt.Errorf("SMBv1: %w", protocol.ErrReset), fmt.Errorf("SMBv2: %w", protocol.ErrNoNTLM)), probe.StatusSMBNoNTLM},
+		{fmt.Errorf("session setup: %w", &protocol.ErrNTStatus{Code: 0xc0000022}), probe.StatusSMBNoNTLM},
+		{errors.New("lookup files: no such host"), probe.StatusError},
+	}
+	for _, tt := range tests {
+		assert.Equal(t, tt.want, probe.SMBStatus(tt.err), "%v", tt.err)
+	}
+
+	assert.Equal(t, probe.StatusFiltered, probe.HostStatus(probe.StatusClosed, probe.StatusFiltered))
+	assert.Equal(t, probe.StatusOpen, probe.HostStatus(probe.StatusClosed, probe.StatusOpen))
+	assert.Equal(t, probe.StatusClosed, probe.HostStatus(probe.StatusClosed))
+}
+
+type errTimeout struct{}
+
+func (errTimeout) Error() string   { return "i/o timeout" }
+func (errTimeout) Timeout() bool   { return true }
+func (errTimeout) Temporary() bool { return true }
+
+// flakyProber fails with errs in turn, then succeeds.
+type flakyProber struct {
+	errs  []error
+	tries int
+}
+
+func (*flakyProber) Name() string { return "flaky" }
+
+func (*flakyProber) DefaultPorts() []uint16 { return nil }
+
+func (p *flakyProber) Probe(ctx context.Context, conn *protocol.Connection) (*ntlmssp.Challenge, probe.Extra, error) {
+	p.tries++
+	if p.tries <= len(p.errs) {
+		return nil, nil, p.errs[p.tries-1]
+	}
+	challenge := ntlmssp.NewChallenge()
+	return &challenge, nil, nil
+}
+
+func TestRetry(t *testing.T) {
+	ln := listen(t)
+	addr := serve(t, ln, func(net.Conn) {})
+	retry := probe.Retry{Attempts: 2, Backoff: time.Millisecond}
+
+	p := &flakyProber{errs: []error{protocol.ErrReset, protocol.ErrTimeout}}
+	res := retry.Run(context.Background(), p, config(t, addr))
+	assert.NoError(t, res.Err)
+	assert.Equal(t, 3, p.tries)
+
+	p = &flakyProber{errs: []error{protocol.ErrReset, protocol.ErrReset, protocol.ErrReset}}
+	res = retry.Run(context.Background(), p, config(t, addr))
+	assert.ErrorIs(t, res.Err, protocol.ErrReset)
+	assert.Equal(t, 3, p.tries)
+
+	// A server that answered is not asked again.
+	p = &flakyProber{errs: []error{errors.Join(protocol.ErrReset, protocol.ErrNoNTLM)}}
+	res = retry.Run(context.Background(), p, config(t, addr))
+	assert.ErrorIs(t, res.Err, protocol.ErrNoNTLM)
+	assert.Equal(t, 1, p.tries)
+}
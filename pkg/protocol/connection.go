@@ -1,16 +1,24 @@
 package protocol
 
 import (
+	"cmp"
+	"context"
 	"crypto/tls"
 	"errors"
 	"fmt"
+	"io"
 	"net"
-	"net/url"
+	"net/netip"
+	"slices"
+	"strings"
 	"time"
 
-	"golang.org/x/net/proxy"
+	"github.com/d0rvin/winscope-smb/pkg/protocol/netbios"
 )
 
+// maxNetBIOSRetargets bounds how many NBSS retarget responses are followed.
+const maxNetBIOSRetargets = 3
+
 type Connection struct {
 	Host          string
 	Port          uint16
@@ -21,7 +29,17 @@ type Connection struct {
 	WriteTimeout  time.Duration
 	TLSConfig     *tls.Config
 	ProxyAddr     string
+	NetBIOS       bool
+	NetBIOSName   string
+	QUIC          bool
+	LocalAddr     netip.Addr
+	Interface     string
+	IPVersion     int
+	Resolver      *Resolver
+	Recorder      Recorder
+	Dialer        func(network, address string) (net.Conn, error)
 	conn          net.Conn
+	tlsState      *tls.ConnectionState
 }
 
 type Option func(*Connection)
@@ -50,12 +68,68 @@ func WithTLS(config *tls.Config) Option {
 	}
 }
 
+// WithProxy routes TCP connections through a proxy URL (socks5, socks4,
+// socks4a, http or https), or a comma-separated chain of them where each
+// proxy is reached through the one before it.
 func WithProxy(proxyAddr string) Option {
 	return func(c *Connection) {
 		c.ProxyAddr = proxyAddr
 	}
 }
 
+// WithNetBIOSSession establishes an NBSS session, as required on port 139,
+// before any other traffic. When calledName is empty the name is derived
+// from the host name or its PTR records, falling back to *SMBSERVER.
+func WithNetBIOSSession(calledName string) Option {
+	return func(c *Connection) {
+		c.NetBIOS = true
+		c.NetBIOSName = calledName
+	}
+}
+
+// WithAddr dials addr instead of resolving the host, which is still used
+// as the TLS server name and to derive NetBIOS names.
+func WithAddr(addr netip.Addr) Option {
+	return func(c *Connection) {
+		c.Addr = &net.IPAddr{IP: addr.AsSlice(), Zone: addr.Zone()}
+	}
+}
+
+// WithLocalAddr binds outgoing connections to a source address.
+func WithLocalAddr(addr netip.Addr) Option {
+	return func(c *Connection) {
+		c.LocalAddr = addr
+	}
+}
+
+// WithInterface binds outgoing connections to an address of the named
+// interface in the same family as the target.
+func WithInterface(name string) Option {
+	return func(c *Connection) {
+		c.Interface = name
+	}
+}
+
+// WithIPVersion restricts host name resolution to IPv4 (4) or IPv6 (6).
+// The default, 0, takes the first address of either family.
+func WithIPVersion(version int) Option {
+	return func(c *Connection) {
+		c.IPVersion = version
+	}
+}
+
+// WithDialer makes TCP connections with dial instead of a net.Dialer, e.g.
+// to replay recorded traffic. The proxy, source address, interface and dial
+// timeout settings are then left to dial.
+func WithDialer(dial func(network, address string) (net.Conn, error)) Option {
+	return func(c *Connection) {
+		c.Dialer = dial
+	}
+}
+
+// NewConnection resolves host, through the WithResolver server if set,
+// unless WithAddr is given. Through a proxy a host name is left for the
+// proxy to resolve and Addr stays nil.
 func NewConnection(host string, port uint16, opts ...Option) (*Connection, error) {
 	if host == "" {
 		return nil, errors.New("invalid host")
@@ -63,14 +137,9 @@ func NewConnection(host string, port uint16, opts ...Option) (*Connection, error
 	if port == 0 {
 		return nil, errors.New("invalid port")
 	}
-	addr, err := net.ResolveIPAddr("ip", host)
-	if err != nil {
-		return nil, err
-	}
 	c := &Connection{
 		Host:          host,
 		Port:          port,
-		Addr:          addr,
 		DialTimeout:   5 * time.Second,
 		DialKeepAlive: 15 * time.Second,
 		ReadTimeout:   10 * time.Second,
@@ -79,50 +148,269 @@ func NewConnection(host string, port uint16, opts ...Option) (*Connection, error
 	for _, opt := range opts {
 		opt(c)
 	}
+	if c.Addr != nil || (c.ProxyAddr != "" && net.ParseIP(strings.Trim(host, "[]")) == nil) {
+		return c, nil
+	}
+	network, err := ipNetwork(c.IPVersion)
+	if err != nil {
+		return nil, err
+	}
+	addrs, err := c.Resolver.LookupNetIP(context.Background(), network, strings.Trim(host, "[]"))
+	if err != nil {
+		return nil, err
+	}
+	if len(addrs) == 0 {
+		return nil, fmt.Errorf("no address for %s", host)
+	}
+	// Like net.ResolveIPAddr, prefer IPv4 unless a version is required.
+	addr := addrs[0]
+	if i := slices.IndexFunc(addrs, netip.Addr.Is4); i >= 0 {
+		addr = addrs[i]
+	}
+	WithAddr(addr)(c)
 	return c, nil
 }
 
+func ipNetwork(version int) (string, error) {
+	switch version {
+	case 0:
+		return "ip", nil
+	case 4:
+		return "ip4", nil
+	case 6:
+		return "ip6", nil
+	}
+	return "", fmt.Errorf("invalid IP version %d", version)
+}
+
+// Dial connects to the server. network is ignored for QUIC connections,
+// which always run over UDP. Errors are classified as described for Classify.
 func (c *Connection) Dial(network string) error {
+	if err := c.dial(network); err != nil {
+		return Classify(err)
+	}
+	return nil
+}
+
+func (c *Connection) dial(network string) error {
+	if c.QUIC {
+		return c.dialQUIC()
+	}
+
+	var conn net.Conn
 	var err error
+	if c.NetBIOS {
+		conn, err = c.dialNetBIOS(network)
+	} else {
+		conn, err = c.dialRaw(network, c.Host, c.Port)
+	}
+	if err != nil {
+		return err
+	}
+
+	c.conn = conn
+	if c.TLSConfig != nil {
+		return c.StartTLS(c.TLSConfig)
+	}
+	return nil
+}
+
+// StartTLS upgrades an established connection to TLS. Dial calls it when
+// WithTLS is set; protocols such as RDP that negotiate security in cleartext
+// first call it themselves. An empty ServerName defaults to Host. The
+// connection is closed if the handshake fails.
+func (c *Connection) StartTLS(config *tls.Config) error {
+	if c.conn == nil {
+		return net.ErrClosed
+	}
+	if config.ServerName == "" {
+		config = config.Clone()
+		config.ServerName = c.Host
+	}
+	c.TLSConfig = config
+
+	// The handshake is not recorded; the recording carries on with the
+	// decrypted stream.
+	raw := c.conn
+	rc, recording := raw.(*recordingConn)
+	if recording {
+		raw = rc.Conn
+	}
+	tlsConn := tls.Client(raw, config)
+	if c.ReadTimeout > 0 {
+		_ = c.conn.SetDeadline(time.Now().Add(c.ReadTimeout))
+	}
+	if err := tlsConn.Handshake(); err != nil {
+		closeErr := c.Close()
+		return Classify(fmt.Errorf("TLS handshake failed: %w (connection closed: %v)", err, closeErr))
+	}
+	_ = c.conn.SetDeadline(time.Time{})
+	c.conn = tlsConn
+	if recording {
+		c.conn = &recordingConn{Conn: tlsConn, stream: rc.stream}
+	}
+	state := tlsConn.ConnectionState()
+	c.tlsState = &state
+	return nil
+}
 
+// dialRaw connects to host, or to the resolved Addr when host is Host and
+// no proxy needs the name. The connection is recorded from the start, so
+// that a NetBIOS session request is replayed too.
+func (c *Connection) dialRaw(network, host string, port uint16) (net.Conn, error) {
+	conn, err := c.connect(network, host, port)
+	if err != nil || c.Recorder == nil {
+		return conn, err
+	}
+	return c.record(conn, port), nil
+}
+
+func (c *Connection) connect(network, host string, port uint16) (net.Conn, error) {
+	var remote net.IP
 	if c.ProxyAddr == "" {
-		dialer := net.Dialer{
-			Timeout:   c.DialTimeout,
-			KeepAlive: c.DialKeepAlive,
-		}
-		if c.TLSConfig != nil {
-			c.conn, err = tls.DialWithDialer(&dialer, network, net.JoinHostPort(c.Host, fmt.Sprintf("%d", c.Port)), c.TLSConfig)
-		} else {
-			c.conn, err = dialer.Dial(network, net.JoinHostPort(c.Host, fmt.Sprintf("%d", c.Port)))
+		if host == c.Host && c.Addr != nil {
+			host = c.Addr.String()
 		}
-		return err
+		remote = net.ParseIP(strings.Split(host, "%")[0])
 	}
-
-	proxyURL, err := url.Parse(c.ProxyAddr)
+	addr := net.JoinHostPort(host, fmt.Sprintf("%d", port))
+	if c.Dialer != nil {
+		return c.Dialer(network, addr)
+	}
+	dialer := &net.Dialer{
+		Timeout:   c.DialTimeout,
+		KeepAlive: c.DialKeepAlive,
+		Resolver:  c.Resolver.resolver(),
+	}
+	local, err := c.localAddr(remote)
 	if err != nil {
-		return fmt.Errorf("invalid proxy address: %w", err)
+		return nil, err
+	}
+	if local != nil {
+		dialer.LocalAddr = &net.TCPAddr{IP: local.IP, Zone: local.Zone}
+	}
+	if c.ProxyAddr == "" {
+		return dialer.Dial(network, addr)
 	}
 
-	proxyDialer, err := proxy.FromURL(proxyURL, proxy.Direct)
+	proxyDialer, err := proxyDialer(c.ProxyAddr, dialer, c.Resolver)
 	if err != nil {
-		return fmt.Errorf("failed to create proxy dialer: %w", err)
+		return nil, err
 	}
+	return proxyDialer.Dial(network, addr)
+}
+
+// dialNetBIOS tries each candidate called name until the server accepts a
+// session, following retarget responses along the way.
+func (c *Connection) dialNetBIOS(network string) (net.Conn, error) {
+	host, port := c.Host, c.Port
+	retargets := 0
+	var lastErr error
+	for _, name := range c.netBIOSNames() {
+		for {
+			conn, err := c.dialRaw(network, host, port)
+			if err != nil {
+				return nil, err
+			}
+			if c.ReadTimeout > 0 {
+				_ = conn.SetDeadline(time.Now().Add(c.ReadTimeout))
+			}
+			err = netbios.RequestSession(conn, name, netbios.DefaultCallingName)
+			if err == nil {
+				_ = conn.SetDeadline(time.Time{})
+				return conn, nil
+			}
+			_ = conn.Close()
 
-	c.conn, err = proxyDialer.Dial(network, net.JoinHostPort(c.Host, fmt.Sprintf("%d", c.Port)))
+			var retarget *netbios.RetargetError
+			if errors.As(err, &retarget) && retargets < maxNetBIOSRetargets {
+				host, port = retarget.IP.String(), retarget.Port
+				retargets++
+				continue
+			}
+			var negative *netbios.NegativeResponseError
+			if !errors.As(err, &negative) {
+				return nil, err
+			}
+			lastErr = err
+			break
+		}
+	}
+	return nil, lastErr
+}
+
+// localAddr returns the source address to bind for a connection to remote,
+// or nil to let the system choose. remote is nil when dialing a proxy by
+// name; IPv4 is then preferred unless IPVersion is 6.
+func (c *Connection) localAddr(remote net.IP) (*net.IPAddr, error) {
+	want4 := c.IPVersion != 6
+	if remote != nil {
+		want4 = remote.To4() != nil
+	}
+	if c.LocalAddr.IsValid() {
+		local := c.LocalAddr.Unmap()
+		if remote != nil && local.Is4() != want4 {
+			return nil, fmt.Errorf("source address %s cannot reach %s", local, remote)
+		}
+		return &net.IPAddr{IP: local.AsSlice(), Zone: local.Zone()}, nil
+	}
+	if c.Interface == "" {
+		return nil, nil
+	}
+
+	iface, err := net.InterfaceByName(c.Interface)
 	if err != nil {
-		return err
+		return nil, err
+	}
+	addrs, err := iface.Addrs()
+	if err != nil {
+		return nil, err
 	}
+	// Link-local sources only reach link-local targets, which in turn are
+	// best reached from one.
+	var global, linkLocal *net.IPAddr
+	for _, a := range addrs {
+		ipNet, ok := a.(*net.IPNet)
+		if !ok || (ipNet.IP.To4() != nil) != want4 {
+			continue
+		}
+		if ipNet.IP.IsLinkLocalUnicast() {
+			linkLocal = cmp.Or(linkLocal, &net.IPAddr{IP: ipNet.IP, Zone: iface.Name})
+		} else {
+			global = cmp.Or(global, &net.IPAddr{IP: ipNet.IP})
+		}
+	}
+	if remote != nil && remote.IsLinkLocalUnicast() {
+		global, linkLocal = linkLocal, global
+	}
+	if local := cmp.Or(global, linkLocal); local != nil {
+		return local, nil
+	}
+	family := 6
+	if want4 {
+		family = 4
+	}
+	return nil, fmt.Errorf("interface %s has no IPv%d address", c.Interface, family)
+}
 
-	if c.TLSConfig != nil {
-		tlsConn := tls.Client(c.conn, c.TLSConfig)
-		if err := tlsConn.Handshake(); err != nil {
-			closeErr := c.conn.Close()
-			c.conn = nil
-			return fmt.Errorf("TLS handshake failed: %w (connection closed: %v)", err, closeErr)
+func (c *Connection) netBIOSNames() []string {
+	if c.NetBIOSName != "" {
+		return []string{c.NetBIOSName}
+	}
+
+	var names []string
+	if net.ParseIP(c.Host) == nil {
+		names = append(names, strings.ToUpper(strings.Split(c.Host, ".")[0]))
+	} else if addr, err := netip.ParseAddr(c.Host); err == nil && c.ProxyAddr == "" {
+		ptrs, _ := c.Resolver.LookupAddr(context.Background(), addr)
+		for _, ptr := range ptrs {
+			name := strings.ToUpper(strings.Split(ptr, ".")[0])
+			if name != "" && !slices.Contains(names, name) {
+				names = append(names, name)
+			}
 		}
-		c.conn = tlsConn
 	}
-	return err
+	return append(names, netbios.SMBServerName)
 }
 
 func (c *Connection) Write(data []byte) (int, error) {
@@ -136,7 +424,8 @@ func (c *Connection) Write(data []byte) (int, error) {
 		}
 	}
 
-	return c.conn.Write(data)
+	n, err := c.conn.Write(data)
+	return n, Classify(err)
 }
 
 func (c *Connection) Read(data []byte) (int, error) {
@@ -150,7 +439,12 @@ func (c *Connection) Read(data []byte) (int, error) {
 		}
 	}
 
-	return c.conn.Read(data)
+	// io.EOF is returned as is, since readers compare against it.
+	n, err := c.conn.Read(data)
+	if err == io.EOF {
+		return n, err
+	}
+	return n, Classify(err)
 }
 
 func (c *Connection) Close() error {
@@ -165,3 +459,12 @@ func (c *Connection) Close() error {
 func (c *Connection) Conn() net.Conn {
 	return c.conn
 }
+
+// ConnectionState returns the TLS state of a TLS or QUIC connection,
+// including the server certificate chain.
+func (c *Connection) ConnectionState() (tls.ConnectionState, bool) {
+	if c.tlsState == nil {
+		return tls.ConnectionState{}, false
+	}
+	return *c.tlsState, true
+}
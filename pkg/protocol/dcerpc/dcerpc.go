@@ -0,0 +1,327 @@
+package dcerpc
+
+import (
+	"bufio"
+	"encoding/binary"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"io"
+	"strings"
+
+	"github.com/d0rvin/winscope-smb/pkg/encoding"
+)
+
+const (
+	PacketTypeRequest          uint8 = 0
+	PacketTypeResponse         uint8 = 2
+	PacketTypeFault            uint8 = 3
+	PacketTypeBind             uint8 = 11
+	PacketTypeBindAck          uint8 = 12
+	PacketTypeBindNak          uint8 = 13
+	PacketTypeAlterContext     uint8 = 14
+	PacketTypeAlterContextResp uint8 = 15
+	PacketTypeAuth3            uint8 = 16
+)
+
+const (
+	PacketFlagFirstFrag uint8 = 0x01
+	PacketFlagLastFrag  uint8 = 0x02
+)
+
+// Authentication services and levels used in the auth verifier.
+const (
+	AuthTypeWinNT    uint8 = 10
+	AuthLevelConnect uint8 = 2
+)
+
+const (
+	headerSize      = 16
+	requestHdrSize  = 24
+	responseHdrSize = 24
+	defaultMaxFrag  = 4280
+)
+
+// NDRSyntax is the NDR 2.0 transfer syntax.
+var NDRSyntax = SyntaxID{
+	UUID:    MustParseUUID("8a885d04-1ceb-11c9-9fe8-08002b104860"),
+	Version: 2,
+}
+
+type Header struct {
+	RPCVers      uint8
+	RPCVersMinor uint8
+	PacketType   uint8
+	PacketFlags  uint8
+	DataRep      []byte `smb:"fixed:4"`
+	FragLength   uint16
+	AuthLength   uint16
+	CallID       uint32
+}
+
+func newHeader(packetType uint8, callID uint32) Header {
+	return Header{
+		RPCVers:     5,
+		PacketType:  packetType,
+		PacketFlags: PacketFlagFirstFrag | PacketFlagLastFrag,
+		// Little-endian integers, ASCII characters, IEEE floats.
+		DataRep: []byte{0x10, 0x00, 0x00, 0x00},
+		CallID:  callID,
+	}
+}
+
+type SyntaxID struct {
+	UUID         []byte `smb:"fixed:16"`
+	Version      uint16
+	VersionMinor uint16
+}
+
+type Bind struct {
+	Header
+	MaxXmitFrag    uint16
+	MaxRecvFrag    uint16
+	AssocGroupID   uint32
+	NumCtxItems    uint8
+	Reserved       uint8
+	Reserved2      uint16
+	ContextID      uint16
+	NumTransItems  uint8
+	Reserved3      uint8
+	AbstractSyntax SyntaxID
+	TransferSyntax SyntaxID
+	// AuthVerifier is the optional sec_trailer followed by the auth value.
+	AuthVerifier []byte
+}
+
+type Request struct {
+	Header
+	AllocHint uint32
+	ContextID uint16
+	Opnum     uint16
+	StubData  []byte
+}
+
+// MustParseUUID encodes a textual UUID in the mixed-endian DCE wire format.
+func MustParseUUID(s string) []byte {
+	raw, err := hex.DecodeString(strings.ReplaceAll(s, "-", ""))
+	if err != nil || len(raw) != 16 {
+		panic(fmt.Sprintf("dcerpc: invalid UUID %q", s))
+	}
+	uuid := make([]byte, 16)
+	binary.LittleEndian.PutUint32(uuid[0:], binary.BigEndian.Uint32(raw[0:]))
+	binary.LittleEndian.PutUint16(uuid[4:], binary.BigEndian.Uint16(raw[4:]))
+	binary.LittleEndian.PutUint16(uuid[6:], binary.BigEndian.Uint16(raw[6:]))
+	copy(uuid[8:], raw[8:])
+	return uuid
+}
+
+// UNCName returns serverName with the \\ prefix that the server name
+// arguments of SRVSVC and WKSSVC calls take.
+func UNCName(serverName string) string {
+	if strings.HasPrefix(serverName, `\\`) {
+		return serverName
+	}
+	return `\\` + serverName
+}
+
+// Client is a connection-oriented DCE/RPC client bound to a single interface.
+// The transport is typically an SMB named pipe or a TCP connection.
+type Client struct {
+	w           io.Writer
+	r           *bufio.Reader
+	callID      uint32
+	contextID   uint16
+	maxXmitFrag uint16
+	maxRecvFrag uint16
+}
+
+func NewClient(rw io.ReadWriter) *Client {
+	return &Client{
+		w:           rw,
+		r:           bufio.NewReaderSize(rw, defaultMaxFrag),
+		callID:      1,
+		maxXmitFrag: defaultMaxFrag,
+		maxRecvFrag: defaultMaxFrag,
+	}
+}
+
+func (c *Client) Bind(abstract SyntaxID) error {
+	_, err := c.bind(abstract, nil, 0)
+	return err
+}
+
+// BindAuth binds with an auth verifier of the given type and level carrying
+// token, and returns the auth value of the bind_ack, such as the NTLM
+// CHALLENGE sent in reply to a NEGOTIATE.
+func (c *Client) BindAuth(abstract SyntaxID, authType, authLevel uint8, token []byte) ([]byte, error) {
+	// auth_type, auth_level, auth_pad_length, auth_reserved, auth_context_id
+	verifier := []byte{authType, authLevel, 0, 0, 0, 0, 0, 0}
+	return c.bind(abstract, append(verifier, token...), uint16(len(token)))
+}
+
+func (c *Client) bind(abstract SyntaxID, verifier []byte, authLength uint16) ([]byte, error) {
+	req := Bind{
+		Header:         newHeader(PacketTypeBind, c.callID),
+		MaxXmitFrag:    c.maxXmitFrag,
+		MaxRecvFrag:    c.maxRecvFrag,
+		NumCtxItems:    1,
+		ContextID:      c.contextID,
+		NumTransItems:  1,
+		AbstractSyntax: abstract,
+		TransferSyntax: NDRSyntax,
+		AuthVerifier:   verifier,
+	}
+	req.AuthLength = authLength
+	if err := c.write(&req); err != nil {
+		return nil, err
+	}
+
+	h, buf, err := c.readPDU()
+	if err != nil {
+		return nil, err
+	}
+	switch h.PacketType {
+	case PacketTypeBindAck:
+	case PacketTypeBindNak:
+		if len(buf) >= headerSize+2 {
+			return nil, fmt.Errorf("bind rejected: reason %d", binary.LittleEndian.Uint16(buf[headerSize:]))
+		}
+		return nil, errors.New("bind rejected")
+	default:
+		return nil, fmt.Errorf("unexpected packet type %d in bind response", h.PacketType)
+	}
+
+	if err := c.parseBindAck(buf); err != nil {
+		return nil, err
+	}
+	if h.AuthLength == 0 {
+		return nil, nil
+	}
+	if int(h.AuthLength) > len(buf)-headerSize {
+		return nil, errors.New("bind ack auth value exceeds fragment")
+	}
+	return buf[len(buf)-int(h.AuthLength):], nil
+}
+
+func (c *Client) parseBindAck(buf []byte) error {
+	if len(buf) < headerSize+10 {
+		return errors.New("bind ack too short")
+	}
+	// The server's max_recv_frag bounds the fragments we send, and its
+	// max_xmit_frag those it sends.
+	serverXmit := binary.LittleEndian.Uint16(buf[headerSize:])
+	serverRecv := binary.LittleEndian.Uint16(buf[headerSize+2:])
+	if serverRecv <= requestHdrSize {
+		return fmt.Errorf("bind ack max_recv_frag %d leaves no room for request data", serverRecv)
+	}
+	c.maxXmitFrag = min(c.maxXmitFrag, serverRecv)
+	c.maxRecvFrag = min(c.maxRecvFrag, serverXmit)
+
+	secAddrLen := int(binary.LittleEndian.Uint16(buf[headerSize+8:]))
+	off := headerSize + 10 + secAddrLen
+	off += (4 - off%4) % 4
+	if len(buf) < off+8 {
+		return errors.New("bind ack too short")
+	}
+	if numResults := buf[off]; numResults == 0 {
+		return errors.New("bind ack has no results")
+	}
+	if result := binary.LittleEndian.Uint16(buf[off+4:]); result != 0 {
+		return fmt.Errorf("presentation context rejected: result %d, reason %d",
+			result, binary.LittleEndian.Uint16(buf[off+6:]))
+	}
+	return nil
+}
+
+// Call invokes opnum with the NDR encoded stub and returns the NDR encoded
+// response stub, reassembled from all response fragments.
+func (c *Client) Call(opnum uint16, stub []byte) ([]byte, error) {
+	c.callID++
+	maxStub := int(c.maxXmitFrag) - requestHdrSize
+	for first := true; first || len(stub) > 0; first = false {
+		chunk := stub[:min(len(stub), maxStub)]
+		stub = stub[len(chunk):]
+
+		req := Request{
+			Header:    newHeader(PacketTypeRequest, c.callID),
+			AllocHint: uint32(len(chunk) + len(stub)),
+			ContextID: c.contextID,
+			Opnum:     opnum,
+			StubData:  chunk,
+		}
+		req.PacketFlags = 0
+		if first {
+			req.PacketFlags |= PacketFlagFirstFrag
+		}
+		if len(stub) == 0 {
+			req.PacketFlags |= PacketFlagLastFrag
+		}
+		if err := c.write(&req); err != nil {
+			return nil, err
+		}
+	}
+
+	var res []byte
+	for {
+		h, buf, err := c.readPDU()
+		if err != nil {
+			return nil, err
+		}
+		if h.CallID != c.callID {
+			return nil, fmt.Errorf("unexpected call ID %d (want %d)", h.CallID, c.callID)
+		}
+		switch h.PacketType {
+		case PacketTypeResponse:
+		case PacketTypeFault:
+			if len(buf) >= responseHdrSize+4 {
+				return nil, fmt.Errorf("DCE/RPC fault: 0x%08x", binary.LittleEndian.Uint32(buf[responseHdrSize:]))
+			}
+			return nil, errors.New("DCE/RPC fault")
+		default:
+			return nil, fmt.Errorf("unexpected packet type %d in call response", h.PacketType)
+		}
+
+		end := len(buf)
+		if h.AuthLength > 0 {
+			end -= int(h.AuthLength) + 8
+		}
+		if end < responseHdrSize {
+			return nil, errors.New("response fragment too short")
+		}
+		res = append(res, buf[responseHdrSize:end]...)
+		if h.PacketFlags&PacketFlagLastFrag != 0 {
+			return res, nil
+		}
+	}
+}
+
+func (c *Client) write(pdu any) error {
+	buf, err := encoding.Marshal(pdu)
+	if err != nil {
+		return err
+	}
+	binary.LittleEndian.PutUint16(buf[8:], uint16(len(buf)))
+	_, err = c.w.Write(buf)
+	return err
+}
+
+func (c *Client) readPDU() (Header, []byte, error) {
+	buf := make([]byte, headerSize)
+	if _, err := io.ReadFull(c.r, buf); err != nil {
+		return Header{}, nil, err
+	}
+
+	var h Header
+	if err := encoding.Unmarshal(buf, &h); err != nil {
+		return Header{}, nil, err
+	}
+	if h.RPCVers != 5 || h.FragLength < headerSize {
+		return Header{}, nil, errors.New("not a DCE/RPC packet")
+	}
+
+	buf = append(buf, make([]byte, int(h.FragLength)-headerSize)...)
+	if _, err := io.ReadFull(c.r, buf[headerSize:]); err != nil {
+		return Header{}, nil, err
+	}
+	return h, buf, nil
+}
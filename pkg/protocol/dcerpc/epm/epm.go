@@ -0,0 +1,36 @@
+package epm
+
+import (
+	"fmt"
+
+	"github.com/d0rvin/winscope-smb/pkg/encoding"
+	"github.com/d0rvin/winscope-smb/pkg/protocol"
+	"github.com/d0rvin/winscope-smb/pkg/protocol/dcerpc"
+	"github.com/d0rvin/winscope-smb/pkg/protocol/ntlmssp"
+)
+
+// DefaultPort is the endpoint mapper's TCP port.
+const DefaultPort = 135
+
+var SyntaxID = dcerpc.SyntaxID{
+	UUID:    dcerpc.MustParseUUID("e1af8308-5d1f-11c9-91a4-08002b14a0fa"),
+	Version: 3,
+}
+
+// Challenge binds c to the endpoint mapper with an NTLMSSP auth verifier
+// holding a NEGOTIATE message and returns the CHALLENGE from the bind_ack.
+// No credentials are needed, so this works on hosts that only expose 135.
+func Challenge(c *dcerpc.Client) (*ntlmssp.Challenge, error) {
+	negotiate, err := encoding.Marshal(ntlmssp.NewNegotiate("", ""))
+	if err != nil {
+		return nil, err
+	}
+	token, err := c.BindAuth(SyntaxID, dcerpc.AuthTypeWinNT, dcerpc.AuthLevelConnect, negotiate)
+	if err != nil {
+		return nil, err
+	}
+	if len(token) == 0 {
+		return nil, fmt.Errorf("%w: bind ack carries no NTLM challenge", protocol.ErrNoNTLM)
+	}
+	return ntlmssp.ParseChallenge(token)
+}
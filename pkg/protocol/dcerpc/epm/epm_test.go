@@ -0,0 +1,73 @@
+package epm_test
+
+import (
+	"bytes"
+	"encoding/binary"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/d0rvin/winscope-smb/pkg/encoding"
+	"github.com/d0rvin/winscope-smb/pkg/protocol/dcerpc"
+	"github.com/d0rvin/winscope-smb/pkg/protocol/dcerpc/epm"
+	"github.com/d0rvin/winscope-smb/pkg/protocol/ntlmssp"
+)
+
+// fakeEPM answers a bind with a bind_ack whose auth verifier holds
+// challenge, and records the client's auth verifier.
+type fakeEPM struct {
+	out       bytes.Buffer
+	challenge []byte
+	verifier  []byte
+}
+
+func (p *fakeEPM) Read(b []byte) (int, error) {
+	return p.out.Read(b)
+}
+
+func (p *fakeEPM) Write(b []byte) (int, error) {
+	if authLen := int(binary.LittleEndian.Uint16(b[10:])); authLen > 0 {
+		p.verifier = append([]byte(nil), b[len(b)-authLen-8:]...)
+	}
+	ack := []byte{
+		0x05, 0x00, dcerpc.PacketTypeBindAck, 0x03, 0x10, 0x00, 0x00, 0x00,
+		0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00,
+		0xb8, 0x10, 0xb8, 0x10, 0x01, 0x00, 0x00, 0x00,
+		0x04, 0x00, '1', '3', '5', 0x00, 0x00, 0x00, // secondary address, then padding
+		0x01, 0x00, 0x00, 0x00,
+		0x00, 0x00, 0x00, 0x00,
+	}
+	ack = append(ack, make([]byte, 20)...)
+	ack = append(ack, dcerpc.AuthTypeWinNT, dcerpc.AuthLevelConnect, 0, 0, 0, 0, 0, 0)
+	ack = append(ack, p.challenge...)
+	binary.LittleEndian.PutUint16(ack[8:], uint16(len(ack)))
+	binary.LittleEndian.PutUint16(ack[10:], uint16(len(p.challenge)))
+	binary.LittleEndian.PutUint32(ack[12:], binary.LittleEndian.Uint32(b[12:]))
+	p.out.Write(ack)
+	return len(b), nil
+}
+
+func TestChallenge(t *testing.T) {
+	challenge := ntlmssp.NewChallenge()
+	challenge.Version = &ntlmssp.Version{Major: 6, Minor: 1, Build: 7601, Reserved: make([]byte, 3), Revision: 15}
+	challenge.TargetInfo = &ntlmssp.AvPairSlice{
+		{AvID: ntlmssp.AvNBComputerName, Value: encoding.ToUnicode("LEGACY01")},
+		{AvID: ntlmssp.AvEOL},
+	}
+	buf, err := encoding.Marshal(challenge)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	pipe := &fakeEPM{challenge: buf}
+	got, err := epm.Challenge(dcerpc.NewClient(pipe))
+	if !assert.NoError(t, err) {
+		return
+	}
+	assert.Equal(t, uint16(7601), got.Version.Build)
+	assert.Equal(t, "LEGACY01", got.TargetInfo.Parse().NBComputerName)
+
+	assert.Equal(t, dcerpc.AuthTypeWinNT, pipe.verifier[0])
+	assert.Equal(t, dcerpc.AuthLevelConnect, pipe.verifier[1])
+	assert.Equal(t, []byte(ntlmssp.Signature), pipe.verifier[8:16])
+}
@@ -0,0 +1,159 @@
+package ndr
+
+import (
+	"bytes"
+	"encoding/binary"
+	"errors"
+	"fmt"
+
+	"github.com/d0rvin/winscope-smb/pkg/encoding"
+)
+
+type Writer struct {
+	buf   bytes.Buffer
+	refID uint32
+}
+
+func NewWriter() *Writer {
+	return &Writer{refID: 0x00020000}
+}
+
+func (w *Writer) Bytes() []byte {
+	return w.buf.Bytes()
+}
+
+func (w *Writer) Align(n int) {
+	for w.buf.Len()%n != 0 {
+		w.buf.WriteByte(0x00)
+	}
+}
+
+func (w *Writer) WriteUint16(v uint16) {
+	w.Align(2)
+	_ = binary.Write(&w.buf, binary.LittleEndian, v)
+}
+
+func (w *Writer) WriteUint32(v uint32) {
+	w.Align(4)
+	_ = binary.Write(&w.buf, binary.LittleEndian, v)
+}
+
+func (w *Writer) WriteBytes(b []byte) {
+	w.buf.Write(b)
+}
+
+// WritePointer writes the referent ID of a unique pointer, or NULL when the
+// referent is absent. The referent itself must be written by the caller.
+func (w *Writer) WritePointer(present bool) {
+	if !present {
+		w.WriteUint32(0)
+		return
+	}
+	w.WriteUint32(w.refID)
+	w.refID += 4
+}
+
+// WriteString writes a null-terminated conformant varying UTF-16 string.
+func (w *Writer) WriteString(s string) {
+	data := append(encoding.ToUnicode(s), 0x00, 0x00)
+	count := uint32(len(data) / 2)
+	w.WriteUint32(count)
+	w.WriteUint32(0)
+	w.WriteUint32(count)
+	w.buf.Write(data)
+}
+
+// WriteUniqueString writes a unique pointer to a string, NULL if s is empty.
+func (w *Writer) WriteUniqueString(s string) {
+	w.WritePointer(s != "")
+	if s != "" {
+		w.WriteString(s)
+	}
+}
+
+// Reader decodes NDR data. Errors are sticky: once a read runs past the end
+// of the buffer every later read returns zero values and Err reports it.
+type Reader struct {
+	buf []byte
+	off int
+	err error
+}
+
+func NewReader(buf []byte) *Reader {
+	return &Reader{buf: buf}
+}
+
+func (r *Reader) Err() error {
+	return r.err
+}
+
+func (r *Reader) Align(n int) {
+	r.off += (n - r.off%n) % n
+}
+
+func (r *Reader) next(n int) []byte {
+	if r.err != nil {
+		return nil
+	}
+	if n < 0 || r.off+n > len(r.buf) {
+		r.err = fmt.Errorf("ndr: read of %d bytes at offset %d exceeds buffer of %d", n, r.off, len(r.buf))
+		return nil
+	}
+	b := r.buf[r.off : r.off+n]
+	r.off += n
+	return b
+}
+
+func (r *Reader) Uint8() uint8 {
+	b := r.next(1)
+	if b == nil {
+		return 0
+	}
+	return b[0]
+}
+
+func (r *Reader) Uint16() uint16 {
+	r.Align(2)
+	b := r.next(2)
+	if b == nil {
+		return 0
+	}
+	return binary.LittleEndian.Uint16(b)
+}
+
+func (r *Reader) Uint32() uint32 {
+	r.Align(4)
+	b := r.next(4)
+	if b == nil {
+		return 0
+	}
+	return binary.LittleEndian.Uint32(b)
+}
+
+func (r *Reader) Bytes(n int) []byte {
+	return r.next(n)
+}
+
+// Pointer reads the referent ID of a pointer; zero means NULL.
+func (r *Reader) Pointer() uint32 {
+	return r.Uint32()
+}
+
+// String reads a conformant varying UTF-16 string and strips its terminator.
+func (r *Reader) String() string {
+	maxCount := r.Uint32()
+	offset := r.Uint32()
+	count := r.Uint32()
+	if r.err == nil && (offset > maxCount || count > maxCount-offset) {
+		r.err = errors.New("ndr: invalid conformant varying string bounds")
+	}
+	data := r.next(int(count) * 2)
+	if data == nil {
+		return ""
+	}
+	s := encoding.FromUnicode(data)
+	for len(s) > 0 && s[len(s)-1] == 0 {
+		s = s[:len(s)-1]
+	}
+	return s
+}
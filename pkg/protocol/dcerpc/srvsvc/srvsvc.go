@@ -0,0 +1,283 @@
+package srvsvc
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/d0rvin/winscope-smb/pkg/protocol/dcerpc"
+	"github.com/d0rvin/winscope-smb/pkg/protocol/dcerpc/ndr"
+)
+
+const PipeName = "srvsvc"
+
+var SyntaxID = dcerpc.SyntaxID{
+	UUID:    dcerpc.MustParseUUID("4b324fc8-1670-01d3-1278-5a47bf6ee188"),
+	Version: 3,
+}
+
+const (
+	OpNetShareEnumAll  uint16 = 15
+	OpNetServerGetInfo uint16 = 21
+)
+
+const (
+	PlatformIDDOS uint32 = 300
+	PlatformIDOS2 uint32 = 400
+	PlatformIDNT  uint32 = 500
+	PlatformIDOSF uint32 = 600
+	PlatformIDVMS uint32 = 700
+)
+
+const (
+	SvTypeWorkstation      uint32 = 0x00000001
+	SvTypeServer           uint32 = 0x00000002
+	SvTypeSQLServer        uint32 = 0x00000004
+	SvTypeDomainCtrl       uint32 = 0x00000008
+	SvTypeDomainBakCtrl    uint32 = 0x00000010
+	SvTypeTimeSource       uint32 = 0x00000020
+	SvTypeAFP              uint32 = 0x00000040
+	SvTypeNovell           uint32 = 0x00000080
+	SvTypeDomainMember     uint32 = 0x00000100
+	SvTypePrintQServer     uint32 = 0x00000200
+	SvTypeDialinServer     uint32 = 0x00000400
+	SvTypeServerUnix       uint32 = 0x00000800
+	SvTypeNT               uint32 = 0x00001000
+	SvTypeWFW              uint32 = 0x00002000
+	SvTypeServerMFPN       uint32 = 0x00004000
+	SvTypeServerNT         uint32 = 0x00008000
+	SvTypePotentialBrowser uint32 = 0x00010000
+	SvTypeBackupBrowser    uint32 = 0x00020000
+	SvTypeMasterBrowser    uint32 = 0x00040000
+	SvTypeDomainMaster     uint32 = 0x00080000
+	SvTypeServerOSF        uint32 = 0x00100000
+	SvTypeServerVMS        uint32 = 0x00200000
+	SvTypeWindows          uint32 = 0x00400000
+	SvTypeDFS              uint32 = 0x00800000
+	SvTypeClusterNT        uint32 = 0x01000000
+	SvTypeTerminalServer   uint32 = 0x02000000
+	SvTypeClusterVSNT      uint32 = 0x04000000
+	SvTypeDCE              uint32 = 0x10000000
+	SvTypeAlternateXport   uint32 = 0x20000000
+	SvTypeLocalListOnly    uint32 = 0x40000000
+	SvTypeDomainEnum       uint32 = 0x80000000
+)
+
+var svTypeNames = []struct {
+	bit  uint32
+	name string
+}{
+	{SvTypeWorkstation, "workstation"},
+	{SvTypeServer, "server"},
+	{SvTypeSQLServer, "sql server"},
+	{SvTypeDomainCtrl, "domain controller"},
+	{SvTypeDomainBakCtrl, "backup domain controller"},
+	{SvTypeTimeSource, "time source"},
+	{SvTypeAFP, "apple file server"},
+	{SvTypeNovell, "novell server"},
+	{SvTypeDomainMember, "domain member"},
+	{SvTypePrintQServer, "print server"},
+	{SvTypeDialinServer, "dial-in server"},
+	{SvTypeServerUnix, "unix server"},
+	{SvTypeNT, "nt"},
+	{SvTypeWFW, "windows for workgroups"},
+	{SvTypeServerMFPN, "microsoft file and print for netware"},
+	{SvTypeServerNT, "nt server"},
+	{SvTypePotentialBrowser, "potential browser"},
+	{SvTypeBackupBrowser, "backup browser"},
+	{SvTypeMasterBrowser, "master browser"},
+	{SvTypeDomainMaster, "domain master browser"},
+	{SvTypeServerOSF, "osf server"},
+	{SvTypeServerVMS, "vms server"},
+	{SvTypeWindows, "windows"},
+	{SvTypeDFS, "dfs root"},
+	{SvTypeClusterNT, "cluster"},
+	{SvTypeTerminalServer, "terminal server"},
+	{SvTypeClusterVSNT, "cluster virtual server"},
+	{SvTypeDCE, "ibm dss"},
+	{SvTypeAlternateXport, "alternate transport"},
+	{SvTypeLocalListOnly, "local list only"},
+	{SvTypeDomainEnum, "primary domain"},
+}
+
+// ServerTypeNames returns the names of the SV_TYPE bits set in serverType.
+func ServerTypeNames(serverType uint32) []string {
+	var names []string
+	for _, t := range svTypeNames {
+		if serverType&t.bit != 0 {
+			names = append(names, t.name)
+		}
+	}
+	return names
+}
+
+func PlatformName(platformID uint32) string {
+	switch platformID {
+	case PlatformIDDOS:
+		return "DOS"
+	case PlatformIDOS2:
+		return "OS/2"
+	case PlatformIDNT:
+		return "NT"
+	case PlatformIDOSF:
+		return "OSF"
+	case PlatformIDVMS:
+		return "VMS"
+	default:
+		return fmt.Sprintf("%d", platformID)
+	}
+}
+
+const (
+	STypeDiskTree  uint32 = 0x00000000
+	STypePrintQ    uint32 = 0x00000001
+	STypeDevice    uint32 = 0x00000002
+	STypeIPC       uint32 = 0x00000003
+	STypeClusterFS uint32 = 0x02000000
+	STypeTemporary uint32 = 0x40000000
+	STypeSpecial   uint32 = 0x80000000
+)
+
+type ServerInfo101 struct {
+	PlatformID   uint32
+	Name         string
+	VersionMajor uint32
+	VersionMinor uint32
+	Type         uint32
+	Comment      string
+}
+
+type ShareInfo1 struct {
+	Name   string
+	Type   uint32
+	Remark string
+}
+
+func (s ShareInfo1) TypeName() string {
+	var name string
+	switch s.Type & 0x0fffffff {
+	case STypeDiskTree:
+		name = "disk"
+	case STypePrintQ:
+		name = "printer"
+	case STypeDevice:
+		name = "device"
+	case STypeIPC:
+		name = "ipc"
+	default:
+		name = fmt.Sprintf("0x%08x", s.Type)
+	}
+	if s.Type&STypeSpecial != 0 {
+		name += ", special"
+	}
+	if s.Type&STypeTemporary != 0 {
+		name += ", temporary"
+	}
+	return name
+}
+
+// NetShareEnumAll lists every share on serverName at information level 1.
+// The client must already be bound to SyntaxID.
+func NetShareEnumAll(c *dcerpc.Client, serverName string) ([]ShareInfo1, error) {
+	w := ndr.NewWriter()
+	w.WriteUniqueString(dcerpc.UNCName(serverName))
+	w.WriteUint32(1) // Level
+	w.WriteUint32(1) // union switch
+	w.WritePointer(true)
+	w.WriteUint32(0) // EntriesRead
+	w.WritePointer(false)
+	w.WriteUint32(0xffffffff) // PreferedMaximumLength
+	w.WritePointer(true)
+	w.WriteUint32(0) // ResumeHandle
+
+	stub, err := c.Call(OpNetShareEnumAll, w.Bytes())
+	if err != nil {
+		return nil, err
+	}
+
+	r := ndr.NewReader(stub)
+	r.Uint32() // Level
+	r.Uint32() // union switch
+	var shares []ShareInfo1
+	if r.Pointer() != 0 {
+		r.Uint32() // EntriesRead
+		if r.Pointer() != 0 {
+			count := r.Uint32()
+			if r.Err() == nil && int(count) > len(stub)/12 {
+				return nil, fmt.Errorf("invalid share count %d", count)
+			}
+			type entry struct {
+				namePtr, remarkPtr uint32
+			}
+			entries := make([]entry, count)
+			shares = make([]ShareInfo1, count)
+			for i := range entries {
+				entries[i].namePtr = r.Pointer()
+				shares[i].Type = r.Uint32()
+				entries[i].remarkPtr = r.Pointer()
+			}
+			for i, e := range entries {
+				if e.namePtr != 0 {
+					shares[i].Name = r.String()
+				}
+				if e.remarkPtr != 0 {
+					shares[i].Remark = r.String()
+				}
+			}
+		}
+	}
+	r.Uint32() // TotalEntries
+	if r.Pointer() != 0 {
+		r.Uint32() // ResumeHandle
+	}
+	status := r.Uint32()
+	if err := r.Err(); err != nil {
+		return nil, err
+	}
+	if status != 0 {
+		return nil, fmt.Errorf("NetShareEnumAll: Windows error %d", status)
+	}
+	return shares, nil
+}
+
+// NetServerGetInfo queries serverName at information level 101. The client
+// must already be bound to SyntaxID.
+func NetServerGetInfo(c *dcerpc.Client, serverName string) (*ServerInfo101, error) {
+	w := ndr.NewWriter()
+	w.WriteUniqueString(dcerpc.UNCName(serverName))
+	w.WriteUint32(101) // Level
+
+	stub, err := c.Call(OpNetServerGetInfo, w.Bytes())
+	if err != nil {
+		return nil, err
+	}
+
+	r := ndr.NewReader(stub)
+	r.Uint32() // union switch
+	var info *ServerInfo101
+	if r.Pointer() != 0 {
+		info = &ServerInfo101{}
+		info.PlatformID = r.Uint32()
+		namePtr := r.Pointer()
+		info.VersionMajor = r.Uint32()
+		info.VersionMinor = r.Uint32()
+		info.Type = r.Uint32()
+		commentPtr := r.Pointer()
+		if namePtr != 0 {
+			info.Name = r.String()
+		}
+		if commentPtr != 0 {
+			info.Comment = r.String()
+		}
+	}
+	status := r.Uint32()
+	if err := r.Err(); err != nil {
+		return nil, err
+	}
+	if status != 0 {
+		return nil, fmt.Errorf("NetServerGetInfo: Windows error %d", status)
+	}
+	if info == nil {
+		return nil, errors.New("NetServerGetInfo: empty response")
+	}
+	return info, nil
+}
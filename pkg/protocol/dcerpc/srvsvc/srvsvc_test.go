@@ -0,0 +1,118 @@
+package srvsvc_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/d0rvin/winscope-smb/internal/testutil"
+	"github.com/d0rvin/winscope-smb/pkg/protocol/dcerpc"
+	"github.com/d0rvin/winscope-smb/pkg/protocol/dcerpc/ndr"
+	"github.com/d0rvin/winscope-smb/pkg/protocol/dcerpc/srvsvc"
+	"github.com/stretchr/testify/assert"
+)
+
+func shareEnumStub(shares []srvsvc.ShareInfo1) []byte {
+	w := ndr.NewWriter()
+	w.WriteUint32(1)
+	w.WriteUint32(1)
+	w.WritePointer(true)
+	w.WriteUint32(uint32(len(shares)))
+	w.WritePointer(true)
+	w.WriteUint32(uint32(len(shares)))
+	for _, s := range shares {
+		w.WritePointer(true)
+		w.WriteUint32(s.Type)
+		w.WritePointer(true)
+	}
+	for _, s := range shares {
+		w.WriteString(s.Name)
+		w.WriteString(s.Remark)
+	}
+	w.WriteUint32(uint32(len(shares)))
+	w.WritePointer(true)
+	w.WriteUint32(0)
+	w.WriteUint32(0)
+	return w.Bytes()
+}
+
+func TestNetShareEnumAll(t *testing.T) {
+	shares := []srvsvc.ShareInfo1{
+		{Name: "ADMIN$", Type: srvsvc.STypeDiskTree | srvsvc.STypeSpecial, Remark: "Remote Admin"},
+		{Name: "C$", Type: srvsvc.STypeDiskTree | srvsvc.STypeSpecial, Remark: "Default share"},
+		{Name: "IPC$", Type: srvsvc.STypeIPC | srvsvc.STypeSpecial, Remark: "Remote IPC"},
+		{Name: "Public", Type: srvsvc.STypeDiskTree, Remark: ""},
+	}
+
+	tests := []struct {
+		name     string
+		fragSize int
+	}{
+		{name: "single fragment", fragSize: 4096},
+		{name: "multiple fragments", fragSize: 16},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			pipe := &testutil.RPCPipe{Stub: shareEnumStub(shares), FragSize: tt.fragSize}
+			c := dcerpc.NewClient(pipe)
+			assert.NoError(t, c.Bind(srvsvc.SyntaxID))
+
+			got, err := srvsvc.NetShareEnumAll(c, "192.0.2.10")
+			assert.NoError(t, err)
+			assert.Equal(t, shares, got)
+			assert.Equal(t, "disk, special", got[0].TypeName())
+			assert.Equal(t, "ipc, special", got[2].TypeName())
+		})
+	}
+}
+
+func TestNetServerGetInfo(t *testing.T) {
+	w := ndr.NewWriter()
+	w.WriteUint32(101)
+	w.WritePointer(true)
+	w.WriteUint32(srvsvc.PlatformIDNT)
+	w.WritePointer(true)
+	w.WriteUint32(10)
+	w.WriteUint32(0)
+	w.WriteUint32(srvsvc.SvTypeWorkstation | srvsvc.SvTypeServer | srvsvc.SvTypeDomainCtrl | srvsvc.SvTypeNT)
+	w.WritePointer(true)
+	w.WriteString("DC01")
+	w.WriteString("Domain controller")
+	w.WriteUint32(0)
+
+	pipe := &testutil.RPCPipe{Stub: w.Bytes(), FragSize: 4096}
+	c := dcerpc.NewClient(pipe)
+	assert.NoError(t, c.Bind(srvsvc.SyntaxID))
+
+	got, err := srvsvc.NetServerGetInfo(c, "192.0.2.10")
+	assert.NoError(t, err)
+	assert.Equal(t, &srvsvc.ServerInfo101{
+		PlatformID:   srvsvc.PlatformIDNT,
+		Name:         "DC01",
+		VersionMajor: 10,
+		VersionMinor: 0,
+		Type:         srvsvc.SvTypeWorkstation | srvsvc.SvTypeServer | srvsvc.SvTypeDomainCtrl | srvsvc.SvTypeNT,
+		Comment:      "Domain controller",
+	}, got)
+	assert.Equal(t, []string{"workstation", "server", "domain controller", "nt"}, srvsvc.ServerTypeNames(got.Type))
+}
+
+func TestBindAckMaxRecvFrag(t *testing.T) {
+	// Requests are split to fit the server's max_recv_frag.
+	pipe := &testutil.RPCPipe{Stub: shareEnumStub(nil), FragSize: 4096, MaxRecvFrag: 32}
+	c := dcerpc.NewClient(pipe)
+	if !assert.NoError(t, c.Bind(srvsvc.SyntaxID)) {
+		return
+	}
+	_, err := srvsvc.NetShareEnumAll(c, "192.0.2.10")
+	assert.NoError(t, err)
+	assert.Greater(t, len(pipe.Requests), 1)
+	for _, req := range pipe.Requests {
+		assert.LessOrEqual(t, len(req), 32)
+	}
+
+	// A server that takes no request data is refused.
+	for _, size := range []uint16{16, 24} {
+		c = dcerpc.NewClient(&testutil.RPCPipe{MaxRecvFrag: size})
+		assert.EqualError(t, c.Bind(srvsvc.SyntaxID), fmt.Sprintf("bind ack max_recv_frag %d leaves no room for request data", size))
+	}
+}
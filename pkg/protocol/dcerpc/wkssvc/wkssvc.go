@@ -0,0 +1,87 @@
+package wkssvc
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/d0rvin/winscope-smb/pkg/protocol/dcerpc"
+	"github.com/d0rvin/winscope-smb/pkg/protocol/dcerpc/ndr"
+)
+
+const PipeName = "wkssvc"
+
+var SyntaxID = dcerpc.SyntaxID{
+	UUID:    dcerpc.MustParseUUID("6bffd098-a112-3610-9833-46c3f87e345a"),
+	Version: 1,
+}
+
+const (
+	OpNetWkstaGetInfo uint16 = 0
+)
+
+// WkstaInfo holds a WKSTA_INFO_100 or WKSTA_INFO_102. LanRoot and
+// LoggedOnUsers are only filled in at level 102.
+type WkstaInfo struct {
+	Level         uint32
+	PlatformID    uint32
+	ComputerName  string
+	LanGroup      string
+	VersionMajor  uint32
+	VersionMinor  uint32
+	LanRoot       string
+	LoggedOnUsers uint32
+}
+
+// NetWkstaGetInfo queries serverName at level 100 or 102. Level 102 adds the
+// logged-on user count but needs administrative rights. The client must
+// already be bound to SyntaxID.
+func NetWkstaGetInfo(c *dcerpc.Client, serverName string, level uint32) (*WkstaInfo, error) {
+	if level != 100 && level != 102 {
+		return nil, fmt.Errorf("unsupported level %d", level)
+	}
+	w := ndr.NewWriter()
+	w.WriteUniqueString(dcerpc.UNCName(serverName))
+	w.WriteUint32(level)
+
+	stub, err := c.Call(OpNetWkstaGetInfo, w.Bytes())
+	if err != nil {
+		return nil, err
+	}
+
+	r := ndr.NewReader(stub)
+	r.Uint32() // union switch
+	var info *WkstaInfo
+	if r.Pointer() != 0 {
+		info = &WkstaInfo{Level: level}
+		info.PlatformID = r.Uint32()
+		computerNamePtr := r.Pointer()
+		lanGroupPtr := r.Pointer()
+		info.VersionMajor = r.Uint32()
+		info.VersionMinor = r.Uint32()
+		var lanRootPtr uint32
+		if level == 102 {
+			lanRootPtr = r.Pointer()
+			info.LoggedOnUsers = r.Uint32()
+		}
+		if computerNamePtr != 0 {
+			info.ComputerName = r.String()
+		}
+		if lanGroupPtr != 0 {
+			info.LanGroup = r.String()
+		}
+		if lanRootPtr != 0 {
+			info.LanRoot = r.String()
+		}
+	}
+	status := r.Uint32()
+	if err := r.Err(); err != nil {
+		return nil, err
+	}
+	if status != 0 {
+		return nil, fmt.Errorf("NetWkstaGetInfo: Windows error %d", status)
+	}
+	if info == nil {
+		return nil, errors.New("NetWkstaGetInfo: empty response")
+	}
+	return info, nil
+}
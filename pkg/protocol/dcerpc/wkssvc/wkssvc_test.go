@@ -0,0 +1,61 @@
+package wkssvc_test
+
+import (
+	"testing"
+
+	"github.com/d0rvin/winscope-smb/internal/testutil"
+	"github.com/d0rvin/winscope-smb/pkg/protocol/dcerpc"
+	"github.com/d0rvin/winscope-smb/pkg/protocol/dcerpc/ndr"
+	"github.com/d0rvin/winscope-smb/pkg/protocol/dcerpc/wkssvc"
+	"github.com/stretchr/testify/assert"
+)
+
+// wkstaInfoStub encodes the NetWkstaGetInfo response for info.
+func wkstaInfoStub(info *wkssvc.WkstaInfo) []byte {
+	w := ndr.NewWriter()
+	w.WriteUint32(info.Level)
+	w.WritePointer(true)
+	w.WriteUint32(info.PlatformID)
+	w.WritePointer(true)
+	w.WritePointer(true)
+	w.WriteUint32(info.VersionMajor)
+	w.WriteUint32(info.VersionMinor)
+	if info.Level == 102 {
+		w.WritePointer(true)
+		w.WriteUint32(info.LoggedOnUsers)
+	}
+	w.WriteString(info.ComputerName)
+	w.WriteString(info.LanGroup)
+	if info.Level == 102 {
+		w.WriteString(info.LanRoot)
+	}
+	w.WriteUint32(0)
+	return w.Bytes()
+}
+
+func TestNetWkstaGetInfo(t *testing.T) {
+	for _, want := range []*wkssvc.WkstaInfo{
+		{Level: 100, PlatformID: 500, ComputerName: "WS01", LanGroup: "CORP", VersionMajor: 10},
+		{Level: 102, PlatformID: 500, ComputerName: "WS01", LanGroup: "CORP", VersionMajor: 6, VersionMinor: 1, LanRoot: `C:\Windows`, LoggedOnUsers: 2},
+	} {
+		pipe := &testutil.RPCPipe{Stub: wkstaInfoStub(want), FragSize: 4096}
+		c := dcerpc.NewClient(pipe)
+		if !assert.NoError(t, c.Bind(wkssvc.SyntaxID)) {
+			return
+		}
+		got, err := wkssvc.NetWkstaGetInfo(c, "192.0.2.10", want.Level)
+		assert.NoError(t, err)
+		assert.Equal(t, want, got)
+
+		// The server name is sent in UNC form.
+		if assert.Len(t, pipe.Requests, 1) {
+			r := ndr.NewReader(pipe.Requests[0][24:])
+			r.Pointer()
+			assert.Equal(t, `\\192.0.2.10`, r.String())
+			assert.Equal(t, want.Level, r.Uint32())
+		}
+	}
+
+	_, err := wkssvc.NetWkstaGetInfo(dcerpc.NewClient(&testutil.RPCPipe{}), "192.0.2.10", 101)
+	assert.EqualError(t, err, "unsupported level 101")
+}
@@ -0,0 +1,102 @@
+package protocol
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net"
+	"os"
+	"syscall"
+
+	"github.com/d0rvin/winscope-smb/pkg/protocol/ntstatus"
+)
+
+// Failure classes, matched with errors.Is. Connection errors are
+// classified as they happen; Classify does the same for errors from
+// elsewhere, such as an HTTP transport.
+var (
+	ErrConnRefused = errors.New("connection refused")
+	ErrTimeout     = errors.New("timeout")
+	ErrReset       = errors.New("connection reset")
+	// ErrNotSMB is returned when a server answers with something other
+	// than SMB.
+	ErrNotSMB = errors.New("not an SMB server")
+	// ErrNoNTLM is returned when a server does not offer NTLM.
+	ErrNoNTLM = errors.New("NTLM unavailable")
+)
+
+// ErrNTStatus is a failure NT status returned by an SMB server. It wraps
+// the ntstatus.Status, so errors.Is(err, ntstatus.AccessDenied) matches.
+type ErrNTStatus struct {
+	Code ntstatus.Status
+}
+
+func (e *ErrNTStatus) Error() string {
+	return "NT status error: " + e.Code.Error()
+}
+
+func (e *ErrNTStatus) Unwrap() error {
+	return e.Code
+}
+
+// ErrDecode is returned when a reply cannot be decoded. Field names the
+// message or field that failed.
+type ErrDecode struct {
+	Field string
+	Err   error
+}
+
+func (e *ErrDecode) Error() string {
+	return fmt.Sprintf("decode %s: %v", e.Field, e.Err)
+}
+
+func (e *ErrDecode) Unwrap() error {
+	return e.Err
+}
+
+// classifiedError adds a failure class to an error without changing its
+// message.
+type classifiedError struct {
+	class error
+	err   error
+}
+
+func (e *classifiedError) Error() string {
+	return e.err.Error()
+}
+
+func (e *classifiedError) Unwrap() []error {
+	return []error{e.class, e.err}
+}
+
+// Classify wraps err so that errors.Is matches ErrConnRefused, ErrTimeout
+// or ErrReset when the underlying network error is one of those. Other
+// errors are returned as is.
+func Classify(err error) error {
+	var class error
+	var netErr net.Error
+	switch {
+	case err == nil:
+		return nil
+	case errors.Is(err, syscall.ECONNREFUSED):
+		class = ErrConnRefused
+	case errors.Is(err, syscall.ECONNRESET), errors.Is(err, syscall.ECONNABORTED), errors.Is(err, syscall.EPIPE):
+		class = ErrReset
+	case errors.Is(err, os.ErrDeadlineExceeded), errors.Is(err, context.DeadlineExceeded),
+		errors.As(err, &netErr) && netErr.Timeout():
+		class = ErrTimeout
+	default:
+		return err
+	}
+	if errors.Is(err, class) {
+		return err
+	}
+	return &classifiedError{class: class, err: err}
+}
+
+// Transient reports whether err is worth retrying: timeouts and resets,
+// which a lossy link or an overloaded server cause, but not refused
+// connections or protocol errors.
+func Transient(err error) bool {
+	return errors.Is(err, ErrTimeout) || errors.Is(err, ErrReset)
+}
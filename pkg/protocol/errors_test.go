@@ -0,0 +1,59 @@
+package protocol_test
+
+import (
+	"errors"
+	"fmt"
+	"net"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/d0rvin/winscope-smb/pkg/protocol"
+	"github.com/d0rvin/winscope-smb/pkg/protocol/ntstatus"
+)
+
+func TestErrorClasses(t *testing.T) {
+	// A port that was just released refuses connections.
+	ln := listen(t)
+	port := uint16(ln.Addr().(*net.TCPAddr).Port)
+	_ = ln.Close()
+	c, err := protocol.NewConnection("127.0.0.1", port)
+	if assert.NoError(t, err) {
+		err = c.Dial("tcp")
+		assert.ErrorIs(t, err, protocol.ErrConnRefused)
+		assert.False(t, protocol.Transient(err))
+		assert.Contains(t, err.Error(), "connect: connection refused")
+	}
+
+	// A silent server times out, and the net.Error is still reachable.
+	ln = listen(t)
+	accept(ln, func(conn net.Conn) { time.Sleep(time.Second) })
+	port = uint16(ln.Addr().(*net.TCPAddr).Port)
+	c, err = protocol.NewConnection("127.0.0.1", port, protocol.WithReadTimeout(50*time.Millisecond))
+	if assert.NoError(t, err) && assert.NoError(t, c.Dial("tcp")) {
+		_, err = c.Read(make([]byte, 1))
+		assert.ErrorIs(t, err, protocol.ErrTimeout)
+		assert.True(t, protocol.Transient(err))
+		var netErr net.Error
+		assert.True(t, errors.As(err, &netErr) && netErr.Timeout())
+		_ = c.Close()
+	}
+
+	assert.NoError(t, protocol.Classify(nil))
+	plain := errors.New("boom")
+	assert.Same(t, plain, protocol.Classify(plain))
+
+	var status *protocol.ErrNTStatus
+	err = fmt.Errorf("session setup: %w", &protocol.ErrNTStatus{Code: 0xc0000022})
+	if assert.ErrorAs(t, err, &status) {
+		assert.Equal(t, ntstatus.AccessDenied, status.Code)
+	}
+	assert.EqualError(t, status, "NT status error: STATUS_ACCESS_DENIED (0xc0000022): "+
+		"A process has requested access to an object but has not been granted those access rights.")
+	assert.ErrorIs(t, err, ntstatus.AccessDenied)
+
+	decode := &protocol.ErrDecode{Field: "NTLM challenge", Err: plain}
+	assert.ErrorIs(t, decode, plain)
+	assert.EqualError(t, decode, "decode NTLM challenge: boom")
+}
@@ -121,3 +121,17 @@ func (r *NegTokenResp) UnmarshalBinary(buf []byte, meta *encoding.Metadata) (int
 	offset := len(buf) - len(rest)
 	return offset, nil
 }
+
+// ResponseToken returns the mechanism token carried in a NegTokenResp, or buf
+// unchanged when the peer answered with a bare mechanism token, as IIS does
+// for raw NTLMSSP sent under the Negotiate scheme.
+func ResponseToken(buf []byte) ([]byte, error) {
+	if len(buf) == 0 || buf[0] != 0xa1 {
+		return buf, nil
+	}
+	var resp NegTokenResp
+	if _, err := resp.UnmarshalBinary(buf, nil); err != nil {
+		return nil, err
+	}
+	return resp.ResponseToken, nil
+}
@@ -0,0 +1,218 @@
+package httpntlm
+
+import (
+	"context"
+	"crypto/tls"
+	"encoding/base64"
+	"errors"
+	"fmt"
+	"net"
+	"net/http"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/d0rvin/winscope-smb/pkg/encoding"
+	"github.com/d0rvin/winscope-smb/pkg/protocol"
+	"github.com/d0rvin/winscope-smb/pkg/protocol/gss"
+	"github.com/d0rvin/winscope-smb/pkg/protocol/ntlmssp"
+)
+
+const (
+	SchemeNTLM      = "NTLM"
+	SchemeNegotiate = "Negotiate"
+)
+
+// DefaultPaths are endpoints that commonly require Windows authentication:
+// IIS, Exchange Web Services, WinRM, Autodiscover and RPC over HTTP.
+var DefaultPaths = []string{"/", "/ews/", "/wsman", "/autodiscover/", "/rpc/"}
+
+const defaultTimeout = 10 * time.Second
+
+// Result is the outcome of probing a single URL.
+type Result struct {
+	URL        string
+	StatusCode int
+	Server     string
+	// Schemes lists the authentication schemes offered in WWW-Authenticate.
+	Schemes []string
+	// Scheme is the scheme under which the challenge was returned.
+	Scheme    string
+	Challenge *ntlmssp.Challenge
+	Err       error
+}
+
+type Client struct {
+	baseURL string
+	client  *http.Client
+}
+
+// IsTLSPort reports whether port is conventionally served over HTTPS.
+func IsTLSPort(port uint16) bool {
+	switch port {
+	case 443, 4443, 5986, 8443:
+		return true
+	}
+	return false
+}
+
+// NewClient returns a client for cfg.Host:cfg.Port. Connections go through
+// protocol.Connection, so options such as WithProxy apply; certificates are
+// not verified since only the NTLM challenge is of interest.
+func NewClient(cfg protocol.Config, useTLS bool) *Client {
+	scheme := "http"
+	if useTLS {
+		scheme = "https"
+	}
+	transport := &http.Transport{
+		DialContext: func(ctx context.Context, network, addr string) (net.Conn, error) {
+			c, err := protocol.NewConnection(cfg.Host, cfg.Port, cfg.Options...)
+			if err != nil {
+				return nil, err
+			}
+			if err := c.Dial(network); err != nil {
+				return nil, err
+			}
+			return c.Conn(), nil
+		},
+		TLSClientConfig: &tls.Config{InsecureSkipVerify: true},
+	}
+	return newClient(scheme, cfg.Host, cfg.Port, transport)
+}
+
+// NewClientConn returns a client whose requests all go over c, which is
+// already dialed and, for HTTPS, past the TLS handshake. Requests fail once
+// the server closes the connection.
+func NewClientConn(c *protocol.Connection) *Client {
+	conns := make(chan net.Conn, 1)
+	conns <- c.Conn()
+	dial := func(ctx context.Context, network, addr string) (net.Conn, error) {
+		select {
+		case conn := <-conns:
+			return conn, nil
+		default:
+			return nil, errors.New("connection closed by server")
+		}
+	}
+
+	scheme := "http"
+	transport := &http.Transport{DialContext: dial}
+	if _, ok := c.ConnectionState(); ok {
+		scheme = "https"
+		transport.DialTLSContext = dial
+	}
+	return newClient(scheme, c.Host, c.Port, transport)
+}
+
+func newClient(scheme, host string, port uint16, transport http.RoundTripper) *Client {
+	return &Client{
+		baseURL: fmt.Sprintf("%s://%s", scheme, net.JoinHostPort(host, fmt.Sprintf("%d", port))),
+		client: &http.Client{
+			Transport: transport,
+			Timeout:   defaultTimeout,
+			CheckRedirect: func(*http.Request, []*http.Request) error {
+				return http.ErrUseLastResponse
+			},
+		},
+	}
+}
+
+func (c *Client) Close() {
+	c.client.CloseIdleConnections()
+}
+
+// Probe sends an NTLM NEGOTIATE message to path, first under the NTLM scheme
+// and then under Negotiate if that is all the server offers, and decodes the
+// CHALLENGE from the 401 response.
+func (c *Client) Probe(path string) Result {
+	res := Result{URL: c.baseURL + path}
+
+	negotiate, err := encoding.Marshal(ntlmssp.NewNegotiate("", ""))
+	if err != nil {
+		res.Err = err
+		return res
+	}
+	token := base64.StdEncoding.EncodeToString(negotiate)
+
+	for _, scheme := range []string{SchemeNTLM, SchemeNegotiate} {
+		if scheme != SchemeNTLM && !hasScheme(res.Schemes, scheme) {
+			break
+		}
+
+		req, err := http.NewRequest(http.MethodGet, res.URL, nil)
+		if err != nil {
+			res.Err = err
+			return res
+		}
+		req.Header.Set("Authorization", scheme+" "+token)
+		resp, err := c.client.Do(req)
+		if err != nil {
+			res.Err = err
+			return res
+		}
+		_ = resp.Body.Close()
+
+		if res.ReadResponse(resp) {
+			return res
+		}
+	}
+
+	if len(res.Schemes) == 0 {
+		res.Err = fmt.Errorf("no authentication requested (HTTP %d)", res.StatusCode)
+	} else {
+		res.Err = fmt.Errorf("%w: no NTLM challenge returned (offered %s)", protocol.ErrNoNTLM, strings.Join(res.Schemes, ", "))
+	}
+	return res
+}
+
+// ReadResponse records the status, server and authentication schemes of
+// resp, adding to the schemes already recorded, and decodes the challenge
+// of an NTLM or Negotiate WWW-Authenticate header. It reports whether resp
+// carried a challenge, setting Err if it could not be decoded.
+func (res *Result) ReadResponse(resp *http.Response) bool {
+	res.StatusCode = resp.StatusCode
+	res.Server = resp.Header.Get("Server")
+	for _, value := range resp.Header.Values("WWW-Authenticate") {
+		name, data, _ := strings.Cut(strings.TrimSpace(value), " ")
+		if !hasScheme(res.Schemes, name) {
+			res.Schemes = append(res.Schemes, name)
+		}
+		if data == "" || !strings.EqualFold(name, SchemeNTLM) && !strings.EqualFold(name, SchemeNegotiate) {
+			continue
+		}
+		res.Scheme = name
+		res.Challenge, res.Err = decodeChallenge(strings.TrimSpace(data))
+		return true
+	}
+	return false
+}
+
+// ProbeAll probes each path in turn.
+func (c *Client) ProbeAll(paths []string) []Result {
+	results := make([]Result, 0, len(paths))
+	for _, path := range paths {
+		results = append(results, c.Probe(path))
+	}
+	return results
+}
+
+func hasScheme(schemes []string, scheme string) bool {
+	return slices.ContainsFunc(schemes, func(s string) bool {
+		return strings.EqualFold(s, scheme)
+	})
+}
+
+func decodeChallenge(data string) (*ntlmssp.Challenge, error) {
+	buf, err := base64.StdEncoding.DecodeString(data)
+	if err != nil {
+		return nil, fmt.Errorf("invalid base64 challenge: %w", err)
+	}
+	token, err := gss.ResponseToken(buf)
+	if err != nil {
+		return nil, err
+	}
+	if len(token) == 0 {
+		return nil, errors.New("empty challenge token")
+	}
+	return ntlmssp.ParseChallenge(token)
+}
@@ -0,0 +1,99 @@
+package httpntlm_test
+
+import (
+	"encoding/base64"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/d0rvin/winscope-smb/internal/testutil"
+	"github.com/d0rvin/winscope-smb/pkg/encoding"
+	"github.com/d0rvin/winscope-smb/pkg/protocol"
+	"github.com/d0rvin/winscope-smb/pkg/protocol/gss"
+	"github.com/d0rvin/winscope-smb/pkg/protocol/httpntlm"
+	"github.com/d0rvin/winscope-smb/pkg/protocol/ntlmssp"
+)
+
+func newServer(t *testing.T) *httptest.Server {
+	c := testutil.NewChallenge("EXCH01", ntlmssp.Version{Major: 10, Build: 17763},
+		ntlmssp.AvPair{AvID: ntlmssp.AvNBDomainName, Value: encoding.ToUnicode("CORP")},
+		ntlmssp.AvPair{AvID: ntlmssp.AvDNSComputerName, Value: encoding.ToUnicode("exch01.corp.example")})
+	c.ServerChallenge = 0x0807060504030201
+	c.TargetName = encoding.ToUnicode("CORP")
+	challenge := testutil.Marshal(t, c)
+	wrapped, err := (&gss.NegTokenResp{ResponseToken: challenge}).MarshalBinary(nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	mux := http.NewServeMux()
+	// IIS with Windows authentication: both schemes, raw NTLMSSP answers.
+	mux.HandleFunc("/ews/", func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Server", "Microsoft-IIS/10.0")
+		scheme, data, _ := strings.Cut(r.Header.Get("Authorization"), " ")
+		if buf, _ := base64.StdEncoding.DecodeString(data); scheme == httpntlm.SchemeNTLM && strings.HasPrefix(string(buf), ntlmssp.Signature) {
+			w.Header().Set("WWW-Authenticate", "NTLM "+base64.StdEncoding.EncodeToString(challenge))
+		} else {
+			w.Header().Add("WWW-Authenticate", "Negotiate")
+			w.Header().Add("WWW-Authenticate", "NTLM")
+		}
+		w.WriteHeader(http.StatusUnauthorized)
+	})
+	// WinRM: Negotiate only, SPNEGO wrapped answer.
+	mux.HandleFunc("/wsman", func(w http.ResponseWriter, r *http.Request) {
+		if strings.HasPrefix(r.Header.Get("Authorization"), httpntlm.SchemeNegotiate+" ") {
+			w.Header().Set("WWW-Authenticate", "Negotiate "+base64.StdEncoding.EncodeToString(wrapped))
+		} else {
+			w.Header().Set("WWW-Authenticate", "Negotiate")
+		}
+		w.WriteHeader(http.StatusUnauthorized)
+	})
+	mux.HandleFunc("/basic/", func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("WWW-Authenticate", `Basic realm="corp"`)
+		w.WriteHeader(http.StatusUnauthorized)
+	})
+	mux.HandleFunc("/", func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusOK)
+	})
+	return httptest.NewServer(mux)
+}
+
+func TestProbe(t *testing.T) {
+	srv := newServer(t)
+	defer srv.Close()
+
+	host, portStr, _ := net.SplitHostPort(srv.Listener.Addr().String())
+	port, _ := strconv.Atoi(portStr)
+	c := httpntlm.NewClient(protocol.Config{Host: host, Port: uint16(port)}, false)
+	defer c.Close()
+
+	results := c.ProbeAll([]string{"/ews/", "/wsman", "/basic/", "/"})
+	assert.Len(t, results, 4)
+
+	for _, res := range results[:2] {
+		assert.NoError(t, res.Err, res.URL)
+		if !assert.NotNil(t, res.Challenge, res.URL) {
+			continue
+		}
+		assert.Equal(t, http.StatusUnauthorized, res.StatusCode)
+		assert.Equal(t, uint16(17763), res.Challenge.Version.Build)
+		os, ok := res.Challenge.Version.ParseToOS()
+		assert.True(t, ok)
+		assert.NotEmpty(t, os)
+		detail := res.Challenge.TargetInfo.Parse()
+		assert.Equal(t, "EXCH01", detail.NBComputerName)
+		assert.Equal(t, "exch01.corp.example", detail.DNSComputerName)
+	}
+	assert.Equal(t, httpntlm.SchemeNTLM, results[0].Scheme)
+	assert.Equal(t, "Microsoft-IIS/10.0", results[0].Server)
+	assert.Equal(t, httpntlm.SchemeNegotiate, results[1].Scheme)
+	assert.Equal(t, []string{"Negotiate"}, results[1].Schemes)
+
+	assert.ErrorContains(t, results[2].Err, "offered Basic")
+	assert.ErrorContains(t, results[3].Err, "no authentication requested")
+}
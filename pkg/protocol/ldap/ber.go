@@ -0,0 +1,133 @@
+package ldap
+
+import (
+	"encoding/binary"
+	"errors"
+	"io"
+)
+
+// BER tags used by the messages this package builds and parses. LDAP only
+// uses single-byte tags.
+const (
+	tagBoolean     byte = 0x01
+	tagInteger     byte = 0x02
+	tagOctetString byte = 0x04
+	tagEnumerated  byte = 0x0a
+	tagSequence    byte = 0x30
+	tagSet         byte = 0x31
+)
+
+// maxMessageSize bounds a single LDAP message read from the server.
+const maxMessageSize = 1 << 20
+
+// tlv encodes a BER element with a definite, minimal length.
+func tlv(tag byte, content ...[]byte) []byte {
+	var body []byte
+	for _, c := range content {
+		body = append(body, c...)
+	}
+	buf := []byte{tag}
+	switch n := len(body); {
+	case n < 0x80:
+		buf = append(buf, byte(n))
+	case n <= 0xff:
+		buf = append(buf, 0x81, byte(n))
+	case n <= 0xffff:
+		buf = append(buf, 0x82)
+		buf = binary.BigEndian.AppendUint16(buf, uint16(n))
+	default:
+		buf = append(buf, 0x84)
+		buf = binary.BigEndian.AppendUint32(buf, uint32(n))
+	}
+	return append(buf, body...)
+}
+
+// berInt encodes a non-negative integer in the fewest octets.
+func berInt(tag byte, v int) []byte {
+	var content []byte
+	for {
+		content = append([]byte{byte(v)}, content...)
+		v >>= 8
+		if v == 0 && content[0]&0x80 == 0 {
+			break
+		}
+	}
+	return tlv(tag, content)
+}
+
+func berString(tag byte, s string) []byte {
+	return tlv(tag, []byte(s))
+}
+
+// parseTLV splits the first element off buf. Active Directory always uses
+// the four-byte long form (0x84), which strict DER decoders reject, so any
+// definite length of up to four octets is accepted.
+func parseTLV(buf []byte) (tag byte, content, rest []byte, err error) {
+	if len(buf) < 2 {
+		return 0, nil, nil, errors.New("BER element truncated")
+	}
+	tag = buf[0]
+	length, hdr := int(buf[1]), 2
+	if length&0x80 != 0 {
+		n := length & 0x7f
+		if n == 0 || n > 4 {
+			return 0, nil, nil, errors.New("unsupported BER length")
+		}
+		if len(buf) < 2+n {
+			return 0, nil, nil, errors.New("BER element truncated")
+		}
+		length = 0
+		for _, b := range buf[2 : 2+n] {
+			length = length<<8 | int(b)
+		}
+		hdr += n
+	}
+	if length > len(buf)-hdr {
+		return 0, nil, nil, errors.New("BER element exceeds message bounds")
+	}
+	return tag, buf[hdr : hdr+length], buf[hdr+length:], nil
+}
+
+func parseInt(content []byte) int {
+	v := 0
+	for i, b := range content {
+		if i == 0 && b&0x80 != 0 {
+			v = -1
+		}
+		v = v<<8 | int(b)
+	}
+	return v
+}
+
+// readElement reads one complete BER element from r.
+func readElement(r io.Reader) ([]byte, error) {
+	hdr := make([]byte, 2)
+	if _, err := io.ReadFull(r, hdr); err != nil {
+		return nil, err
+	}
+	length := int(hdr[1])
+	if length&0x80 != 0 {
+		n := length & 0x7f
+		if n == 0 || n > 4 {
+			return nil, errors.New("unsupported BER length")
+		}
+		ext := make([]byte, n)
+		if _, err := io.ReadFull(r, ext); err != nil {
+			return nil, err
+		}
+		hdr = append(hdr, ext...)
+		length = 0
+		for _, b := range ext {
+			length = length<<8 | int(b)
+		}
+	}
+	if length > maxMessageSize {
+		return nil, errors.New("LDAP message too large")
+	}
+	buf := make([]byte, len(hdr)+length)
+	copy(buf, hdr)
+	if _, err := io.ReadFull(r, buf[len(hdr):]); err != nil {
+		return nil, err
+	}
+	return buf, nil
+}
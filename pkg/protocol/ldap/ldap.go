@@ -0,0 +1,304 @@
+package ldap
+
+import (
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+)
+
+const (
+	DefaultPort = 389
+	TLSPort     = 636
+)
+
+const (
+	MechanismSPNEGO = "GSS-SPNEGO"
+	// MechanismSicily is Microsoft's pre-SASL NTLM bind, which carries raw
+	// NTLMSSP messages in the bind request and the matchedDN of the reply.
+	MechanismSicily = "NTLM"
+)
+
+// Protocol operation tags (APPLICATION class).
+const (
+	OpBindRequest      byte = 0x60
+	OpBindResponse     byte = 0x61
+	OpSearchRequest    byte = 0x63
+	OpSearchResEntry   byte = 0x64
+	OpSearchResDone    byte = 0x65
+	OpSearchResRef     byte = 0x73
+	OpExtendedResponse byte = 0x78
+)
+
+// Authentication choice tags in a BindRequest (context class).
+const (
+	authSASL            byte = 0xa3
+	authSicilyNegotiate byte = 0x8a
+)
+
+const (
+	saslCredentials byte = 0x87
+	filterPresent   byte = 0x87
+)
+
+const ldapVersion3 = 3
+
+const (
+	ResultSuccess                = 0
+	ResultProtocolError          = 2
+	ResultAuthMethodNotSupported = 7
+	ResultStrongerAuthRequired   = 8
+	ResultSASLBindInProgress     = 14
+	ResultInvalidCredentials     = 49
+	ResultUnwillingToPerform     = 53
+)
+
+// RootDSEAttributes are the rootDSE attributes requested by RootDSE.
+var RootDSEAttributes = []string{
+	"defaultNamingContext",
+	"dnsHostName",
+	"serverName",
+	"domainFunctionality",
+	"forestFunctionality",
+	"domainControllerFunctionality",
+	"isGlobalCatalogReady",
+	"supportedSASLMechanisms",
+}
+
+// RootDSE holds the attributes of an anonymous rootDSE read. Functional
+// levels are -1 when the server did not return them.
+type RootDSE struct {
+	DefaultNamingContext          string
+	DNSHostName                   string
+	ServerName                    string
+	DomainFunctionality           int
+	ForestFunctionality           int
+	DomainControllerFunctionality int
+	IsGlobalCatalogReady          bool
+	SupportedSASLMechanisms       []string
+}
+
+// IsDomainController reports whether the rootDSE looks like that of an
+// Active Directory domain controller rather than AD LDS or another server.
+func (r *RootDSE) IsDomainController() bool {
+	return r.DomainControllerFunctionality >= 0 && r.DefaultNamingContext != ""
+}
+
+// DomainName converts the default naming context, e.g. DC=corp,DC=example,
+// to a DNS domain name.
+func (r *RootDSE) DomainName() string {
+	var labels []string
+	for rdn := range strings.SplitSeq(r.DefaultNamingContext, ",") {
+		key, value, ok := strings.Cut(strings.TrimSpace(rdn), "=")
+		if ok && strings.EqualFold(key, "DC") {
+			labels = append(labels, value)
+		}
+	}
+	return strings.Join(labels, ".")
+}
+
+// FunctionalLevelName maps an msDS-Behavior-Version value to the Windows
+// Server release that introduced it.
+func FunctionalLevelName(level int) string {
+	switch level {
+	case 0:
+		return "Windows 2000"
+	case 1:
+		return "Windows Server 2003 interim"
+	case 2:
+		return "Windows Server 2003"
+	case 3:
+		return "Windows Server 2008"
+	case 4:
+		return "Windows Server 2008 R2"
+	case 5:
+		return "Windows Server 2012"
+	case 6:
+		return "Windows Server 2012 R2"
+	case 7:
+		return "Windows Server 2016"
+	case 10:
+		return "Windows Server 2025"
+	}
+	return strconv.Itoa(level)
+}
+
+// ResultError is a non-success LDAPResult.
+type ResultError struct {
+	Code       int
+	Diagnostic string
+}
+
+func (e *ResultError) Error() string {
+	if e.Diagnostic == "" {
+		return fmt.Sprintf("LDAP result code %d", e.Code)
+	}
+	return fmt.Sprintf("LDAP result code %d: %s", e.Code, e.Diagnostic)
+}
+
+// Result is the common LDAPResult of bind, search done and extended
+// responses.
+type Result struct {
+	Code       int
+	MatchedDN  []byte
+	Diagnostic string
+	// SASLCredentials is serverSaslCreds from a BindResponse.
+	SASLCredentials []byte
+}
+
+func marshalMessage(id int, op []byte) []byte {
+	return tlv(tagSequence, berInt(tagInteger, id), op)
+}
+
+// MarshalSASLBind encodes a SASL BindRequest with the given mechanism and
+// credentials.
+func MarshalSASLBind(id int, mechanism string, credentials []byte) []byte {
+	sasl := tlv(authSASL, berString(tagOctetString, mechanism), tlv(tagOctetString, credentials))
+	return marshalMessage(id, tlv(OpBindRequest, berInt(tagInteger, ldapVersion3), berString(tagOctetString, ""), sasl))
+}
+
+// MarshalSicilyNegotiate encodes a Sicily BindRequest carrying an NTLM
+// NEGOTIATE message.
+func MarshalSicilyNegotiate(id int, negotiate []byte) []byte {
+	return marshalMessage(id, tlv(OpBindRequest, berInt(tagInteger, ldapVersion3), berString(tagOctetString, MechanismSicily), tlv(authSicilyNegotiate, negotiate)))
+}
+
+// MarshalRootDSESearch encodes a base-scope search of the empty DN with the
+// filter (objectClass=*).
+func MarshalRootDSESearch(id int, attributes []string) []byte {
+	var attrs []byte
+	for _, a := range attributes {
+		attrs = append(attrs, berString(tagOctetString, a)...)
+	}
+	return marshalMessage(id, tlv(OpSearchRequest,
+		berString(tagOctetString, ""), // baseObject
+		berInt(tagEnumerated, 0),      // scope: baseObject
+		berInt(tagEnumerated, 0),      // derefAliases: never
+		berInt(tagInteger, 0),         // sizeLimit
+		berInt(tagInteger, 0),         // timeLimit
+		tlv(tagBoolean, []byte{0}),    // typesOnly
+		berString(filterPresent, "objectClass"),
+		tlv(tagSequence, attrs),
+	))
+}
+
+// ParseMessage splits an LDAPMessage into its message ID, protocol
+// operation tag and operation content. Controls are ignored.
+func ParseMessage(buf []byte) (id int, op byte, content []byte, err error) {
+	tag, msg, _, err := parseTLV(buf)
+	if err != nil {
+		return 0, 0, nil, err
+	}
+	if tag != tagSequence {
+		return 0, 0, nil, fmt.Errorf("unexpected LDAP message tag 0x%02x", tag)
+	}
+	tag, idBytes, msg, err := parseTLV(msg)
+	if err != nil {
+		return 0, 0, nil, err
+	}
+	if tag != tagInteger {
+		return 0, 0, nil, errors.New("LDAP message ID missing")
+	}
+	op, content, _, err = parseTLV(msg)
+	if err != nil {
+		return 0, 0, nil, err
+	}
+	return parseInt(idBytes), op, content, nil
+}
+
+// ParseResult decodes an LDAPResult, including serverSaslCreds if present.
+func ParseResult(content []byte) (*Result, error) {
+	var fields [3][]byte
+	for i, want := range []byte{tagEnumerated, tagOctetString, tagOctetString} {
+		tag, value, rest, err := parseTLV(content)
+		if err != nil {
+			return nil, err
+		}
+		if tag != want {
+			return nil, fmt.Errorf("unexpected tag 0x%02x in LDAP result", tag)
+		}
+		fields[i], content = value, rest
+	}
+	res := &Result{
+		Code:       parseInt(fields[0]),
+		MatchedDN:  fields[1],
+		Diagnostic: string(fields[2]),
+	}
+	for len(content) > 0 {
+		tag, value, rest, err := parseTLV(content)
+		if err != nil {
+			return nil, err
+		}
+		if tag == saslCredentials {
+			res.SASLCredentials = value
+		}
+		content = rest
+	}
+	return res, nil
+}
+
+// ParseSearchEntry decodes a SearchResultEntry into its attributes.
+func ParseSearchEntry(content []byte) (map[string][]string, error) {
+	_, _, rest, err := parseTLV(content) // objectName
+	if err != nil {
+		return nil, err
+	}
+	tag, list, _, err := parseTLV(rest)
+	if err != nil {
+		return nil, err
+	}
+	if tag != tagSequence {
+		return nil, fmt.Errorf("unexpected tag 0x%02x in search entry", tag)
+	}
+	attrs := map[string][]string{}
+	for len(list) > 0 {
+		var attr []byte
+		if _, attr, list, err = parseTLV(list); err != nil {
+			return nil, err
+		}
+		_, name, vals, err := parseTLV(attr)
+		if err != nil {
+			return nil, err
+		}
+		if _, vals, _, err = parseTLV(vals); err != nil {
+			return nil, err
+		}
+		key := strings.ToLower(string(name))
+		for len(vals) > 0 {
+			var v []byte
+			if _, v, vals, err = parseTLV(vals); err != nil {
+				return nil, err
+			}
+			attrs[key] = append(attrs[key], string(v))
+		}
+	}
+	return attrs, nil
+}
+
+// newRootDSE maps search entry attributes, keyed by lower-case name, to a
+// RootDSE.
+func newRootDSE(attrs map[string][]string) *RootDSE {
+	first := func(name string) string {
+		if v := attrs[strings.ToLower(name)]; len(v) > 0 {
+			return v[0]
+		}
+		return ""
+	}
+	level := func(name string) int {
+		n, err := strconv.Atoi(first(name))
+		if err != nil {
+			return -1
+		}
+		return n
+	}
+	return &RootDSE{
+		DefaultNamingContext:          first("defaultNamingContext"),
+		DNSHostName:                   first("dnsHostName"),
+		ServerName:                    first("serverName"),
+		DomainFunctionality:           level("domainFunctionality"),
+		ForestFunctionality:           level("forestFunctionality"),
+		DomainControllerFunctionality: level("domainControllerFunctionality"),
+		IsGlobalCatalogReady:          strings.EqualFold(first("isGlobalCatalogReady"), "TRUE"),
+		SupportedSASLMechanisms:       attrs[strings.ToLower("supportedSASLMechanisms")],
+	}
+}
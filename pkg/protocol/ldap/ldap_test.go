@@ -0,0 +1,196 @@
+package ldap_test
+
+import (
+	"encoding/binary"
+	"io"
+	"net"
+	"strconv"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/d0rvin/winscope-smb/internal/testutil"
+	"github.com/d0rvin/winscope-smb/pkg/encoding"
+	"github.com/d0rvin/winscope-smb/pkg/protocol"
+	"github.com/d0rvin/winscope-smb/pkg/protocol/gss"
+	"github.com/d0rvin/winscope-smb/pkg/protocol/ldap"
+	"github.com/d0rvin/winscope-smb/pkg/protocol/ntlmssp"
+)
+
+// ber encodes an element with the four-byte long form length that Active
+// Directory uses for every element.
+func ber(tag byte, content ...[]byte) []byte {
+	var body []byte
+	for _, c := range content {
+		body = append(body, c...)
+	}
+	buf := []byte{tag, 0x84}
+	buf = binary.BigEndian.AppendUint32(buf, uint32(len(body)))
+	return append(buf, body...)
+}
+
+func message(id int, op []byte) []byte {
+	return ber(0x30, ber(0x02, []byte{byte(id)}), op)
+}
+
+func result(op byte, code int, matchedDN []byte, extra ...[]byte) []byte {
+	return ber(op, append([][]byte{ber(0x0a, []byte{byte(code)}), ber(0x04, matchedDN), ber(0x04, nil)}, extra...)...)
+}
+
+// readMessage reads one client message, which always uses short or
+// minimal long form lengths.
+func readMessage(r io.Reader) ([]byte, error) {
+	hdr := make([]byte, 2)
+	if _, err := io.ReadFull(r, hdr); err != nil {
+		return nil, err
+	}
+	length := int(hdr[1])
+	if length&0x80 != 0 {
+		ext := make([]byte, length&0x7f)
+		if _, err := io.ReadFull(r, ext); err != nil {
+			return nil, err
+		}
+		hdr = append(hdr, ext...)
+		length = 0
+		for _, b := range ext {
+			length = length<<8 | int(b)
+		}
+	}
+	body := make([]byte, length)
+	_, err := io.ReadFull(r, body)
+	return append(hdr, body...), err
+}
+
+// serveLDAP is a stand-in domain controller. It answers rootDSE searches
+// and NTLM binds; GSS-SPNEGO binds are refused unless spnego is set. The
+// authentication choice of each bind is sent to binds.
+func serveLDAP(t *testing.T, ln net.Listener, spnego bool, binds chan<- byte) {
+	conn, err := ln.Accept()
+	if err != nil {
+		return
+	}
+	defer conn.Close()
+	challenge := testutil.Challenge(t, "DC01", ntlmssp.Version{Major: 10, Build: 20348},
+		ntlmssp.AvPair{AvID: ntlmssp.AvDNSDomainName, Value: encoding.ToUnicode("corp.example")})
+
+	for {
+		buf, err := readMessage(conn)
+		if err != nil {
+			return
+		}
+		id, op, content, err := ldap.ParseMessage(buf)
+		if err != nil {
+			t.Error(err)
+			return
+		}
+
+		var resp []byte
+		switch op {
+		case ldap.OpSearchRequest:
+			attrs := ber(0x30,
+				ber(0x30, ber(0x04, []byte("defaultNamingContext")), ber(0x31, ber(0x04, []byte("DC=corp,DC=example")))),
+				ber(0x30, ber(0x04, []byte("dnsHostName")), ber(0x31, ber(0x04, []byte("dc01.corp.example")))),
+				ber(0x30, ber(0x04, []byte("domainFunctionality")), ber(0x31, ber(0x04, []byte("7")))),
+				ber(0x30, ber(0x04, []byte("domainControllerFunctionality")), ber(0x31, ber(0x04, []byte("10")))),
+				ber(0x30, ber(0x04, []byte("isGlobalCatalogReady")), ber(0x31, ber(0x04, []byte("TRUE")))),
+				ber(0x30, ber(0x04, []byte("supportedSASLMechanisms")), ber(0x31,
+					ber(0x04, []byte("GSSAPI")), ber(0x04, []byte("GSS-SPNEGO")), ber(0x04, []byte("EXTERNAL")), ber(0x04, []byte("DIGEST-MD5")))),
+			)
+			resp = append(message(id, ber(ldap.OpSearchResEntry, ber(0x04, nil), attrs)),
+				message(id, result(ldap.OpSearchResDone, ldap.ResultSuccess, nil))...)
+		case ldap.OpBindRequest:
+			// Skip the version and the short name to the authentication choice.
+			auth := content[3:]
+			auth = auth[2+int(auth[1]):]
+			binds <- auth[0]
+			switch {
+			case auth[0] == 0x8a:
+				resp = message(id, result(ldap.OpBindResponse, ldap.ResultSuccess, challenge))
+			case spnego:
+				wrapped, err := (&gss.NegTokenResp{ResponseToken: challenge}).MarshalBinary(nil)
+				if err != nil {
+					t.Error(err)
+					return
+				}
+				resp = message(id, result(ldap.OpBindResponse, ldap.ResultSASLBindInProgress, nil, ber(0x87, wrapped)))
+			default:
+				resp = message(id, result(ldap.OpBindResponse, ldap.ResultAuthMethodNotSupported, nil))
+			}
+		default:
+			t.Errorf("unexpected operation 0x%02x", op)
+			return
+		}
+		if _, err := conn.Write(resp); err != nil {
+			return
+		}
+	}
+}
+
+func dial(t *testing.T, ln net.Listener) *ldap.Session {
+	t.Helper()
+	host, portStr, _ := net.SplitHostPort(ln.Addr().String())
+	port, _ := strconv.Atoi(portStr)
+	s, err := ldap.NewSession(protocol.Config{Host: host, Port: uint16(port)})
+	if err != nil {
+		t.Fatal(err)
+	}
+	return s
+}
+
+func TestRootDSEAndBind(t *testing.T) {
+	ln, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer ln.Close()
+	binds := make(chan byte, 2)
+	go serveLDAP(t, ln, true, binds)
+
+	s := dial(t, ln)
+	defer s.Close()
+
+	dse, err := s.RootDSE()
+	if assert.NoError(t, err) {
+		assert.Equal(t, "dc01.corp.example", dse.DNSHostName)
+		assert.Equal(t, "corp.example", dse.DomainName())
+		assert.Equal(t, 7, dse.DomainFunctionality)
+		assert.Equal(t, -1, dse.ForestFunctionality)
+		assert.Equal(t, "Windows Server 2025", ldap.FunctionalLevelName(dse.DomainControllerFunctionality))
+		assert.True(t, dse.IsDomainController())
+		assert.True(t, dse.IsGlobalCatalogReady)
+		assert.Contains(t, dse.SupportedSASLMechanisms, ldap.MechanismSPNEGO)
+	}
+
+	challenge, err := s.Bind(ldap.MechanismSPNEGO)
+	if assert.NoError(t, err) {
+		assert.Equal(t, uint16(20348), challenge.Version.Build)
+		assert.Equal(t, "DC01", challenge.TargetInfo.Parse().NBComputerName)
+	}
+	assert.Equal(t, byte(0xa3), <-binds)
+}
+
+func TestBindSicily(t *testing.T) {
+	ln, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer ln.Close()
+	binds := make(chan byte, 2)
+	go serveLDAP(t, ln, false, binds)
+
+	s := dial(t, ln)
+	defer s.Close()
+
+	_, err = s.Bind(ldap.MechanismSPNEGO)
+	var resErr *ldap.ResultError
+	if assert.ErrorAs(t, err, &resErr) {
+		assert.Equal(t, ldap.ResultAuthMethodNotSupported, resErr.Code)
+	}
+
+	challenge, err := s.Bind(ldap.MechanismSicily)
+	if assert.NoError(t, err) {
+		assert.Equal(t, "corp.example", challenge.TargetInfo.Parse().DNSDomainName)
+	}
+	assert.Equal(t, byte(0xa3), <-binds)
+	assert.Equal(t, byte(0x8a), <-binds)
+}
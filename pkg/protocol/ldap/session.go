@@ -0,0 +1,182 @@
+package ldap
+
+import (
+	"crypto/tls"
+	"errors"
+	"fmt"
+
+	"github.com/d0rvin/winscope-smb/pkg/encoding"
+	"github.com/d0rvin/winscope-smb/pkg/protocol"
+	"github.com/d0rvin/winscope-smb/pkg/protocol/gss"
+	"github.com/d0rvin/winscope-smb/pkg/protocol/ntlmssp"
+)
+
+type Session struct {
+	conn      *protocol.Connection
+	messageID int
+}
+
+// IsTLSPort reports whether port is conventionally LDAP over TLS (LDAPS or
+// the global catalog over TLS).
+func IsTLSPort(port uint16) bool {
+	return port == TLSPort || port == 3269
+}
+
+func NewSession(cfg protocol.Config) (*Session, error) {
+	c, err := protocol.NewConnection(cfg.Host, cfg.Port, cfg.Options...)
+	if err != nil {
+		return nil, err
+	}
+	if err := c.Dial("tcp"); err != nil {
+		_ = c.Close()
+		return nil, err
+	}
+	return NewSessionConn(c), nil
+}
+
+// NewSessionConn starts an LDAP session on a dialed connection. On LDAPS
+// and global catalog TLS ports the connection must already be TLS, e.g.
+// dialed with WithTLS; no StartTLS is sent.
+func NewSessionConn(c *protocol.Connection) *Session {
+	return &Session{conn: c}
+}
+
+func (s *Session) Close() error {
+	return s.conn.Close()
+}
+
+// RootDSE reads the rootDSE anonymously. It must be called before a bind.
+func (s *Session) RootDSE() (*RootDSE, error) {
+	id := s.nextID()
+	if _, err := s.conn.Write(MarshalRootDSESearch(id, RootDSEAttributes)); err != nil {
+		return nil, err
+	}
+	var dse *RootDSE
+	for {
+		op, content, err := s.receive(id)
+		if err != nil {
+			return nil, err
+		}
+		switch op {
+		case OpSearchResEntry:
+			attrs, err := ParseSearchEntry(content)
+			if err != nil {
+				return nil, err
+			}
+			dse = newRootDSE(attrs)
+		case OpSearchResDone:
+			res, err := ParseResult(content)
+			if err != nil {
+				return nil, err
+			}
+			if res.Code != ResultSuccess {
+				return nil, &ResultError{Code: res.Code, Diagnostic: res.Diagnostic}
+			}
+			if dse == nil {
+				return nil, errors.New("no rootDSE entry returned")
+			}
+			return dse, nil
+		case OpSearchResRef:
+		default:
+			return nil, fmt.Errorf("unexpected LDAP operation 0x%02x in search response", op)
+		}
+	}
+}
+
+// Bind sends a bind request with an NTLM NEGOTIATE message using mechanism,
+// MechanismSPNEGO or MechanismSicily, and returns the CHALLENGE from the
+// reply.
+func (s *Session) Bind(mechanism string) (*ntlmssp.Challenge, error) {
+	negotiate, err := encoding.Marshal(ntlmssp.NewNegotiate("", ""))
+	if err != nil {
+		return nil, err
+	}
+
+	id := s.nextID()
+	var req []byte
+	switch mechanism {
+	case MechanismSPNEGO:
+		init, err := gss.NewNegTokenInit()
+		if err != nil {
+			return nil, err
+		}
+		init.Data.MechToken = negotiate
+		token, err := init.MarshalBinary(nil)
+		if err != nil {
+			return nil, err
+		}
+		req = MarshalSASLBind(id, MechanismSPNEGO, token)
+	case MechanismSicily:
+		req = MarshalSicilyNegotiate(id, negotiate)
+	default:
+		return nil, fmt.Errorf("unsupported bind mechanism %q", mechanism)
+	}
+	if _, err := s.conn.Write(req); err != nil {
+		return nil, err
+	}
+
+	op, content, err := s.receive(id)
+	if err != nil {
+		return nil, err
+	}
+	if op != OpBindResponse {
+		return nil, fmt.Errorf("unexpected LDAP operation 0x%02x in bind response", op)
+	}
+	res, err := ParseResult(content)
+	if err != nil {
+		return nil, err
+	}
+
+	// A Sicily challenge arrives in matchedDN with result success, a
+	// GSS-SPNEGO one in serverSaslCreds with saslBindInProgress.
+	var token []byte
+	switch {
+	case mechanism == MechanismSicily && res.Code == ResultSuccess:
+		token = res.MatchedDN
+	case mechanism == MechanismSPNEGO && res.Code == ResultSASLBindInProgress:
+		if token, err = gss.ResponseToken(res.SASLCredentials); err != nil {
+			return nil, err
+		}
+	default:
+		return nil, &ResultError{Code: res.Code, Diagnostic: res.Diagnostic}
+	}
+	if len(token) == 0 {
+		return nil, errors.New("empty challenge token")
+	}
+	return ntlmssp.ParseChallenge(token)
+}
+
+// ConnectionState reports the TLS state on LDAPS ports.
+func (s *Session) ConnectionState() (tls.ConnectionState, bool) {
+	return s.conn.ConnectionState()
+}
+
+// receive reads the next message for id. An unsolicited notice of
+// disconnection is returned as its result error.
+func (s *Session) receive(id int) (byte, []byte, error) {
+	for {
+		buf, err := readElement(s.conn)
+		if err != nil {
+			return 0, nil, err
+		}
+		msgID, op, content, err := ParseMessage(buf)
+		if err != nil {
+			return 0, nil, err
+		}
+		if msgID == id {
+			return op, content, nil
+		}
+		if msgID == 0 && op == OpExtendedResponse {
+			res, err := ParseResult(content)
+			if err != nil {
+				return 0, nil, err
+			}
+			return 0, nil, &ResultError{Code: res.Code, Diagnostic: res.Diagnostic}
+		}
+	}
+}
+
+func (s *Session) nextID() int {
+	s.messageID++
+	return s.messageID
+}
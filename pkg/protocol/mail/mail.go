@@ -0,0 +1,460 @@
+package mail
+
+import (
+	"bufio"
+	"crypto/tls"
+	"encoding/base64"
+	"errors"
+	"fmt"
+	"slices"
+	"strconv"
+	"strings"
+
+	"github.com/d0rvin/winscope-smb/pkg/encoding"
+	"github.com/d0rvin/winscope-smb/pkg/protocol"
+	"github.com/d0rvin/winscope-smb/pkg/protocol/ntlmssp"
+)
+
+const (
+	ProtocolSMTP = "SMTP"
+	ProtocolIMAP = "IMAP"
+	ProtocolPOP3 = "POP3"
+)
+
+const clientName = "winscope-smb"
+
+// negotiateName stands in for the base64 NEGOTIATE line in errors.
+const negotiateName = "NTLM NEGOTIATE"
+
+// maxLines bounds multi-line replies so a misbehaving server cannot keep a
+// probe reading forever.
+const maxLines = 256
+
+// ProtocolForPort maps the well-known mail ports to their protocol.
+func ProtocolForPort(port uint16) (string, bool) {
+	switch port {
+	case 25, 465, 587, 2525:
+		return ProtocolSMTP, true
+	case 143, 993:
+		return ProtocolIMAP, true
+	case 110, 995:
+		return ProtocolPOP3, true
+	}
+	return "", false
+}
+
+// IsTLSPort reports whether port uses implicit TLS (SMTPS, IMAPS, POP3S).
+func IsTLSPort(port uint16) bool {
+	switch port {
+	case 465, 993, 995:
+		return true
+	}
+	return false
+}
+
+// ReplyError is a negative reply to a command.
+type ReplyError struct {
+	Command string
+	Reply   string
+}
+
+func (e *ReplyError) Error() string {
+	return fmt.Sprintf("%s rejected: %s", e.Command, e.Reply)
+}
+
+type Session struct {
+	conn         *protocol.Connection
+	r            *bufio.Reader
+	proto        string
+	tag          int
+	banner       string
+	capabilities []string
+}
+
+// NewSession connects, reads the greeting and the server capabilities.
+// proto is one of ProtocolSMTP, ProtocolIMAP or ProtocolPOP3.
+func NewSession(cfg protocol.Config, proto string) (*Session, error) {
+	switch proto {
+	case ProtocolSMTP, ProtocolIMAP, ProtocolPOP3:
+	default:
+		return nil, fmt.Errorf("unsupported mail protocol %q", proto)
+	}
+	c, err := protocol.NewConnection(cfg.Host, cfg.Port, cfg.Options...)
+	if err != nil {
+		return nil, err
+	}
+	if err := c.Dial("tcp"); err != nil {
+		_ = c.Close()
+		return nil, err
+	}
+	s, err := NewSessionConn(c, proto)
+	if err != nil {
+		_ = c.Close()
+		return nil, err
+	}
+	return s, nil
+}
+
+// NewSessionConn is like NewSession on a connection that is already dialed.
+// The connection is left open on error.
+func NewSessionConn(c *protocol.Connection, proto string) (*Session, error) {
+	switch proto {
+	case ProtocolSMTP, ProtocolIMAP, ProtocolPOP3:
+	default:
+		return nil, fmt.Errorf("unsupported mail protocol %q", proto)
+	}
+	s := &Session{conn: c, r: bufio.NewReader(c), proto: proto}
+	if err := s.greet(); err != nil {
+		return nil, err
+	}
+	if err := s.readCapabilities(); err != nil {
+		return nil, err
+	}
+	return s, nil
+}
+
+func (s *Session) Close() error {
+	return s.conn.Close()
+}
+
+// Banner returns the server greeting without its status prefix.
+func (s *Session) Banner() string {
+	return s.banner
+}
+
+// Capabilities returns the SMTP EHLO keywords, IMAP capabilities or POP3
+// CAPA lines, upper-cased.
+func (s *Session) Capabilities() []string {
+	return s.capabilities
+}
+
+// StartTLS upgrades the connection if the server offers STARTTLS (STLS on
+// POP3) and the connection is not already encrypted, then re-reads the
+// capabilities. It reports whether an upgrade took place.
+func (s *Session) StartTLS() (bool, error) {
+	if _, ok := s.conn.ConnectionState(); ok {
+		return false, nil
+	}
+	command := "STARTTLS"
+	if s.proto == ProtocolPOP3 {
+		command = "STLS"
+	}
+	if !s.hasCapability(command) {
+		return false, nil
+	}
+
+	switch s.proto {
+	case ProtocolSMTP:
+		if _, err := s.smtpCommand(command, command, 220); err != nil {
+			return false, err
+		}
+	case ProtocolIMAP:
+		if _, err := s.imapCommand(command); err != nil {
+			return false, err
+		}
+	case ProtocolPOP3:
+		if _, err := s.pop3Command(command); err != nil {
+			return false, err
+		}
+	}
+	if err := s.conn.StartTLS(&tls.Config{InsecureSkipVerify: true}); err != nil {
+		return false, err
+	}
+	s.r.Reset(s.conn)
+	return true, s.readCapabilities()
+}
+
+// ConnectionState reports the TLS state after implicit TLS or StartTLS.
+func (s *Session) ConnectionState() (tls.ConnectionState, bool) {
+	return s.conn.ConnectionState()
+}
+
+// Challenge starts AUTH NTLM (AUTHENTICATE NTLM on IMAP), sends the NTLM
+// NEGOTIATE message in reply to the first continuation and decodes the
+// base64 CHALLENGE from the second.
+func (s *Session) Challenge() (*ntlmssp.Challenge, error) {
+	negotiate, err := encoding.Marshal(ntlmssp.NewNegotiate("", ""))
+	if err != nil {
+		return nil, err
+	}
+	token := base64.StdEncoding.EncodeToString(negotiate)
+
+	var data string
+	switch s.proto {
+	case ProtocolSMTP:
+		if _, err = s.smtpCommand("AUTH NTLM", "AUTH NTLM", 334); err == nil {
+			data, err = s.smtpCommand(negotiateName, token, 334)
+		}
+	case ProtocolIMAP:
+		tag := s.nextTag()
+		if err = s.writeLine(tag + " AUTHENTICATE NTLM"); err == nil {
+			if _, err = s.imapContinuation(tag, "AUTHENTICATE NTLM"); err == nil {
+				if err = s.writeLine(token); err == nil {
+					data, err = s.imapContinuation(tag, negotiateName)
+				}
+			}
+		}
+	case ProtocolPOP3:
+		if _, err = s.pop3Continuation("AUTH NTLM", "AUTH NTLM"); err == nil {
+			data, err = s.pop3Continuation(negotiateName, token)
+		}
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	buf, err := base64.StdEncoding.DecodeString(strings.TrimSpace(data))
+	if err != nil {
+		return nil, fmt.Errorf("invalid base64 challenge: %w", err)
+	}
+	if len(buf) == 0 {
+		return nil, errors.New("empty challenge token")
+	}
+	return ntlmssp.ParseChallenge(buf)
+}
+
+func (s *Session) greet() error {
+	line, err := s.readLine()
+	if err != nil {
+		return err
+	}
+	switch s.proto {
+	case ProtocolSMTP:
+		code, text, err := s.smtpReply(line)
+		if err != nil {
+			return err
+		}
+		if code != 220 {
+			return &ReplyError{Command: "greeting", Reply: strings.Join(text, " ")}
+		}
+		s.banner = strings.Join(text, " ")
+	case ProtocolIMAP:
+		status, text, _ := strings.Cut(strings.TrimPrefix(line, "* "), " ")
+		if !strings.EqualFold(status, "OK") && !strings.EqualFold(status, "PREAUTH") {
+			return &ReplyError{Command: "greeting", Reply: line}
+		}
+		s.banner = text
+	case ProtocolPOP3:
+		if !strings.HasPrefix(line, "+OK") {
+			return &ReplyError{Command: "greeting", Reply: line}
+		}
+		s.banner = strings.TrimSpace(strings.TrimPrefix(line, "+OK"))
+	}
+	return nil
+}
+
+// readCapabilities issues EHLO, CAPABILITY or CAPA. POP3 servers without
+// CAPA are treated as having no capabilities.
+func (s *Session) readCapabilities() error {
+	s.capabilities = nil
+	switch s.proto {
+	case ProtocolSMTP:
+		if err := s.writeLine("EHLO " + clientName); err != nil {
+			return err
+		}
+		line, err := s.readLine()
+		if err != nil {
+			return err
+		}
+		code, text, err := s.smtpReply(line)
+		if err != nil {
+			return err
+		}
+		if code != 250 {
+			return &ReplyError{Command: "EHLO", Reply: strings.Join(text, " ")}
+		}
+		// The first line is the server's greeting to the client.
+		for _, t := range text[1:] {
+			s.capabilities = append(s.capabilities, strings.ToUpper(t))
+		}
+	case ProtocolIMAP:
+		untagged, err := s.imapCommand("CAPABILITY")
+		if err != nil {
+			return err
+		}
+		for _, line := range untagged {
+			if caps, ok := strings.CutPrefix(strings.ToUpper(line), "CAPABILITY "); ok {
+				s.capabilities = append(s.capabilities, strings.Fields(caps)...)
+			}
+		}
+	case ProtocolPOP3:
+		if err := s.writeLine("CAPA"); err != nil {
+			return err
+		}
+		line, err := s.readLine()
+		if err != nil {
+			return err
+		}
+		if !strings.HasPrefix(line, "+OK") {
+			return nil
+		}
+		for range maxLines {
+			line, err := s.readLine()
+			if err != nil {
+				return err
+			}
+			if line == "." {
+				return nil
+			}
+			s.capabilities = append(s.capabilities, strings.ToUpper(line))
+		}
+		return errors.New("CAPA response too long")
+	}
+	return nil
+}
+
+// hasCapability matches a capability keyword; for SMTP and POP3 only the
+// first word of each line is compared.
+func (s *Session) hasCapability(name string) bool {
+	return slices.ContainsFunc(s.capabilities, func(c string) bool {
+		keyword, _, _ := strings.Cut(c, " ")
+		return keyword == name
+	})
+}
+
+// smtpCommand sends line and reads the reply, which must carry want. The
+// text of the last reply line is returned; name identifies the command in
+// errors.
+func (s *Session) smtpCommand(name, line string, want int) (string, error) {
+	if err := s.writeLine(line); err != nil {
+		return "", err
+	}
+	first, err := s.readLine()
+	if err != nil {
+		return "", err
+	}
+	code, text, err := s.smtpReply(first)
+	if err != nil {
+		return "", err
+	}
+	if code != want {
+		return "", &ReplyError{Command: name, Reply: fmt.Sprintf("%d %s", code, strings.Join(text, " "))}
+	}
+	return text[len(text)-1], nil
+}
+
+// smtpReply reads the continuation lines of a reply starting with first and
+// returns the code and the text of each line.
+func (s *Session) smtpReply(first string) (int, []string, error) {
+	line := first
+	var text []string
+	for range maxLines {
+		if len(line) < 3 {
+			return 0, nil, fmt.Errorf("malformed SMTP reply %q", line)
+		}
+		code, err := strconv.Atoi(line[:3])
+		if err != nil {
+			return 0, nil, fmt.Errorf("malformed SMTP reply %q", line)
+		}
+		if len(line) > 4 {
+			text = append(text, line[4:])
+		} else {
+			text = append(text, "")
+		}
+		if len(line) == 3 || line[3] != '-' {
+			return code, text, nil
+		}
+		if line, err = s.readLine(); err != nil {
+			return 0, nil, err
+		}
+	}
+	return 0, nil, errors.New("SMTP reply too long")
+}
+
+// imapCommand sends a tagged command and returns the untagged lines that
+// precede an OK completion.
+func (s *Session) imapCommand(command string) ([]string, error) {
+	tag := s.nextTag()
+	if err := s.writeLine(tag + " " + command); err != nil {
+		return nil, err
+	}
+	var untagged []string
+	for range maxLines {
+		line, err := s.readLine()
+		if err != nil {
+			return nil, err
+		}
+		if rest, ok := strings.CutPrefix(line, "* "); ok {
+			untagged = append(untagged, rest)
+			continue
+		}
+		if rest, ok := strings.CutPrefix(line, tag+" "); ok {
+			if !strings.HasPrefix(strings.ToUpper(rest), "OK") {
+				return nil, &ReplyError{Command: command, Reply: rest}
+			}
+			return untagged, nil
+		}
+	}
+	return nil, errors.New("IMAP response too long")
+}
+
+// imapContinuation waits for a "+" continuation request and returns its
+// data. A tagged completion means the exchange was refused.
+func (s *Session) imapContinuation(tag, command string) (string, error) {
+	for range maxLines {
+		line, err := s.readLine()
+		if err != nil {
+			return "", err
+		}
+		if line == "+" {
+			return "", nil
+		}
+		if data, ok := strings.CutPrefix(line, "+ "); ok {
+			return data, nil
+		}
+		if rest, ok := strings.CutPrefix(line, tag+" "); ok {
+			return "", &ReplyError{Command: command, Reply: rest}
+		}
+	}
+	return "", errors.New("IMAP response too long")
+}
+
+func (s *Session) pop3Command(command string) (string, error) {
+	if err := s.writeLine(command); err != nil {
+		return "", err
+	}
+	line, err := s.readLine()
+	if err != nil {
+		return "", err
+	}
+	if !strings.HasPrefix(line, "+OK") {
+		return "", &ReplyError{Command: command, Reply: line}
+	}
+	return strings.TrimSpace(strings.TrimPrefix(line, "+OK")), nil
+}
+
+func (s *Session) pop3Continuation(name, line string) (string, error) {
+	if err := s.writeLine(line); err != nil {
+		return "", err
+	}
+	reply, err := s.readLine()
+	if err != nil {
+		return "", err
+	}
+	if reply == "+" {
+		return "", nil
+	}
+	data, ok := strings.CutPrefix(reply, "+ ")
+	if !ok {
+		return "", &ReplyError{Command: name, Reply: reply}
+	}
+	return data, nil
+}
+
+func (s *Session) writeLine(line string) error {
+	_, err := s.conn.Write([]byte(line + "\r\n"))
+	return err
+}
+
+func (s *Session) readLine() (string, error) {
+	line, err := s.r.ReadString('\n')
+	if err != nil {
+		return "", err
+	}
+	return strings.TrimRight(line, "\r\n"), nil
+}
+
+func (s *Session) nextTag() string {
+	s.tag++
+	return fmt.Sprintf("a%03d", s.tag)
+}
@@ -0,0 +1,248 @@
+package mail_test
+
+import (
+	"bufio"
+	"crypto/tls"
+	"encoding/base64"
+	"fmt"
+	"net"
+	"strconv"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/d0rvin/winscope-smb/internal/testutil"
+	"github.com/d0rvin/winscope-smb/pkg/protocol"
+	"github.com/d0rvin/winscope-smb/pkg/protocol/mail"
+	"github.com/d0rvin/winscope-smb/pkg/protocol/ntlmssp"
+)
+
+// mailServer is a stand-in Exchange front end. Before STARTTLS it only
+// advertises the upgrade; AUTH NTLM is answered once the connection is
+// encrypted, or at once when startTLS is false.
+type mailServer struct {
+	t         *testing.T
+	proto     string
+	startTLS  bool
+	challenge string
+	negotiate chan<- []byte
+
+	conn net.Conn
+	r    *bufio.Reader
+	tls  bool
+}
+
+func (m *mailServer) send(format string, args ...any) {
+	_, _ = fmt.Fprintf(m.conn, format+"\r\n", args...)
+}
+
+func (m *mailServer) upgrade() bool {
+	tlsConn := tls.Server(m.conn, &tls.Config{Certificates: []tls.Certificate{testutil.SelfSignedCert(m.t, "mail01.corp.example")}})
+	if err := tlsConn.Handshake(); err != nil {
+		m.t.Error(err)
+		return false
+	}
+	m.conn, m.r, m.tls = tlsConn, bufio.NewReader(tlsConn), true
+	return true
+}
+
+func (m *mailServer) authAllowed() bool {
+	return m.tls || !m.startTLS
+}
+
+// readToken reads the client's base64 NEGOTIATE line.
+func (m *mailServer) readToken() bool {
+	line, err := m.r.ReadString('\n')
+	if err != nil {
+		return false
+	}
+	buf, err := base64.StdEncoding.DecodeString(strings.TrimSpace(line))
+	if err != nil {
+		m.t.Error(err)
+		return false
+	}
+	m.negotiate <- buf
+	return true
+}
+
+func (m *mailServer) serve(ln net.Listener) {
+	conn, err := ln.Accept()
+	if err != nil {
+		return
+	}
+	m.conn, m.r = conn, bufio.NewReader(conn)
+	defer func() { _ = m.conn.Close() }()
+
+	switch m.proto {
+	case mail.ProtocolSMTP:
+		m.send("220-mail01.corp.example Microsoft ESMTP MAIL Service ready")
+		m.send("220 at Mon, 1 Jan 2024 00:00:00 +0000")
+	case mail.ProtocolIMAP:
+		m.send("* OK The Microsoft Exchange IMAP4 service is ready.")
+	case mail.ProtocolPOP3:
+		m.send("+OK The Microsoft Exchange POP3 service is ready.")
+	}
+
+	for {
+		line, err := m.r.ReadString('\n')
+		if err != nil {
+			return
+		}
+		fields := strings.Fields(line)
+		if len(fields) == 0 {
+			continue
+		}
+		switch m.proto {
+		case mail.ProtocolSMTP:
+			switch strings.ToUpper(fields[0]) {
+			case "EHLO":
+				m.send("250-mail01.corp.example Hello")
+				m.send("250-SIZE 37748736")
+				if m.authAllowed() {
+					m.send("250-AUTH NTLM LOGIN")
+				} else {
+					m.send("250-STARTTLS")
+				}
+				m.send("250 8BITMIME")
+			case "STARTTLS":
+				m.send("220 2.0.0 SMTP server ready")
+				if !m.upgrade() {
+					return
+				}
+			case "AUTH":
+				if !m.authAllowed() {
+					m.send("504 5.7.4 Unrecognized authentication type")
+					continue
+				}
+				m.send("334 NTLM supported")
+				if !m.readToken() {
+					return
+				}
+				m.send("334 %s", m.challenge)
+			}
+		case mail.ProtocolIMAP:
+			tag := fields[0]
+			switch strings.ToUpper(fields[1]) {
+			case "CAPABILITY":
+				if m.authAllowed() {
+					m.send("* CAPABILITY IMAP4 IMAP4rev1 AUTH=NTLM AUTH=GSSAPI IDLE")
+				} else {
+					m.send("* CAPABILITY IMAP4 IMAP4rev1 STARTTLS LOGINDISABLED IDLE")
+				}
+				m.send("%s OK CAPABILITY completed.", tag)
+			case "STARTTLS":
+				m.send("%s OK Begin TLS negotiation now.", tag)
+				if !m.upgrade() {
+					return
+				}
+			case "AUTHENTICATE":
+				if !m.authAllowed() {
+					m.send("%s BAD Command Argument Error.", tag)
+					continue
+				}
+				m.send("+ ")
+				if !m.readToken() {
+					return
+				}
+				m.send("+ %s", m.challenge)
+			}
+		case mail.ProtocolPOP3:
+			switch strings.ToUpper(fields[0]) {
+			case "CAPA":
+				m.send("+OK")
+				m.send("TOP")
+				if m.authAllowed() {
+					m.send("SASL NTLM GSSAPI")
+				} else {
+					m.send("STLS")
+				}
+				m.send(".")
+			case "STLS":
+				m.send("+OK Begin TLS negotiation.")
+				if !m.upgrade() {
+					return
+				}
+			case "AUTH":
+				if !m.authAllowed() {
+					m.send("-ERR Protocol error.")
+					continue
+				}
+				m.send("+")
+				if !m.readToken() {
+					return
+				}
+				m.send("+ %s", m.challenge)
+			}
+		}
+	}
+}
+
+func TestChallenge(t *testing.T) {
+	for _, proto := range []string{mail.ProtocolSMTP, mail.ProtocolIMAP, mail.ProtocolPOP3} {
+		for _, startTLS := range []bool{false, true} {
+			t.Run(fmt.Sprintf("%s/starttls=%v", proto, startTLS), func(t *testing.T) {
+				ln, err := net.Listen("tcp", "127.0.0.1:0")
+				if err != nil {
+					t.Fatal(err)
+				}
+				defer ln.Close()
+				negotiate := make(chan []byte, 1)
+				srv := &mailServer{t: t, proto: proto, startTLS: startTLS, challenge: base64.StdEncoding.EncodeToString(testutil.Challenge(t, "MAIL01", ntlmssp.Version{Major: 10, Build: 17763})), negotiate: negotiate}
+				go srv.serve(ln)
+
+				host, portStr, _ := net.SplitHostPort(ln.Addr().String())
+				port, _ := strconv.Atoi(portStr)
+				s, err := mail.NewSession(protocol.Config{Host: host, Port: uint16(port)}, proto)
+				if !assert.NoError(t, err) {
+					return
+				}
+				defer s.Close()
+				assert.Contains(t, s.Banner(), "Microsoft")
+
+				upgraded, err := s.StartTLS()
+				assert.NoError(t, err)
+				assert.Equal(t, startTLS, upgraded)
+				state, ok := s.ConnectionState()
+				assert.Equal(t, startTLS, ok)
+				if ok {
+					assert.Equal(t, "mail01.corp.example", state.PeerCertificates[0].Subject.CommonName)
+				}
+
+				challenge, err := s.Challenge()
+				if !assert.NoError(t, err) {
+					return
+				}
+				assert.Equal(t, uint16(17763), challenge.Version.Build)
+				assert.Equal(t, "MAIL01", challenge.TargetInfo.Parse().NBComputerName)
+				assert.Equal(t, []byte(ntlmssp.Signature), (<-negotiate)[:8])
+			})
+		}
+	}
+}
+
+func TestChallengeRefused(t *testing.T) {
+	ln, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer ln.Close()
+	srv := &mailServer{t: t, proto: mail.ProtocolSMTP, startTLS: true}
+	go srv.serve(ln)
+
+	host, portStr, _ := net.SplitHostPort(ln.Addr().String())
+	port, _ := strconv.Atoi(portStr)
+	s, err := mail.NewSession(protocol.Config{Host: host, Port: uint16(port)}, mail.ProtocolSMTP)
+	if !assert.NoError(t, err) {
+		return
+	}
+	defer s.Close()
+
+	// Without the upgrade the server refuses AUTH NTLM.
+	_, err = s.Challenge()
+	var replyErr *mail.ReplyError
+	if assert.ErrorAs(t, err, &replyErr) {
+		assert.Equal(t, "AUTH NTLM", replyErr.Command)
+		assert.Contains(t, replyErr.Reply, "504")
+	}
+}
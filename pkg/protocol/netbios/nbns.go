@@ -0,0 +1,244 @@
+package netbios
+
+import (
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"math/rand/v2"
+	"net"
+	"strings"
+	"time"
+)
+
+const NameServicePort = 137
+
+const (
+	nbnsTypeNBSTAT = 0x0021
+	nbnsClassIN    = 0x0001
+	nbnsHeaderSize = 12
+	nbnsRetries    = 3
+)
+
+const (
+	NameFlagGroup      = 0x8000
+	NameFlagNodeType   = 0x6000
+	NameFlagDeregister = 0x1000
+	NameFlagConflict   = 0x0800
+	NameFlagActive     = 0x0400
+	NameFlagPermanent  = 0x0200
+)
+
+// msBrowseName is registered by master browsers with suffix 0x01.
+const msBrowseName = "\x01\x02__MSBROWSE__\x02"
+
+type NameEntry struct {
+	Name   string
+	Suffix byte
+	Flags  uint16
+}
+
+func (e NameEntry) IsGroup() bool {
+	return e.Flags&NameFlagGroup != 0
+}
+
+// String formats the entry the way nbtstat does, e.g. "FILESRV<20>".
+func (e NameEntry) String() string {
+	name := strings.Map(func(r rune) rune {
+		if r < 0x20 || r > 0x7e {
+			return '.'
+		}
+		return r
+	}, e.Name)
+	return fmt.Sprintf("%s<%02x>", name, e.Suffix)
+}
+
+// Description names the well-known service registered under the entry, or
+// returns an empty string.
+func (e NameEntry) Description() string {
+	if e.Name == msBrowseName && e.Suffix == 0x01 {
+		return "Master Browser"
+	}
+	if e.IsGroup() {
+		switch e.Suffix {
+		case 0x00:
+			return "Domain Name"
+		case 0x1c:
+			return "Domain Controllers"
+		case 0x1e:
+			return "Browser Service Elections"
+		}
+		return ""
+	}
+	switch e.Suffix {
+	case SuffixWorkstation:
+		return "Workstation Service"
+	case SuffixMessenger:
+		return "Messenger Service"
+	case 0x06:
+		return "RAS Server Service"
+	case 0x1b:
+		return "Domain Master Browser"
+	case 0x1d:
+		return "Master Browser"
+	case 0x1f:
+		return "NetDDE Service"
+	case SuffixServer:
+		return "File Server Service"
+	}
+	return ""
+}
+
+// NodeStatus is the answer to an NBSTAT query: the node's name table and
+// the MAC address reported in its statistics.
+type NodeStatus struct {
+	Names []NameEntry
+	MAC   net.HardwareAddr
+}
+
+// ComputerName returns the unique server or workstation name.
+func (s *NodeStatus) ComputerName() string {
+	for _, suffix := range []byte{SuffixServer, SuffixWorkstation} {
+		for _, e := range s.Names {
+			if !e.IsGroup() && e.Suffix == suffix {
+				return e.Name
+			}
+		}
+	}
+	return ""
+}
+
+// DomainName returns the workgroup or domain the node belongs to.
+func (s *NodeStatus) DomainName() string {
+	for _, e := range s.Names {
+		if e.IsGroup() && e.Suffix == 0x00 {
+			return e.Name
+		}
+	}
+	return ""
+}
+
+// QueryNodeStatus sends an NBSTAT query for the wildcard name to addr
+// (host:port, usually port 137) and waits up to timeout for the answer,
+// retransmitting the query a few times since NBNS runs over UDP.
+func QueryNodeStatus(addr string, timeout time.Duration) (*NodeStatus, error) {
+	conn, err := net.DialTimeout("udp", addr, timeout)
+	if err != nil {
+		return nil, err
+	}
+	defer conn.Close()
+
+	id := uint16(rand.N(0x10000))
+	query := nodeStatusQuery(id)
+	deadline := time.Now().Add(timeout)
+	buf := make([]byte, 2048)
+	for attempt := 0; attempt < nbnsRetries; attempt++ {
+		if _, err := conn.Write(query); err != nil {
+			return nil, err
+		}
+
+		wait := time.Now().Add(timeout / nbnsRetries)
+		if attempt == nbnsRetries-1 || wait.After(deadline) {
+			wait = deadline
+		}
+		_ = conn.SetReadDeadline(wait)
+		for {
+			n, err := conn.Read(buf)
+			var netErr net.Error
+			if errors.As(err, &netErr) && netErr.Timeout() {
+				break
+			}
+			if err != nil {
+				return nil, err
+			}
+			if n < 2 || binary.BigEndian.Uint16(buf) != id {
+				continue
+			}
+			return parseNodeStatus(buf[:n])
+		}
+	}
+	return nil, fmt.Errorf("no NBSTAT response from %s", addr)
+}
+
+func nodeStatusQuery(id uint16) []byte {
+	buf := make([]byte, nbnsHeaderSize, nbnsHeaderSize+38)
+	binary.BigEndian.PutUint16(buf[0:], id)
+	binary.BigEndian.PutUint16(buf[4:], 1) // QDCOUNT
+
+	// The wildcard name is padded with NULs rather than spaces.
+	raw := make([]byte, 16)
+	raw[0] = '*'
+	buf = append(buf, encodeRaw(raw)...)
+	buf = binary.BigEndian.AppendUint16(buf, nbnsTypeNBSTAT)
+	return binary.BigEndian.AppendUint16(buf, nbnsClassIN)
+}
+
+func parseNodeStatus(buf []byte) (*NodeStatus, error) {
+	if len(buf) < nbnsHeaderSize {
+		return nil, errors.New("NBSTAT response too short")
+	}
+	flags := binary.BigEndian.Uint16(buf[2:])
+	if flags&0x8000 == 0 {
+		return nil, errors.New("NBSTAT packet is not a response")
+	}
+	if rcode := flags & 0x000f; rcode != 0 {
+		return nil, fmt.Errorf("NBSTAT query failed: rcode %d", rcode)
+	}
+	if binary.BigEndian.Uint16(buf[6:]) == 0 {
+		return nil, errors.New("NBSTAT response has no answer")
+	}
+
+	off := nbnsHeaderSize
+	for i := 0; i < int(binary.BigEndian.Uint16(buf[4:])); i++ {
+		if off = skipName(buf, off); off < 0 {
+			break
+		}
+		off += 4
+	}
+	off = skipName(buf, off)
+	if off < 0 || len(buf) < off+10 {
+		return nil, errors.New("NBSTAT response truncated")
+	}
+	if rrType := binary.BigEndian.Uint16(buf[off:]); rrType != nbnsTypeNBSTAT {
+		return nil, fmt.Errorf("unexpected NBNS record type 0x%04x", rrType)
+	}
+	rdLen := int(binary.BigEndian.Uint16(buf[off+8:]))
+	off += 10
+	if len(buf) < off+rdLen || rdLen < 1 {
+		return nil, errors.New("NBSTAT response truncated")
+	}
+	rdata := buf[off : off+rdLen]
+
+	count := int(rdata[0])
+	if len(rdata) < 1+count*18 {
+		return nil, errors.New("NBSTAT name table truncated")
+	}
+	status := &NodeStatus{}
+	for i := 0; i < count; i++ {
+		entry := rdata[1+i*18 : 1+(i+1)*18]
+		status.Names = append(status.Names, NameEntry{
+			Name:   strings.TrimRight(string(entry[:15]), " \x00"),
+			Suffix: entry[15],
+			Flags:  binary.BigEndian.Uint16(entry[16:]),
+		})
+	}
+	if stats := rdata[1+count*18:]; len(stats) >= 6 {
+		status.MAC = net.HardwareAddr(append([]byte(nil), stats[:6]...))
+	}
+	return status, nil
+}
+
+// skipName returns the offset just past the (possibly compressed) name at
+// off, or -1 if the name runs past the end of buf.
+func skipName(buf []byte, off int) int {
+	for off >= 0 && off < len(buf) {
+		switch l := int(buf[off]); {
+		case l == 0:
+			return off + 1
+		case l&0xc0 == 0xc0:
+			return off + 2
+		default:
+			off += 1 + l
+		}
+	}
+	return -1
+}
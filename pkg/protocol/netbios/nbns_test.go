@@ -0,0 +1,102 @@
+package netbios_test
+
+import (
+	"encoding/binary"
+	"net"
+	"testing"
+	"time"
+
+	"github.com/d0rvin/winscope-smb/pkg/protocol/netbios"
+	"github.com/stretchr/testify/assert"
+)
+
+// startResponder answers NBSTAT queries on a local UDP port with names and
+// mac, ignoring the first drop queries.
+func startResponder(t *testing.T, names []netbios.NameEntry, mac net.HardwareAddr, drop int) string {
+	t.Helper()
+	pc, err := net.ListenPacket("udp", "127.0.0.1:0")
+	if err != nil {
+		t.Fatal(err)
+	}
+	t.Cleanup(func() { _ = pc.Close() })
+
+	go func() {
+		buf := make([]byte, 512)
+		for {
+			n, addr, err := pc.ReadFrom(buf)
+			if err != nil {
+				return
+			}
+			if drop > 0 {
+				drop--
+				continue
+			}
+			query := buf[:n]
+			// Wildcard name "*" padded with NULs, type NBSTAT, class IN.
+			if n != 50 || string(query[13:15]) != "CK" || string(query[15:45]) != "AAAAAAAAAAAAAAAAAAAAAAAAAAAAAA" ||
+				binary.BigEndian.Uint16(query[46:]) != 0x21 {
+				continue
+			}
+
+			resp := []byte{query[0], query[1], 0x84, 0x00, 0, 0, 0, 1, 0, 0, 0, 0}
+			resp = append(resp, query[12:46]...)
+			resp = append(resp, 0x00, 0x21, 0x00, 0x01, 0, 0, 0, 0)
+			rdata := []byte{byte(len(names))}
+			for _, e := range names {
+				entry := make([]byte, 18)
+				copy(entry, e.Name+"               ")
+				entry[15] = e.Suffix
+				binary.BigEndian.PutUint16(entry[16:], e.Flags)
+				rdata = append(rdata, entry...)
+			}
+			rdata = append(rdata, mac...)
+			rdata = append(rdata, make([]byte, 40)...)
+			resp = binary.BigEndian.AppendUint16(resp, uint16(len(rdata)))
+			resp = append(resp, rdata...)
+			_, _ = pc.WriteTo(resp, addr)
+		}
+	}()
+	return pc.LocalAddr().String()
+}
+
+func TestQueryNodeStatus(t *testing.T) {
+	names := []netbios.NameEntry{
+		{Name: "FILESRV", Suffix: 0x00, Flags: netbios.NameFlagActive},
+		{Name: "CORP", Suffix: 0x00, Flags: netbios.NameFlagGroup | netbios.NameFlagActive},
+		{Name: "FILESRV", Suffix: 0x20, Flags: netbios.NameFlagActive},
+		{Name: "CORP", Suffix: 0x1d, Flags: netbios.NameFlagActive},
+		{Name: "\x01\x02__MSBROWSE__\x02", Suffix: 0x01, Flags: netbios.NameFlagGroup | netbios.NameFlagActive},
+	}
+	mac := net.HardwareAddr{0x00, 0x15, 0x5d, 0x01, 0x02, 0x03}
+
+	tests := []struct {
+		name string
+		drop int
+	}{
+		{name: "first answer", drop: 0},
+		{name: "retransmit", drop: 1},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			addr := startResponder(t, names, mac, tt.drop)
+			status, err := netbios.QueryNodeStatus(addr, 3*time.Second)
+			assert.NoError(t, err)
+			assert.Equal(t, names, status.Names)
+			assert.Equal(t, mac, status.MAC)
+			assert.Equal(t, "FILESRV", status.ComputerName())
+			assert.Equal(t, "CORP", status.DomainName())
+
+			assert.Equal(t, "File Server Service", status.Names[2].Description())
+			assert.Equal(t, "Domain Name", status.Names[1].Description())
+			assert.Equal(t, "Master Browser", status.Names[3].Description())
+			assert.Equal(t, "Master Browser", status.Names[4].Description())
+			assert.Equal(t, "..__MSBROWSE__.<01>", status.Names[4].String())
+		})
+	}
+}
+
+func TestQueryNodeStatusTimeout(t *testing.T) {
+	addr := startResponder(t, nil, nil, 100)
+	_, err := netbios.QueryNodeStatus(addr, 300*time.Millisecond)
+	assert.Error(t, err)
+}
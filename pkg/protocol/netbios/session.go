@@ -0,0 +1,159 @@
+package netbios
+
+import (
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"io"
+	"net"
+	"strings"
+)
+
+const (
+	SessionMessage          = 0x00
+	SessionRequest          = 0x81
+	PositiveSessionResponse = 0x82
+	NegativeSessionResponse = 0x83
+	RetargetSessionResponse = 0x84
+	SessionKeepAlive        = 0x85
+)
+
+const (
+	ErrNotListeningOnCalledName   = 0x80
+	ErrNotListeningForCallingName = 0x81
+	ErrCalledNameNotPresent       = 0x82
+	ErrInsufficientResources      = 0x83
+	ErrUnspecified                = 0x8f
+)
+
+const (
+	SuffixWorkstation = 0x00
+	SuffixMessenger   = 0x03
+	SuffixServer      = 0x20
+)
+
+// SMBServerName is the wildcard called name accepted by most SMB servers
+// when the real NetBIOS name is unknown.
+const SMBServerName = "*SMBSERVER"
+
+const DefaultCallingName = "WINSCOPE"
+
+type NegativeResponseError struct {
+	Code uint8
+}
+
+func (e *NegativeResponseError) Error() string {
+	var reason string
+	switch e.Code {
+	case ErrNotListeningOnCalledName:
+		reason = "not listening on called name"
+	case ErrNotListeningForCallingName:
+		reason = "not listening for calling name"
+	case ErrCalledNameNotPresent:
+		reason = "called name not present"
+	case ErrInsufficientResources:
+		reason = "insufficient resources"
+	default:
+		reason = "unspecified error"
+	}
+	return fmt.Sprintf("NetBIOS session refused: %s (0x%02x)", reason, e.Code)
+}
+
+// RetargetError asks the caller to repeat the session request against
+// another address.
+type RetargetError struct {
+	IP   net.IP
+	Port uint16
+}
+
+func (e *RetargetError) Error() string {
+	return fmt.Sprintf("NetBIOS session retargeted to %s", net.JoinHostPort(e.IP.String(), fmt.Sprintf("%d", e.Port)))
+}
+
+// EncodeName returns the first-level encoding (RFC 1001 14.1) of name with
+// the given suffix, as a length-prefixed label without scope.
+func EncodeName(name string, suffix byte) []byte {
+	name = strings.ToUpper(name)
+	if len(name) > 15 {
+		name = name[:15]
+	}
+	raw := make([]byte, 16)
+	copy(raw, fmt.Sprintf("%-15s", name))
+	raw[15] = suffix
+	return encodeRaw(raw)
+}
+
+func encodeRaw(raw []byte) []byte {
+	buf := make([]byte, 0, 34)
+	buf = append(buf, 0x20)
+	for _, b := range raw {
+		buf = append(buf, 'A'+b>>4, 'A'+b&0x0f)
+	}
+	return append(buf, 0x00)
+}
+
+// DecodeName reverses EncodeName, returning the trimmed name and its suffix.
+func DecodeName(buf []byte) (string, byte, error) {
+	if len(buf) < 33 || buf[0] != 0x20 {
+		return "", 0, errors.New("invalid encoded NetBIOS name")
+	}
+	raw := make([]byte, 16)
+	for i := range raw {
+		hi, lo := buf[1+i*2]-'A', buf[2+i*2]-'A'
+		if hi > 0x0f || lo > 0x0f {
+			return "", 0, errors.New("invalid encoded NetBIOS name")
+		}
+		raw[i] = hi<<4 | lo
+	}
+	return strings.TrimRight(string(raw[:15]), " \x00"), raw[15], nil
+}
+
+// RequestSession performs the NBSS session establishment on a freshly
+// connected port 139 stream. A refused or retargeted request is reported as
+// *NegativeResponseError or *RetargetError; the server closes the
+// connection in both cases.
+func RequestSession(conn io.ReadWriter, calledName, callingName string) error {
+	called := EncodeName(calledName, SuffixServer)
+	calling := EncodeName(callingName, SuffixWorkstation)
+
+	req := []byte{SessionRequest, 0x00, 0x00, 0x00}
+	binary.BigEndian.PutUint16(req[2:], uint16(len(called)+len(calling)))
+	req = append(req, called...)
+	req = append(req, calling...)
+	if _, err := conn.Write(req); err != nil {
+		return err
+	}
+
+	for {
+		hdr := make([]byte, 4)
+		if _, err := io.ReadFull(conn, hdr); err != nil {
+			return err
+		}
+		data := make([]byte, int(hdr[1]&0x01)<<16|int(binary.BigEndian.Uint16(hdr[2:])))
+		if _, err := io.ReadFull(conn, data); err != nil {
+			return err
+		}
+
+		switch hdr[0] {
+		case SessionKeepAlive:
+			continue
+		case PositiveSessionResponse:
+			return nil
+		case NegativeSessionResponse:
+			if len(data) < 1 {
+				return &NegativeResponseError{Code: ErrUnspecified}
+			}
+			return &NegativeResponseError{Code: data[0]}
+		case RetargetSessionResponse:
+			if len(data) < 6 {
+				return errors.New("invalid NetBIOS retarget response")
+			}
+			return &RetargetError{
+				IP:   net.IP(data[0:4]),
+				Port: binary.BigEndian.Uint16(data[4:6]),
+			}
+		default:
+			return fmt.Errorf("unexpected NetBIOS session packet type 0x%02x", hdr[0])
+		}
+	}
+}
@@ -0,0 +1,69 @@
+package netbios_test
+
+import (
+	"bytes"
+	"net"
+	"testing"
+
+	"github.com/d0rvin/winscope-smb/pkg/protocol/netbios"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestEncodeName(t *testing.T) {
+	// RFC 1001 14.1 example: "FRED" padded with spaces.
+	enc := netbios.EncodeName("fred", netbios.SuffixServer)
+	assert.Len(t, enc, 34)
+	assert.Equal(t, "EGFCEFEECACACACACACACACACACACACA", string(enc[1:33]))
+
+	name, suffix, err := netbios.DecodeName(enc)
+	assert.NoError(t, err)
+	assert.Equal(t, "FRED", name)
+	assert.Equal(t, byte(netbios.SuffixServer), suffix)
+
+	_, _, err = netbios.DecodeName([]byte{0x20, 'Z'})
+	assert.Error(t, err)
+}
+
+// fakeSession records the session request and replies with resp.
+type fakeSession struct {
+	req  bytes.Buffer
+	resp *bytes.Reader
+}
+
+func (s *fakeSession) Read(b []byte) (int, error)  { return s.resp.Read(b) }
+func (s *fakeSession) Write(b []byte) (int, error) { return s.req.Write(b) }
+
+func TestRequestSession(t *testing.T) {
+	tests := []struct {
+		name string
+		resp []byte
+		want error
+	}{
+		{
+			name: "positive",
+			resp: []byte{netbios.SessionKeepAlive, 0, 0, 0, netbios.PositiveSessionResponse, 0, 0, 0},
+		},
+		{
+			name: "negative",
+			resp: []byte{netbios.NegativeSessionResponse, 0, 0, 1, netbios.ErrCalledNameNotPresent},
+			want: &netbios.NegativeResponseError{Code: netbios.ErrCalledNameNotPresent},
+		},
+		{
+			name: "retarget",
+			resp: []byte{netbios.RetargetSessionResponse, 0, 0, 6, 192, 0, 2, 7, 0x00, 0x8b},
+			want: &netbios.RetargetError{IP: net.IP{192, 0, 2, 7}, Port: 139},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			conn := &fakeSession{resp: bytes.NewReader(tt.resp)}
+			err := netbios.RequestSession(conn, "FILESRV", netbios.DefaultCallingName)
+			assert.Equal(t, tt.want, err)
+
+			req := conn.req.Bytes()
+			assert.Equal(t, []byte{netbios.SessionRequest, 0, 0, 68}, req[:4])
+			assert.Equal(t, netbios.EncodeName("FILESRV", netbios.SuffixServer), req[4:38])
+			assert.Equal(t, netbios.EncodeName(netbios.DefaultCallingName, netbios.SuffixWorkstation), req[38:])
+		})
+	}
+}
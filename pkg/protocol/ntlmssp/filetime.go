@@ -27,3 +27,10 @@ func FileTimeToSystemTime(t []byte) time.Time {
 	}
 	return time.Unix(0, ft.Nanoseconds())
 }
+
+func SystemTimeToFileTime(t time.Time) []byte {
+	nsec := t.UnixNano()/100 + 116444736000000000
+	buf := make([]byte, 8)
+	binary.LittleEndian.PutUint64(buf, uint64(nsec))
+	return buf
+}
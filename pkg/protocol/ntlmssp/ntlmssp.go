@@ -2,7 +2,9 @@ package ntlmssp
 
 import (
 	"bytes"
+	"crypto/rand"
 	"encoding/binary"
+	"errors"
 	"fmt"
 	"strings"
 	"time"
@@ -159,6 +161,9 @@ func (s *AvPairSlice) UnmarshalBinary(buf []byte, meta *encoding.Metadata) (int,
 	if !ok {
 		return 0, fmt.Errorf("missing unmarshal field '%s' offset", meta.CurrField)
 	}
+	if o < 0 || l < 0 || o+l > len(meta.ParentBuf) {
+		return 0, fmt.Errorf("field '%s' exceeds message bounds", meta.CurrField)
+	}
 	for i := l; i > 0; {
 		var avPair AvPair
 		err := encoding.Unmarshal(meta.ParentBuf[o:o+i], &avPair)
@@ -209,6 +214,32 @@ func (s *AvPairSlice) Parse() *AvDetail {
 	return &ret
 }
 
+// NewAvPairs is the inverse of Parse: it returns the set names of d in the
+// order Windows sends them, a timestamp if d.Time is set and the AvEOL.
+func NewAvPairs(d AvDetail) AvPairSlice {
+	var s AvPairSlice
+	for _, p := range []struct {
+		id    uint16
+		value string
+	}{
+		{AvNBDomainName, d.NBDomainName},
+		{AvNBComputerName, d.NBComputerName},
+		{AvDNSDomainName, d.DNSDomainName},
+		{AvDNSComputerName, d.DNSComputerName},
+		{AvDNSTreeName, d.DNSTreeName},
+		{AvTargetName, d.TargetName},
+	} {
+		if p.value != "" {
+			value := encoding.ToUnicode(p.value)
+			s = append(s, AvPair{AvID: p.id, AvLen: uint16(len(value)), Value: value})
+		}
+	}
+	if !d.Time.IsZero() {
+		s = append(s, AvPair{AvID: AvTimestamp, AvLen: 8, Value: SystemTimeToFileTime(d.Time)})
+	}
+	return append(s, AvPair{AvID: AvEOL})
+}
+
 func NewChallenge() Challenge {
 	return Challenge{
 		Header: Header{
@@ -227,6 +258,32 @@ func NewChallenge() Challenge {
 	}
 }
 
+// ParseChallenge decodes a CHALLENGE message as carried by HTTP, LDAP and
+// other transports that hand over the raw NTLMSSP token. Version is nil
+// unless FlgNegVersion is set and the payload leaves room for it.
+func ParseChallenge(buf []byte) (*Challenge, error) {
+	if len(buf) < 12 || string(buf[:8]) != Signature {
+		return nil, errors.New("not an NTLMSSP message")
+	}
+	if msgType := binary.LittleEndian.Uint32(buf[8:]); msgType != TypeNtLmChallenge {
+		return nil, fmt.Errorf("unexpected NTLMSSP message type %d", msgType)
+	}
+	challenge := NewChallenge()
+	if err := encoding.Unmarshal(buf, &challenge); err != nil {
+		return nil, err
+	}
+	// Without the version the payload starts where it would have been.
+	if challenge.NegotiateFlags&FlgNegVersion == 0 ||
+		challenge.TargetNameBufferOffset < challengeVersionEnd ||
+		challenge.TargetInfoBufferOffset < challengeVersionEnd {
+		challenge.Version = nil
+	}
+	return &challenge, nil
+}
+
+// challengeVersionEnd is the offset of the end of a CHALLENGE's version.
+const challengeVersionEnd = 56
+
 type Negotiate struct {
 	Header
 	NegotiateFlags          uint32
@@ -258,3 +315,136 @@ func NewNegotiate(domainName, workstation string) Negotiate {
 		Workstation: []byte(workstation),
 	}
 }
+
+// ParseNegotiate decodes a NEGOTIATE message as a server receives it,
+// together with the client's version, which is nil unless FlgNegVersion
+// is set. The domain and workstation names are OEM strings.
+func ParseNegotiate(buf []byte) (*Negotiate, *Version, error) {
+	if len(buf) < 12 || string(buf[:8]) != Signature {
+		return nil, nil, errors.New("not an NTLMSSP message")
+	}
+	if msgType := binary.LittleEndian.Uint32(buf[8:]); msgType != TypeNtLmNegotiate {
+		return nil, nil, fmt.Errorf("unexpected NTLMSSP message type %d", msgType)
+	}
+	var neg Negotiate
+	if err := encoding.Unmarshal(buf, &neg); err != nil {
+		return nil, nil, err
+	}
+	// The version follows the fixed fields, before the payload.
+	if neg.NegotiateFlags&FlgNegVersion == 0 || len(buf) < 40 {
+		return &neg, nil, nil
+	}
+	var version Version
+	if err := encoding.Unmarshal(buf[32:40], &version); err != nil {
+		return nil, nil, err
+	}
+	return &neg, &version, nil
+}
+
+type Authenticate struct {
+	Header
+	LmChallengeResponseLen                uint16 `smb:"len:LmChallengeResponse"`
+	LmChallengeResponseMaxLen             uint16 `smb:"len:LmChallengeResponse"`
+	LmChallengeResponseBufferOffset       uint32 `smb:"offset:LmChallengeResponse"`
+	NtChallengeResponseLen                uint16 `smb:"len:NtChallengeResponse"`
+	NtChallengeResponseMaxLen             uint16 `smb:"len:NtChallengeResponse"`
+	NtChallengeResponseBufferOffset       uint32 `smb:"offset:NtChallengeResponse"`
+	DomainNameLen                         uint16 `smb:"len:DomainName"`
+	DomainNameMaxLen                      uint16 `smb:"len:DomainName"`
+	DomainNameBufferOffset                uint32 `smb:"offset:DomainName"`
+	UserNameLen                           uint16 `smb:"len:UserName"`
+	UserNameMaxLen                        uint16 `smb:"len:UserName"`
+	UserNameBufferOffset                  uint32 `smb:"offset:UserName"`
+	WorkstationLen                        uint16 `smb:"len:Workstation"`
+	WorkstationMaxLen                     uint16 `smb:"len:Workstation"`
+	WorkstationBufferOffset               uint32 `smb:"offset:Workstation"`
+	EncryptedRandomSessionKeyLen          uint16 `smb:"len:EncryptedRandomSessionKey"`
+	EncryptedRandomSessionKeyMaxLen       uint16 `smb:"len:EncryptedRandomSessionKey"`
+	EncryptedRandomSessionKeyBufferOffset uint32 `smb:"offset:EncryptedRandomSessionKey"`
+	NegotiateFlags                        uint32
+	LmChallengeResponse                   []byte
+	NtChallengeResponse                   []byte
+	DomainName                            []byte
+	UserName                              []byte
+	Workstation                           []byte
+	EncryptedRandomSessionKey             []byte
+}
+
+// Credentials identifies the account used to answer a server challenge.
+// An empty User requests an anonymous (null session) logon.
+type Credentials struct {
+	Domain      string
+	User        string
+	Password    string
+	Workstation string
+}
+
+func (c Credentials) IsAnonymous() bool {
+	return c.User == ""
+}
+
+// NewAuthenticate answers the challenge with an NTLMv2 response for cred and
+// returns the message together with the session key it establishes. The
+// session key is nil for anonymous logons.
+func NewAuthenticate(challenge *Challenge, cred Credentials) (Authenticate, []byte, error) {
+	auth := Authenticate{
+		Header: Header{
+			Signature:   []byte(Signature),
+			MessageType: TypeNtLmAuthenticate,
+		},
+		NegotiateFlags: challenge.NegotiateFlags & (FlgNeg56 |
+			FlgNeg128 |
+			FlgNegTargetInfo |
+			FlgNegExtendedSessionSecurity |
+			FlgNegAlwaysSign |
+			FlgNegNtLm |
+			FlgNegSign |
+			FlgNegUnicode),
+		DomainName:                encoding.ToUnicode(cred.Domain),
+		UserName:                  encoding.ToUnicode(cred.User),
+		Workstation:               encoding.ToUnicode(cred.Workstation),
+		EncryptedRandomSessionKey: []byte{},
+	}
+
+	if cred.IsAnonymous() {
+		auth.NegotiateFlags |= FlgNegAnonymous
+		auth.LmChallengeResponse = []byte{0x00}
+		auth.NtChallengeResponse = []byte{}
+		return auth, nil, nil
+	}
+
+	targetInfo := []byte{}
+	timestamp := SystemTimeToFileTime(time.Now())
+	hasTimestamp := false
+	if challenge.TargetInfo != nil {
+		buf, err := challenge.TargetInfo.MarshalBinary(nil)
+		if err != nil {
+			return Authenticate{}, nil, err
+		}
+		targetInfo = buf
+		for _, pair := range *challenge.TargetInfo {
+			if pair.AvID == AvTimestamp && len(pair.Value) == 8 {
+				timestamp = pair.Value
+				hasTimestamp = true
+			}
+		}
+	}
+
+	clientChallenge := make([]byte, 8)
+	if _, err := rand.Read(clientChallenge); err != nil {
+		return Authenticate{}, nil, err
+	}
+	serverChallenge := make([]byte, 8)
+	binary.LittleEndian.PutUint64(serverChallenge, challenge.ServerChallenge)
+
+	ntowf := NTOWFv2(cred.Password, cred.User, cred.Domain)
+	nt, lm, sessionKey := NTLMv2Response(ntowf, serverChallenge, clientChallenge, timestamp, targetInfo)
+	if hasTimestamp {
+		// MS-NLMP 3.1.5.1.2: a server that sends MsvAvTimestamp expects a
+		// zeroed LmChallengeResponse.
+		lm = make([]byte, 24)
+	}
+	auth.NtChallengeResponse = nt
+	auth.LmChallengeResponse = lm
+	return auth, sessionKey, nil
+}
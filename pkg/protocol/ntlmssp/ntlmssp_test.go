@@ -0,0 +1,115 @@
+package ntlmssp_test
+
+import (
+	"encoding/binary"
+	"testing"
+	"time"
+
+	"github.com/d0rvin/winscope-smb/pkg/encoding"
+	"github.com/d0rvin/winscope-smb/pkg/protocol/ntlmssp"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestParseChallenge(t *testing.T) {
+	challenge := ntlmssp.NewChallenge()
+	challenge.Version = &ntlmssp.Version{Major: 6, Minor: 3, Build: 9600, Reserved: make([]byte, 3), Revision: 15}
+	challenge.TargetInfo = &ntlmssp.AvPairSlice{
+		{AvID: ntlmssp.AvNBComputerName, Value: encoding.ToUnicode("WEB01")},
+		{AvID: ntlmssp.AvEOL},
+	}
+	buf, err := encoding.Marshal(challenge)
+	assert.NoError(t, err)
+
+	got, err := ntlmssp.ParseChallenge(buf)
+	assert.NoError(t, err)
+	assert.Equal(t, uint16(9600), got.Version.Build)
+	assert.Equal(t, "WEB01", got.TargetInfo.Parse().NBComputerName)
+
+	// A target info length running past the message must not panic.
+	truncated := append([]byte(nil), buf...)
+	binary.LittleEndian.PutUint16(truncated[40:], 0x100)
+	binary.LittleEndian.PutUint16(truncated[42:], 0x100)
+	_, err = ntlmssp.ParseChallenge(truncated)
+	assert.Error(t, err)
+
+	// Without FlgNegVersion the payload may start at offset 48, where the
+	// version would otherwise be.
+	buf = []byte("NTLMSSP\x00")
+	buf = binary.LittleEndian.AppendUint32(buf, ntlmssp.TypeNtLmChallenge)
+	buf = append(buf, 8, 0, 8, 0, 48, 0, 0, 0)
+	buf = binary.LittleEndian.AppendUint32(buf, 0xa2898205&^ntlmssp.FlgNegVersion)
+	buf = append(buf, make([]byte, 16)...)
+	buf = append(buf, 4, 0, 4, 0, 56, 0, 0, 0)
+	buf = append(buf, encoding.ToUnicode("CORP")...)
+	buf = append(buf, 0, 0, 0, 0)
+	got, err = ntlmssp.ParseChallenge(buf)
+	if assert.NoError(t, err) {
+		assert.Nil(t, got.Version)
+		assert.Equal(t, "CORP", encoding.FromUnicode(got.TargetName))
+		assert.Len(t, *got.TargetInfo, 1)
+	}
+
+	negotiate, err := encoding.Marshal(ntlmssp.NewNegotiate("", ""))
+	assert.NoError(t, err)
+	_, err = ntlmssp.ParseChallenge(negotiate)
+	assert.Error(t, err)
+
+	_, err = ntlmssp.ParseChallenge([]byte("HTTP/1.1"))
+	assert.Error(t, err)
+}
+
+func TestParseNegotiate(t *testing.T) {
+	buf, err := encoding.Marshal(ntlmssp.NewNegotiate("WORKGROUP", "KALI"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	neg, version, err := ntlmssp.ParseNegotiate(buf)
+	if assert.NoError(t, err) {
+		assert.Equal(t, "WORKGROUP", string(neg.DomainName))
+		assert.Equal(t, "KALI", string(neg.Workstation))
+		assert.Nil(t, version)
+	}
+
+	// As sent by Windows 10 22H2, with the version and no names.
+	buf = []byte("NTLMSSP\x00")
+	buf = binary.LittleEndian.AppendUint32(buf, ntlmssp.TypeNtLmNegotiate)
+	buf = binary.LittleEndian.AppendUint32(buf, 0xe2088297)
+	buf = append(buf, make([]byte, 16)...)
+	buf = append(buf, 10, 0, 0x63, 0x4a, 0, 0, 0, 15)
+	neg, version, err = ntlmssp.ParseNegotiate(buf)
+	if assert.NoError(t, err) {
+		assert.Equal(t, uint32(0xe2088297), neg.NegotiateFlags)
+		assert.Empty(t, neg.DomainName)
+		assert.Equal(t, &ntlmssp.Version{Major: 10, Build: 19043, Reserved: make([]byte, 3), Revision: 15}, version)
+	}
+
+	_, _, err = ntlmssp.ParseNegotiate(buf[:8])
+	assert.Error(t, err)
+	challenge := binary.LittleEndian.AppendUint32([]byte("NTLMSSP\x00"), ntlmssp.TypeNtLmChallenge)
+	_, _, err = ntlmssp.ParseNegotiate(append(challenge, make([]byte, 40)...))
+	assert.EqualError(t, err, "unexpected NTLMSSP message type 2")
+}
+
+func TestNewAvPairs(t *testing.T) {
+	now := time.Unix(1700000000, 0)
+	pairs := ntlmssp.NewAvPairs(ntlmssp.AvDetail{
+		NBDomainName:    "CORP",
+		NBComputerName:  "DC01",
+		DNSDomainName:   "corp.example",
+		DNSComputerName: "dc01.corp.example",
+		DNSTreeName:     "corp.example",
+		Time:            now,
+	})
+	var ids []uint16
+	for _, p := range pairs {
+		ids = append(ids, p.AvID)
+	}
+	assert.Equal(t, []uint16{2, 1, 4, 3, 5, 7, 0}, ids)
+
+	detail := pairs.Parse()
+	assert.Equal(t, "DC01", detail.NBComputerName)
+	assert.Equal(t, "dc01.corp.example", detail.DNSComputerName)
+	assert.True(t, now.Equal(detail.Time))
+
+	assert.Equal(t, ntlmssp.AvPairSlice{{AvID: ntlmssp.AvEOL}}, ntlmssp.NewAvPairs(ntlmssp.AvDetail{}))
+}
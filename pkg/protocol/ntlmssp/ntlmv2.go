@@ -0,0 +1,48 @@
+package ntlmssp
+
+import (
+	"bytes"
+	"crypto/hmac"
+	"crypto/md5"
+	"strings"
+
+	"golang.org/x/crypto/md4"
+
+	"github.com/d0rvin/winscope-smb/pkg/encoding"
+)
+
+func hmacMD5(key []byte, data ...[]byte) []byte {
+	mac := hmac.New(md5.New, key)
+	for _, d := range data {
+		mac.Write(d)
+	}
+	return mac.Sum(nil)
+}
+
+func NTOWFv1(password string) []byte {
+	hash := md4.New()
+	hash.Write(encoding.ToUnicode(password))
+	return hash.Sum(nil)
+}
+
+func NTOWFv2(password, user, domain string) []byte {
+	return hmacMD5(NTOWFv1(password), encoding.ToUnicode(strings.ToUpper(user)+domain))
+}
+
+// NTLMv2Response computes the NTLMv2 NtChallengeResponse, LmChallengeResponse
+// and SessionBaseKey as described in MS-NLMP 3.3.2.
+func NTLMv2Response(ntowf, serverChallenge, clientChallenge, timestamp, targetInfo []byte) (nt, lm, sessionBaseKey []byte) {
+	temp := new(bytes.Buffer)
+	temp.Write([]byte{0x01, 0x01, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00})
+	temp.Write(timestamp)
+	temp.Write(clientChallenge)
+	temp.Write([]byte{0x00, 0x00, 0x00, 0x00})
+	temp.Write(targetInfo)
+	temp.Write([]byte{0x00, 0x00, 0x00, 0x00})
+
+	ntProofStr := hmacMD5(ntowf, serverChallenge, temp.Bytes())
+	nt = append(ntProofStr, temp.Bytes()...)
+	lm = append(hmacMD5(ntowf, serverChallenge, clientChallenge), clientChallenge...)
+	sessionBaseKey = hmacMD5(ntowf, ntProofStr)
+	return nt, lm, sessionBaseKey
+}
@@ -0,0 +1,58 @@
+package ntlmssp_test
+
+import (
+	"bytes"
+	"encoding/hex"
+	"testing"
+
+	"github.com/d0rvin/winscope-smb/pkg/encoding"
+	"github.com/d0rvin/winscope-smb/pkg/protocol/ntlmssp"
+	"github.com/stretchr/testify/assert"
+)
+
+func unhex(s string) []byte {
+	b, err := hex.DecodeString(s)
+	if err != nil {
+		panic(err)
+	}
+	return b
+}
+
+// Test vectors from MS-NLMP 4.2.4.
+func TestNTLMv2Response(t *testing.T) {
+	targetInfo := new(bytes.Buffer)
+	targetInfo.Write([]byte{0x02, 0x00, 0x0c, 0x00})
+	targetInfo.Write(encoding.ToUnicode("Domain"))
+	targetInfo.Write([]byte{0x01, 0x00, 0x0c, 0x00})
+	targetInfo.Write(encoding.ToUnicode("Server"))
+	targetInfo.Write([]byte{0x00, 0x00, 0x00, 0x00})
+
+	ntowf := ntlmssp.NTOWFv2("Password", "User", "Domain")
+	assert.Equal(t, unhex("0c868a403bfd7a93a3001ef22ef02e3f"), ntowf)
+
+	nt, lm, sessionBaseKey := ntlmssp.NTLMv2Response(
+		ntowf,
+		unhex("0123456789abcdef"),
+		unhex("aaaaaaaaaaaaaaaa"),
+		make([]byte, 8),
+		targetInfo.Bytes(),
+	)
+	assert.Equal(t, unhex("68cd0ab851e51c96aabc927bebef6a1c"), nt[:16])
+	assert.Equal(t, unhex("86c35097ac9cec102554764a57cccc19aaaaaaaaaaaaaaaa"), lm)
+	assert.Equal(t, unhex("8de40ccadbc14a82f15cb0ad0de95ca3"), sessionBaseKey)
+}
+
+func TestNewAuthenticate_Anonymous(t *testing.T) {
+	challenge := ntlmssp.NewChallenge()
+	auth, sessionKey, err := ntlmssp.NewAuthenticate(&challenge, ntlmssp.Credentials{})
+	assert.NoError(t, err)
+	assert.Nil(t, sessionKey)
+	assert.Equal(t, []byte{0x00}, auth.LmChallengeResponse)
+	assert.Empty(t, auth.NtChallengeResponse)
+	assert.NotZero(t, auth.NegotiateFlags&ntlmssp.FlgNegAnonymous)
+
+	buf, err := encoding.Marshal(auth)
+	assert.NoError(t, err)
+	assert.Equal(t, []byte(ntlmssp.Signature), buf[:8])
+	assert.Len(t, buf, 64+1)
+}
@@ -0,0 +1,156 @@
+//go:build ignore
+
+// gen.go writes table.go from the NTSTATUS codes in golang.org/x/sys/windows,
+// which are themselves generated from the Windows SDK ntstatus.h, and the
+// descriptions in descriptions.txt. Run it with go generate.
+package main
+
+import (
+	"bufio"
+	"bytes"
+	"fmt"
+	"go/format"
+	"log"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"regexp"
+	"slices"
+	"strconv"
+	"strings"
+)
+
+var statusLine = regexp.MustCompile(`^\s+(STATUS_\w+)\s+NTStatus\s+=\s+0x([0-9A-Fa-f]{8})$`)
+
+type status struct {
+	code        uint32
+	name        string
+	description string
+}
+
+func main() {
+	dir, err := exec.Command("go", "list", "-m", "-f", "{{.Dir}}", "golang.org/x/sys").Output()
+	if err != nil {
+		log.Fatalf("locating golang.org/x/sys: %v", err)
+	}
+	codes, err := readCodes(filepath.Join(strings.TrimSpace(string(dir)), "windows", "zerrors_windows.go"))
+	if err != nil {
+		log.Fatal(err)
+	}
+	descriptions, err := readDescriptions("descriptions.txt")
+	if err != nil {
+		log.Fatal(err)
+	}
+
+	// Some codes have two names, e.g. STATUS_WAIT_0 and STATUS_SUCCESS;
+	// the described one wins, then the first.
+	byCode := map[uint32]*status{}
+	var table []*status
+	for _, s := range codes {
+		s.description = descriptions[s.name]
+		delete(descriptions, s.name)
+		prev, ok := byCode[s.code]
+		switch {
+		case !ok:
+			row := *s
+			byCode[s.code] = &row
+			table = append(table, &row)
+		case prev.description == "" && s.description != "":
+			*prev = *s
+		}
+	}
+	for name := range descriptions {
+		log.Fatalf("descriptions.txt: unknown status %s", name)
+	}
+	slices.SortFunc(table, func(a, b *status) int { return int(int64(a.code) - int64(b.code)) })
+
+	var buf bytes.Buffer
+	buf.WriteString("// Code generated by gen.go; DO NOT EDIT.\n\npackage ntstatus\n\nconst (\n")
+	idents := map[string]string{}
+	for _, s := range codes {
+		ident := goName(s.name)
+		if other, ok := idents[ident]; ok {
+			log.Fatalf("%s and %s both map to %s", other, s.name, ident)
+		}
+		idents[ident] = s.name
+		fmt.Fprintf(&buf, "\t%s Status = 0x%08X\n", ident, s.code)
+	}
+	buf.WriteString(")\n\nvar table = []entry{\n")
+	for _, s := range table {
+		fmt.Fprintf(&buf, "\t{0x%08X, %q, %q, %s},\n", s.code, s.name, s.description, severity(s.code))
+	}
+	buf.WriteString("}\n")
+
+	src, err := format.Source(buf.Bytes())
+	if err != nil {
+		log.Fatal(err)
+	}
+	if err := os.WriteFile("table.go", src, 0o644); err != nil {
+		log.Fatal(err)
+	}
+}
+
+func readCodes(path string) ([]*status, error) {
+	f, err := os.Open(path)
+	if err != nil {
+		return nil, err
+	}
+	defer f.Close()
+
+	var codes []*status
+	scanner := bufio.NewScanner(f)
+	for scanner.Scan() {
+		m := statusLine.FindStringSubmatch(scanner.Text())
+		if m == nil {
+			continue
+		}
+		code, err := strconv.ParseUint(m[2], 16, 32)
+		if err != nil {
+			return nil, err
+		}
+		codes = append(codes, &status{code: uint32(code), name: m[1]})
+	}
+	if len(codes) == 0 {
+		return nil, fmt.Errorf("no NTSTATUS codes in %s", path)
+	}
+	return codes, scanner.Err()
+}
+
+func readDescriptions(path string) (map[string]string, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+	descriptions := map[string]string{}
+	for i, line := range strings.Split(string(data), "\n") {
+		if line = strings.TrimSpace(line); line == "" || strings.HasPrefix(line, "#") {
+			continue
+		}
+		name, text, ok := strings.Cut(line, " ")
+		if !ok {
+			return nil, fmt.Errorf("%s:%d: missing description", path, i+1)
+		}
+		if _, dup := descriptions[name]; dup {
+			return nil, fmt.Errorf("%s:%d: duplicate status %s", path, i+1, name)
+		}
+		descriptions[name] = strings.TrimSpace(text)
+	}
+	return descriptions, nil
+}
+
+// goName turns STATUS_ACCESS_DENIED into AccessDenied.
+func goName(name string) string {
+	var b strings.Builder
+	for word := range strings.SplitSeq(strings.TrimPrefix(name, "STATUS_"), "_") {
+		if word == "" {
+			continue
+		}
+		b.WriteString(word[:1])
+		b.WriteString(strings.ToLower(word[1:]))
+	}
+	return b.String()
+}
+
+func severity(code uint32) string {
+	return [...]string{"SeveritySuccess", "SeverityInformational", "SeverityWarning", "SeverityError"}[code>>30]
+}
@@ -0,0 +1,87 @@
+package ntstatus
+
+import (
+	"fmt"
+	"slices"
+)
+
+//go:generate go run gen.go
+
+// Status is an NTSTATUS code as returned in SMB headers. A failure status
+// is an error; errors.Is matches it against the constants in this package.
+type Status uint32
+
+// Severity is held in the top two bits of a Status.
+type Severity uint8
+
+const (
+	SeveritySuccess Severity = iota
+	SeverityInformational
+	SeverityWarning
+	SeverityError
+)
+
+func (s Severity) String() string {
+	return [...]string{"success", "informational", "warning", "error"}[s&3]
+}
+
+type entry struct {
+	code        Status
+	name        string
+	description string
+	severity    Severity
+}
+
+func (s Status) lookup() (entry, bool) {
+	i, ok := slices.BinarySearchFunc(table, s, func(e entry, s Status) int {
+		return int(int64(e.code) - int64(s))
+	})
+	if !ok {
+		return entry{}, false
+	}
+	return table[i], true
+}
+
+// Name returns the symbolic name, e.g. STATUS_ACCESS_DENIED, or "" for an
+// unknown code.
+func (s Status) Name() string {
+	e, _ := s.lookup()
+	return e.name
+}
+
+// Description returns the text Windows documents for the status, or "" if
+// none is known.
+func (s Status) Description() string {
+	e, _ := s.lookup()
+	return e.description
+}
+
+func (s Status) Severity() Severity {
+	return Severity(s >> 30)
+}
+
+// IsError reports whether s has error severity.
+func (s Status) IsError() bool {
+	return s.Severity() == SeverityError
+}
+
+// String returns the symbolic name, or the code in hex if it is unknown.
+func (s Status) String() string {
+	if name := s.Name(); name != "" {
+		return name
+	}
+	return fmt.Sprintf("0x%08x", uint32(s))
+}
+
+// Error returns the name, code and description, e.g.
+// "STATUS_ACCESS_DENIED (0xc0000022): A process has requested access ...".
+func (s Status) Error() string {
+	e, ok := s.lookup()
+	switch {
+	case !ok:
+		return fmt.Sprintf("NTSTATUS 0x%08x", uint32(s))
+	case e.description == "":
+		return fmt.Sprintf("%s (0x%08x)", e.name, uint32(s))
+	}
+	return fmt.Sprintf("%s (0x%08x): %s", e.name, uint32(s), e.description)
+}
@@ -0,0 +1,51 @@
+package ntstatus_test
+
+import (
+	"errors"
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/d0rvin/winscope-smb/pkg/protocol/ntstatus"
+)
+
+func TestStatus(t *testing.T) {
+	for _, tt := range []struct {
+		status   ntstatus.Status
+		name     string
+		severity ntstatus.Severity
+	}{
+		{ntstatus.Success, "STATUS_SUCCESS", ntstatus.SeveritySuccess},
+		{ntstatus.Pending, "STATUS_PENDING", ntstatus.SeveritySuccess},
+		{ntstatus.BufferOverflow, "STATUS_BUFFER_OVERFLOW", ntstatus.SeverityWarning},
+		{ntstatus.NotSupported, "STATUS_NOT_SUPPORTED", ntstatus.SeverityError},
+		{ntstatus.AccessDenied, "STATUS_ACCESS_DENIED", ntstatus.SeverityError},
+		{ntstatus.InsufficientResources, "STATUS_INSUFFICIENT_RESOURCES", ntstatus.SeverityError},
+		{0x40000000, "STATUS_OBJECT_NAME_EXISTS", ntstatus.SeverityInformational},
+	} {
+		assert.Equal(t, tt.name, tt.status.Name())
+		assert.Equal(t, tt.name, tt.status.String())
+		assert.Equal(t, tt.severity, tt.status.Severity(), tt.name)
+	}
+
+	assert.Equal(t, ntstatus.Status(0xC0000022), ntstatus.AccessDenied)
+	assert.Equal(t, ntstatus.Status(0xC000009A), ntstatus.InsufficientResources)
+	assert.True(t, ntstatus.LogonFailure.IsError())
+	assert.False(t, ntstatus.BufferOverflow.IsError())
+	assert.Equal(t, "error", ntstatus.SeverityError.String())
+
+	assert.Equal(t, "STATUS_NOT_SUPPORTED (0xc00000bb): The request is not supported.", ntstatus.NotSupported.Error())
+	assert.Equal(t, "STATUS_WAIT_1 (0x00000001)", ntstatus.Wait1.Error())
+	unknown := ntstatus.Status(0xC0FFEE00)
+	assert.Empty(t, unknown.Name())
+	assert.Equal(t, "0xc0ffee00", unknown.String())
+	assert.Equal(t, "NTSTATUS 0xc0ffee00", unknown.Error())
+
+	err := fmt.Errorf("tree connect: %w", ntstatus.BadNetworkName)
+	assert.True(t, errors.Is(err, ntstatus.BadNetworkName))
+	var status ntstatus.Status
+	if assert.True(t, errors.As(err, &status)) {
+		assert.Equal(t, "The network name cannot be found.", status.Description())
+	}
+}
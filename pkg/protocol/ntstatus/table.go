@@ -0,0 +1,5147 @@
+// Code generated by gen.go; DO NOT EDIT.
+
+package ntstatus
+
+const (
+	Wait0                                                 Status = 0x00000000
+	Success                                               Status = 0x00000000
+	Wait1                                                 Status = 0x00000001
+	Wait2                                                 Status = 0x00000002
+	Wait3                                                 Status = 0x00000003
+	Wait63                                                Status = 0x0000003F
+	Abandoned                                             Status = 0x00000080
+	AbandonedWait0                                        Status = 0x00000080
+	AbandonedWait63                                       Status = 0x000000BF
+	UserApc                                               Status = 0x000000C0
+	AlreadyComplete                                       Status = 0x000000FF
+	KernelApc                                             Status = 0x00000100
+	Alerted                                               Status = 0x00000101
+	Timeout                                               Status = 0x00000102
+	Pending                                               Status = 0x00000103
+	Reparse                                               Status = 0x00000104
+	MoreEntries                                           Status = 0x00000105
+	NotAllAssigned                                        Status = 0x00000106
+	SomeNotMapped                                         Status = 0x00000107
+	OplockBreakInProgress                                 Status = 0x00000108
+	VolumeMounted                                         Status = 0x00000109
+	RxactCommitted                                        Status = 0x0000010A
+	NotifyCleanup                                         Status = 0x0000010B
+	NotifyEnumDir                                         Status = 0x0000010C
+	NoQuotasForAccount                                    Status = 0x0000010D
+	PrimaryTransportConnectFailed                         Status = 0x0000010E
+	PageFaultTransition                                   Status = 0x00000110
+	PageFaultDemandZero                                   Status = 0x00000111
+	PageFaultCopyOnWrite                                  Status = 0x00000112
+	PageFaultGuardPage                                    Status = 0x00000113
+	PageFaultPagingFile                                   Status = 0x00000114
+	CachePageLocked                                       Status = 0x00000115
+	CrashDump                                             Status = 0x00000116
+	BufferAllZeros                                        Status = 0x00000117
+	ReparseObject                                         Status = 0x00000118
+	ResourceRequirementsChanged                           Status = 0x00000119
+	TranslationComplete                                   Status = 0x00000120
+	DsMembershipEvaluatedLocally                          Status = 0x00000121
+	NothingToTerminate                                    Status = 0x00000122
+	ProcessNotInJob                                       Status = 0x00000123
+	ProcessInJob                                          Status = 0x00000124
+	VolsnapHibernateReady                                 Status = 0x00000125
+	FsfilterOpCompletedSuccessfully                       Status = 0x00000126
+	InterruptVectorAlreadyConnected                       Status = 0x00000127
+	InterruptStillConnected                               Status = 0x00000128
+	ProcessCloned                                         Status = 0x00000129
+	FileLockedWithOnlyReaders                             Status = 0x0000012A
+	FileLockedWithWriters                                 Status = 0x0000012B
+	ValidImageHash                                        Status = 0x0000012C
+	ValidCatalogHash                                      Status = 0x0000012D
+	ValidStrongCodeHash                                   Status = 0x0000012E
+	Ghosted                                               Status = 0x0000012F
+	DataOverwritten                                       Status = 0x00000130
+	ResourcemanagerReadOnly                               Status = 0x00000202
+	RingPreviouslyEmpty                                   Status = 0x00000210
+	RingPreviouslyFull                                    Status = 0x00000211
+	RingPreviouslyAboveQuota                              Status = 0x00000212
+	RingNewlyEmpty                                        Status = 0x00000213
+	RingSignalOppositeEndpoint                            Status = 0x00000214
+	OplockSwitchedToNewHandle                             Status = 0x00000215
+	OplockHandleClosed                                    Status = 0x00000216
+	WaitForOplock                                         Status = 0x00000367
+	ReparseGlobal                                         Status = 0x00000368
+	FltIoComplete                                         Status = 0x001C0001
+	ObjectNameExists                                      Status = 0x40000000
+	ThreadWasSuspended                                    Status = 0x40000001
+	WorkingSetLimitRange                                  Status = 0x40000002
+	ImageNotAtBase                                        Status = 0x40000003
+	RxactStateCreated                                     Status = 0x40000004
+	SegmentNotification                                   Status = 0x40000005
+	LocalUserSessionKey                                   Status = 0x40000006
+	BadCurrentDirectory                                   Status = 0x40000007
+	SerialMoreWrites                                      Status = 0x40000008
+	RegistryRecovered                                     Status = 0x40000009
+	FtReadRecoveryFromBackup                              Status = 0x4000000A
+	FtWriteRecovery                                       Status = 0x4000000B
+	SerialCounterTimeout                                  Status = 0x4000000C
+	NullLmPassword                                        Status = 0x4000000D
+	ImageMachineTypeMismatch                              Status = 0x4000000E
+	ReceivePartial                                        Status = 0x4000000F
+	ReceiveExpedited                                      Status = 0x40000010
+	ReceivePartialExpedited                               Status = 0x40000011
+	EventDone                                             Status = 0x40000012
+	EventPending                                          Status = 0x40000013
+	CheckingFileSystem                                    Status = 0x40000014
+	FatalAppExit                                          Status = 0x40000015
+	PredefinedHandle                                      Status = 0x40000016
+	WasUnlocked                                           Status = 0x40000017
+	ServiceNotification                                   Status = 0x40000018
+	WasLocked                                             Status = 0x40000019
+	LogHardError                                          Status = 0x4000001A
+	AlreadyWin32                                          Status = 0x4000001B
+	Wx86Unsimulate                                        Status = 0x4000001C
+	Wx86Continue                                          Status = 0x4000001D
+	Wx86SingleStep                                        Status = 0x4000001E
+	Wx86Breakpoint                                        Status = 0x4000001F
+	Wx86ExceptionContinue                                 Status = 0x40000020
+	Wx86ExceptionLastchance                               Status = 0x40000021
+	Wx86ExceptionChain                                    Status = 0x40000022
+	ImageMachineTypeMismatchExe                           Status = 0x40000023
+	NoYieldPerformed                                      Status = 0x40000024
+	TimerResumeIgnored                                    Status = 0x40000025
+	ArbitrationUnhandled                                  Status = 0x40000026
+	CardbusNotSupported                                   Status = 0x40000027
+	Wx86Createwx86tib                                     Status = 0x40000028
+	MpProcessorMismatch                                   Status = 0x40000029
+	Hibernated                                            Status = 0x4000002A
+	ResumeHibernation                                     Status = 0x4000002B
+	FirmwareUpdated                                       Status = 0x4000002C
+	DriversLeakingLockedPages                             Status = 0x4000002D
+	MessageRetrieved                                      Status = 0x4000002E
+	SystemPowerstateTransition                            Status = 0x4000002F
+	AlpcCheckCompletionList                               Status = 0x40000030
+	SystemPowerstateComplexTransition                     Status = 0x40000031
+	AccessAuditByPolicy                                   Status = 0x40000032
+	AbandonHiberfile                                      Status = 0x40000033
+	BizrulesNotEnabled                                    Status = 0x40000034
+	FtReadFromCopy                                        Status = 0x40000035
+	ImageAtDifferentBase                                  Status = 0x40000036
+	PatchDeferred                                         Status = 0x40000037
+	HeuristicDamagePossible                               Status = 0x40190001
+	GuardPageViolation                                    Status = 0x80000001
+	DatatypeMisalignment                                  Status = 0x80000002
+	Breakpoint                                            Status = 0x80000003
+	SingleStep                                            Status = 0x80000004
+	BufferOverflow                                        Status = 0x80000005
+	NoMoreFiles                                           Status = 0x80000006
+	WakeSystemDebugger                                    Status = 0x80000007
+	HandlesClosed                                         Status = 0x8000000A
+	NoInheritance                                         Status = 0x8000000B
+	GuidSubstitutionMade                                  Status = 0x8000000C
+	PartialCopy                                           Status = 0x8000000D
+	DevicePaperEmpty                                      Status = 0x8000000E
+	DevicePoweredOff                                      Status = 0x8000000F
+	DeviceOffLine                                         Status = 0x80000010
+	DeviceBusy                                            Status = 0x80000011
+	NoMoreEas                                             Status = 0x80000012
+	InvalidEaName                                         Status = 0x80000013
+	EaListInconsistent                                    Status = 0x80000014
+	InvalidEaFlag                                         Status = 0x80000015
+	VerifyRequired                                        Status = 0x80000016
+	ExtraneousInformation                                 Status = 0x80000017
+	RxactCommitNecessary                                  Status = 0x80000018
+	NoMoreEntries                                         Status = 0x8000001A
+	FilemarkDetected                                      Status = 0x8000001B
+	MediaChanged                                          Status = 0x8000001C
+	BusReset                                              Status = 0x8000001D
+	EndOfMedia                                            Status = 0x8000001E
+	BeginningOfMedia                                      Status = 0x8000001F
+	MediaCheck                                            Status = 0x80000020
+	SetmarkDetected                                       Status = 0x80000021
+	NoDataDetected                                        Status = 0x80000022
+	RedirectorHasOpenHandles                              Status = 0x80000023
+	ServerHasOpenHandles                                  Status = 0x80000024
+	AlreadyDisconnected                                   Status = 0x80000025
+	Longjump                                              Status = 0x80000026
+	CleanerCartridgeInstalled                             Status = 0x80000027
+	PlugplayQueryVetoed                                   Status = 0x80000028
+	UnwindConsolidate                                     Status = 0x80000029
+	RegistryHiveRecovered                                 Status = 0x8000002A
+	DllMightBeInsecure                                    Status = 0x8000002B
+	DllMightBeIncompatible                                Status = 0x8000002C
+	StoppedOnSymlink                                      Status = 0x8000002D
+	CannotGrantRequestedOplock                            Status = 0x8000002E
+	NoAceCondition                                        Status = 0x8000002F
+	DeviceSupportInProgress                               Status = 0x80000030
+	DevicePowerCycleRequired                              Status = 0x80000031
+	NoWorkDone                                            Status = 0x80000032
+	ClusterNodeAlreadyUp                                  Status = 0x80130001
+	ClusterNodeAlreadyDown                                Status = 0x80130002
+	ClusterNetworkAlreadyOnline                           Status = 0x80130003
+	ClusterNetworkAlreadyOffline                          Status = 0x80130004
+	ClusterNodeAlreadyMember                              Status = 0x80130005
+	FltBufferTooSmall                                     Status = 0x801C0001
+	FvePartialMetadata                                    Status = 0x80210001
+	FveTransientState                                     Status = 0x80210002
+	CloudFilePropertyBlobChecksumMismatch                 Status = 0x8000CF00
+	Unsuccessful                                          Status = 0xC0000001
+	NotImplemented                                        Status = 0xC0000002
+	InvalidInfoClass                                      Status = 0xC0000003
+	InfoLengthMismatch                                    Status = 0xC0000004
+	AccessViolation                                       Status = 0xC0000005
+	InPageError                                           Status = 0xC0000006
+	PagefileQuota                                         Status = 0xC0000007
+	InvalidHandle                                         Status = 0xC0000008
+	BadInitialStack                                       Status = 0xC0000009
+	BadInitialPc                                          Status = 0xC000000A
+	InvalidCid                                            Status = 0xC000000B
+	TimerNotCanceled                                      Status = 0xC000000C
+	InvalidParameter                                      Status = 0xC000000D
+	NoSuchDevice                                          Status = 0xC000000E
+	NoSuchFile                                            Status = 0xC000000F
+	InvalidDeviceRequest                                  Status = 0xC0000010
+	EndOfFile                                             Status = 0xC0000011
+	WrongVolume                                           Status = 0xC0000012
+	NoMediaInDevice                                       Status = 0xC0000013
+	UnrecognizedMedia                                     Status = 0xC0000014
+	NonexistentSector                                     Status = 0xC0000015
+	MoreProcessingRequired                                Status = 0xC0000016
+	NoMemory                                              Status = 0xC0000017
+	ConflictingAddresses                                  Status = 0xC0000018
+	NotMappedView                                         Status = 0xC0000019
+	UnableToFreeVm                                        Status = 0xC000001A
+	UnableToDeleteSection                                 Status = 0xC000001B
+	InvalidSystemService                                  Status = 0xC000001C
+	IllegalInstruction                                    Status = 0xC000001D
+	InvalidLockSequence                                   Status = 0xC000001E
+	InvalidViewSize                                       Status = 0xC000001F
+	InvalidFileForSection                                 Status = 0xC0000020
+	AlreadyCommitted                                      Status = 0xC0000021
+	AccessDenied                                          Status = 0xC0000022
+	BufferTooSmall                                        Status = 0xC0000023
+	ObjectTypeMismatch                                    Status = 0xC0000024
+	NoncontinuableException                               Status = 0xC0000025
+	InvalidDisposition                                    Status = 0xC0000026
+	Unwind                                                Status = 0xC0000027
+	BadStack                                              Status = 0xC0000028
+	InvalidUnwindTarget                                   Status = 0xC0000029
+	NotLocked                                             Status = 0xC000002A
+	ParityError                                           Status = 0xC000002B
+	UnableToDecommitVm                                    Status = 0xC000002C
+	NotCommitted                                          Status = 0xC000002D
+	InvalidPortAttributes                                 Status = 0xC000002E
+	PortMessageTooLong                                    Status = 0xC000002F
+	InvalidParameterMix                                   Status = 0xC0000030
+	InvalidQuotaLower                                     Status = 0xC0000031
+	DiskCorruptError                                      Status = 0xC0000032
+	ObjectNameInvalid                                     Status = 0xC0000033
+	ObjectNameNotFound                                    Status = 0xC0000034
+	ObjectNameCollision                                   Status = 0xC0000035
+	PortDoNotDisturb                                      Status = 0xC0000036
+	PortDisconnected                                      Status = 0xC0000037
+	DeviceAlreadyAttached                                 Status = 0xC0000038
+	ObjectPathInvalid                                     Status = 0xC0000039
+	ObjectPathNotFound                                    Status = 0xC000003A
+	ObjectPathSyntaxBad                                   Status = 0xC000003B
+	DataOverrun                                           Status = 0xC000003C
+	DataLateError                                         Status = 0xC000003D
+	DataError                                             Status = 0xC000003E
+	CrcError                                              Status = 0xC000003F
+	SectionTooBig                                         Status = 0xC0000040
+	PortConnectionRefused                                 Status = 0xC0000041
+	InvalidPortHandle                                     Status = 0xC0000042
+	SharingViolation                                      Status = 0xC0000043
+	QuotaExceeded                                         Status = 0xC0000044
+	InvalidPageProtection                                 Status = 0xC0000045
+	MutantNotOwned                                        Status = 0xC0000046
+	SemaphoreLimitExceeded                                Status = 0xC0000047
+	PortAlreadySet                                        Status = 0xC0000048
+	SectionNotImage                                       Status = 0xC0000049
+	SuspendCountExceeded                                  Status = 0xC000004A
+	ThreadIsTerminating                                   Status = 0xC000004B
+	BadWorkingSetLimit                                    Status = 0xC000004C
+	IncompatibleFileMap                                   Status = 0xC000004D
+	SectionProtection                                     Status = 0xC000004E
+	EasNotSupported                                       Status = 0xC000004F
+	EaTooLarge                                            Status = 0xC0000050
+	NonexistentEaEntry                                    Status = 0xC0000051
+	NoEasOnFile                                           Status = 0xC0000052
+	EaCorruptError                                        Status = 0xC0000053
+	FileLockConflict                                      Status = 0xC0000054
+	LockNotGranted                                        Status = 0xC0000055
+	DeletePending                                         Status = 0xC0000056
+	CtlFileNotSupported                                   Status = 0xC0000057
+	UnknownRevision                                       Status = 0xC0000058
+	RevisionMismatch                                      Status = 0xC0000059
+	InvalidOwner                                          Status = 0xC000005A
+	InvalidPrimaryGroup                                   Status = 0xC000005B
+	NoImpersonationToken                                  Status = 0xC000005C
+	CantDisableMandatory                                  Status = 0xC000005D
+	NoLogonServers                                        Status = 0xC000005E
+	NoSuchLogonSession                                    Status = 0xC000005F
+	NoSuchPrivilege                                       Status = 0xC0000060
+	PrivilegeNotHeld                                      Status = 0xC0000061
+	InvalidAccountName                                    Status = 0xC0000062
+	UserExists                                            Status = 0xC0000063
+	NoSuchUser                                            Status = 0xC0000064
+	GroupExists                                           Status = 0xC0000065
+	NoSuchGroup                                           Status = 0xC0000066
+	MemberInGroup                                         Status = 0xC0000067
+	MemberNotInGroup                                      Status = 0xC0000068
+	LastAdmin                                             Status = 0xC0000069
+	WrongPassword                                         Status = 0xC000006A
+	IllFormedPassword                                     Status = 0xC000006B
+	PasswordRestriction                                   Status = 0xC000006C
+	LogonFailure                                          Status = 0xC000006D
+	AccountRestriction                                    Status = 0xC000006E
+	InvalidLogonHours                                     Status = 0xC000006F
+	InvalidWorkstation                                    Status = 0xC0000070
+	PasswordExpired                                       Status = 0xC0000071
+	AccountDisabled                                       Status = 0xC0000072
+	NoneMapped                                            Status = 0xC0000073
+	TooManyLuidsRequested                                 Status = 0xC0000074
+	LuidsExhausted                                        Status = 0xC0000075
+	InvalidSubAuthority                                   Status = 0xC0000076
+	InvalidAcl                                            Status = 0xC0000077
+	InvalidSid                                            Status = 0xC0000078
+	InvalidSecurityDescr                                  Status = 0xC0000079
+	ProcedureNotFound                                     Status = 0xC000007A
+	InvalidImageFormat                                    Status = 0xC000007B
+	NoToken                                               Status = 0xC000007C
+	BadInheritanceAcl                                     Status = 0xC000007D
+	RangeNotLocked                                        Status = 0xC000007E
+	DiskFull                                              Status = 0xC000007F
+	ServerDisabled                                        Status = 0xC0000080
+	ServerNotDisabled                                     Status = 0xC0000081
+	TooManyGuidsRequested                                 Status = 0xC0000082
+	GuidsExhausted                                        Status = 0xC0000083
+	InvalidIdAuthority                                    Status = 0xC0000084
+	AgentsExhausted                                       Status = 0xC0000085
+	InvalidVolumeLabel                                    Status = 0xC0000086
+	SectionNotExtended                                    Status = 0xC0000087
+	NotMappedData                                         Status = 0xC0000088
+	ResourceDataNotFound                                  Status = 0xC0000089
+	ResourceTypeNotFound                                  Status = 0xC000008A
+	ResourceNameNotFound                                  Status = 0xC000008B
+	ArrayBoundsExceeded                                   Status = 0xC000008C
+	FloatDenormalOperand                                  Status = 0xC000008D
+	FloatDivideByZero                                     Status = 0xC000008E
+	FloatInexactResult                                    Status = 0xC000008F
+	FloatInvalidOperation                                 Status = 0xC0000090
+	FloatOverflow                                         Status = 0xC0000091
+	FloatStackCheck                                       Status = 0xC0000092
+	FloatUnderflow                                        Status = 0xC0000093
+	IntegerDivideByZero                                   Status = 0xC0000094
+	IntegerOverflow                                       Status = 0xC0000095
+	PrivilegedInstruction                                 Status = 0xC0000096
+	TooManyPagingFiles                                    Status = 0xC0000097
+	FileInvalid                                           Status = 0xC0000098
+	AllottedSpaceExceeded                                 Status = 0xC0000099
+	InsufficientResources                                 Status = 0xC000009A
+	DfsExitPathFound                                      Status = 0xC000009B
+	DeviceDataError                                       Status = 0xC000009C
+	DeviceNotConnected                                    Status = 0xC000009D
+	DevicePowerFailure                                    Status = 0xC000009E
+	FreeVmNotAtBase                                       Status = 0xC000009F
+	MemoryNotAllocated                                    Status = 0xC00000A0
+	WorkingSetQuota                                       Status = 0xC00000A1
+	MediaWriteProtected                                   Status = 0xC00000A2
+	DeviceNotReady                                        Status = 0xC00000A3
+	InvalidGroupAttributes                                Status = 0xC00000A4
+	BadImpersonationLevel                                 Status = 0xC00000A5
+	CantOpenAnonymous                                     Status = 0xC00000A6
+	BadValidationClass                                    Status = 0xC00000A7
+	BadTokenType                                          Status = 0xC00000A8
+	BadMasterBootRecord                                   Status = 0xC00000A9
+	InstructionMisalignment                               Status = 0xC00000AA
+	InstanceNotAvailable                                  Status = 0xC00000AB
+	PipeNotAvailable                                      Status = 0xC00000AC
+	InvalidPipeState                                      Status = 0xC00000AD
+	PipeBusy                                              Status = 0xC00000AE
+	IllegalFunction                                       Status = 0xC00000AF
+	PipeDisconnected                                      Status = 0xC00000B0
+	PipeClosing                                           Status = 0xC00000B1
+	PipeConnected                                         Status = 0xC00000B2
+	PipeListening                                         Status = 0xC00000B3
+	InvalidReadMode                                       Status = 0xC00000B4
+	IoTimeout                                             Status = 0xC00000B5
+	FileForcedClosed                                      Status = 0xC00000B6
+	ProfilingNotStarted                                   Status = 0xC00000B7
+	ProfilingNotStopped                                   Status = 0xC00000B8
+	CouldNotInterpret                                     Status = 0xC00000B9
+	FileIsADirectory                                      Status = 0xC00000BA
+	NotSupported                                          Status = 0xC00000BB
+	RemoteNotListening                                    Status = 0xC00000BC
+	DuplicateName                                         Status = 0xC00000BD
+	BadNetworkPath                                        Status = 0xC00000BE
+	NetworkBusy                                           Status = 0xC00000BF
+	DeviceDoesNotExist                                    Status = 0xC00000C0
+	TooManyCommands                                       Status = 0xC00000C1
+	AdapterHardwareError                                  Status = 0xC00000C2
+	InvalidNetworkResponse                                Status = 0xC00000C3
+	UnexpectedNetworkError                                Status = 0xC00000C4
+	BadRemoteAdapter                                      Status = 0xC00000C5
+	PrintQueueFull                                        Status = 0xC00000C6
+	NoSpoolSpace                                          Status = 0xC00000C7
+	PrintCancelled                                        Status = 0xC00000C8
+	NetworkNameDeleted                                    Status = 0xC00000C9
+	NetworkAccessDenied                                   Status = 0xC00000CA
+	BadDeviceType                                         Status = 0xC00000CB
+	BadNetworkName                                        Status = 0xC00000CC
+	TooManyNames                                          Status = 0xC00000CD
+	TooManySessions                                       Status = 0xC00000CE
+	SharingPaused                                         Status = 0xC00000CF
+	RequestNotAccepted                                    Status = 0xC00000D0
+	RedirectorPaused                                      Status = 0xC00000D1
+	NetWriteFault                                         Status = 0xC00000D2
+	ProfilingAtLimit                                      Status = 0xC00000D3
+	NotSameDevice                                         Status = 0xC00000D4
+	FileRenamed                                           Status = 0xC00000D5
+	VirtualCircuitClosed                                  Status = 0xC00000D6
+	NoSecurityOnObject                                    Status = 0xC00000D7
+	CantWait                                              Status = 0xC00000D8
+	PipeEmpty                                             Status = 0xC00000D9
+	CantAccessDomainInfo                                  Status = 0xC00000DA
+	CantTerminateSelf                                     Status = 0xC00000DB
+	InvalidServerState                                    Status = 0xC00000DC
+	InvalidDomainState                                    Status = 0xC00000DD
+	InvalidDomainRole                                     Status = 0xC00000DE
+	NoSuchDomain                                          Status = 0xC00000DF
+	DomainExists                                          Status = 0xC00000E0
+	DomainLimitExceeded                                   Status = 0xC00000E1
+	OplockNotGranted                                      Status = 0xC00000E2
+	InvalidOplockProtocol                                 Status = 0xC00000E3
+	InternalDbCorruption                                  Status = 0xC00000E4
+	InternalError                                         Status = 0xC00000E5
+	GenericNotMapped                                      Status = 0xC00000E6
+	BadDescriptorFormat                                   Status = 0xC00000E7
+	InvalidUserBuffer                                     Status = 0xC00000E8
+	UnexpectedIoError                                     Status = 0xC00000E9
+	UnexpectedMmCreateErr                                 Status = 0xC00000EA
+	UnexpectedMmMapError                                  Status = 0xC00000EB
+	UnexpectedMmExtendErr                                 Status = 0xC00000EC
+	NotLogonProcess                                       Status = 0xC00000ED
+	LogonSessionExists                                    Status = 0xC00000EE
+	InvalidParameter1                                     Status = 0xC00000EF
+	InvalidParameter2                                     Status = 0xC00000F0
+	InvalidParameter3                                     Status = 0xC00000F1
+	InvalidParameter4                                     Status = 0xC00000F2
+	InvalidParameter5                                     Status = 0xC00000F3
+	InvalidParameter6                                     Status = 0xC00000F4
+	InvalidParameter7                                     Status = 0xC00000F5
+	InvalidParameter8                                     Status = 0xC00000F6
+	InvalidParameter9                                     Status = 0xC00000F7
+	InvalidParameter10                                    Status = 0xC00000F8
+	InvalidParameter11                                    Status = 0xC00000F9
+	InvalidParameter12                                    Status = 0xC00000FA
+	RedirectorNotStarted                                  Status = 0xC00000FB
+	RedirectorStarted                                     Status = 0xC00000FC
+	StackOverflow                                         Status = 0xC00000FD
+	NoSuchPackage                                         Status = 0xC00000FE
+	BadFunctionTable                                      Status = 0xC00000FF
+	VariableNotFound                                      Status = 0xC0000100
+	DirectoryNotEmpty                                     Status = 0xC0000101
+	FileCorruptError                                      Status = 0xC0000102
+	NotADirectory                                         Status = 0xC0000103
+	BadLogonSessionState                                  Status = 0xC0000104
+	LogonSessionCollision                                 Status = 0xC0000105
+	NameTooLong                                           Status = 0xC0000106
+	FilesOpen                                             Status = 0xC0000107
+	ConnectionInUse                                       Status = 0xC0000108
+	MessageNotFound                                       Status = 0xC0000109
+	ProcessIsTerminating                                  Status = 0xC000010A
+	InvalidLogonType                                      Status = 0xC000010B
+	NoGuidTranslation                                     Status = 0xC000010C
+	CannotImpersonate                                     Status = 0xC000010D
+	ImageAlreadyLoaded                                    Status = 0xC000010E
+	AbiosNotPresent                                       Status = 0xC000010F
+	AbiosLidNotExist                                      Status = 0xC0000110
+	AbiosLidAlreadyOwned                                  Status = 0xC0000111
+	AbiosNotLidOwner                                      Status = 0xC0000112
+	AbiosInvalidCommand                                   Status = 0xC0000113
+	AbiosInvalidLid                                       Status = 0xC0000114
+	AbiosSelectorNotAvailable                             Status = 0xC0000115
+	AbiosInvalidSelector                                  Status = 0xC0000116
+	NoLdt                                                 Status = 0xC0000117
+	InvalidLdtSize                                        Status = 0xC0000118
+	InvalidLdtOffset                                      Status = 0xC0000119
+	InvalidLdtDescriptor                                  Status = 0xC000011A
+	InvalidImageNeFormat                                  Status = 0xC000011B
+	RxactInvalidState                                     Status = 0xC000011C
+	RxactCommitFailure                                    Status = 0xC000011D
+	MappedFileSizeZero                                    Status = 0xC000011E
+	TooManyOpenedFiles                                    Status = 0xC000011F
+	Cancelled                                             Status = 0xC0000120
+	CannotDelete                                          Status = 0xC0000121
+	InvalidComputerName                                   Status = 0xC0000122
+	FileDeleted                                           Status = 0xC0000123
+	SpecialAccount                                        Status = 0xC0000124
+	SpecialGroup                                          Status = 0xC0000125
+	SpecialUser                                           Status = 0xC0000126
+	MembersPrimaryGroup                                   Status = 0xC0000127
+	FileClosed                                            Status = 0xC0000128
+	TooManyThreads                                        Status = 0xC0000129
+	ThreadNotInProcess                                    Status = 0xC000012A
+	TokenAlreadyInUse                                     Status = 0xC000012B
+	PagefileQuotaExceeded                                 Status = 0xC000012C
+	CommitmentLimit                                       Status = 0xC000012D
+	InvalidImageLeFormat                                  Status = 0xC000012E
+	InvalidImageNotMz                                     Status = 0xC000012F
+	InvalidImageProtect                                   Status = 0xC0000130
+	InvalidImageWin16                                     Status = 0xC0000131
+	LogonServerConflict                                   Status = 0xC0000132
+	TimeDifferenceAtDc                                    Status = 0xC0000133
+	SynchronizationRequired                               Status = 0xC0000134
+	DllNotFound                                           Status = 0xC0000135
+	OpenFailed                                            Status = 0xC0000136
+	IoPrivilegeFailed                                     Status = 0xC0000137
+	OrdinalNotFound                                       Status = 0xC0000138
+	EntrypointNotFound                                    Status = 0xC0000139
+	ControlCExit                                          Status = 0xC000013A
+	LocalDisconnect                                       Status = 0xC000013B
+	RemoteDisconnect                                      Status = 0xC000013C
+	RemoteResources                                       Status = 0xC000013D
+	LinkFailed                                            Status = 0xC000013E
+	LinkTimeout                                           Status = 0xC000013F
+	InvalidConnection                                     Status = 0xC0000140
+	InvalidAddress                                        Status = 0xC0000141
+	DllInitFailed                                         Status = 0xC0000142
+	MissingSystemfile                                     Status = 0xC0000143
+	UnhandledException                                    Status = 0xC0000144
+	AppInitFailure                                        Status = 0xC0000145
+	PagefileCreateFailed                                  Status = 0xC0000146
+	NoPagefile                                            Status = 0xC0000147
+	InvalidLevel                                          Status = 0xC0000148
+	WrongPasswordCore                                     Status = 0xC0000149
+	IllegalFloatContext                                   Status = 0xC000014A
+	PipeBroken                                            Status = 0xC000014B
+	RegistryCorrupt                                       Status = 0xC000014C
+	RegistryIoFailed                                      Status = 0xC000014D
+	NoEventPair                                           Status = 0xC000014E
+	UnrecognizedVolume                                    Status = 0xC000014F
+	SerialNoDeviceInited                                  Status = 0xC0000150
+	NoSuchAlias                                           Status = 0xC0000151
+	MemberNotInAlias                                      Status = 0xC0000152
+	MemberInAlias                                         Status = 0xC0000153
+	AliasExists                                           Status = 0xC0000154
+	LogonNotGranted                                       Status = 0xC0000155
+	TooManySecrets                                        Status = 0xC0000156
+	SecretTooLong                                         Status = 0xC0000157
+	InternalDbError                                       Status = 0xC0000158
+	FullscreenMode                                        Status = 0xC0000159
+	TooManyContextIds                                     Status = 0xC000015A
+	LogonTypeNotGranted                                   Status = 0xC000015B
+	NotRegistryFile                                       Status = 0xC000015C
+	NtCrossEncryptionRequired                             Status = 0xC000015D
+	DomainCtrlrConfigError                                Status = 0xC000015E
+	FtMissingMember                                       Status = 0xC000015F
+	IllFormedServiceEntry                                 Status = 0xC0000160
+	IllegalCharacter                                      Status = 0xC0000161
+	UnmappableCharacter                                   Status = 0xC0000162
+	UndefinedCharacter                                    Status = 0xC0000163
+	FloppyVolume                                          Status = 0xC0000164
+	FloppyIdMarkNotFound                                  Status = 0xC0000165
+	FloppyWrongCylinder                                   Status = 0xC0000166
+	FloppyUnknownError                                    Status = 0xC0000167
+	FloppyBadRegisters                                    Status = 0xC0000168
+	DiskRecalibrateFailed                                 Status = 0xC0000169
+	DiskOperationFailed                                   Status = 0xC000016A
+	DiskResetFailed                                       Status = 0xC000016B
+	SharedIrqBusy                                         Status = 0xC000016C
+	FtOrphaning                                           Status = 0xC000016D
+	BiosFailedToConnectInterrupt                          Status = 0xC000016E
+	PartitionFailure                                      Status = 0xC0000172
+	InvalidBlockLength                                    Status = 0xC0000173
+	DeviceNotPartitioned                                  Status = 0xC0000174
+	UnableToLockMedia                                     Status = 0xC0000175
+	UnableToUnloadMedia                                   Status = 0xC0000176
+	EomOverflow                                           Status = 0xC0000177
+	NoMedia                                               Status = 0xC0000178
+	NoSuchMember                                          Status = 0xC000017A
+	InvalidMember                                         Status = 0xC000017B
+	KeyDeleted                                            Status = 0xC000017C
+	NoLogSpace                                            Status = 0xC000017D
+	TooManySids                                           Status = 0xC000017E
+	LmCrossEncryptionRequired                             Status = 0xC000017F
+	KeyHasChildren                                        Status = 0xC0000180
+	ChildMustBeVolatile                                   Status = 0xC0000181
+	DeviceConfigurationError                              Status = 0xC0000182
+	DriverInternalError                                   Status = 0xC0000183
+	InvalidDeviceState                                    Status = 0xC0000184
+	IoDeviceError                                         Status = 0xC0000185
+	DeviceProtocolError                                   Status = 0xC0000186
+	BackupController                                      Status = 0xC0000187
+	LogFileFull                                           Status = 0xC0000188
+	TooLate                                               Status = 0xC0000189
+	NoTrustLsaSecret                                      Status = 0xC000018A
+	NoTrustSamAccount                                     Status = 0xC000018B
+	TrustedDomainFailure                                  Status = 0xC000018C
+	TrustedRelationshipFailure                            Status = 0xC000018D
+	EventlogFileCorrupt                                   Status = 0xC000018E
+	EventlogCantStart                                     Status = 0xC000018F
+	TrustFailure                                          Status = 0xC0000190
+	MutantLimitExceeded                                   Status = 0xC0000191
+	NetlogonNotStarted                                    Status = 0xC0000192
+	AccountExpired                                        Status = 0xC0000193
+	PossibleDeadlock                                      Status = 0xC0000194
+	NetworkCredentialConflict                             Status = 0xC0000195
+	RemoteSessionLimit                                    Status = 0xC0000196
+	EventlogFileChanged                                   Status = 0xC0000197
+	NologonInterdomainTrustAccount                        Status = 0xC0000198
+	NologonWorkstationTrustAccount                        Status = 0xC0000199
+	NologonServerTrustAccount                             Status = 0xC000019A
+	DomainTrustInconsistent                               Status = 0xC000019B
+	FsDriverRequired                                      Status = 0xC000019C
+	ImageAlreadyLoadedAsDll                               Status = 0xC000019D
+	IncompatibleWithGlobalShortNameRegistrySetting        Status = 0xC000019E
+	ShortNamesNotEnabledOnVolume                          Status = 0xC000019F
+	SecurityStreamIsInconsistent                          Status = 0xC00001A0
+	InvalidLockRange                                      Status = 0xC00001A1
+	InvalidAceCondition                                   Status = 0xC00001A2
+	ImageSubsystemNotPresent                              Status = 0xC00001A3
+	NotificationGuidAlreadyDefined                        Status = 0xC00001A4
+	InvalidExceptionHandler                               Status = 0xC00001A5
+	DuplicatePrivileges                                   Status = 0xC00001A6
+	NotAllowedOnSystemFile                                Status = 0xC00001A7
+	RepairNeeded                                          Status = 0xC00001A8
+	QuotaNotEnabled                                       Status = 0xC00001A9
+	NoApplicationPackage                                  Status = 0xC00001AA
+	FileMetadataOptimizationInProgress                    Status = 0xC00001AB
+	NotSameObject                                         Status = 0xC00001AC
+	FatalMemoryExhaustion                                 Status = 0xC00001AD
+	ErrorProcessNotInJob                                  Status = 0xC00001AE
+	CpuSetInvalid                                         Status = 0xC00001AF
+	IoDeviceInvalidData                                   Status = 0xC00001B0
+	IoUnalignedWrite                                      Status = 0xC00001B1
+	NetworkOpenRestriction                                Status = 0xC0000201
+	NoUserSessionKey                                      Status = 0xC0000202
+	UserSessionDeleted                                    Status = 0xC0000203
+	ResourceLangNotFound                                  Status = 0xC0000204
+	InsuffServerResources                                 Status = 0xC0000205
+	InvalidBufferSize                                     Status = 0xC0000206
+	InvalidAddressComponent                               Status = 0xC0000207
+	InvalidAddressWildcard                                Status = 0xC0000208
+	TooManyAddresses                                      Status = 0xC0000209
+	AddressAlreadyExists                                  Status = 0xC000020A
+	AddressClosed                                         Status = 0xC000020B
+	ConnectionDisconnected                                Status = 0xC000020C
+	ConnectionReset                                       Status = 0xC000020D
+	TooManyNodes                                          Status = 0xC000020E
+	TransactionAborted                                    Status = 0xC000020F
+	TransactionTimedOut                                   Status = 0xC0000210
+	TransactionNoRelease                                  Status = 0xC0000211
+	TransactionNoMatch                                    Status = 0xC0000212
+	TransactionResponded                                  Status = 0xC0000213
+	TransactionInvalidId                                  Status = 0xC0000214
+	TransactionInvalidType                                Status = 0xC0000215
+	NotServerSession                                      Status = 0xC0000216
+	NotClientSession                                      Status = 0xC0000217
+	CannotLoadRegistryFile                                Status = 0xC0000218
+	DebugAttachFailed                                     Status = 0xC0000219
+	SystemProcessTerminated                               Status = 0xC000021A
+	DataNotAccepted                                       Status = 0xC000021B
+	NoBrowserServersFound                                 Status = 0xC000021C
+	VdmHardError                                          Status = 0xC000021D
+	DriverCancelTimeout                                   Status = 0xC000021E
+	ReplyMessageMismatch                                  Status = 0xC000021F
+	MappedAlignment                                       Status = 0xC0000220
+	ImageChecksumMismatch                                 Status = 0xC0000221
+	LostWritebehindData                                   Status = 0xC0000222
+	ClientServerParametersInvalid                         Status = 0xC0000223
+	PasswordMustChange                                    Status = 0xC0000224
+	NotFound                                              Status = 0xC0000225
+	NotTinyStream                                         Status = 0xC0000226
+	RecoveryFailure                                       Status = 0xC0000227
+	StackOverflowRead                                     Status = 0xC0000228
+	FailCheck                                             Status = 0xC0000229
+	DuplicateObjectid                                     Status = 0xC000022A
+	ObjectidExists                                        Status = 0xC000022B
+	ConvertToLarge                                        Status = 0xC000022C
+	Retry                                                 Status = 0xC000022D
+	FoundOutOfScope                                       Status = 0xC000022E
+	AllocateBucket                                        Status = 0xC000022F
+	PropsetNotFound                                       Status = 0xC0000230
+	MarshallOverflow                                      Status = 0xC0000231
+	InvalidVariant                                        Status = 0xC0000232
+	DomainControllerNotFound                              Status = 0xC0000233
+	AccountLockedOut                                      Status = 0xC0000234
+	HandleNotClosable                                     Status = 0xC0000235
+	ConnectionRefused                                     Status = 0xC0000236
+	GracefulDisconnect                                    Status = 0xC0000237
+	AddressAlreadyAssociated                              Status = 0xC0000238
+	AddressNotAssociated                                  Status = 0xC0000239
+	ConnectionInvalid                                     Status = 0xC000023A
+	ConnectionActive                                      Status = 0xC000023B
+	NetworkUnreachable                                    Status = 0xC000023C
+	HostUnreachable                                       Status = 0xC000023D
+	ProtocolUnreachable                                   Status = 0xC000023E
+	PortUnreachable                                       Status = 0xC000023F
+	RequestAborted                                        Status = 0xC0000240
+	ConnectionAborted                                     Status = 0xC0000241
+	BadCompressionBuffer                                  Status = 0xC0000242
+	UserMappedFile                                        Status = 0xC0000243
+	AuditFailed                                           Status = 0xC0000244
+	TimerResolutionNotSet                                 Status = 0xC0000245
+	ConnectionCountLimit                                  Status = 0xC0000246
+	LoginTimeRestriction                                  Status = 0xC0000247
+	LoginWkstaRestriction                                 Status = 0xC0000248
+	ImageMpUpMismatch                                     Status = 0xC0000249
+	InsufficientLogonInfo                                 Status = 0xC0000250
+	BadDllEntrypoint                                      Status = 0xC0000251
+	BadServiceEntrypoint                                  Status = 0xC0000252
+	LpcReplyLost                                          Status = 0xC0000253
+	IpAddressConflict1                                    Status = 0xC0000254
+	IpAddressConflict2                                    Status = 0xC0000255
+	RegistryQuotaLimit                                    Status = 0xC0000256
+	PathNotCovered                                        Status = 0xC0000257
+	NoCallbackActive                                      Status = 0xC0000258
+	LicenseQuotaExceeded                                  Status = 0xC0000259
+	PwdTooShort                                           Status = 0xC000025A
+	PwdTooRecent                                          Status = 0xC000025B
+	PwdHistoryConflict                                    Status = 0xC000025C
+	PlugplayNoDevice                                      Status = 0xC000025E
+	UnsupportedCompression                                Status = 0xC000025F
+	InvalidHwProfile                                      Status = 0xC0000260
+	InvalidPlugplayDevicePath                             Status = 0xC0000261
+	DriverOrdinalNotFound                                 Status = 0xC0000262
+	DriverEntrypointNotFound                              Status = 0xC0000263
+	ResourceNotOwned                                      Status = 0xC0000264
+	TooManyLinks                                          Status = 0xC0000265
+	QuotaListInconsistent                                 Status = 0xC0000266
+	FileIsOffline                                         Status = 0xC0000267
+	EvaluationExpiration                                  Status = 0xC0000268
+	IllegalDllRelocation                                  Status = 0xC0000269
+	LicenseViolation                                      Status = 0xC000026A
+	DllInitFailedLogoff                                   Status = 0xC000026B
+	DriverUnableToLoad                                    Status = 0xC000026C
+	DfsUnavailable                                        Status = 0xC000026D
+	VolumeDismounted                                      Status = 0xC000026E
+	Wx86InternalError                                     Status = 0xC000026F
+	Wx86FloatStackCheck                                   Status = 0xC0000270
+	ValidateContinue                                      Status = 0xC0000271
+	NoMatch                                               Status = 0xC0000272
+	NoMoreMatches                                         Status = 0xC0000273
+	NotAReparsePoint                                      Status = 0xC0000275
+	IoReparseTagInvalid                                   Status = 0xC0000276
+	IoReparseTagMismatch                                  Status = 0xC0000277
+	IoReparseDataInvalid                                  Status = 0xC0000278
+	IoReparseTagNotHandled                                Status = 0xC0000279
+	PwdTooLong                                            Status = 0xC000027A
+	StowedException                                       Status = 0xC000027B
+	ContextStowedException                                Status = 0xC000027C
+	ReparsePointNotResolved                               Status = 0xC0000280
+	DirectoryIsAReparsePoint                              Status = 0xC0000281
+	RangeListConflict                                     Status = 0xC0000282
+	SourceElementEmpty                                    Status = 0xC0000283
+	DestinationElementFull                                Status = 0xC0000284
+	IllegalElementAddress                                 Status = 0xC0000285
+	MagazineNotPresent                                    Status = 0xC0000286
+	ReinitializationNeeded                                Status = 0xC0000287
+	DeviceRequiresCleaning                                Status = 0x80000288
+	DeviceDoorOpen                                        Status = 0x80000289
+	EncryptionFailed                                      Status = 0xC000028A
+	DecryptionFailed                                      Status = 0xC000028B
+	RangeNotFound                                         Status = 0xC000028C
+	NoRecoveryPolicy                                      Status = 0xC000028D
+	NoEfs                                                 Status = 0xC000028E
+	WrongEfs                                              Status = 0xC000028F
+	NoUserKeys                                            Status = 0xC0000290
+	FileNotEncrypted                                      Status = 0xC0000291
+	NotExportFormat                                       Status = 0xC0000292
+	FileEncrypted                                         Status = 0xC0000293
+	WakeSystem                                            Status = 0x40000294
+	WmiGuidNotFound                                       Status = 0xC0000295
+	WmiInstanceNotFound                                   Status = 0xC0000296
+	WmiItemidNotFound                                     Status = 0xC0000297
+	WmiTryAgain                                           Status = 0xC0000298
+	SharedPolicy                                          Status = 0xC0000299
+	PolicyObjectNotFound                                  Status = 0xC000029A
+	PolicyOnlyInDs                                        Status = 0xC000029B
+	VolumeNotUpgraded                                     Status = 0xC000029C
+	RemoteStorageNotActive                                Status = 0xC000029D
+	RemoteStorageMediaError                               Status = 0xC000029E
+	NoTrackingService                                     Status = 0xC000029F
+	ServerSidMismatch                                     Status = 0xC00002A0
+	DsNoAttributeOrValue                                  Status = 0xC00002A1
+	DsInvalidAttributeSyntax                              Status = 0xC00002A2
+	DsAttributeTypeUndefined                              Status = 0xC00002A3
+	DsAttributeOrValueExists                              Status = 0xC00002A4
+	DsBusy                                                Status = 0xC00002A5
+	DsUnavailable                                         Status = 0xC00002A6
+	DsNoRidsAllocated                                     Status = 0xC00002A7
+	DsNoMoreRids                                          Status = 0xC00002A8
+	DsIncorrectRoleOwner                                  Status = 0xC00002A9
+	DsRidmgrInitError                                     Status = 0xC00002AA
+	DsObjClassViolation                                   Status = 0xC00002AB
+	DsCantOnNonLeaf                                       Status = 0xC00002AC
+	DsCantOnRdn                                           Status = 0xC00002AD
+	DsCantModObjClass                                     Status = 0xC00002AE
+	DsCrossDomMoveFailed                                  Status = 0xC00002AF
+	DsGcNotAvailable                                      Status = 0xC00002B0
+	DirectoryServiceRequired                              Status = 0xC00002B1
+	ReparseAttributeConflict                              Status = 0xC00002B2
+	CantEnableDenyOnly                                    Status = 0xC00002B3
+	FloatMultipleFaults                                   Status = 0xC00002B4
+	FloatMultipleTraps                                    Status = 0xC00002B5
+	DeviceRemoved                                         Status = 0xC00002B6
+	JournalDeleteInProgress                               Status = 0xC00002B7
+	JournalNotActive                                      Status = 0xC00002B8
+	Nointerface                                           Status = 0xC00002B9
+	DsRidmgrDisabled                                      Status = 0xC00002BA
+	DsAdminLimitExceeded                                  Status = 0xC00002C1
+	DriverFailedSleep                                     Status = 0xC00002C2
+	MutualAuthenticationFailed                            Status = 0xC00002C3
+	CorruptSystemFile                                     Status = 0xC00002C4
+	DatatypeMisalignmentError                             Status = 0xC00002C5
+	WmiReadOnly                                           Status = 0xC00002C6
+	WmiSetFailure                                         Status = 0xC00002C7
+	CommitmentMinimum                                     Status = 0xC00002C8
+	RegNatConsumption                                     Status = 0xC00002C9
+	TransportFull                                         Status = 0xC00002CA
+	DsSamInitFailure                                      Status = 0xC00002CB
+	OnlyIfConnected                                       Status = 0xC00002CC
+	DsSensitiveGroupViolation                             Status = 0xC00002CD
+	PnpRestartEnumeration                                 Status = 0xC00002CE
+	JournalEntryDeleted                                   Status = 0xC00002CF
+	DsCantModPrimarygroupid                               Status = 0xC00002D0
+	SystemImageBadSignature                               Status = 0xC00002D1
+	PnpRebootRequired                                     Status = 0xC00002D2
+	PowerStateInvalid                                     Status = 0xC00002D3
+	DsInvalidGroupType                                    Status = 0xC00002D4
+	DsNoNestGlobalgroupInMixeddomain                      Status = 0xC00002D5
+	DsNoNestLocalgroupInMixeddomain                       Status = 0xC00002D6
+	DsGlobalCantHaveLocalMember                           Status = 0xC00002D7
+	DsGlobalCantHaveUniversalMember                       Status = 0xC00002D8
+	DsUniversalCantHaveLocalMember                        Status = 0xC00002D9
+	DsGlobalCantHaveCrossdomainMember                     Status = 0xC00002DA
+	DsLocalCantHaveCrossdomainLocalMember                 Status = 0xC00002DB
+	DsHavePrimaryMembers                                  Status = 0xC00002DC
+	WmiNotSupported                                       Status = 0xC00002DD
+	InsufficientPower                                     Status = 0xC00002DE
+	SamNeedBootkeyPassword                                Status = 0xC00002DF
+	SamNeedBootkeyFloppy                                  Status = 0xC00002E0
+	DsCantStart                                           Status = 0xC00002E1
+	DsInitFailure                                         Status = 0xC00002E2
+	SamInitFailure                                        Status = 0xC00002E3
+	DsGcRequired                                          Status = 0xC00002E4
+	DsLocalMemberOfLocalOnly                              Status = 0xC00002E5
+	DsNoFpoInUniversalGroups                              Status = 0xC00002E6
+	DsMachineAccountQuotaExceeded                         Status = 0xC00002E7
+	MultipleFaultViolation                                Status = 0xC00002E8
+	CurrentDomainNotAllowed                               Status = 0xC00002E9
+	CannotMake                                            Status = 0xC00002EA
+	SystemShutdown                                        Status = 0xC00002EB
+	DsInitFailureConsole                                  Status = 0xC00002EC
+	DsSamInitFailureConsole                               Status = 0xC00002ED
+	UnfinishedContextDeleted                              Status = 0xC00002EE
+	NoTgtReply                                            Status = 0xC00002EF
+	ObjectidNotFound                                      Status = 0xC00002F0
+	NoIpAddresses                                         Status = 0xC00002F1
+	WrongCredentialHandle                                 Status = 0xC00002F2
+	CryptoSystemInvalid                                   Status = 0xC00002F3
+	MaxReferralsExceeded                                  Status = 0xC00002F4
+	MustBeKdc                                             Status = 0xC00002F5
+	StrongCryptoNotSupported                              Status = 0xC00002F6
+	TooManyPrincipals                                     Status = 0xC00002F7
+	NoPaData                                              Status = 0xC00002F8
+	PkinitNameMismatch                                    Status = 0xC00002F9
+	SmartcardLogonRequired                                Status = 0xC00002FA
+	KdcInvalidRequest                                     Status = 0xC00002FB
+	KdcUnableToRefer                                      Status = 0xC00002FC
+	KdcUnknownEtype                                       Status = 0xC00002FD
+	ShutdownInProgress                                    Status = 0xC00002FE
+	ServerShutdownInProgress                              Status = 0xC00002FF
+	NotSupportedOnSbs                                     Status = 0xC0000300
+	WmiGuidDisconnected                                   Status = 0xC0000301
+	WmiAlreadyDisabled                                    Status = 0xC0000302
+	WmiAlreadyEnabled                                     Status = 0xC0000303
+	MftTooFragmented                                      Status = 0xC0000304
+	CopyProtectionFailure                                 Status = 0xC0000305
+	CssAuthenticationFailure                              Status = 0xC0000306
+	CssKeyNotPresent                                      Status = 0xC0000307
+	CssKeyNotEstablished                                  Status = 0xC0000308
+	CssScrambledSector                                    Status = 0xC0000309
+	CssRegionMismatch                                     Status = 0xC000030A
+	CssResetsExhausted                                    Status = 0xC000030B
+	PasswordChangeRequired                                Status = 0xC000030C
+	LostModeLogonRestriction                              Status = 0xC000030D
+	PkinitFailure                                         Status = 0xC0000320
+	SmartcardSubsystemFailure                             Status = 0xC0000321
+	NoKerbKey                                             Status = 0xC0000322
+	HostDown                                              Status = 0xC0000350
+	UnsupportedPreauth                                    Status = 0xC0000351
+	EfsAlgBlobTooBig                                      Status = 0xC0000352
+	PortNotSet                                            Status = 0xC0000353
+	DebuggerInactive                                      Status = 0xC0000354
+	DsVersionCheckFailure                                 Status = 0xC0000355
+	AuditingDisabled                                      Status = 0xC0000356
+	Prent4MachineAccount                                  Status = 0xC0000357
+	DsAgCantHaveUniversalMember                           Status = 0xC0000358
+	InvalidImageWin32                                     Status = 0xC0000359
+	InvalidImageWin64                                     Status = 0xC000035A
+	BadBindings                                           Status = 0xC000035B
+	NetworkSessionExpired                                 Status = 0xC000035C
+	ApphelpBlock                                          Status = 0xC000035D
+	AllSidsFiltered                                       Status = 0xC000035E
+	NotSafeModeDriver                                     Status = 0xC000035F
+	AccessDisabledByPolicyDefault                         Status = 0xC0000361
+	AccessDisabledByPolicyPath                            Status = 0xC0000362
+	AccessDisabledByPolicyPublisher                       Status = 0xC0000363
+	AccessDisabledByPolicyOther                           Status = 0xC0000364
+	FailedDriverEntry                                     Status = 0xC0000365
+	DeviceEnumerationError                                Status = 0xC0000366
+	MountPointNotResolved                                 Status = 0xC0000368
+	InvalidDeviceObjectParameter                          Status = 0xC0000369
+	McaOccured                                            Status = 0xC000036A
+	DriverBlockedCritical                                 Status = 0xC000036B
+	DriverBlocked                                         Status = 0xC000036C
+	DriverDatabaseError                                   Status = 0xC000036D
+	SystemHiveTooLarge                                    Status = 0xC000036E
+	InvalidImportOfNonDll                                 Status = 0xC000036F
+	DsShuttingDown                                        Status = 0x40000370
+	NoSecrets                                             Status = 0xC0000371
+	AccessDisabledNoSaferUiByPolicy                       Status = 0xC0000372
+	FailedStackSwitch                                     Status = 0xC0000373
+	HeapCorruption                                        Status = 0xC0000374
+	SmartcardWrongPin                                     Status = 0xC0000380
+	SmartcardCardBlocked                                  Status = 0xC0000381
+	SmartcardCardNotAuthenticated                         Status = 0xC0000382
+	SmartcardNoCard                                       Status = 0xC0000383
+	SmartcardNoKeyContainer                               Status = 0xC0000384
+	SmartcardNoCertificate                                Status = 0xC0000385
+	SmartcardNoKeyset                                     Status = 0xC0000386
+	SmartcardIoError                                      Status = 0xC0000387
+	DowngradeDetected                                     Status = 0xC0000388
+	SmartcardCertRevoked                                  Status = 0xC0000389
+	IssuingCaUntrusted                                    Status = 0xC000038A
+	RevocationOfflineC                                    Status = 0xC000038B
+	PkinitClientFailure                                   Status = 0xC000038C
+	SmartcardCertExpired                                  Status = 0xC000038D
+	DriverFailedPriorUnload                               Status = 0xC000038E
+	SmartcardSilentContext                                Status = 0xC000038F
+	PerUserTrustQuotaExceeded                             Status = 0xC0000401
+	AllUserTrustQuotaExceeded                             Status = 0xC0000402
+	UserDeleteTrustQuotaExceeded                          Status = 0xC0000403
+	DsNameNotUnique                                       Status = 0xC0000404
+	DsDuplicateIdFound                                    Status = 0xC0000405
+	DsGroupConversionError                                Status = 0xC0000406
+	VolsnapPrepareHibernate                               Status = 0xC0000407
+	User2userRequired                                     Status = 0xC0000408
+	StackBufferOverrun                                    Status = 0xC0000409
+	NoS4uProtSupport                                      Status = 0xC000040A
+	CrossrealmDelegationFailure                           Status = 0xC000040B
+	RevocationOfflineKdc                                  Status = 0xC000040C
+	IssuingCaUntrustedKdc                                 Status = 0xC000040D
+	KdcCertExpired                                        Status = 0xC000040E
+	KdcCertRevoked                                        Status = 0xC000040F
+	ParameterQuotaExceeded                                Status = 0xC0000410
+	HibernationFailure                                    Status = 0xC0000411
+	DelayLoadFailed                                       Status = 0xC0000412
+	AuthenticationFirewallFailed                          Status = 0xC0000413
+	VdmDisallowed                                         Status = 0xC0000414
+	HungDisplayDriverThread                               Status = 0xC0000415
+	InsufficientResourceForSpecifiedSharedSectionSize     Status = 0xC0000416
+	InvalidCruntimeParameter                              Status = 0xC0000417
+	NtlmBlocked                                           Status = 0xC0000418
+	DsSrcSidExistsInForest                                Status = 0xC0000419
+	DsDomainNameExistsInForest                            Status = 0xC000041A
+	DsFlatNameExistsInForest                              Status = 0xC000041B
+	InvalidUserPrincipalName                              Status = 0xC000041C
+	FatalUserCallbackException                            Status = 0xC000041D
+	AssertionFailure                                      Status = 0xC0000420
+	VerifierStop                                          Status = 0xC0000421
+	CallbackPopStack                                      Status = 0xC0000423
+	IncompatibleDriverBlocked                             Status = 0xC0000424
+	HiveUnloaded                                          Status = 0xC0000425
+	CompressionDisabled                                   Status = 0xC0000426
+	FileSystemLimitation                                  Status = 0xC0000427
+	InvalidImageHash                                      Status = 0xC0000428
+	NotCapable                                            Status = 0xC0000429
+	RequestOutOfSequence                                  Status = 0xC000042A
+	ImplementationLimit                                   Status = 0xC000042B
+	ElevationRequired                                     Status = 0xC000042C
+	NoSecurityContext                                     Status = 0xC000042D
+	Pku2uCertFailure                                      Status = 0xC000042F
+	BeyondVdl                                             Status = 0xC0000432
+	EncounteredWriteInProgress                            Status = 0xC0000433
+	PteChanged                                            Status = 0xC0000434
+	PurgeFailed                                           Status = 0xC0000435
+	CredRequiresConfirmation                              Status = 0xC0000440
+	CsEncryptionInvalidServerResponse                     Status = 0xC0000441
+	CsEncryptionUnsupportedServer                         Status = 0xC0000442
+	CsEncryptionExistingEncryptedFile                     Status = 0xC0000443
+	CsEncryptionNewEncryptedFile                          Status = 0xC0000444
+	CsEncryptionFileNotCse                                Status = 0xC0000445
+	InvalidLabel                                          Status = 0xC0000446
+	DriverProcessTerminated                               Status = 0xC0000450
+	AmbiguousSystemDevice                                 Status = 0xC0000451
+	SystemDeviceNotFound                                  Status = 0xC0000452
+	RestartBootApplication                                Status = 0xC0000453
+	InsufficientNvramResources                            Status = 0xC0000454
+	InvalidSession                                        Status = 0xC0000455
+	ThreadAlreadyInSession                                Status = 0xC0000456
+	ThreadNotInSession                                    Status = 0xC0000457
+	InvalidWeight                                         Status = 0xC0000458
+	RequestPaused                                         Status = 0xC0000459
+	NoRangesProcessed                                     Status = 0xC0000460
+	DiskResourcesExhausted                                Status = 0xC0000461
+	NeedsRemediation                                      Status = 0xC0000462
+	DeviceFeatureNotSupported                             Status = 0xC0000463
+	DeviceUnreachable                                     Status = 0xC0000464
+	InvalidToken                                          Status = 0xC0000465
+	ServerUnavailable                                     Status = 0xC0000466
+	FileNotAvailable                                      Status = 0xC0000467
+	DeviceInsufficientResources                           Status = 0xC0000468
+	PackageUpdating                                       Status = 0xC0000469
+	NotReadFromCopy                                       Status = 0xC000046A
+	FtWriteFailure                                        Status = 0xC000046B
+	FtDiScanRequired                                      Status = 0xC000046C
+	ObjectNotExternallyBacked                             Status = 0xC000046D
+	ExternalBackingProviderUnknown                        Status = 0xC000046E
+	CompressionNotBeneficial                              Status = 0xC000046F
+	DataChecksumError                                     Status = 0xC0000470
+	IntermixedKernelEaOperation                           Status = 0xC0000471
+	TrimReadZeroNotSupported                              Status = 0xC0000472
+	TooManySegmentDescriptors                             Status = 0xC0000473
+	InvalidOffsetAlignment                                Status = 0xC0000474
+	InvalidFieldInParameterList                           Status = 0xC0000475
+	OperationInProgress                                   Status = 0xC0000476
+	InvalidInitiatorTargetPath                            Status = 0xC0000477
+	ScrubDataDisabled                                     Status = 0xC0000478
+	NotRedundantStorage                                   Status = 0xC0000479
+	ResidentFileNotSupported                              Status = 0xC000047A
+	CompressedFileNotSupported                            Status = 0xC000047B
+	DirectoryNotSupported                                 Status = 0xC000047C
+	IoOperationTimeout                                    Status = 0xC000047D
+	SystemNeedsRemediation                                Status = 0xC000047E
+	AppxIntegrityFailureClrNgen                           Status = 0xC000047F
+	ShareUnavailable                                      Status = 0xC0000480
+	ApisetNotHosted                                       Status = 0xC0000481
+	ApisetNotPresent                                      Status = 0xC0000482
+	DeviceHardwareError                                   Status = 0xC0000483
+	FirmwareSlotInvalid                                   Status = 0xC0000484
+	FirmwareImageInvalid                                  Status = 0xC0000485
+	StorageTopologyIdMismatch                             Status = 0xC0000486
+	WimNotBootable                                        Status = 0xC0000487
+	BlockedByParentalControls                             Status = 0xC0000488
+	NeedsRegistration                                     Status = 0xC0000489
+	QuotaActivity                                         Status = 0xC000048A
+	CallbackInvokeInline                                  Status = 0xC000048B
+	BlockTooManyReferences                                Status = 0xC000048C
+	MarkedToDisallowWrites                                Status = 0xC000048D
+	NetworkAccessDeniedEdp                                Status = 0xC000048E
+	EnclaveFailure                                        Status = 0xC000048F
+	PnpNoCompatDrivers                                    Status = 0xC0000490
+	PnpDriverPackageNotFound                              Status = 0xC0000491
+	PnpDriverConfigurationNotFound                        Status = 0xC0000492
+	PnpDriverConfigurationIncomplete                      Status = 0xC0000493
+	PnpFunctionDriverRequired                             Status = 0xC0000494
+	PnpDeviceConfigurationPending                         Status = 0xC0000495
+	DeviceHintNameBufferTooSmall                          Status = 0xC0000496
+	PackageNotAvailable                                   Status = 0xC0000497
+	DeviceInMaintenance                                   Status = 0xC0000499
+	NotSupportedOnDax                                     Status = 0xC000049A
+	FreeSpaceTooFragmented                                Status = 0xC000049B
+	DaxMappingExists                                      Status = 0xC000049C
+	ChildProcessBlocked                                   Status = 0xC000049D
+	StorageLostDataPersistence                            Status = 0xC000049E
+	VrfCfgEnabled                                         Status = 0xC000049F
+	PartitionTerminating                                  Status = 0xC00004A0
+	ExternalSyskeyNotSupported                            Status = 0xC00004A1
+	EnclaveViolation                                      Status = 0xC00004A2
+	FileProtectedUnderDpl                                 Status = 0xC00004A3
+	VolumeNotClusterAligned                               Status = 0xC00004A4
+	NoPhysicallyAlignedFreeSpaceFound                     Status = 0xC00004A5
+	AppxFileNotEncrypted                                  Status = 0xC00004A6
+	RwrawEncryptedFileNotEncrypted                        Status = 0xC00004A7
+	RwrawEncryptedInvalidEdatainfoFileoffset              Status = 0xC00004A8
+	RwrawEncryptedInvalidEdatainfoFilerange               Status = 0xC00004A9
+	RwrawEncryptedInvalidEdatainfoParameter               Status = 0xC00004AA
+	FtReadFailure                                         Status = 0xC00004AB
+	PatchConflict                                         Status = 0xC00004AC
+	StorageReserveIdInvalid                               Status = 0xC00004AD
+	StorageReserveDoesNotExist                            Status = 0xC00004AE
+	StorageReserveAlreadyExists                           Status = 0xC00004AF
+	StorageReserveNotEmpty                                Status = 0xC00004B0
+	NotADaxVolume                                         Status = 0xC00004B1
+	NotDaxMappable                                        Status = 0xC00004B2
+	CaseDifferingNamesInDir                               Status = 0xC00004B3
+	FileNotSupported                                      Status = 0xC00004B4
+	NotSupportedWithBtt                                   Status = 0xC00004B5
+	EncryptionDisabled                                    Status = 0xC00004B6
+	EncryptingMetadataDisallowed                          Status = 0xC00004B7
+	CantClearEncryptionFlag                               Status = 0xC00004B8
+	InvalidTaskName                                       Status = 0xC0000500
+	InvalidTaskIndex                                      Status = 0xC0000501
+	ThreadAlreadyInTask                                   Status = 0xC0000502
+	CallbackBypass                                        Status = 0xC0000503
+	UndefinedScope                                        Status = 0xC0000504
+	InvalidCap                                            Status = 0xC0000505
+	NotGuiProcess                                         Status = 0xC0000506
+	DeviceHung                                            Status = 0xC0000507
+	ContainerAssigned                                     Status = 0xC0000508
+	JobNoContainer                                        Status = 0xC0000509
+	DeviceUnresponsive                                    Status = 0xC000050A
+	ReparsePointEncountered                               Status = 0xC000050B
+	AttributeNotPresent                                   Status = 0xC000050C
+	NotATieredVolume                                      Status = 0xC000050D
+	AlreadyHasStreamId                                    Status = 0xC000050E
+	JobNotEmpty                                           Status = 0xC000050F
+	AlreadyInitialized                                    Status = 0xC0000510
+	EnclaveNotTerminated                                  Status = 0xC0000511
+	EnclaveIsTerminating                                  Status = 0xC0000512
+	Smb1NotAvailable                                      Status = 0xC0000513
+	SmrGarbageCollectionRequired                          Status = 0xC0000514
+	Interrupted                                           Status = 0xC0000515
+	ThreadNotRunning                                      Status = 0xC0000516
+	FailFastException                                     Status = 0xC0000602
+	ImageCertRevoked                                      Status = 0xC0000603
+	DynamicCodeBlocked                                    Status = 0xC0000604
+	ImageCertExpired                                      Status = 0xC0000605
+	StrictCfgViolation                                    Status = 0xC0000606
+	SetContextDenied                                      Status = 0xC000060A
+	CrossPartitionViolation                               Status = 0xC000060B
+	PortClosed                                            Status = 0xC0000700
+	MessageLost                                           Status = 0xC0000701
+	InvalidMessage                                        Status = 0xC0000702
+	RequestCanceled                                       Status = 0xC0000703
+	RecursiveDispatch                                     Status = 0xC0000704
+	LpcReceiveBufferExpected                              Status = 0xC0000705
+	LpcInvalidConnectionUsage                             Status = 0xC0000706
+	LpcRequestsNotAllowed                                 Status = 0xC0000707
+	ResourceInUse                                         Status = 0xC0000708
+	HardwareMemoryError                                   Status = 0xC0000709
+	ThreadpoolHandleException                             Status = 0xC000070A
+	ThreadpoolSetEventOnCompletionFailed                  Status = 0xC000070B
+	ThreadpoolReleaseSemaphoreOnCompletionFailed          Status = 0xC000070C
+	ThreadpoolReleaseMutexOnCompletionFailed              Status = 0xC000070D
+	ThreadpoolFreeLibraryOnCompletionFailed               Status = 0xC000070E
+	ThreadpoolReleasedDuringOperation                     Status = 0xC000070F
+	CallbackReturnedWhileImpersonating                    Status = 0xC0000710
+	ApcReturnedWhileImpersonating                         Status = 0xC0000711
+	ProcessIsProtected                                    Status = 0xC0000712
+	McaException                                          Status = 0xC0000713
+	CertificateMappingNotUnique                           Status = 0xC0000714
+	SymlinkClassDisabled                                  Status = 0xC0000715
+	InvalidIdnNormalization                               Status = 0xC0000716
+	NoUnicodeTranslation                                  Status = 0xC0000717
+	AlreadyRegistered                                     Status = 0xC0000718
+	ContextMismatch                                       Status = 0xC0000719
+	PortAlreadyHasCompletionList                          Status = 0xC000071A
+	CallbackReturnedThreadPriority                        Status = 0xC000071B
+	InvalidThread                                         Status = 0xC000071C
+	CallbackReturnedTransaction                           Status = 0xC000071D
+	CallbackReturnedLdrLock                               Status = 0xC000071E
+	CallbackReturnedLang                                  Status = 0xC000071F
+	CallbackReturnedPriBack                               Status = 0xC0000720
+	CallbackReturnedThreadAffinity                        Status = 0xC0000721
+	LpcHandleCountExceeded                                Status = 0xC0000722
+	ExecutableMemoryWrite                                 Status = 0xC0000723
+	KernelExecutableMemoryWrite                           Status = 0xC0000724
+	AttachedExecutableMemoryWrite                         Status = 0xC0000725
+	TriggeredExecutableMemoryWrite                        Status = 0xC0000726
+	DiskRepairDisabled                                    Status = 0xC0000800
+	DsDomainRenameInProgress                              Status = 0xC0000801
+	DiskQuotaExceeded                                     Status = 0xC0000802
+	DataLostRepair                                        Status = 0x80000803
+	ContentBlocked                                        Status = 0xC0000804
+	BadClusters                                           Status = 0xC0000805
+	VolumeDirty                                           Status = 0xC0000806
+	DiskRepairRedirected                                  Status = 0x40000807
+	DiskRepairUnsuccessful                                Status = 0xC0000808
+	CorruptLogOverfull                                    Status = 0xC0000809
+	CorruptLogCorrupted                                   Status = 0xC000080A
+	CorruptLogUnavailable                                 Status = 0xC000080B
+	CorruptLogDeletedFull                                 Status = 0xC000080C
+	CorruptLogCleared                                     Status = 0xC000080D
+	OrphanNameExhausted                                   Status = 0xC000080E
+	ProactiveScanInProgress                               Status = 0xC000080F
+	EncryptedIoNotPossible                                Status = 0xC0000810
+	CorruptLogUplevelRecords                              Status = 0xC0000811
+	FileCheckedOut                                        Status = 0xC0000901
+	CheckoutRequired                                      Status = 0xC0000902
+	BadFileType                                           Status = 0xC0000903
+	FileTooLarge                                          Status = 0xC0000904
+	FormsAuthRequired                                     Status = 0xC0000905
+	VirusInfected                                         Status = 0xC0000906
+	VirusDeleted                                          Status = 0xC0000907
+	BadMcfgTable                                          Status = 0xC0000908
+	CannotBreakOplock                                     Status = 0xC0000909
+	BadKey                                                Status = 0xC000090A
+	BadData                                               Status = 0xC000090B
+	NoKey                                                 Status = 0xC000090C
+	FileHandleRevoked                                     Status = 0xC0000910
+	WowAssertion                                          Status = 0xC0009898
+	InvalidSignature                                      Status = 0xC000A000
+	HmacNotSupported                                      Status = 0xC000A001
+	AuthTagMismatch                                       Status = 0xC000A002
+	InvalidStateTransition                                Status = 0xC000A003
+	InvalidKernelInfoVersion                              Status = 0xC000A004
+	InvalidPepInfoVersion                                 Status = 0xC000A005
+	HandleRevoked                                         Status = 0xC000A006
+	EofOnGhostedRange                                     Status = 0xC000A007
+	IpsecQueueOverflow                                    Status = 0xC000A010
+	NdQueueOverflow                                       Status = 0xC000A011
+	HoplimitExceeded                                      Status = 0xC000A012
+	ProtocolNotSupported                                  Status = 0xC000A013
+	FastpathRejected                                      Status = 0xC000A014
+	LostWritebehindDataNetworkDisconnected                Status = 0xC000A080
+	LostWritebehindDataNetworkServerError                 Status = 0xC000A081
+	LostWritebehindDataLocalDiskError                     Status = 0xC000A082
+	XmlParseError                                         Status = 0xC000A083
+	XmldsigError                                          Status = 0xC000A084
+	WrongCompartment                                      Status = 0xC000A085
+	AuthipFailure                                         Status = 0xC000A086
+	DsOidMappedGroupCantHaveMembers                       Status = 0xC000A087
+	DsOidNotFound                                         Status = 0xC000A088
+	IncorrectAccountType                                  Status = 0xC000A089
+	HashNotSupported                                      Status = 0xC000A100
+	HashNotPresent                                        Status = 0xC000A101
+	SecondaryIcProviderNotRegistered                      Status = 0xC000A121
+	GpioClientInformationInvalid                          Status = 0xC000A122
+	GpioVersionNotSupported                               Status = 0xC000A123
+	GpioInvalidRegistrationPacket                         Status = 0xC000A124
+	GpioOperationDenied                                   Status = 0xC000A125
+	GpioIncompatibleConnectMode                           Status = 0xC000A126
+	GpioInterruptAlreadyUnmasked                          Status = 0x8000A127
+	CannotSwitchRunlevel                                  Status = 0xC000A141
+	InvalidRunlevelSetting                                Status = 0xC000A142
+	RunlevelSwitchTimeout                                 Status = 0xC000A143
+	ServicesFailedAutostart                               Status = 0x4000A144
+	RunlevelSwitchAgentTimeout                            Status = 0xC000A145
+	RunlevelSwitchInProgress                              Status = 0xC000A146
+	NotAppcontainer                                       Status = 0xC000A200
+	NotSupportedInAppcontainer                            Status = 0xC000A201
+	InvalidPackageSidLength                               Status = 0xC000A202
+	LpacAccessDenied                                      Status = 0xC000A203
+	AdminlessAccessDenied                                 Status = 0xC000A204
+	AppDataNotFound                                       Status = 0xC000A281
+	AppDataExpired                                        Status = 0xC000A282
+	AppDataCorrupt                                        Status = 0xC000A283
+	AppDataLimitExceeded                                  Status = 0xC000A284
+	AppDataRebootRequired                                 Status = 0xC000A285
+	OffloadReadFltNotSupported                            Status = 0xC000A2A1
+	OffloadWriteFltNotSupported                           Status = 0xC000A2A2
+	OffloadReadFileNotSupported                           Status = 0xC000A2A3
+	OffloadWriteFileNotSupported                          Status = 0xC000A2A4
+	WofWimHeaderCorrupt                                   Status = 0xC000A2A5
+	WofWimResourceTableCorrupt                            Status = 0xC000A2A6
+	WofFileResourceTableCorrupt                           Status = 0xC000A2A7
+	FileSystemVirtualizationUnavailable                   Status = 0xC000CE01
+	FileSystemVirtualizationMetadataCorrupt               Status = 0xC000CE02
+	FileSystemVirtualizationBusy                          Status = 0xC000CE03
+	FileSystemVirtualizationProviderUnknown               Status = 0xC000CE04
+	FileSystemVirtualizationInvalidOperation              Status = 0xC000CE05
+	CloudFileSyncRootMetadataCorrupt                      Status = 0xC000CF00
+	CloudFileProviderNotRunning                           Status = 0xC000CF01
+	CloudFileMetadataCorrupt                              Status = 0xC000CF02
+	CloudFileMetadataTooLarge                             Status = 0xC000CF03
+	CloudFilePropertyBlobTooLarge                         Status = 0x8000CF04
+	CloudFileTooManyPropertyBlobs                         Status = 0x8000CF05
+	CloudFilePropertyVersionNotSupported                  Status = 0xC000CF06
+	NotACloudFile                                         Status = 0xC000CF07
+	CloudFileNotInSync                                    Status = 0xC000CF08
+	CloudFileAlreadyConnected                             Status = 0xC000CF09
+	CloudFileNotSupported                                 Status = 0xC000CF0A
+	CloudFileInvalidRequest                               Status = 0xC000CF0B
+	CloudFileReadOnlyVolume                               Status = 0xC000CF0C
+	CloudFileConnectedProviderOnly                        Status = 0xC000CF0D
+	CloudFileValidationFailed                             Status = 0xC000CF0E
+	CloudFileAuthenticationFailed                         Status = 0xC000CF0F
+	CloudFileInsufficientResources                        Status = 0xC000CF10
+	CloudFileNetworkUnavailable                           Status = 0xC000CF11
+	CloudFileUnsuccessful                                 Status = 0xC000CF12
+	CloudFileNotUnderSyncRoot                             Status = 0xC000CF13
+	CloudFileInUse                                        Status = 0xC000CF14
+	CloudFilePinned                                       Status = 0xC000CF15
+	CloudFileRequestAborted                               Status = 0xC000CF16
+	CloudFilePropertyCorrupt                              Status = 0xC000CF17
+	CloudFileAccessDenied                                 Status = 0xC000CF18
+	CloudFileIncompatibleHardlinks                        Status = 0xC000CF19
+	CloudFilePropertyLockConflict                         Status = 0xC000CF1A
+	CloudFileRequestCanceled                              Status = 0xC000CF1B
+	CloudFileProviderTerminated                           Status = 0xC000CF1D
+	NotACloudSyncRoot                                     Status = 0xC000CF1E
+	CloudFileRequestTimeout                               Status = 0xC000CF1F
+	AcpiInvalidOpcode                                     Status = 0xC0140001
+	AcpiStackOverflow                                     Status = 0xC0140002
+	AcpiAssertFailed                                      Status = 0xC0140003
+	AcpiInvalidIndex                                      Status = 0xC0140004
+	AcpiInvalidArgument                                   Status = 0xC0140005
+	AcpiFatal                                             Status = 0xC0140006
+	AcpiInvalidSupername                                  Status = 0xC0140007
+	AcpiInvalidArgtype                                    Status = 0xC0140008
+	AcpiInvalidObjtype                                    Status = 0xC0140009
+	AcpiInvalidTargettype                                 Status = 0xC014000A
+	AcpiIncorrectArgumentCount                            Status = 0xC014000B
+	AcpiAddressNotMapped                                  Status = 0xC014000C
+	AcpiInvalidEventtype                                  Status = 0xC014000D
+	AcpiHandlerCollision                                  Status = 0xC014000E
+	AcpiInvalidData                                       Status = 0xC014000F
+	AcpiInvalidRegion                                     Status = 0xC0140010
+	AcpiInvalidAccessSize                                 Status = 0xC0140011
+	AcpiAcquireGlobalLock                                 Status = 0xC0140012
+	AcpiAlreadyInitialized                                Status = 0xC0140013
+	AcpiNotInitialized                                    Status = 0xC0140014
+	AcpiInvalidMutexLevel                                 Status = 0xC0140015
+	AcpiMutexNotOwned                                     Status = 0xC0140016
+	AcpiMutexNotOwner                                     Status = 0xC0140017
+	AcpiRsAccess                                          Status = 0xC0140018
+	AcpiInvalidTable                                      Status = 0xC0140019
+	AcpiRegHandlerFailed                                  Status = 0xC0140020
+	AcpiPowerRequestFailed                                Status = 0xC0140021
+	CtxWinstationNameInvalid                              Status = 0xC00A0001
+	CtxInvalidPd                                          Status = 0xC00A0002
+	CtxPdNotFound                                         Status = 0xC00A0003
+	CtxCdmConnect                                         Status = 0x400A0004
+	CtxCdmDisconnect                                      Status = 0x400A0005
+	CtxClosePending                                       Status = 0xC00A0006
+	CtxNoOutbuf                                           Status = 0xC00A0007
+	CtxModemInfNotFound                                   Status = 0xC00A0008
+	CtxInvalidModemname                                   Status = 0xC00A0009
+	CtxResponseError                                      Status = 0xC00A000A
+	CtxModemResponseTimeout                               Status = 0xC00A000B
+	CtxModemResponseNoCarrier                             Status = 0xC00A000C
+	CtxModemResponseNoDialtone                            Status = 0xC00A000D
+	CtxModemResponseBusy                                  Status = 0xC00A000E
+	CtxModemResponseVoice                                 Status = 0xC00A000F
+	CtxTdError                                            Status = 0xC00A0010
+	CtxLicenseClientInvalid                               Status = 0xC00A0012
+	CtxLicenseNotAvailable                                Status = 0xC00A0013
+	CtxLicenseExpired                                     Status = 0xC00A0014
+	CtxWinstationNotFound                                 Status = 0xC00A0015
+	CtxWinstationNameCollision                            Status = 0xC00A0016
+	CtxWinstationBusy                                     Status = 0xC00A0017
+	CtxBadVideoMode                                       Status = 0xC00A0018
+	CtxGraphicsInvalid                                    Status = 0xC00A0022
+	CtxNotConsole                                         Status = 0xC00A0024
+	CtxClientQueryTimeout                                 Status = 0xC00A0026
+	CtxConsoleDisconnect                                  Status = 0xC00A0027
+	CtxConsoleConnect                                     Status = 0xC00A0028
+	CtxShadowDenied                                       Status = 0xC00A002A
+	CtxWinstationAccessDenied                             Status = 0xC00A002B
+	CtxInvalidWd                                          Status = 0xC00A002E
+	CtxWdNotFound                                         Status = 0xC00A002F
+	CtxShadowInvalid                                      Status = 0xC00A0030
+	CtxShadowDisabled                                     Status = 0xC00A0031
+	RdpProtocolError                                      Status = 0xC00A0032
+	CtxClientLicenseNotSet                                Status = 0xC00A0033
+	CtxClientLicenseInUse                                 Status = 0xC00A0034
+	CtxShadowEndedByModeChange                            Status = 0xC00A0035
+	CtxShadowNotRunning                                   Status = 0xC00A0036
+	CtxLogonDisabled                                      Status = 0xC00A0037
+	CtxSecurityLayerError                                 Status = 0xC00A0038
+	TsIncompatibleSessions                                Status = 0xC00A0039
+	TsVideoSubsystemError                                 Status = 0xC00A003A
+	PnpBadMpsTable                                        Status = 0xC0040035
+	PnpTranslationFailed                                  Status = 0xC0040036
+	PnpIrqTranslationFailed                               Status = 0xC0040037
+	PnpInvalidId                                          Status = 0xC0040038
+	IoReissueAsCached                                     Status = 0xC0040039
+	MuiFileNotFound                                       Status = 0xC00B0001
+	MuiInvalidFile                                        Status = 0xC00B0002
+	MuiInvalidRcConfig                                    Status = 0xC00B0003
+	MuiInvalidLocaleName                                  Status = 0xC00B0004
+	MuiInvalidUltimatefallbackName                        Status = 0xC00B0005
+	MuiFileNotLoaded                                      Status = 0xC00B0006
+	ResourceEnumUserStop                                  Status = 0xC00B0007
+	FltNoHandlerDefined                                   Status = 0xC01C0001
+	FltContextAlreadyDefined                              Status = 0xC01C0002
+	FltInvalidAsynchronousRequest                         Status = 0xC01C0003
+	FltDisallowFastIo                                     Status = 0xC01C0004
+	FltInvalidNameRequest                                 Status = 0xC01C0005
+	FltNotSafeToPostOperation                             Status = 0xC01C0006
+	FltNotInitialized                                     Status = 0xC01C0007
+	FltFilterNotReady                                     Status = 0xC01C0008
+	FltPostOperationCleanup                               Status = 0xC01C0009
+	FltInternalError                                      Status = 0xC01C000A
+	FltDeletingObject                                     Status = 0xC01C000B
+	FltMustBeNonpagedPool                                 Status = 0xC01C000C
+	FltDuplicateEntry                                     Status = 0xC01C000D
+	FltCbdqDisabled                                       Status = 0xC01C000E
+	FltDoNotAttach                                        Status = 0xC01C000F
+	FltDoNotDetach                                        Status = 0xC01C0010
+	FltInstanceAltitudeCollision                          Status = 0xC01C0011
+	FltInstanceNameCollision                              Status = 0xC01C0012
+	FltFilterNotFound                                     Status = 0xC01C0013
+	FltVolumeNotFound                                     Status = 0xC01C0014
+	FltInstanceNotFound                                   Status = 0xC01C0015
+	FltContextAllocationNotFound                          Status = 0xC01C0016
+	FltInvalidContextRegistration                         Status = 0xC01C0017
+	FltNameCacheMiss                                      Status = 0xC01C0018
+	FltNoDeviceObject                                     Status = 0xC01C0019
+	FltVolumeAlreadyMounted                               Status = 0xC01C001A
+	FltAlreadyEnlisted                                    Status = 0xC01C001B
+	FltContextAlreadyLinked                               Status = 0xC01C001C
+	FltNoWaiterForReply                                   Status = 0xC01C0020
+	FltRegistrationBusy                                   Status = 0xC01C0023
+	SxsSectionNotFound                                    Status = 0xC0150001
+	SxsCantGenActctx                                      Status = 0xC0150002
+	SxsInvalidActctxdataFormat                            Status = 0xC0150003
+	SxsAssemblyNotFound                                   Status = 0xC0150004
+	SxsManifestFormatError                                Status = 0xC0150005
+	SxsManifestParseError                                 Status = 0xC0150006
+	SxsActivationContextDisabled                          Status = 0xC0150007
+	SxsKeyNotFound                                        Status = 0xC0150008
+	SxsVersionConflict                                    Status = 0xC0150009
+	SxsWrongSectionType                                   Status = 0xC015000A
+	SxsThreadQueriesDisabled                              Status = 0xC015000B
+	SxsAssemblyMissing                                    Status = 0xC015000C
+	SxsReleaseActivationContext                           Status = 0x4015000D
+	SxsProcessDefaultAlreadySet                           Status = 0xC015000E
+	SxsEarlyDeactivation                                  Status = 0xC015000F
+	SxsInvalidDeactivation                                Status = 0xC0150010
+	SxsMultipleDeactivation                               Status = 0xC0150011
+	SxsSystemDefaultActivationContextEmpty                Status = 0xC0150012
+	SxsProcessTerminationRequested                        Status = 0xC0150013
+	SxsCorruptActivationStack                             Status = 0xC0150014
+	SxsCorruption                                         Status = 0xC0150015
+	SxsInvalidIdentityAttributeValue                      Status = 0xC0150016
+	SxsInvalidIdentityAttributeName                       Status = 0xC0150017
+	SxsIdentityDuplicateAttribute                         Status = 0xC0150018
+	SxsIdentityParseError                                 Status = 0xC0150019
+	SxsComponentStoreCorrupt                              Status = 0xC015001A
+	SxsFileHashMismatch                                   Status = 0xC015001B
+	SxsManifestIdentitySameButContentsDifferent           Status = 0xC015001C
+	SxsIdentitiesDifferent                                Status = 0xC015001D
+	SxsAssemblyIsNotADeployment                           Status = 0xC015001E
+	SxsFileNotPartOfAssembly                              Status = 0xC015001F
+	AdvancedInstallerFailed                               Status = 0xC0150020
+	XmlEncodingMismatch                                   Status = 0xC0150021
+	SxsManifestTooBig                                     Status = 0xC0150022
+	SxsSettingNotRegistered                               Status = 0xC0150023
+	SxsTransactionClosureIncomplete                       Status = 0xC0150024
+	SmiPrimitiveInstallerFailed                           Status = 0xC0150025
+	GenericCommandFailed                                  Status = 0xC0150026
+	SxsFileHashMissing                                    Status = 0xC0150027
+	ClusterInvalidNode                                    Status = 0xC0130001
+	ClusterNodeExists                                     Status = 0xC0130002
+	ClusterJoinInProgress                                 Status = 0xC0130003
+	ClusterNodeNotFound                                   Status = 0xC0130004
+	ClusterLocalNodeNotFound                              Status = 0xC0130005
+	ClusterNetworkExists                                  Status = 0xC0130006
+	ClusterNetworkNotFound                                Status = 0xC0130007
+	ClusterNetinterfaceExists                             Status = 0xC0130008
+	ClusterNetinterfaceNotFound                           Status = 0xC0130009
+	ClusterInvalidRequest                                 Status = 0xC013000A
+	ClusterInvalidNetworkProvider                         Status = 0xC013000B
+	ClusterNodeDown                                       Status = 0xC013000C
+	ClusterNodeUnreachable                                Status = 0xC013000D
+	ClusterNodeNotMember                                  Status = 0xC013000E
+	ClusterJoinNotInProgress                              Status = 0xC013000F
+	ClusterInvalidNetwork                                 Status = 0xC0130010
+	ClusterNoNetAdapters                                  Status = 0xC0130011
+	ClusterNodeUp                                         Status = 0xC0130012
+	ClusterNodePaused                                     Status = 0xC0130013
+	ClusterNodeNotPaused                                  Status = 0xC0130014
+	ClusterNoSecurityContext                              Status = 0xC0130015
+	ClusterNetworkNotInternal                             Status = 0xC0130016
+	ClusterPoisoned                                       Status = 0xC0130017
+	ClusterNonCsvPath                                     Status = 0xC0130018
+	ClusterCsvVolumeNotLocal                              Status = 0xC0130019
+	ClusterCsvReadOplockBreakInProgress                   Status = 0xC0130020
+	ClusterCsvAutoPauseError                              Status = 0xC0130021
+	ClusterCsvRedirected                                  Status = 0xC0130022
+	ClusterCsvNotRedirected                               Status = 0xC0130023
+	ClusterCsvVolumeDraining                              Status = 0xC0130024
+	ClusterCsvSnapshotCreationInProgress                  Status = 0xC0130025
+	ClusterCsvVolumeDrainingSucceededDownlevel            Status = 0xC0130026
+	ClusterCsvNoSnapshots                                 Status = 0xC0130027
+	CsvIoPauseTimeout                                     Status = 0xC0130028
+	ClusterCsvInvalidHandle                               Status = 0xC0130029
+	ClusterCsvSupportedOnlyOnCoordinator                  Status = 0xC0130030
+	ClusterCamTicketReplayDetected                        Status = 0xC0130031
+	TransactionalConflict                                 Status = 0xC0190001
+	InvalidTransaction                                    Status = 0xC0190002
+	TransactionNotActive                                  Status = 0xC0190003
+	TmInitializationFailed                                Status = 0xC0190004
+	RmNotActive                                           Status = 0xC0190005
+	RmMetadataCorrupt                                     Status = 0xC0190006
+	TransactionNotJoined                                  Status = 0xC0190007
+	DirectoryNotRm                                        Status = 0xC0190008
+	CouldNotResizeLog                                     Status = 0x80190009
+	TransactionsUnsupportedRemote                         Status = 0xC019000A
+	LogResizeInvalidSize                                  Status = 0xC019000B
+	RemoteFileVersionMismatch                             Status = 0xC019000C
+	CrmProtocolAlreadyExists                              Status = 0xC019000F
+	TransactionPropagationFailed                          Status = 0xC0190010
+	CrmProtocolNotFound                                   Status = 0xC0190011
+	TransactionSuperiorExists                             Status = 0xC0190012
+	TransactionRequestNotValid                            Status = 0xC0190013
+	TransactionNotRequested                               Status = 0xC0190014
+	TransactionAlreadyAborted                             Status = 0xC0190015
+	TransactionAlreadyCommitted                           Status = 0xC0190016
+	TransactionInvalidMarshallBuffer                      Status = 0xC0190017
+	CurrentTransactionNotValid                            Status = 0xC0190018
+	LogGrowthFailed                                       Status = 0xC0190019
+	ObjectNoLongerExists                                  Status = 0xC0190021
+	StreamMiniversionNotFound                             Status = 0xC0190022
+	StreamMiniversionNotValid                             Status = 0xC0190023
+	MiniversionInaccessibleFromSpecifiedTransaction       Status = 0xC0190024
+	CantOpenMiniversionWithModifyIntent                   Status = 0xC0190025
+	CantCreateMoreStreamMiniversions                      Status = 0xC0190026
+	HandleNoLongerValid                                   Status = 0xC0190028
+	NoTxfMetadata                                         Status = 0x80190029
+	LogCorruptionDetected                                 Status = 0xC0190030
+	CantRecoverWithHandleOpen                             Status = 0x80190031
+	RmDisconnected                                        Status = 0xC0190032
+	EnlistmentNotSuperior                                 Status = 0xC0190033
+	RecoveryNotNeeded                                     Status = 0x40190034
+	RmAlreadyStarted                                      Status = 0x40190035
+	FileIdentityNotPersistent                             Status = 0xC0190036
+	CantBreakTransactionalDependency                      Status = 0xC0190037
+	CantCrossRmBoundary                                   Status = 0xC0190038
+	TxfDirNotEmpty                                        Status = 0xC0190039
+	IndoubtTransactionsExist                              Status = 0xC019003A
+	TmVolatile                                            Status = 0xC019003B
+	RollbackTimerExpired                                  Status = 0xC019003C
+	TxfAttributeCorrupt                                   Status = 0xC019003D
+	EfsNotAllowedInTransaction                            Status = 0xC019003E
+	TransactionalOpenNotAllowed                           Status = 0xC019003F
+	TransactedMappingUnsupportedRemote                    Status = 0xC0190040
+	TxfMetadataAlreadyPresent                             Status = 0x80190041
+	TransactionScopeCallbacksNotSet                       Status = 0x80190042
+	TransactionRequiredPromotion                          Status = 0xC0190043
+	CannotExecuteFileInTransaction                        Status = 0xC0190044
+	TransactionsNotFrozen                                 Status = 0xC0190045
+	TransactionFreezeInProgress                           Status = 0xC0190046
+	NotSnapshotVolume                                     Status = 0xC0190047
+	NoSavepointWithOpenFiles                              Status = 0xC0190048
+	SparseNotAllowedInTransaction                         Status = 0xC0190049
+	TmIdentityMismatch                                    Status = 0xC019004A
+	FloatedSection                                        Status = 0xC019004B
+	CannotAcceptTransactedWork                            Status = 0xC019004C
+	CannotAbortTransactions                               Status = 0xC019004D
+	TransactionNotFound                                   Status = 0xC019004E
+	ResourcemanagerNotFound                               Status = 0xC019004F
+	EnlistmentNotFound                                    Status = 0xC0190050
+	TransactionmanagerNotFound                            Status = 0xC0190051
+	TransactionmanagerNotOnline                           Status = 0xC0190052
+	TransactionmanagerRecoveryNameCollision               Status = 0xC0190053
+	TransactionNotRoot                                    Status = 0xC0190054
+	TransactionObjectExpired                              Status = 0xC0190055
+	CompressionNotAllowedInTransaction                    Status = 0xC0190056
+	TransactionResponseNotEnlisted                        Status = 0xC0190057
+	TransactionRecordTooLong                              Status = 0xC0190058
+	NoLinkTrackingInTransaction                           Status = 0xC0190059
+	OperationNotSupportedInTransaction                    Status = 0xC019005A
+	TransactionIntegrityViolated                          Status = 0xC019005B
+	TransactionmanagerIdentityMismatch                    Status = 0xC019005C
+	RmCannotBeFrozenForSnapshot                           Status = 0xC019005D
+	TransactionMustWritethrough                           Status = 0xC019005E
+	TransactionNoSuperior                                 Status = 0xC019005F
+	ExpiredHandle                                         Status = 0xC0190060
+	TransactionNotEnlisted                                Status = 0xC0190061
+	LogSectorInvalid                                      Status = 0xC01A0001
+	LogSectorParityInvalid                                Status = 0xC01A0002
+	LogSectorRemapped                                     Status = 0xC01A0003
+	LogBlockIncomplete                                    Status = 0xC01A0004
+	LogInvalidRange                                       Status = 0xC01A0005
+	LogBlocksExhausted                                    Status = 0xC01A0006
+	LogReadContextInvalid                                 Status = 0xC01A0007
+	LogRestartInvalid                                     Status = 0xC01A0008
+	LogBlockVersion                                       Status = 0xC01A0009
+	LogBlockInvalid                                       Status = 0xC01A000A
+	LogReadModeInvalid                                    Status = 0xC01A000B
+	LogNoRestart                                          Status = 0x401A000C
+	LogMetadataCorrupt                                    Status = 0xC01A000D
+	LogMetadataInvalid                                    Status = 0xC01A000E
+	LogMetadataInconsistent                               Status = 0xC01A000F
+	LogReservationInvalid                                 Status = 0xC01A0010
+	LogCantDelete                                         Status = 0xC01A0011
+	LogContainerLimitExceeded                             Status = 0xC01A0012
+	LogStartOfLog                                         Status = 0xC01A0013
+	LogPolicyAlreadyInstalled                             Status = 0xC01A0014
+	LogPolicyNotInstalled                                 Status = 0xC01A0015
+	LogPolicyInvalid                                      Status = 0xC01A0016
+	LogPolicyConflict                                     Status = 0xC01A0017
+	LogPinnedArchiveTail                                  Status = 0xC01A0018
+	LogRecordNonexistent                                  Status = 0xC01A0019
+	LogRecordsReservedInvalid                             Status = 0xC01A001A
+	LogSpaceReservedInvalid                               Status = 0xC01A001B
+	LogTailInvalid                                        Status = 0xC01A001C
+	LogFull                                               Status = 0xC01A001D
+	LogMultiplexed                                        Status = 0xC01A001E
+	LogDedicated                                          Status = 0xC01A001F
+	LogArchiveNotInProgress                               Status = 0xC01A0020
+	LogArchiveInProgress                                  Status = 0xC01A0021
+	LogEphemeral                                          Status = 0xC01A0022
+	LogNotEnoughContainers                                Status = 0xC01A0023
+	LogClientAlreadyRegistered                            Status = 0xC01A0024
+	LogClientNotRegistered                                Status = 0xC01A0025
+	LogFullHandlerInProgress                              Status = 0xC01A0026
+	LogContainerReadFailed                                Status = 0xC01A0027
+	LogContainerWriteFailed                               Status = 0xC01A0028
+	LogContainerOpenFailed                                Status = 0xC01A0029
+	LogContainerStateInvalid                              Status = 0xC01A002A
+	LogStateInvalid                                       Status = 0xC01A002B
+	LogPinned                                             Status = 0xC01A002C
+	LogMetadataFlushFailed                                Status = 0xC01A002D
+	LogInconsistentSecurity                               Status = 0xC01A002E
+	LogAppendedFlushFailed                                Status = 0xC01A002F
+	LogPinnedReservation                                  Status = 0xC01A0030
+	VideoHungDisplayDriverThread                          Status = 0xC01B00EA
+	VideoHungDisplayDriverThreadRecovered                 Status = 0x801B00EB
+	VideoDriverDebugReportRequest                         Status = 0x401B00EC
+	MonitorNoDescriptor                                   Status = 0xC01D0001
+	MonitorUnknownDescriptorFormat                        Status = 0xC01D0002
+	MonitorInvalidDescriptorChecksum                      Status = 0xC01D0003
+	MonitorInvalidStandardTimingBlock                     Status = 0xC01D0004
+	MonitorWmiDatablockRegistrationFailed                 Status = 0xC01D0005
+	MonitorInvalidSerialNumberMondscBlock                 Status = 0xC01D0006
+	MonitorInvalidUserFriendlyMondscBlock                 Status = 0xC01D0007
+	MonitorNoMoreDescriptorData                           Status = 0xC01D0008
+	MonitorInvalidDetailedTimingBlock                     Status = 0xC01D0009
+	MonitorInvalidManufactureDate                         Status = 0xC01D000A
+	GraphicsNotExclusiveModeOwner                         Status = 0xC01E0000
+	GraphicsInsufficientDmaBuffer                         Status = 0xC01E0001
+	GraphicsInvalidDisplayAdapter                         Status = 0xC01E0002
+	GraphicsAdapterWasReset                               Status = 0xC01E0003
+	GraphicsInvalidDriverModel                            Status = 0xC01E0004
+	GraphicsPresentModeChanged                            Status = 0xC01E0005
+	GraphicsPresentOccluded                               Status = 0xC01E0006
+	GraphicsPresentDenied                                 Status = 0xC01E0007
+	GraphicsCannotcolorconvert                            Status = 0xC01E0008
+	GraphicsDriverMismatch                                Status = 0xC01E0009
+	GraphicsPartialDataPopulated                          Status = 0x401E000A
+	GraphicsPresentRedirectionDisabled                    Status = 0xC01E000B
+	GraphicsPresentUnoccluded                             Status = 0xC01E000C
+	GraphicsWindowdcNotAvailable                          Status = 0xC01E000D
+	GraphicsWindowlessPresentDisabled                     Status = 0xC01E000E
+	GraphicsPresentInvalidWindow                          Status = 0xC01E000F
+	GraphicsPresentBufferNotBound                         Status = 0xC01E0010
+	GraphicsVailStateChanged                              Status = 0xC01E0011
+	GraphicsIndirectDisplayAbandonSwapchain               Status = 0xC01E0012
+	GraphicsIndirectDisplayDeviceStopped                  Status = 0xC01E0013
+	GraphicsNoVideoMemory                                 Status = 0xC01E0100
+	GraphicsCantLockMemory                                Status = 0xC01E0101
+	GraphicsAllocationBusy                                Status = 0xC01E0102
+	GraphicsTooManyReferences                             Status = 0xC01E0103
+	GraphicsTryAgainLater                                 Status = 0xC01E0104
+	GraphicsTryAgainNow                                   Status = 0xC01E0105
+	GraphicsAllocationInvalid                             Status = 0xC01E0106
+	GraphicsUnswizzlingApertureUnavailable                Status = 0xC01E0107
+	GraphicsUnswizzlingApertureUnsupported                Status = 0xC01E0108
+	GraphicsCantEvictPinnedAllocation                     Status = 0xC01E0109
+	GraphicsInvalidAllocationUsage                        Status = 0xC01E0110
+	GraphicsCantRenderLockedAllocation                    Status = 0xC01E0111
+	GraphicsAllocationClosed                              Status = 0xC01E0112
+	GraphicsInvalidAllocationInstance                     Status = 0xC01E0113
+	GraphicsInvalidAllocationHandle                       Status = 0xC01E0114
+	GraphicsWrongAllocationDevice                         Status = 0xC01E0115
+	GraphicsAllocationContentLost                         Status = 0xC01E0116
+	GraphicsGpuExceptionOnDevice                          Status = 0xC01E0200
+	GraphicsSkipAllocationPreparation                     Status = 0x401E0201
+	GraphicsInvalidVidpnTopology                          Status = 0xC01E0300
+	GraphicsVidpnTopologyNotSupported                     Status = 0xC01E0301
+	GraphicsVidpnTopologyCurrentlyNotSupported            Status = 0xC01E0302
+	GraphicsInvalidVidpn                                  Status = 0xC01E0303
+	GraphicsInvalidVideoPresentSource                     Status = 0xC01E0304
+	GraphicsInvalidVideoPresentTarget                     Status = 0xC01E0305
+	GraphicsVidpnModalityNotSupported                     Status = 0xC01E0306
+	GraphicsModeNotPinned                                 Status = 0x401E0307
+	GraphicsInvalidVidpnSourcemodeset                     Status = 0xC01E0308
+	GraphicsInvalidVidpnTargetmodeset                     Status = 0xC01E0309
+	GraphicsInvalidFrequency                              Status = 0xC01E030A
+	GraphicsInvalidActiveRegion                           Status = 0xC01E030B
+	GraphicsInvalidTotalRegion                            Status = 0xC01E030C
+	GraphicsInvalidVideoPresentSourceMode                 Status = 0xC01E0310
+	GraphicsInvalidVideoPresentTargetMode                 Status = 0xC01E0311
+	GraphicsPinnedModeMustRemainInSet                     Status = 0xC01E0312
+	GraphicsPathAlreadyInTopology                         Status = 0xC01E0313
+	GraphicsModeAlreadyInModeset                          Status = 0xC01E0314
+	GraphicsInvalidVideopresentsourceset                  Status = 0xC01E0315
+	GraphicsInvalidVideopresenttargetset                  Status = 0xC01E0316
+	GraphicsSourceAlreadyInSet                            Status = 0xC01E0317
+	GraphicsTargetAlreadyInSet                            Status = 0xC01E0318
+	GraphicsInvalidVidpnPresentPath                       Status = 0xC01E0319
+	GraphicsNoRecommendedVidpnTopology                    Status = 0xC01E031A
+	GraphicsInvalidMonitorFrequencyrangeset               Status = 0xC01E031B
+	GraphicsInvalidMonitorFrequencyrange                  Status = 0xC01E031C
+	GraphicsFrequencyrangeNotInSet                        Status = 0xC01E031D
+	GraphicsNoPreferredMode                               Status = 0x401E031E
+	GraphicsFrequencyrangeAlreadyInSet                    Status = 0xC01E031F
+	GraphicsStaleModeset                                  Status = 0xC01E0320
+	GraphicsInvalidMonitorSourcemodeset                   Status = 0xC01E0321
+	GraphicsInvalidMonitorSourceMode                      Status = 0xC01E0322
+	GraphicsNoRecommendedFunctionalVidpn                  Status = 0xC01E0323
+	GraphicsModeIdMustBeUnique                            Status = 0xC01E0324
+	GraphicsEmptyAdapterMonitorModeSupportIntersection    Status = 0xC01E0325
+	GraphicsVideoPresentTargetsLessThanSources            Status = 0xC01E0326
+	GraphicsPathNotInTopology                             Status = 0xC01E0327
+	GraphicsAdapterMustHaveAtLeastOneSource               Status = 0xC01E0328
+	GraphicsAdapterMustHaveAtLeastOneTarget               Status = 0xC01E0329
+	GraphicsInvalidMonitordescriptorset                   Status = 0xC01E032A
+	GraphicsInvalidMonitordescriptor                      Status = 0xC01E032B
+	GraphicsMonitordescriptorNotInSet                     Status = 0xC01E032C
+	GraphicsMonitordescriptorAlreadyInSet                 Status = 0xC01E032D
+	GraphicsMonitordescriptorIdMustBeUnique               Status = 0xC01E032E
+	GraphicsInvalidVidpnTargetSubsetType                  Status = 0xC01E032F
+	GraphicsResourcesNotRelated                           Status = 0xC01E0330
+	GraphicsSourceIdMustBeUnique                          Status = 0xC01E0331
+	GraphicsTargetIdMustBeUnique                          Status = 0xC01E0332
+	GraphicsNoAvailableVidpnTarget                        Status = 0xC01E0333
+	GraphicsMonitorCouldNotBeAssociatedWithAdapter        Status = 0xC01E0334
+	GraphicsNoVidpnmgr                                    Status = 0xC01E0335
+	GraphicsNoActiveVidpn                                 Status = 0xC01E0336
+	GraphicsStaleVidpnTopology                            Status = 0xC01E0337
+	GraphicsMonitorNotConnected                           Status = 0xC01E0338
+	GraphicsSourceNotInTopology                           Status = 0xC01E0339
+	GraphicsInvalidPrimarysurfaceSize                     Status = 0xC01E033A
+	GraphicsInvalidVisibleregionSize                      Status = 0xC01E033B
+	GraphicsInvalidStride                                 Status = 0xC01E033C
+	GraphicsInvalidPixelformat                            Status = 0xC01E033D
+	GraphicsInvalidColorbasis                             Status = 0xC01E033E
+	GraphicsInvalidPixelvalueaccessmode                   Status = 0xC01E033F
+	GraphicsTargetNotInTopology                           Status = 0xC01E0340
+	GraphicsNoDisplayModeManagementSupport                Status = 0xC01E0341
+	GraphicsVidpnSourceInUse                              Status = 0xC01E0342
+	GraphicsCantAccessActiveVidpn                         Status = 0xC01E0343
+	GraphicsInvalidPathImportanceOrdinal                  Status = 0xC01E0344
+	GraphicsInvalidPathContentGeometryTransformation      Status = 0xC01E0345
+	GraphicsPathContentGeometryTransformationNotSupported Status = 0xC01E0346
+	GraphicsInvalidGammaRamp                              Status = 0xC01E0347
+	GraphicsGammaRampNotSupported                         Status = 0xC01E0348
+	GraphicsMultisamplingNotSupported                     Status = 0xC01E0349
+	GraphicsModeNotInModeset                              Status = 0xC01E034A
+	GraphicsDatasetIsEmpty                                Status = 0x401E034B
+	GraphicsNoMoreElementsInDataset                       Status = 0x401E034C
+	GraphicsInvalidVidpnTopologyRecommendationReason      Status = 0xC01E034D
+	GraphicsInvalidPathContentType                        Status = 0xC01E034E
+	GraphicsInvalidCopyprotectionType                     Status = 0xC01E034F
+	GraphicsUnassignedModesetAlreadyExists                Status = 0xC01E0350
+	GraphicsPathContentGeometryTransformationNotPinned    Status = 0x401E0351
+	GraphicsInvalidScanlineOrdering                       Status = 0xC01E0352
+	GraphicsTopologyChangesNotAllowed                     Status = 0xC01E0353
+	GraphicsNoAvailableImportanceOrdinals                 Status = 0xC01E0354
+	GraphicsIncompatiblePrivateFormat                     Status = 0xC01E0355
+	GraphicsInvalidModePruningAlgorithm                   Status = 0xC01E0356
+	GraphicsInvalidMonitorCapabilityOrigin                Status = 0xC01E0357
+	GraphicsInvalidMonitorFrequencyrangeConstraint        Status = 0xC01E0358
+	GraphicsMaxNumPathsReached                            Status = 0xC01E0359
+	GraphicsCancelVidpnTopologyAugmentation               Status = 0xC01E035A
+	GraphicsInvalidClientType                             Status = 0xC01E035B
+	GraphicsClientvidpnNotSet                             Status = 0xC01E035C
+	GraphicsSpecifiedChildAlreadyConnected                Status = 0xC01E0400
+	GraphicsChildDescriptorNotSupported                   Status = 0xC01E0401
+	GraphicsUnknownChildStatus                            Status = 0x401E042F
+	GraphicsNotALinkedAdapter                             Status = 0xC01E0430
+	GraphicsLeadlinkNotEnumerated                         Status = 0xC01E0431
+	GraphicsChainlinksNotEnumerated                       Status = 0xC01E0432
+	GraphicsAdapterChainNotReady                          Status = 0xC01E0433
+	GraphicsChainlinksNotStarted                          Status = 0xC01E0434
+	GraphicsChainlinksNotPoweredOn                        Status = 0xC01E0435
+	GraphicsInconsistentDeviceLinkState                   Status = 0xC01E0436
+	GraphicsLeadlinkStartDeferred                         Status = 0x401E0437
+	GraphicsNotPostDeviceDriver                           Status = 0xC01E0438
+	GraphicsPollingTooFrequently                          Status = 0x401E0439
+	GraphicsStartDeferred                                 Status = 0x401E043A
+	GraphicsAdapterAccessNotExcluded                      Status = 0xC01E043B
+	GraphicsDependableChildStatus                         Status = 0x401E043C
+	GraphicsOpmNotSupported                               Status = 0xC01E0500
+	GraphicsCoppNotSupported                              Status = 0xC01E0501
+	GraphicsUabNotSupported                               Status = 0xC01E0502
+	GraphicsOpmInvalidEncryptedParameters                 Status = 0xC01E0503
+	GraphicsOpmNoProtectedOutputsExist                    Status = 0xC01E0505
+	GraphicsOpmInternalError                              Status = 0xC01E050B
+	GraphicsOpmInvalidHandle                              Status = 0xC01E050C
+	GraphicsPvpInvalidCertificateLength                   Status = 0xC01E050E
+	GraphicsOpmSpanningModeEnabled                        Status = 0xC01E050F
+	GraphicsOpmTheaterModeEnabled                         Status = 0xC01E0510
+	GraphicsPvpHfsFailed                                  Status = 0xC01E0511
+	GraphicsOpmInvalidSrm                                 Status = 0xC01E0512
+	GraphicsOpmOutputDoesNotSupportHdcp                   Status = 0xC01E0513
+	GraphicsOpmOutputDoesNotSupportAcp                    Status = 0xC01E0514
+	GraphicsOpmOutputDoesNotSupportCgmsa                  Status = 0xC01E0515
+	GraphicsOpmHdcpSrmNeverSet                            Status = 0xC01E0516
+	GraphicsOpmResolutionTooHigh                          Status = 0xC01E0517
+	GraphicsOpmAllHdcpHardwareAlreadyInUse                Status = 0xC01E0518
+	GraphicsOpmProtectedOutputNoLongerExists              Status = 0xC01E051A
+	GraphicsOpmProtectedOutputDoesNotHaveCoppSemantics    Status = 0xC01E051C
+	GraphicsOpmInvalidInformationRequest                  Status = 0xC01E051D
+	GraphicsOpmDriverInternalError                        Status = 0xC01E051E
+	GraphicsOpmProtectedOutputDoesNotHaveOpmSemantics     Status = 0xC01E051F
+	GraphicsOpmSignalingNotSupported                      Status = 0xC01E0520
+	GraphicsOpmInvalidConfigurationRequest                Status = 0xC01E0521
+	GraphicsI2cNotSupported                               Status = 0xC01E0580
+	GraphicsI2cDeviceDoesNotExist                         Status = 0xC01E0581
+	GraphicsI2cErrorTransmittingData                      Status = 0xC01E0582
+	GraphicsI2cErrorReceivingData                         Status = 0xC01E0583
+	GraphicsDdcciVcpNotSupported                          Status = 0xC01E0584
+	GraphicsDdcciInvalidData                              Status = 0xC01E0585
+	GraphicsDdcciMonitorReturnedInvalidTimingStatusByte   Status = 0xC01E0586
+	GraphicsDdcciInvalidCapabilitiesString                Status = 0xC01E0587
+	GraphicsMcaInternalError                              Status = 0xC01E0588
+	GraphicsDdcciInvalidMessageCommand                    Status = 0xC01E0589
+	GraphicsDdcciInvalidMessageLength                     Status = 0xC01E058A
+	GraphicsDdcciInvalidMessageChecksum                   Status = 0xC01E058B
+	GraphicsInvalidPhysicalMonitorHandle                  Status = 0xC01E058C
+	GraphicsMonitorNoLongerExists                         Status = 0xC01E058D
+	GraphicsOnlyConsoleSessionSupported                   Status = 0xC01E05E0
+	GraphicsNoDisplayDeviceCorrespondsToName              Status = 0xC01E05E1
+	GraphicsDisplayDeviceNotAttachedToDesktop             Status = 0xC01E05E2
+	GraphicsMirroringDevicesNotSupported                  Status = 0xC01E05E3
+	GraphicsInvalidPointer                                Status = 0xC01E05E4
+	GraphicsNoMonitorsCorrespondToDisplayDevice           Status = 0xC01E05E5
+	GraphicsParameterArrayTooSmall                        Status = 0xC01E05E6
+	GraphicsInternalError                                 Status = 0xC01E05E7
+	GraphicsSessionTypeChangeInProgress                   Status = 0xC01E05E8
+	FveLockedVolume                                       Status = 0xC0210000
+	FveNotEncrypted                                       Status = 0xC0210001
+	FveBadInformation                                     Status = 0xC0210002
+	FveTooSmall                                           Status = 0xC0210003
+	FveFailedWrongFs                                      Status = 0xC0210004
+	FveBadPartitionSize                                   Status = 0xC0210005
+	FveFsNotExtended                                      Status = 0xC0210006
+	FveFsMounted                                          Status = 0xC0210007
+	FveNoLicense                                          Status = 0xC0210008
+	FveActionNotAllowed                                   Status = 0xC0210009
+	FveBadData                                            Status = 0xC021000A
+	FveVolumeNotBound                                     Status = 0xC021000B
+	FveNotDataVolume                                      Status = 0xC021000C
+	FveConvReadError                                      Status = 0xC021000D
+	FveConvWriteError                                     Status = 0xC021000E
+	FveOverlappedUpdate                                   Status = 0xC021000F
+	FveFailedSectorSize                                   Status = 0xC0210010
+	FveFailedAuthentication                               Status = 0xC0210011
+	FveNotOsVolume                                        Status = 0xC0210012
+	FveKeyfileNotFound                                    Status = 0xC0210013
+	FveKeyfileInvalid                                     Status = 0xC0210014
+	FveKeyfileNoVmk                                       Status = 0xC0210015
+	FveTpmDisabled                                        Status = 0xC0210016
+	FveTpmSrkAuthNotZero                                  Status = 0xC0210017
+	FveTpmInvalidPcr                                      Status = 0xC0210018
+	FveTpmNoVmk                                           Status = 0xC0210019
+	FvePinInvalid                                         Status = 0xC021001A
+	FveAuthInvalidApplication                             Status = 0xC021001B
+	FveAuthInvalidConfig                                  Status = 0xC021001C
+	FveDebuggerEnabled                                    Status = 0xC021001D
+	FveDryRunFailed                                       Status = 0xC021001E
+	FveBadMetadataPointer                                 Status = 0xC021001F
+	FveOldMetadataCopy                                    Status = 0xC0210020
+	FveRebootRequired                                     Status = 0xC0210021
+	FveRawAccess                                          Status = 0xC0210022
+	FveRawBlocked                                         Status = 0xC0210023
+	FveNoAutounlockMasterKey                              Status = 0xC0210024
+	FveMorFailed                                          Status = 0xC0210025
+	FveNoFeatureLicense                                   Status = 0xC0210026
+	FvePolicyUserDisableRdvNotAllowed                     Status = 0xC0210027
+	FveConvRecoveryFailed                                 Status = 0xC0210028
+	FveVirtualizedSpaceTooBig                             Status = 0xC0210029
+	FveInvalidDatumType                                   Status = 0xC021002A
+	FveVolumeTooSmall                                     Status = 0xC0210030
+	FveEnhPinInvalid                                      Status = 0xC0210031
+	FveFullEncryptionNotAllowedOnTpStorage                Status = 0xC0210032
+	FveWipeNotAllowedOnTpStorage                          Status = 0xC0210033
+	FveNotAllowedOnCsvStack                               Status = 0xC0210034
+	FveNotAllowedOnCluster                                Status = 0xC0210035
+	FveNotAllowedToUpgradeWhileConverting                 Status = 0xC0210036
+	FveWipeCancelNotApplicable                            Status = 0xC0210037
+	FveEdriveDryRunFailed                                 Status = 0xC0210038
+	FveSecurebootDisabled                                 Status = 0xC0210039
+	FveSecurebootConfigChange                             Status = 0xC021003A
+	FveDeviceLockedout                                    Status = 0xC021003B
+	FveVolumeExtendPreventsEowDecrypt                     Status = 0xC021003C
+	FveNotDeVolume                                        Status = 0xC021003D
+	FveProtectionDisabled                                 Status = 0xC021003E
+	FveProtectionCannotBeDisabled                         Status = 0xC021003F
+	FveOsvKsrNotAllowed                                   Status = 0xC0210040
+	FwpCalloutNotFound                                    Status = 0xC0220001
+	FwpConditionNotFound                                  Status = 0xC0220002
+	FwpFilterNotFound                                     Status = 0xC0220003
+	FwpLayerNotFound                                      Status = 0xC0220004
+	FwpProviderNotFound                                   Status = 0xC0220005
+	FwpProviderContextNotFound                            Status = 0xC0220006
+	FwpSublayerNotFound                                   Status = 0xC0220007
+	FwpNotFound                                           Status = 0xC0220008
+	FwpAlreadyExists                                      Status = 0xC0220009
+	FwpInUse                                              Status = 0xC022000A
+	FwpDynamicSessionInProgress                           Status = 0xC022000B
+	FwpWrongSession                                       Status = 0xC022000C
+	FwpNoTxnInProgress                                    Status = 0xC022000D
+	FwpTxnInProgress                                      Status = 0xC022000E
+	FwpTxnAborted                                         Status = 0xC022000F
+	FwpSessionAborted                                     Status = 0xC0220010
+	FwpIncompatibleTxn                                    Status = 0xC0220011
+	FwpTimeout                                            Status = 0xC0220012
+	FwpNetEventsDisabled                                  Status = 0xC0220013
+	FwpIncompatibleLayer                                  Status = 0xC0220014
+	FwpKmClientsOnly                                      Status = 0xC0220015
+	FwpLifetimeMismatch                                   Status = 0xC0220016
+	FwpBuiltinObject                                      Status = 0xC0220017
+	FwpTooManyCallouts                                    Status = 0xC0220018
+	FwpNotificationDropped                                Status = 0xC0220019
+	FwpTrafficMismatch                                    Status = 0xC022001A
+	FwpIncompatibleSaState                                Status = 0xC022001B
+	FwpNullPointer                                        Status = 0xC022001C
+	FwpInvalidEnumerator                                  Status = 0xC022001D
+	FwpInvalidFlags                                       Status = 0xC022001E
+	FwpInvalidNetMask                                     Status = 0xC022001F
+	FwpInvalidRange                                       Status = 0xC0220020
+	FwpInvalidInterval                                    Status = 0xC0220021
+	FwpZeroLengthArray                                    Status = 0xC0220022
+	FwpNullDisplayName                                    Status = 0xC0220023
+	FwpInvalidActionType                                  Status = 0xC0220024
+	FwpInvalidWeight                                      Status = 0xC0220025
+	FwpMatchTypeMismatch                                  Status = 0xC0220026
+	FwpTypeMismatch                                       Status = 0xC0220027
+	FwpOutOfBounds                                        Status = 0xC0220028
+	FwpReserved                                           Status = 0xC0220029
+	FwpDuplicateCondition                                 Status = 0xC022002A
+	FwpDuplicateKeymod                                    Status = 0xC022002B
+	FwpActionIncompatibleWithLayer                        Status = 0xC022002C
+	FwpActionIncompatibleWithSublayer                     Status = 0xC022002D
+	FwpContextIncompatibleWithLayer                       Status = 0xC022002E
+	FwpContextIncompatibleWithCallout                     Status = 0xC022002F
+	FwpIncompatibleAuthMethod                             Status = 0xC0220030
+	FwpIncompatibleDhGroup                                Status = 0xC0220031
+	FwpEmNotSupported                                     Status = 0xC0220032
+	FwpNeverMatch                                         Status = 0xC0220033
+	FwpProviderContextMismatch                            Status = 0xC0220034
+	FwpInvalidParameter                                   Status = 0xC0220035
+	FwpTooManySublayers                                   Status = 0xC0220036
+	FwpCalloutNotificationFailed                          Status = 0xC0220037
+	FwpInvalidAuthTransform                               Status = 0xC0220038
+	FwpInvalidCipherTransform                             Status = 0xC0220039
+	FwpIncompatibleCipherTransform                        Status = 0xC022003A
+	FwpInvalidTransformCombination                        Status = 0xC022003B
+	FwpDuplicateAuthMethod                                Status = 0xC022003C
+	FwpInvalidTunnelEndpoint                              Status = 0xC022003D
+	FwpL2DriverNotReady                                   Status = 0xC022003E
+	FwpKeyDictatorAlreadyRegistered                       Status = 0xC022003F
+	FwpKeyDictationInvalidKeyingMaterial                  Status = 0xC0220040
+	FwpConnectionsDisabled                                Status = 0xC0220041
+	FwpInvalidDnsName                                     Status = 0xC0220042
+	FwpStillOn                                            Status = 0xC0220043
+	FwpIkeextNotRunning                                   Status = 0xC0220044
+	FwpTcpipNotReady                                      Status = 0xC0220100
+	FwpInjectHandleClosing                                Status = 0xC0220101
+	FwpInjectHandleStale                                  Status = 0xC0220102
+	FwpCannotPend                                         Status = 0xC0220103
+	FwpDropNoicmp                                         Status = 0xC0220104
+	NdisClosing                                           Status = 0xC0230002
+	NdisBadVersion                                        Status = 0xC0230004
+	NdisBadCharacteristics                                Status = 0xC0230005
+	NdisAdapterNotFound                                   Status = 0xC0230006
+	NdisOpenFailed                                        Status = 0xC0230007
+	NdisDeviceFailed                                      Status = 0xC0230008
+	NdisMulticastFull                                     Status = 0xC0230009
+	NdisMulticastExists                                   Status = 0xC023000A
+	NdisMulticastNotFound                                 Status = 0xC023000B
+	NdisRequestAborted                                    Status = 0xC023000C
+	NdisResetInProgress                                   Status = 0xC023000D
+	NdisNotSupported                                      Status = 0xC02300BB
+	NdisInvalidPacket                                     Status = 0xC023000F
+	NdisAdapterNotReady                                   Status = 0xC0230011
+	NdisInvalidLength                                     Status = 0xC0230014
+	NdisInvalidData                                       Status = 0xC0230015
+	NdisBufferTooShort                                    Status = 0xC0230016
+	NdisInvalidOid                                        Status = 0xC0230017
+	NdisAdapterRemoved                                    Status = 0xC0230018
+	NdisUnsupportedMedia                                  Status = 0xC0230019
+	NdisGroupAddressInUse                                 Status = 0xC023001A
+	NdisFileNotFound                                      Status = 0xC023001B
+	NdisErrorReadingFile                                  Status = 0xC023001C
+	NdisAlreadyMapped                                     Status = 0xC023001D
+	NdisResourceConflict                                  Status = 0xC023001E
+	NdisMediaDisconnected                                 Status = 0xC023001F
+	NdisInvalidAddress                                    Status = 0xC0230022
+	NdisInvalidDeviceRequest                              Status = 0xC0230010
+	NdisPaused                                            Status = 0xC023002A
+	NdisInterfaceNotFound                                 Status = 0xC023002B
+	NdisUnsupportedRevision                               Status = 0xC023002C
+	NdisInvalidPort                                       Status = 0xC023002D
+	NdisInvalidPortState                                  Status = 0xC023002E
+	NdisLowPowerState                                     Status = 0xC023002F
+	NdisReinitRequired                                    Status = 0xC0230030
+	NdisNoQueues                                          Status = 0xC0230031
+	NdisDot11AutoConfigEnabled                            Status = 0xC0232000
+	NdisDot11MediaInUse                                   Status = 0xC0232001
+	NdisDot11PowerStateInvalid                            Status = 0xC0232002
+	NdisPmWolPatternListFull                              Status = 0xC0232003
+	NdisPmProtocolOffloadListFull                         Status = 0xC0232004
+	NdisDot11ApChannelCurrentlyNotAvailable               Status = 0xC0232005
+	NdisDot11ApBandCurrentlyNotAvailable                  Status = 0xC0232006
+	NdisDot11ApChannelNotAllowed                          Status = 0xC0232007
+	NdisDot11ApBandNotAllowed                             Status = 0xC0232008
+	NdisIndicationRequired                                Status = 0x40230001
+	NdisOffloadPolicy                                     Status = 0xC023100F
+	NdisOffloadConnectionRejected                         Status = 0xC0231012
+	NdisOffloadPathRejected                               Status = 0xC0231013
+	TpmErrorMask                                          Status = 0xC0290000
+	TpmAuthfail                                           Status = 0xC0290001
+	TpmBadindex                                           Status = 0xC0290002
+	TpmBadParameter                                       Status = 0xC0290003
+	TpmAuditfailure                                       Status = 0xC0290004
+	TpmClearDisabled                                      Status = 0xC0290005
+	TpmDeactivated                                        Status = 0xC0290006
+	TpmDisabled                                           Status = 0xC0290007
+	TpmDisabledCmd                                        Status = 0xC0290008
+	TpmFail                                               Status = 0xC0290009
+	TpmBadOrdinal                                         Status = 0xC029000A
+	TpmInstallDisabled                                    Status = 0xC029000B
+	TpmInvalidKeyhandle                                   Status = 0xC029000C
+	TpmKeynotfound                                        Status = 0xC029000D
+	TpmInappropriateEnc                                   Status = 0xC029000E
+	TpmMigratefail                                        Status = 0xC029000F
+	TpmInvalidPcrInfo                                     Status = 0xC0290010
+	TpmNospace                                            Status = 0xC0290011
+	TpmNosrk                                              Status = 0xC0290012
+	TpmNotsealedBlob                                      Status = 0xC0290013
+	TpmOwnerSet                                           Status = 0xC0290014
+	TpmResources                                          Status = 0xC0290015
+	TpmShortrandom                                        Status = 0xC0290016
+	TpmSize                                               Status = 0xC0290017
+	TpmWrongpcrval                                        Status = 0xC0290018
+	TpmBadParamSize                                       Status = 0xC0290019
+	TpmShaThread                                          Status = 0xC029001A
+	TpmShaError                                           Status = 0xC029001B
+	TpmFailedselftest                                     Status = 0xC029001C
+	TpmAuth2fail                                          Status = 0xC029001D
+	TpmBadtag                                             Status = 0xC029001E
+	TpmIoerror                                            Status = 0xC029001F
+	TpmEncryptError                                       Status = 0xC0290020
+	TpmDecryptError                                       Status = 0xC0290021
+	TpmInvalidAuthhandle                                  Status = 0xC0290022
+	TpmNoEndorsement                                      Status = 0xC0290023
+	TpmInvalidKeyusage                                    Status = 0xC0290024
+	TpmWrongEntitytype                                    Status = 0xC0290025
+	TpmInvalidPostinit                                    Status = 0xC0290026
+	TpmInappropriateSig                                   Status = 0xC0290027
+	TpmBadKeyProperty                                     Status = 0xC0290028
+	TpmBadMigration                                       Status = 0xC0290029
+	TpmBadScheme                                          Status = 0xC029002A
+	TpmBadDatasize                                        Status = 0xC029002B
+	TpmBadMode                                            Status = 0xC029002C
+	TpmBadPresence                                        Status = 0xC029002D
+	TpmBadVersion                                         Status = 0xC029002E
+	TpmNoWrapTransport                                    Status = 0xC029002F
+	TpmAuditfailUnsuccessful                              Status = 0xC0290030
+	TpmAuditfailSuccessful                                Status = 0xC0290031
+	TpmNotresetable                                       Status = 0xC0290032
+	TpmNotlocal                                           Status = 0xC0290033
+	TpmBadType                                            Status = 0xC0290034
+	TpmInvalidResource                                    Status = 0xC0290035
+	TpmNotfips                                            Status = 0xC0290036
+	TpmInvalidFamily                                      Status = 0xC0290037
+	TpmNoNvPermission                                     Status = 0xC0290038
+	TpmRequiresSign                                       Status = 0xC0290039
+	TpmKeyNotsupported                                    Status = 0xC029003A
+	TpmAuthConflict                                       Status = 0xC029003B
+	TpmAreaLocked                                         Status = 0xC029003C
+	TpmBadLocality                                        Status = 0xC029003D
+	TpmReadOnly                                           Status = 0xC029003E
+	TpmPerNowrite                                         Status = 0xC029003F
+	TpmFamilycount                                        Status = 0xC0290040
+	TpmWriteLocked                                        Status = 0xC0290041
+	TpmBadAttributes                                      Status = 0xC0290042
+	TpmInvalidStructure                                   Status = 0xC0290043
+	TpmKeyOwnerControl                                    Status = 0xC0290044
+	TpmBadCounter                                         Status = 0xC0290045
+	TpmNotFullwrite                                       Status = 0xC0290046
+	TpmContextGap                                         Status = 0xC0290047
+	TpmMaxnvwrites                                        Status = 0xC0290048
+	TpmNooperator                                         Status = 0xC0290049
+	TpmResourcemissing                                    Status = 0xC029004A
+	TpmDelegateLock                                       Status = 0xC029004B
+	TpmDelegateFamily                                     Status = 0xC029004C
+	TpmDelegateAdmin                                      Status = 0xC029004D
+	TpmTransportNotexclusive                              Status = 0xC029004E
+	TpmOwnerControl                                       Status = 0xC029004F
+	TpmDaaResources                                       Status = 0xC0290050
+	TpmDaaInputData0                                      Status = 0xC0290051
+	TpmDaaInputData1                                      Status = 0xC0290052
+	TpmDaaIssuerSettings                                  Status = 0xC0290053
+	TpmDaaTpmSettings                                     Status = 0xC0290054
+	TpmDaaStage                                           Status = 0xC0290055
+	TpmDaaIssuerValidity                                  Status = 0xC0290056
+	TpmDaaWrongW                                          Status = 0xC0290057
+	TpmBadHandle                                          Status = 0xC0290058
+	TpmBadDelegate                                        Status = 0xC0290059
+	TpmBadcontext                                         Status = 0xC029005A
+	TpmToomanycontexts                                    Status = 0xC029005B
+	TpmMaTicketSignature                                  Status = 0xC029005C
+	TpmMaDestination                                      Status = 0xC029005D
+	TpmMaSource                                           Status = 0xC029005E
+	TpmMaAuthority                                        Status = 0xC029005F
+	TpmPermanentek                                        Status = 0xC0290061
+	TpmBadSignature                                       Status = 0xC0290062
+	TpmNocontextspace                                     Status = 0xC0290063
+	Tpm20EAsymmetric                                      Status = 0xC0290081
+	Tpm20EAttributes                                      Status = 0xC0290082
+	Tpm20EHash                                            Status = 0xC0290083
+	Tpm20EValue                                           Status = 0xC0290084
+	Tpm20EHierarchy                                       Status = 0xC0290085
+	Tpm20EKeySize                                         Status = 0xC0290087
+	Tpm20EMgf                                             Status = 0xC0290088
+	Tpm20EMode                                            Status = 0xC0290089
+	Tpm20EType                                            Status = 0xC029008A
+	Tpm20EHandle                                          Status = 0xC029008B
+	Tpm20EKdf                                             Status = 0xC029008C
+	Tpm20ERange                                           Status = 0xC029008D
+	Tpm20EAuthFail                                        Status = 0xC029008E
+	Tpm20ENonce                                           Status = 0xC029008F
+	Tpm20EPp                                              Status = 0xC0290090
+	Tpm20EScheme                                          Status = 0xC0290092
+	Tpm20ESize                                            Status = 0xC0290095
+	Tpm20ESymmetric                                       Status = 0xC0290096
+	Tpm20ETag                                             Status = 0xC0290097
+	Tpm20ESelector                                        Status = 0xC0290098
+	Tpm20EInsufficient                                    Status = 0xC029009A
+	Tpm20ESignature                                       Status = 0xC029009B
+	Tpm20EKey                                             Status = 0xC029009C
+	Tpm20EPolicyFail                                      Status = 0xC029009D
+	Tpm20EIntegrity                                       Status = 0xC029009F
+	Tpm20ETicket                                          Status = 0xC02900A0
+	Tpm20EReservedBits                                    Status = 0xC02900A1
+	Tpm20EBadAuth                                         Status = 0xC02900A2
+	Tpm20EExpired                                         Status = 0xC02900A3
+	Tpm20EPolicyCc                                        Status = 0xC02900A4
+	Tpm20EBinding                                         Status = 0xC02900A5
+	Tpm20ECurve                                           Status = 0xC02900A6
+	Tpm20EEccPoint                                        Status = 0xC02900A7
+	Tpm20EInitialize                                      Status = 0xC0290100
+	Tpm20EFailure                                         Status = 0xC0290101
+	Tpm20ESequence                                        Status = 0xC0290103
+	Tpm20EPrivate                                         Status = 0xC029010B
+	Tpm20EHmac                                            Status = 0xC0290119
+	Tpm20EDisabled                                        Status = 0xC0290120
+	Tpm20EExclusive                                       Status = 0xC0290121
+	Tpm20EEccCurve                                        Status = 0xC0290123
+	Tpm20EAuthType                                        Status = 0xC0290124
+	Tpm20EAuthMissing                                     Status = 0xC0290125
+	Tpm20EPolicy                                          Status = 0xC0290126
+	Tpm20EPcr                                             Status = 0xC0290127
+	Tpm20EPcrChanged                                      Status = 0xC0290128
+	Tpm20EUpgrade                                         Status = 0xC029012D
+	Tpm20ETooManyContexts                                 Status = 0xC029012E
+	Tpm20EAuthUnavailable                                 Status = 0xC029012F
+	Tpm20EReboot                                          Status = 0xC0290130
+	Tpm20EUnbalanced                                      Status = 0xC0290131
+	Tpm20ECommandSize                                     Status = 0xC0290142
+	Tpm20ECommandCode                                     Status = 0xC0290143
+	Tpm20EAuthsize                                        Status = 0xC0290144
+	Tpm20EAuthContext                                     Status = 0xC0290145
+	Tpm20ENvRange                                         Status = 0xC0290146
+	Tpm20ENvSize                                          Status = 0xC0290147
+	Tpm20ENvLocked                                        Status = 0xC0290148
+	Tpm20ENvAuthorization                                 Status = 0xC0290149
+	Tpm20ENvUninitialized                                 Status = 0xC029014A
+	Tpm20ENvSpace                                         Status = 0xC029014B
+	Tpm20ENvDefined                                       Status = 0xC029014C
+	Tpm20EBadContext                                      Status = 0xC0290150
+	Tpm20ECphash                                          Status = 0xC0290151
+	Tpm20EParent                                          Status = 0xC0290152
+	Tpm20ENeedsTest                                       Status = 0xC0290153
+	Tpm20ENoResult                                        Status = 0xC0290154
+	Tpm20ESensitive                                       Status = 0xC0290155
+	TpmCommandBlocked                                     Status = 0xC0290400
+	TpmInvalidHandle                                      Status = 0xC0290401
+	TpmDuplicateVhandle                                   Status = 0xC0290402
+	TpmEmbeddedCommandBlocked                             Status = 0xC0290403
+	TpmEmbeddedCommandUnsupported                         Status = 0xC0290404
+	TpmRetry                                              Status = 0xC0290800
+	TpmNeedsSelftest                                      Status = 0xC0290801
+	TpmDoingSelftest                                      Status = 0xC0290802
+	TpmDefendLockRunning                                  Status = 0xC0290803
+	TpmCommandCanceled                                    Status = 0xC0291001
+	TpmTooManyContexts                                    Status = 0xC0291002
+	TpmNotFound                                           Status = 0xC0291003
+	TpmAccessDenied                                       Status = 0xC0291004
+	TpmInsufficientBuffer                                 Status = 0xC0291005
+	TpmPpiFunctionUnsupported                             Status = 0xC0291006
+	PcpErrorMask                                          Status = 0xC0292000
+	PcpDeviceNotReady                                     Status = 0xC0292001
+	PcpInvalidHandle                                      Status = 0xC0292002
+	PcpInvalidParameter                                   Status = 0xC0292003
+	PcpFlagNotSupported                                   Status = 0xC0292004
+	PcpNotSupported                                       Status = 0xC0292005
+	PcpBufferTooSmall                                     Status = 0xC0292006
+	PcpInternalError                                      Status = 0xC0292007
+	PcpAuthenticationFailed                               Status = 0xC0292008
+	PcpAuthenticationIgnored                              Status = 0xC0292009
+	PcpPolicyNotFound                                     Status = 0xC029200A
+	PcpProfileNotFound                                    Status = 0xC029200B
+	PcpValidationFailed                                   Status = 0xC029200C
+	PcpDeviceNotFound                                     Status = 0xC029200D
+	PcpWrongParent                                        Status = 0xC029200E
+	PcpKeyNotLoaded                                       Status = 0xC029200F
+	PcpNoKeyCertification                                 Status = 0xC0292010
+	PcpKeyNotFinalized                                    Status = 0xC0292011
+	PcpAttestationChallengeNotSet                         Status = 0xC0292012
+	PcpNotPcrBound                                        Status = 0xC0292013
+	PcpKeyAlreadyFinalized                                Status = 0xC0292014
+	PcpKeyUsagePolicyNotSupported                         Status = 0xC0292015
+	PcpKeyUsagePolicyInvalid                              Status = 0xC0292016
+	PcpSoftKeyError                                       Status = 0xC0292017
+	PcpKeyNotAuthenticated                                Status = 0xC0292018
+	PcpKeyNotAik                                          Status = 0xC0292019
+	PcpKeyNotSigningKey                                   Status = 0xC029201A
+	PcpLockedOut                                          Status = 0xC029201B
+	PcpClaimTypeNotSupported                              Status = 0xC029201C
+	PcpTpmVersionNotSupported                             Status = 0xC029201D
+	PcpBufferLengthMismatch                               Status = 0xC029201E
+	PcpIfxRsaKeyCreationBlocked                           Status = 0xC029201F
+	PcpTicketMissing                                      Status = 0xC0292020
+	PcpRawPolicyNotSupported                              Status = 0xC0292021
+	PcpKeyHandleInvalidated                               Status = 0xC0292022
+	PcpUnsupportedPssSalt                                 Status = 0x40292023
+	RtpmContextContinue                                   Status = 0x00293000
+	RtpmContextComplete                                   Status = 0x00293001
+	RtpmNoResult                                          Status = 0xC0293002
+	RtpmPcrReadIncomplete                                 Status = 0xC0293003
+	RtpmInvalidContext                                    Status = 0xC0293004
+	RtpmUnsupportedCmd                                    Status = 0xC0293005
+	TpmZeroExhaustEnabled                                 Status = 0xC0294000
+	HvInvalidHypercallCode                                Status = 0xC0350002
+	HvInvalidHypercallInput                               Status = 0xC0350003
+	HvInvalidAlignment                                    Status = 0xC0350004
+	HvInvalidParameter                                    Status = 0xC0350005
+	HvAccessDenied                                        Status = 0xC0350006
+	HvInvalidPartitionState                               Status = 0xC0350007
+	HvOperationDenied                                     Status = 0xC0350008
+	HvUnknownProperty                                     Status = 0xC0350009
+	HvPropertyValueOutOfRange                             Status = 0xC035000A
+	HvInsufficientMemory                                  Status = 0xC035000B
+	HvPartitionTooDeep                                    Status = 0xC035000C
+	HvInvalidPartitionId                                  Status = 0xC035000D
+	HvInvalidVpIndex                                      Status = 0xC035000E
+	HvInvalidPortId                                       Status = 0xC0350011
+	HvInvalidConnectionId                                 Status = 0xC0350012
+	HvInsufficientBuffers                                 Status = 0xC0350013
+	HvNotAcknowledged                                     Status = 0xC0350014
+	HvInvalidVpState                                      Status = 0xC0350015
+	HvAcknowledged                                        Status = 0xC0350016
+	HvInvalidSaveRestoreState                             Status = 0xC0350017
+	HvInvalidSynicState                                   Status = 0xC0350018
+	HvObjectInUse                                         Status = 0xC0350019
+	HvInvalidProximityDomainInfo                          Status = 0xC035001A
+	HvNoData                                              Status = 0xC035001B
+	HvInactive                                            Status = 0xC035001C
+	HvNoResources                                         Status = 0xC035001D
+	HvFeatureUnavailable                                  Status = 0xC035001E
+	HvInsufficientBuffer                                  Status = 0xC0350033
+	HvInsufficientDeviceDomains                           Status = 0xC0350038
+	HvCpuidFeatureValidationError                         Status = 0xC035003C
+	HvCpuidXsaveFeatureValidationError                    Status = 0xC035003D
+	HvProcessorStartupTimeout                             Status = 0xC035003E
+	HvSmxEnabled                                          Status = 0xC035003F
+	HvInvalidLpIndex                                      Status = 0xC0350041
+	HvInvalidRegisterValue                                Status = 0xC0350050
+	HvInvalidVtlState                                     Status = 0xC0350051
+	HvNxNotDetected                                       Status = 0xC0350055
+	HvInvalidDeviceId                                     Status = 0xC0350057
+	HvInvalidDeviceState                                  Status = 0xC0350058
+	HvPendingPageRequests                                 Status = 0x00350059
+	HvPageRequestInvalid                                  Status = 0xC0350060
+	HvInvalidCpuGroupId                                   Status = 0xC035006F
+	HvInvalidCpuGroupState                                Status = 0xC0350070
+	HvOperationFailed                                     Status = 0xC0350071
+	HvNotAllowedWithNestedVirtActive                      Status = 0xC0350072
+	HvInsufficientRootMemory                              Status = 0xC0350073
+	HvNotPresent                                          Status = 0xC0351000
+	VidDuplicateHandler                                   Status = 0xC0370001
+	VidTooManyHandlers                                    Status = 0xC0370002
+	VidQueueFull                                          Status = 0xC0370003
+	VidHandlerNotPresent                                  Status = 0xC0370004
+	VidInvalidObjectName                                  Status = 0xC0370005
+	VidPartitionNameTooLong                               Status = 0xC0370006
+	VidMessageQueueNameTooLong                            Status = 0xC0370007
+	VidPartitionAlreadyExists                             Status = 0xC0370008
+	VidPartitionDoesNotExist                              Status = 0xC0370009
+	VidPartitionNameNotFound                              Status = 0xC037000A
+	VidMessageQueueAlreadyExists                          Status = 0xC037000B
+	VidExceededMbpEntryMapLimit                           Status = 0xC037000C
+	VidMbStillReferenced                                  Status = 0xC037000D
+	VidChildGpaPageSetCorrupted                           Status = 0xC037000E
+	VidInvalidNumaSettings                                Status = 0xC037000F
+	VidInvalidNumaNodeIndex                               Status = 0xC0370010
+	VidNotificationQueueAlreadyAssociated                 Status = 0xC0370011
+	VidInvalidMemoryBlockHandle                           Status = 0xC0370012
+	VidPageRangeOverflow                                  Status = 0xC0370013
+	VidInvalidMessageQueueHandle                          Status = 0xC0370014
+	VidInvalidGpaRangeHandle                              Status = 0xC0370015
+	VidNoMemoryBlockNotificationQueue                     Status = 0xC0370016
+	VidMemoryBlockLockCountExceeded                       Status = 0xC0370017
+	VidInvalidPpmHandle                                   Status = 0xC0370018
+	VidMbpsAreLocked                                      Status = 0xC0370019
+	VidMessageQueueClosed                                 Status = 0xC037001A
+	VidVirtualProcessorLimitExceeded                      Status = 0xC037001B
+	VidStopPending                                        Status = 0xC037001C
+	VidInvalidProcessorState                              Status = 0xC037001D
+	VidExceededKmContextCountLimit                        Status = 0xC037001E
+	VidKmInterfaceAlreadyInitialized                      Status = 0xC037001F
+	VidMbPropertyAlreadySetReset                          Status = 0xC0370020
+	VidMmioRangeDestroyed                                 Status = 0xC0370021
+	VidInvalidChildGpaPageSet                             Status = 0xC0370022
+	VidReservePageSetIsBeingUsed                          Status = 0xC0370023
+	VidReservePageSetTooSmall                             Status = 0xC0370024
+	VidMbpAlreadyLockedUsingReservedPage                  Status = 0xC0370025
+	VidMbpCountExceededLimit                              Status = 0xC0370026
+	VidSavedStateCorrupt                                  Status = 0xC0370027
+	VidSavedStateUnrecognizedItem                         Status = 0xC0370028
+	VidSavedStateIncompatible                             Status = 0xC0370029
+	VidVtlAccessDenied                                    Status = 0xC037002A
+	VidRemoteNodeParentGpaPagesUsed                       Status = 0x80370001
+	IpsecBadSpi                                           Status = 0xC0360001
+	IpsecSaLifetimeExpired                                Status = 0xC0360002
+	IpsecWrongSa                                          Status = 0xC0360003
+	IpsecReplayCheckFailed                                Status = 0xC0360004
+	IpsecInvalidPacket                                    Status = 0xC0360005
+	IpsecIntegrityCheckFailed                             Status = 0xC0360006
+	IpsecClearTextDrop                                    Status = 0xC0360007
+	IpsecAuthFirewallDrop                                 Status = 0xC0360008
+	IpsecThrottleDrop                                     Status = 0xC0360009
+	IpsecDospBlock                                        Status = 0xC0368000
+	IpsecDospReceivedMulticast                            Status = 0xC0368001
+	IpsecDospInvalidPacket                                Status = 0xC0368002
+	IpsecDospStateLookupFailed                            Status = 0xC0368003
+	IpsecDospMaxEntries                                   Status = 0xC0368004
+	IpsecDospKeymodNotAllowed                             Status = 0xC0368005
+	IpsecDospMaxPerIpRatelimitQueues                      Status = 0xC0368006
+	VolmgrIncompleteRegeneration                          Status = 0x80380001
+	VolmgrIncompleteDiskMigration                         Status = 0x80380002
+	VolmgrDatabaseFull                                    Status = 0xC0380001
+	VolmgrDiskConfigurationCorrupted                      Status = 0xC0380002
+	VolmgrDiskConfigurationNotInSync                      Status = 0xC0380003
+	VolmgrPackConfigUpdateFailed                          Status = 0xC0380004
+	VolmgrDiskContainsNonSimpleVolume                     Status = 0xC0380005
+	VolmgrDiskDuplicate                                   Status = 0xC0380006
+	VolmgrDiskDynamic                                     Status = 0xC0380007
+	VolmgrDiskIdInvalid                                   Status = 0xC0380008
+	VolmgrDiskInvalid                                     Status = 0xC0380009
+	VolmgrDiskLastVoter                                   Status = 0xC038000A
+	VolmgrDiskLayoutInvalid                               Status = 0xC038000B
+	VolmgrDiskLayoutNonBasicBetweenBasicPartitions        Status = 0xC038000C
+	VolmgrDiskLayoutNotCylinderAligned                    Status = 0xC038000D
+	VolmgrDiskLayoutPartitionsTooSmall                    Status = 0xC038000E
+	VolmgrDiskLayoutPrimaryBetweenLogicalPartitions       Status = 0xC038000F
+	VolmgrDiskLayoutTooManyPartitions                     Status = 0xC0380010
+	VolmgrDiskMissing                                     Status = 0xC0380011
+	VolmgrDiskNotEmpty                                    Status = 0xC0380012
+	VolmgrDiskNotEnoughSpace                              Status = 0xC0380013
+	VolmgrDiskRevectoringFailed                           Status = 0xC0380014
+	VolmgrDiskSectorSizeInvalid                           Status = 0xC0380015
+	VolmgrDiskSetNotContained                             Status = 0xC0380016
+	VolmgrDiskUsedByMultipleMembers                       Status = 0xC0380017
+	VolmgrDiskUsedByMultiplePlexes                        Status = 0xC0380018
+	VolmgrDynamicDiskNotSupported                         Status = 0xC0380019
+	VolmgrExtentAlreadyUsed                               Status = 0xC038001A
+	VolmgrExtentNotContiguous                             Status = 0xC038001B
+	VolmgrExtentNotInPublicRegion                         Status = 0xC038001C
+	VolmgrExtentNotSectorAligned                          Status = 0xC038001D
+	VolmgrExtentOverlapsEbrPartition                      Status = 0xC038001E
+	VolmgrExtentVolumeLengthsDoNotMatch                   Status = 0xC038001F
+	VolmgrFaultTolerantNotSupported                       Status = 0xC0380020
+	VolmgrInterleaveLengthInvalid                         Status = 0xC0380021
+	VolmgrMaximumRegisteredUsers                          Status = 0xC0380022
+	VolmgrMemberInSync                                    Status = 0xC0380023
+	VolmgrMemberIndexDuplicate                            Status = 0xC0380024
+	VolmgrMemberIndexInvalid                              Status = 0xC0380025
+	VolmgrMemberMissing                                   Status = 0xC0380026
+	VolmgrMemberNotDetached                               Status = 0xC0380027
+	VolmgrMemberRegenerating                              Status = 0xC0380028
+	VolmgrAllDisksFailed                                  Status = 0xC0380029
+	VolmgrNoRegisteredUsers                               Status = 0xC038002A
+	VolmgrNoSuchUser                                      Status = 0xC038002B
+	VolmgrNotificationReset                               Status = 0xC038002C
+	VolmgrNumberOfMembersInvalid                          Status = 0xC038002D
+	VolmgrNumberOfPlexesInvalid                           Status = 0xC038002E
+	VolmgrPackDuplicate                                   Status = 0xC038002F
+	VolmgrPackIdInvalid                                   Status = 0xC0380030
+	VolmgrPackInvalid                                     Status = 0xC0380031
+	VolmgrPackNameInvalid                                 Status = 0xC0380032
+	VolmgrPackOffline                                     Status = 0xC0380033
+	VolmgrPackHasQuorum                                   Status = 0xC0380034
+	VolmgrPackWithoutQuorum                               Status = 0xC0380035
+	VolmgrPartitionStyleInvalid                           Status = 0xC0380036
+	VolmgrPartitionUpdateFailed                           Status = 0xC0380037
+	VolmgrPlexInSync                                      Status = 0xC0380038
+	VolmgrPlexIndexDuplicate                              Status = 0xC0380039
+	VolmgrPlexIndexInvalid                                Status = 0xC038003A
+	VolmgrPlexLastActive                                  Status = 0xC038003B
+	VolmgrPlexMissing                                     Status = 0xC038003C
+	VolmgrPlexRegenerating                                Status = 0xC038003D
+	VolmgrPlexTypeInvalid                                 Status = 0xC038003E
+	VolmgrPlexNotRaid5                                    Status = 0xC038003F
+	VolmgrPlexNotSimple                                   Status = 0xC0380040
+	VolmgrStructureSizeInvalid                            Status = 0xC0380041
+	VolmgrTooManyNotificationRequests                     Status = 0xC0380042
+	VolmgrTransactionInProgress                           Status = 0xC0380043
+	VolmgrUnexpectedDiskLayoutChange                      Status = 0xC0380044
+	VolmgrVolumeContainsMissingDisk                       Status = 0xC0380045
+	VolmgrVolumeIdInvalid                                 Status = 0xC0380046
+	VolmgrVolumeLengthInvalid                             Status = 0xC0380047
+	VolmgrVolumeLengthNotSectorSizeMultiple               Status = 0xC0380048
+	VolmgrVolumeNotMirrored                               Status = 0xC0380049
+	VolmgrVolumeNotRetained                               Status = 0xC038004A
+	VolmgrVolumeOffline                                   Status = 0xC038004B
+	VolmgrVolumeRetained                                  Status = 0xC038004C
+	VolmgrNumberOfExtentsInvalid                          Status = 0xC038004D
+	VolmgrDifferentSectorSize                             Status = 0xC038004E
+	VolmgrBadBootDisk                                     Status = 0xC038004F
+	VolmgrPackConfigOffline                               Status = 0xC0380050
+	VolmgrPackConfigOnline                                Status = 0xC0380051
+	VolmgrNotPrimaryPack                                  Status = 0xC0380052
+	VolmgrPackLogUpdateFailed                             Status = 0xC0380053
+	VolmgrNumberOfDisksInPlexInvalid                      Status = 0xC0380054
+	VolmgrNumberOfDisksInMemberInvalid                    Status = 0xC0380055
+	VolmgrVolumeMirrored                                  Status = 0xC0380056
+	VolmgrPlexNotSimpleSpanned                            Status = 0xC0380057
+	VolmgrNoValidLogCopies                                Status = 0xC0380058
+	VolmgrPrimaryPackPresent                              Status = 0xC0380059
+	VolmgrNumberOfDisksInvalid                            Status = 0xC038005A
+	VolmgrMirrorNotSupported                              Status = 0xC038005B
+	VolmgrRaid5NotSupported                               Status = 0xC038005C
+	BcdNotAllEntriesImported                              Status = 0x80390001
+	BcdTooManyElements                                    Status = 0xC0390002
+	BcdNotAllEntriesSynchronized                          Status = 0x80390003
+	VhdDriveFooterMissing                                 Status = 0xC03A0001
+	VhdDriveFooterChecksumMismatch                        Status = 0xC03A0002
+	VhdDriveFooterCorrupt                                 Status = 0xC03A0003
+	VhdFormatUnknown                                      Status = 0xC03A0004
+	VhdFormatUnsupportedVersion                           Status = 0xC03A0005
+	VhdSparseHeaderChecksumMismatch                       Status = 0xC03A0006
+	VhdSparseHeaderUnsupportedVersion                     Status = 0xC03A0007
+	VhdSparseHeaderCorrupt                                Status = 0xC03A0008
+	VhdBlockAllocationFailure                             Status = 0xC03A0009
+	VhdBlockAllocationTableCorrupt                        Status = 0xC03A000A
+	VhdInvalidBlockSize                                   Status = 0xC03A000B
+	VhdBitmapMismatch                                     Status = 0xC03A000C
+	VhdParentVhdNotFound                                  Status = 0xC03A000D
+	VhdChildParentIdMismatch                              Status = 0xC03A000E
+	VhdChildParentTimestampMismatch                       Status = 0xC03A000F
+	VhdMetadataReadFailure                                Status = 0xC03A0010
+	VhdMetadataWriteFailure                               Status = 0xC03A0011
+	VhdInvalidSize                                        Status = 0xC03A0012
+	VhdInvalidFileSize                                    Status = 0xC03A0013
+	VirtdiskProviderNotFound                              Status = 0xC03A0014
+	VirtdiskNotVirtualDisk                                Status = 0xC03A0015
+	VhdParentVhdAccessDenied                              Status = 0xC03A0016
+	VhdChildParentSizeMismatch                            Status = 0xC03A0017
+	VhdDifferencingChainCycleDetected                     Status = 0xC03A0018
+	VhdDifferencingChainErrorInParent                     Status = 0xC03A0019
+	VirtualDiskLimitation                                 Status = 0xC03A001A
+	VhdInvalidType                                        Status = 0xC03A001B
+	VhdInvalidState                                       Status = 0xC03A001C
+	VirtdiskUnsupportedDiskSectorSize                     Status = 0xC03A001D
+	VirtdiskDiskAlreadyOwned                              Status = 0xC03A001E
+	VirtdiskDiskOnlineAndWritable                         Status = 0xC03A001F
+	CtlogTrackingNotInitialized                           Status = 0xC03A0020
+	CtlogLogfileSizeExceededMaxsize                       Status = 0xC03A0021
+	CtlogVhdChangedOffline                                Status = 0xC03A0022
+	CtlogInvalidTrackingState                             Status = 0xC03A0023
+	CtlogInconsistentTrackingFile                         Status = 0xC03A0024
+	VhdMetadataFull                                       Status = 0xC03A0028
+	VhdInvalidChangeTrackingId                            Status = 0xC03A0029
+	VhdChangeTrackingDisabled                             Status = 0xC03A002A
+	VhdMissingChangeTrackingInformation                   Status = 0xC03A0030
+	VhdResizeWouldTruncateData                            Status = 0xC03A0031
+	VhdCouldNotComputeMinimumVirtualSize                  Status = 0xC03A0032
+	VhdAlreadyAtOrBelowMinimumVirtualSize                 Status = 0xC03A0033
+	QueryStorageError                                     Status = 0x803A0001
+	GdiHandleLeak                                         Status = 0x803F0001
+	RkfKeyNotFound                                        Status = 0xC0400001
+	RkfDuplicateKey                                       Status = 0xC0400002
+	RkfBlobFull                                           Status = 0xC0400003
+	RkfStoreFull                                          Status = 0xC0400004
+	RkfFileBlocked                                        Status = 0xC0400005
+	RkfActiveKey                                          Status = 0xC0400006
+	RdbssRestartOperation                                 Status = 0xC0410001
+	RdbssContinueOperation                                Status = 0xC0410002
+	RdbssPostOperation                                    Status = 0xC0410003
+	RdbssRetryLookup                                      Status = 0xC0410004
+	BthAttInvalidHandle                                   Status = 0xC0420001
+	BthAttReadNotPermitted                                Status = 0xC0420002
+	BthAttWriteNotPermitted                               Status = 0xC0420003
+	BthAttInvalidPdu                                      Status = 0xC0420004
+	BthAttInsufficientAuthentication                      Status = 0xC0420005
+	BthAttRequestNotSupported                             Status = 0xC0420006
+	BthAttInvalidOffset                                   Status = 0xC0420007
+	BthAttInsufficientAuthorization                       Status = 0xC0420008
+	BthAttPrepareQueueFull                                Status = 0xC0420009
+	BthAttAttributeNotFound                               Status = 0xC042000A
+	BthAttAttributeNotLong                                Status = 0xC042000B
+	BthAttInsufficientEncryptionKeySize                   Status = 0xC042000C
+	BthAttInvalidAttributeValueLength                     Status = 0xC042000D
+	BthAttUnlikely                                        Status = 0xC042000E
+	BthAttInsufficientEncryption                          Status = 0xC042000F
+	BthAttUnsupportedGroupType                            Status = 0xC0420010
+	BthAttInsufficientResources                           Status = 0xC0420011
+	BthAttUnknownError                                    Status = 0xC0421000
+	SecurebootRollbackDetected                            Status = 0xC0430001
+	SecurebootPolicyViolation                             Status = 0xC0430002
+	SecurebootInvalidPolicy                               Status = 0xC0430003
+	SecurebootPolicyPublisherNotFound                     Status = 0xC0430004
+	SecurebootPolicyNotSigned                             Status = 0xC0430005
+	SecurebootNotEnabled                                  Status = 0x80430006
+	SecurebootFileReplaced                                Status = 0xC0430007
+	SecurebootPolicyNotAuthorized                         Status = 0xC0430008
+	SecurebootPolicyUnknown                               Status = 0xC0430009
+	SecurebootPolicyMissingAntirollbackversion            Status = 0xC043000A
+	SecurebootPlatformIdMismatch                          Status = 0xC043000B
+	SecurebootPolicyRollbackDetected                      Status = 0xC043000C
+	SecurebootPolicyUpgradeMismatch                       Status = 0xC043000D
+	SecurebootRequiredPolicyFileMissing                   Status = 0xC043000E
+	SecurebootNotBasePolicy                               Status = 0xC043000F
+	SecurebootNotSupplementalPolicy                       Status = 0xC0430010
+	PlatformManifestNotAuthorized                         Status = 0xC0EB0001
+	PlatformManifestInvalid                               Status = 0xC0EB0002
+	PlatformManifestFileNotAuthorized                     Status = 0xC0EB0003
+	PlatformManifestCatalogNotAuthorized                  Status = 0xC0EB0004
+	PlatformManifestBinaryIdNotFound                      Status = 0xC0EB0005
+	PlatformManifestNotActive                             Status = 0xC0EB0006
+	PlatformManifestNotSigned                             Status = 0xC0EB0007
+	SystemIntegrityRollbackDetected                       Status = 0xC0E90001
+	SystemIntegrityPolicyViolation                        Status = 0xC0E90002
+	SystemIntegrityInvalidPolicy                          Status = 0xC0E90003
+	SystemIntegrityPolicyNotSigned                        Status = 0xC0E90004
+	SystemIntegrityTooManyPolicies                        Status = 0xC0E90005
+	SystemIntegritySupplementalPolicyNotAuthorized        Status = 0xC0E90006
+	NoApplicableAppLicensesFound                          Status = 0xC0EA0001
+	ClipLicenseNotFound                                   Status = 0xC0EA0002
+	ClipDeviceLicenseMissing                              Status = 0xC0EA0003
+	ClipLicenseInvalidSignature                           Status = 0xC0EA0004
+	ClipKeyholderLicenseMissingOrInvalid                  Status = 0xC0EA0005
+	ClipLicenseExpired                                    Status = 0xC0EA0006
+	ClipLicenseSignedByUnknownSource                      Status = 0xC0EA0007
+	ClipLicenseNotSigned                                  Status = 0xC0EA0008
+	ClipLicenseHardwareIdOutOfTolerance                   Status = 0xC0EA0009
+	ClipLicenseDeviceIdMismatch                           Status = 0xC0EA000A
+	AudioEngineNodeNotFound                               Status = 0xC0440001
+	HdaudioEmptyConnectionList                            Status = 0xC0440002
+	HdaudioConnectionListNotSupported                     Status = 0xC0440003
+	HdaudioNoLogicalDevicesCreated                        Status = 0xC0440004
+	HdaudioNullLinkedListEntry                            Status = 0xC0440005
+	SpacesRepaired                                        Status = 0x00E70000
+	SpacesPause                                           Status = 0x00E70001
+	SpacesComplete                                        Status = 0x00E70002
+	SpacesRedirect                                        Status = 0x00E70003
+	SpacesFaultDomainTypeInvalid                          Status = 0xC0E70001
+	SpacesResiliencyTypeInvalid                           Status = 0xC0E70003
+	SpacesDriveSectorSizeInvalid                          Status = 0xC0E70004
+	SpacesDriveRedundancyInvalid                          Status = 0xC0E70006
+	SpacesNumberOfDataCopiesInvalid                       Status = 0xC0E70007
+	SpacesInterleaveLengthInvalid                         Status = 0xC0E70009
+	SpacesNumberOfColumnsInvalid                          Status = 0xC0E7000A
+	SpacesNotEnoughDrives                                 Status = 0xC0E7000B
+	SpacesExtendedError                                   Status = 0xC0E7000C
+	SpacesProvisioningTypeInvalid                         Status = 0xC0E7000D
+	SpacesAllocationSizeInvalid                           Status = 0xC0E7000E
+	SpacesEnclosureAwareInvalid                           Status = 0xC0E7000F
+	SpacesWriteCacheSizeInvalid                           Status = 0xC0E70010
+	SpacesNumberOfGroupsInvalid                           Status = 0xC0E70011
+	SpacesDriveOperationalStateInvalid                    Status = 0xC0E70012
+	SpacesUpdateColumnState                               Status = 0xC0E70013
+	SpacesMapRequired                                     Status = 0xC0E70014
+	SpacesUnsupportedVersion                              Status = 0xC0E70015
+	SpacesCorruptMetadata                                 Status = 0xC0E70016
+	SpacesDrtFull                                         Status = 0xC0E70017
+	SpacesInconsistency                                   Status = 0xC0E70018
+	SpacesLogNotReady                                     Status = 0xC0E70019
+	SpacesNoRedundancy                                    Status = 0xC0E7001A
+	SpacesDriveNotReady                                   Status = 0xC0E7001B
+	SpacesDriveSplit                                      Status = 0xC0E7001C
+	SpacesDriveLostData                                   Status = 0xC0E7001D
+	SpacesEntryIncomplete                                 Status = 0xC0E7001E
+	SpacesEntryInvalid                                    Status = 0xC0E7001F
+	SpacesMarkDirty                                       Status = 0xC0E70020
+	VolsnapBootfileNotValid                               Status = 0xC0500003
+	VolsnapActivationTimeout                              Status = 0xC0500004
+	IoPreempted                                           Status = 0xC0510001
+	SvhdxErrorStored                                      Status = 0xC05C0000
+	SvhdxErrorNotAvailable                                Status = 0xC05CFF00
+	SvhdxUnitAttentionAvailable                           Status = 0xC05CFF01
+	SvhdxUnitAttentionCapacityDataChanged                 Status = 0xC05CFF02
+	SvhdxUnitAttentionReservationsPreempted               Status = 0xC05CFF03
+	SvhdxUnitAttentionReservationsReleased                Status = 0xC05CFF04
+	SvhdxUnitAttentionRegistrationsPreempted              Status = 0xC05CFF05
+	SvhdxUnitAttentionOperatingDefinitionChanged          Status = 0xC05CFF06
+	SvhdxReservationConflict                              Status = 0xC05CFF07
+	SvhdxWrongFileType                                    Status = 0xC05CFF08
+	SvhdxVersionMismatch                                  Status = 0xC05CFF09
+	VhdShared                                             Status = 0xC05CFF0A
+	SvhdxNoInitiator                                      Status = 0xC05CFF0B
+	VhdsetBackingStorageNotFound                          Status = 0xC05CFF0C
+	SmbNoPreauthIntegrityHashOverlap                      Status = 0xC05D0000
+	SmbBadClusterDialect                                  Status = 0xC05D0001
+	SmbGuestLogonBlocked                                  Status = 0xC05D0002
+	SeccoreInvalidCommand                                 Status = 0xC0E80000
+	VsmNotInitialized                                     Status = 0xC0450000
+	VsmDmaProtectionNotInUse                              Status = 0xC0450001
+	AppexecConditionNotSatisfied                          Status = 0xC0EC0000
+	AppexecHandleInvalidated                              Status = 0xC0EC0001
+	AppexecInvalidHostGeneration                          Status = 0xC0EC0002
+	AppexecUnexpectedProcessRegistration                  Status = 0xC0EC0003
+	AppexecInvalidHostState                               Status = 0xC0EC0004
+	AppexecNoDonor                                        Status = 0xC0EC0005
+	AppexecHostIdMismatch                                 Status = 0xC0EC0006
+	AppexecUnknownUser                                    Status = 0xC0EC0007
+)
+
+var table = []entry{
+	{0x00000000, "STATUS_SUCCESS", "The operation completed successfully.", SeveritySuccess},
+	{0x00000001, "STATUS_WAIT_1", "", SeveritySuccess},
+	{0x00000002, "STATUS_WAIT_2", "", SeveritySuccess},
+	{0x00000003, "STATUS_WAIT_3", "", SeveritySuccess},
+	{0x0000003F, "STATUS_WAIT_63", "", SeveritySuccess},
+	{0x00000080, "STATUS_ABANDONED", "The caller attempted to wait for a mutex that has been abandoned.", SeveritySuccess},
+	{0x000000BF, "STATUS_ABANDONED_WAIT_63", "", SeveritySuccess},
+	{0x000000C0, "STATUS_USER_APC", "", SeveritySuccess},
+	{0x000000FF, "STATUS_ALREADY_COMPLETE", "", SeveritySuccess},
+	{0x00000100, "STATUS_KERNEL_APC", "", SeveritySuccess},
+	{0x00000101, "STATUS_ALERTED", "", SeveritySuccess},
+	{0x00000102, "STATUS_TIMEOUT", "The wait completed due to a time-out.", SeveritySuccess},
+	{0x00000103, "STATUS_PENDING", "The operation that was requested is pending completion.", SeveritySuccess},
+	{0x00000104, "STATUS_REPARSE", "A reparse should be performed by the Object Manager because the name of the file resulted in a symbolic link.", SeveritySuccess},
+	{0x00000105, "STATUS_MORE_ENTRIES", "", SeveritySuccess},
+	{0x00000106, "STATUS_NOT_ALL_ASSIGNED", "", SeveritySuccess},
+	{0x00000107, "STATUS_SOME_NOT_MAPPED", "", SeveritySuccess},
+	{0x00000108, "STATUS_OPLOCK_BREAK_IN_PROGRESS", "", SeveritySuccess},
+	{0x00000109, "STATUS_VOLUME_MOUNTED", "", SeveritySuccess},
+	{0x0000010A, "STATUS_RXACT_COMMITTED", "", SeveritySuccess},
+	{0x0000010B, "STATUS_NOTIFY_CLEANUP", "Indicates that a notify change request has been completed due to closing the handle that made the notify change request.", SeveritySuccess},
+	{0x0000010C, "STATUS_NOTIFY_ENUM_DIR", "Indicates that a notify change request is being completed and that the information is not being returned in the caller's buffer.", SeveritySuccess},
+	{0x0000010D, "STATUS_NO_QUOTAS_FOR_ACCOUNT", "", SeveritySuccess},
+	{0x0000010E, "STATUS_PRIMARY_TRANSPORT_CONNECT_FAILED", "", SeveritySuccess},
+	{0x00000110, "STATUS_PAGE_FAULT_TRANSITION", "", SeveritySuccess},
+	{0x00000111, "STATUS_PAGE_FAULT_DEMAND_ZERO", "", SeveritySuccess},
+	{0x00000112, "STATUS_PAGE_FAULT_COPY_ON_WRITE", "", SeveritySuccess},
+	{0x00000113, "STATUS_PAGE_FAULT_GUARD_PAGE", "", SeveritySuccess},
+	{0x00000114, "STATUS_PAGE_FAULT_PAGING_FILE", "", SeveritySuccess},
+	{0x00000115, "STATUS_CACHE_PAGE_LOCKED", "", SeveritySuccess},
+	{0x00000116, "STATUS_CRASH_DUMP", "", SeveritySuccess},
+	{0x00000117, "STATUS_BUFFER_ALL_ZEROS", "", SeveritySuccess},
+	{0x00000118, "STATUS_REPARSE_OBJECT", "", SeveritySuccess},
+	{0x00000119, "STATUS_RESOURCE_REQUIREMENTS_CHANGED", "", SeveritySuccess},
+	{0x00000120, "STATUS_TRANSLATION_COMPLETE", "", SeveritySuccess},
+	{0x00000121, "STATUS_DS_MEMBERSHIP_EVALUATED_LOCALLY", "", SeveritySuccess},
+	{0x00000122, "STATUS_NOTHING_TO_TERMINATE", "", SeveritySuccess},
+	{0x00000123, "STATUS_PROCESS_NOT_IN_JOB", "", SeveritySuccess},
+	{0x00000124, "STATUS_PROCESS_IN_JOB", "", SeveritySuccess},
+	{0x00000125, "STATUS_VOLSNAP_HIBERNATE_READY", "", SeveritySuccess},
+	{0x00000126, "STATUS_FSFILTER_OP_COMPLETED_SUCCESSFULLY", "", SeveritySuccess},
+	{0x00000127, "STATUS_INTERRUPT_VECTOR_ALREADY_CONNECTED", "", SeveritySuccess},
+	{0x00000128, "STATUS_INTERRUPT_STILL_CONNECTED", "", SeveritySuccess},
+	{0x00000129, "STATUS_PROCESS_CLONED", "", SeveritySuccess},
+	{0x0000012A, "STATUS_FILE_LOCKED_WITH_ONLY_READERS", "", SeveritySuccess},
+	{0x0000012B, "STATUS_FILE_LOCKED_WITH_WRITERS", "", SeveritySuccess},
+	{0x0000012C, "STATUS_VALID_IMAGE_HASH", "", SeveritySuccess},
+	{0x0000012D, "STATUS_VALID_CATALOG_HASH", "", SeveritySuccess},
+	{0x0000012E, "STATUS_VALID_STRONG_CODE_HASH", "", SeveritySuccess},
+	{0x0000012F, "STATUS_GHOSTED", "", SeveritySuccess},
+	{0x00000130, "STATUS_DATA_OVERWRITTEN", "", SeveritySuccess},
+	{0x00000202, "STATUS_RESOURCEMANAGER_READ_ONLY", "", SeveritySuccess},
+	{0x00000210, "STATUS_RING_PREVIOUSLY_EMPTY", "", SeveritySuccess},
+	{0x00000211, "STATUS_RING_PREVIOUSLY_FULL", "", SeveritySuccess},
+	{0x00000212, "STATUS_RING_PREVIOUSLY_ABOVE_QUOTA", "", SeveritySuccess},
+	{0x00000213, "STATUS_RING_NEWLY_EMPTY", "", SeveritySuccess},
+	{0x00000214, "STATUS_RING_SIGNAL_OPPOSITE_ENDPOINT", "", SeveritySuccess},
+	{0x00000215, "STATUS_OPLOCK_SWITCHED_TO_NEW_HANDLE", "", SeveritySuccess},
+	{0x00000216, "STATUS_OPLOCK_HANDLE_CLOSED", "", SeveritySuccess},
+	{0x00000367, "STATUS_WAIT_FOR_OPLOCK", "", SeveritySuccess},
+	{0x00000368, "STATUS_REPARSE_GLOBAL", "", SeveritySuccess},
+	{0x001C0001, "STATUS_FLT_IO_COMPLETE", "", SeveritySuccess},
+	{0x00293000, "STATUS_RTPM_CONTEXT_CONTINUE", "", SeveritySuccess},
+	{0x00293001, "STATUS_RTPM_CONTEXT_COMPLETE", "", SeveritySuccess},
+	{0x00350059, "STATUS_HV_PENDING_PAGE_REQUESTS", "", SeveritySuccess},
+	{0x00E70000, "STATUS_SPACES_REPAIRED", "", SeveritySuccess},
+	{0x00E70001, "STATUS_SPACES_PAUSE", "", SeveritySuccess},
+	{0x00E70002, "STATUS_SPACES_COMPLETE", "", SeveritySuccess},
+	{0x00E70003, "STATUS_SPACES_REDIRECT", "", SeveritySuccess},
+	{0x40000000, "STATUS_OBJECT_NAME_EXISTS", "", SeverityInformational},
+	{0x40000001, "STATUS_THREAD_WAS_SUSPENDED", "", SeverityInformational},
+	{0x40000002, "STATUS_WORKING_SET_LIMIT_RANGE", "", SeverityInformational},
+	{0x40000003, "STATUS_IMAGE_NOT_AT_BASE", "", SeverityInformational},
+	{0x40000004, "STATUS_RXACT_STATE_CREATED", "", SeverityInformational},
+	{0x40000005, "STATUS_SEGMENT_NOTIFICATION", "", SeverityInformational},
+	{0x40000006, "STATUS_LOCAL_USER_SESSION_KEY", "", SeverityInformational},
+	{0x40000007, "STATUS_BAD_CURRENT_DIRECTORY", "", SeverityInformational},
+	{0x40000008, "STATUS_SERIAL_MORE_WRITES", "", SeverityInformational},
+	{0x40000009, "STATUS_REGISTRY_RECOVERED", "", SeverityInformational},
+	{0x4000000A, "STATUS_FT_READ_RECOVERY_FROM_BACKUP", "", SeverityInformational},
+	{0x4000000B, "STATUS_FT_WRITE_RECOVERY", "", SeverityInformational},
+	{0x4000000C, "STATUS_SERIAL_COUNTER_TIMEOUT", "", SeverityInformational},
+	{0x4000000D, "STATUS_NULL_LM_PASSWORD", "", SeverityInformational},
+	{0x4000000E, "STATUS_IMAGE_MACHINE_TYPE_MISMATCH", "", SeverityInformational},
+	{0x4000000F, "STATUS_RECEIVE_PARTIAL", "", SeverityInformational},
+	{0x40000010, "STATUS_RECEIVE_EXPEDITED", "", SeverityInformational},
+	{0x40000011, "STATUS_RECEIVE_PARTIAL_EXPEDITED", "", SeverityInformational},
+	{0x40000012, "STATUS_EVENT_DONE", "", SeverityInformational},
+	{0x40000013, "STATUS_EVENT_PENDING", "", SeverityInformational},
+	{0x40000014, "STATUS_CHECKING_FILE_SYSTEM", "", SeverityInformational},
+	{0x40000015, "STATUS_FATAL_APP_EXIT", "", SeverityInformational},
+	{0x40000016, "STATUS_PREDEFINED_HANDLE", "", SeverityInformational},
+	{0x40000017, "STATUS_WAS_UNLOCKED", "", SeverityInformational},
+	{0x40000018, "STATUS_SERVICE_NOTIFICATION", "", SeverityInformational},
+	{0x40000019, "STATUS_WAS_LOCKED", "", SeverityInformational},
+	{0x4000001A, "STATUS_LOG_HARD_ERROR", "", SeverityInformational},
+	{0x4000001B, "STATUS_ALREADY_WIN32", "", SeverityInformational},
+	{0x4000001C, "STATUS_WX86_UNSIMULATE", "", SeverityInformational},
+	{0x4000001D, "STATUS_WX86_CONTINUE", "", SeverityInformational},
+	{0x4000001E, "STATUS_WX86_SINGLE_STEP", "", SeverityInformational},
+	{0x4000001F, "STATUS_WX86_BREAKPOINT", "", SeverityInformational},
+	{0x40000020, "STATUS_WX86_EXCEPTION_CONTINUE", "", SeverityInformational},
+	{0x40000021, "STATUS_WX86_EXCEPTION_LASTCHANCE", "", SeverityInformational},
+	{0x40000022, "STATUS_WX86_EXCEPTION_CHAIN", "", SeverityInformational},
+	{0x40000023, "STATUS_IMAGE_MACHINE_TYPE_MISMATCH_EXE", "", SeverityInformational},
+	{0x40000024, "STATUS_NO_YIELD_PERFORMED", "", SeverityInformational},
+	{0x40000025, "STATUS_TIMER_RESUME_IGNORED", "", SeverityInformational},
+	{0x40000026, "STATUS_ARBITRATION_UNHANDLED", "", SeverityInformational},
+	{0x40000027, "STATUS_CARDBUS_NOT_SUPPORTED", "", SeverityInformational},
+	{0x40000028, "STATUS_WX86_CREATEWX86TIB", "", SeverityInformational},
+	{0x40000029, "STATUS_MP_PROCESSOR_MISMATCH", "", SeverityInformational},
+	{0x4000002A, "STATUS_HIBERNATED", "", SeverityInformational},
+	{0x4000002B, "STATUS_RESUME_HIBERNATION", "", SeverityInformational},
+	{0x4000002C, "STATUS_FIRMWARE_UPDATED", "", SeverityInformational},
+	{0x4000002D, "STATUS_DRIVERS_LEAKING_LOCKED_PAGES", "", SeverityInformational},
+	{0x4000002E, "STATUS_MESSAGE_RETRIEVED", "", SeverityInformational},
+	{0x4000002F, "STATUS_SYSTEM_POWERSTATE_TRANSITION", "", SeverityInformational},
+	{0x40000030, "STATUS_ALPC_CHECK_COMPLETION_LIST", "", SeverityInformational},
+	{0x40000031, "STATUS_SYSTEM_POWERSTATE_COMPLEX_TRANSITION", "", SeverityInformational},
+	{0x40000032, "STATUS_ACCESS_AUDIT_BY_POLICY", "", SeverityInformational},
+	{0x40000033, "STATUS_ABANDON_HIBERFILE", "", SeverityInformational},
+	{0x40000034, "STATUS_BIZRULES_NOT_ENABLED", "", SeverityInformational},
+	{0x40000035, "STATUS_FT_READ_FROM_COPY", "", SeverityInformational},
+	{0x40000036, "STATUS_IMAGE_AT_DIFFERENT_BASE", "", SeverityInformational},
+	{0x40000037, "STATUS_PATCH_DEFERRED", "", SeverityInformational},
+	{0x40000294, "STATUS_WAKE_SYSTEM", "", SeverityInformational},
+	{0x40000370, "STATUS_DS_SHUTTING_DOWN", "", SeverityInformational},
+	{0x40000807, "STATUS_DISK_REPAIR_REDIRECTED", "", SeverityInformational},
+	{0x4000A144, "STATUS_SERVICES_FAILED_AUTOSTART", "", SeverityInformational},
+	{0x400A0004, "STATUS_CTX_CDM_CONNECT", "", SeverityInformational},
+	{0x400A0005, "STATUS_CTX_CDM_DISCONNECT", "", SeverityInformational},
+	{0x4015000D, "STATUS_SXS_RELEASE_ACTIVATION_CONTEXT", "", SeverityInformational},
+	{0x40190001, "STATUS_HEURISTIC_DAMAGE_POSSIBLE", "", SeverityInformational},
+	{0x40190034, "STATUS_RECOVERY_NOT_NEEDED", "", SeverityInformational},
+	{0x40190035, "STATUS_RM_ALREADY_STARTED", "", SeverityInformational},
+	{0x401A000C, "STATUS_LOG_NO_RESTART", "", SeverityInformational},
+	{0x401B00EC, "STATUS_VIDEO_DRIVER_DEBUG_REPORT_REQUEST", "", SeverityInformational},
+	{0x401E000A, "STATUS_GRAPHICS_PARTIAL_DATA_POPULATED", "", SeverityInformational},
+	{0x401E0201, "STATUS_GRAPHICS_SKIP_ALLOCATION_PREPARATION", "", SeverityInformational},
+	{0x401E0307, "STATUS_GRAPHICS_MODE_NOT_PINNED", "", SeverityInformational},
+	{0x401E031E, "STATUS_GRAPHICS_NO_PREFERRED_MODE", "", SeverityInformational},
+	{0x401E034B, "STATUS_GRAPHICS_DATASET_IS_EMPTY", "", SeverityInformational},
+	{0x401E034C, "STATUS_GRAPHICS_NO_MORE_ELEMENTS_IN_DATASET", "", SeverityInformational},
+	{0x401E0351, "STATUS_GRAPHICS_PATH_CONTENT_GEOMETRY_TRANSFORMATION_NOT_PINNED", "", SeverityInformational},
+	{0x401E042F, "STATUS_GRAPHICS_UNKNOWN_CHILD_STATUS", "", SeverityInformational},
+	{0x401E0437, "STATUS_GRAPHICS_LEADLINK_START_DEFERRED", "", SeverityInformational},
+	{0x401E0439, "STATUS_GRAPHICS_POLLING_TOO_FREQUENTLY", "", SeverityInformational},
+	{0x401E043A, "STATUS_GRAPHICS_START_DEFERRED", "", SeverityInformational},
+	{0x401E043C, "STATUS_GRAPHICS_DEPENDABLE_CHILD_STATUS", "", SeverityInformational},
+	{0x40230001, "STATUS_NDIS_INDICATION_REQUIRED", "", SeverityInformational},
+	{0x40292023, "STATUS_PCP_UNSUPPORTED_PSS_SALT", "", SeverityInformational},
+	{0x80000001, "STATUS_GUARD_PAGE_VIOLATION", "", SeverityWarning},
+	{0x80000002, "STATUS_DATATYPE_MISALIGNMENT", "", SeverityWarning},
+	{0x80000003, "STATUS_BREAKPOINT", "", SeverityWarning},
+	{0x80000004, "STATUS_SINGLE_STEP", "", SeverityWarning},
+	{0x80000005, "STATUS_BUFFER_OVERFLOW", "The data was too large to fit into the specified buffer.", SeverityWarning},
+	{0x80000006, "STATUS_NO_MORE_FILES", "No more files were found which match the file specification.", SeverityWarning},
+	{0x80000007, "STATUS_WAKE_SYSTEM_DEBUGGER", "", SeverityWarning},
+	{0x8000000A, "STATUS_HANDLES_CLOSED", "", SeverityWarning},
+	{0x8000000B, "STATUS_NO_INHERITANCE", "", SeverityWarning},
+	{0x8000000C, "STATUS_GUID_SUBSTITUTION_MADE", "", SeverityWarning},
+	{0x8000000D, "STATUS_PARTIAL_COPY", "", SeverityWarning},
+	{0x8000000E, "STATUS_DEVICE_PAPER_EMPTY", "", SeverityWarning},
+	{0x8000000F, "STATUS_DEVICE_POWERED_OFF", "", SeverityWarning},
+	{0x80000010, "STATUS_DEVICE_OFF_LINE", "", SeverityWarning},
+	{0x80000011, "STATUS_DEVICE_BUSY", "", SeverityWarning},
+	{0x80000012, "STATUS_NO_MORE_EAS", "", SeverityWarning},
+	{0x80000013, "STATUS_INVALID_EA_NAME", "", SeverityWarning},
+	{0x80000014, "STATUS_EA_LIST_INCONSISTENT", "", SeverityWarning},
+	{0x80000015, "STATUS_INVALID_EA_FLAG", "", SeverityWarning},
+	{0x80000016, "STATUS_VERIFY_REQUIRED", "", SeverityWarning},
+	{0x80000017, "STATUS_EXTRANEOUS_INFORMATION", "", SeverityWarning},
+	{0x80000018, "STATUS_RXACT_COMMIT_NECESSARY", "", SeverityWarning},
+	{0x8000001A, "STATUS_NO_MORE_ENTRIES", "No more entries are available from an enumeration operation.", SeverityWarning},
+	{0x8000001B, "STATUS_FILEMARK_DETECTED", "", SeverityWarning},
+	{0x8000001C, "STATUS_MEDIA_CHANGED", "", SeverityWarning},
+	{0x8000001D, "STATUS_BUS_RESET", "", SeverityWarning},
+	{0x8000001E, "STATUS_END_OF_MEDIA", "", SeverityWarning},
+	{0x8000001F, "STATUS_BEGINNING_OF_MEDIA", "", SeverityWarning},
+	{0x80000020, "STATUS_MEDIA_CHECK", "", SeverityWarning},
+	{0x80000021, "STATUS_SETMARK_DETECTED", "", SeverityWarning},
+	{0x80000022, "STATUS_NO_DATA_DETECTED", "", SeverityWarning},
+	{0x80000023, "STATUS_REDIRECTOR_HAS_OPEN_HANDLES", "", SeverityWarning},
+	{0x80000024, "STATUS_SERVER_HAS_OPEN_HANDLES", "", SeverityWarning},
+	{0x80000025, "STATUS_ALREADY_DISCONNECTED", "", SeverityWarning},
+	{0x80000026, "STATUS_LONGJUMP", "", SeverityWarning},
+	{0x80000027, "STATUS_CLEANER_CARTRIDGE_INSTALLED", "", SeverityWarning},
+	{0x80000028, "STATUS_PLUGPLAY_QUERY_VETOED", "", SeverityWarning},
+	{0x80000029, "STATUS_UNWIND_CONSOLIDATE", "", SeverityWarning},
+	{0x8000002A, "STATUS_REGISTRY_HIVE_RECOVERED", "", SeverityWarning},
+	{0x8000002B, "STATUS_DLL_MIGHT_BE_INSECURE", "", SeverityWarning},
+	{0x8000002C, "STATUS_DLL_MIGHT_BE_INCOMPATIBLE", "", SeverityWarning},
+	{0x8000002D, "STATUS_STOPPED_ON_SYMLINK", "The create operation stopped after reaching a symbolic link.", SeverityWarning},
+	{0x8000002E, "STATUS_CANNOT_GRANT_REQUESTED_OPLOCK", "", SeverityWarning},
+	{0x8000002F, "STATUS_NO_ACE_CONDITION", "", SeverityWarning},
+	{0x80000030, "STATUS_DEVICE_SUPPORT_IN_PROGRESS", "", SeverityWarning},
+	{0x80000031, "STATUS_DEVICE_POWER_CYCLE_REQUIRED", "", SeverityWarning},
+	{0x80000032, "STATUS_NO_WORK_DONE", "", SeverityWarning},
+	{0x80000288, "STATUS_DEVICE_REQUIRES_CLEANING", "", SeverityWarning},
+	{0x80000289, "STATUS_DEVICE_DOOR_OPEN", "", SeverityWarning},
+	{0x80000803, "STATUS_DATA_LOST_REPAIR", "", SeverityWarning},
+	{0x8000A127, "STATUS_GPIO_INTERRUPT_ALREADY_UNMASKED", "", SeverityWarning},
+	{0x8000CF00, "STATUS_CLOUD_FILE_PROPERTY_BLOB_CHECKSUM_MISMATCH", "", SeverityWarning},
+	{0x8000CF04, "STATUS_CLOUD_FILE_PROPERTY_BLOB_TOO_LARGE", "", SeverityWarning},
+	{0x8000CF05, "STATUS_CLOUD_FILE_TOO_MANY_PROPERTY_BLOBS", "", SeverityWarning},
+	{0x80130001, "STATUS_CLUSTER_NODE_ALREADY_UP", "", SeverityWarning},
+	{0x80130002, "STATUS_CLUSTER_NODE_ALREADY_DOWN", "", SeverityWarning},
+	{0x80130003, "STATUS_CLUSTER_NETWORK_ALREADY_ONLINE", "", SeverityWarning},
+	{0x80130004, "STATUS_CLUSTER_NETWORK_ALREADY_OFFLINE", "", SeverityWarning},
+	{0x80130005, "STATUS_CLUSTER_NODE_ALREADY_MEMBER", "", SeverityWarning},
+	{0x80190009, "STATUS_COULD_NOT_RESIZE_LOG", "", SeverityWarning},
+	{0x80190029, "STATUS_NO_TXF_METADATA", "", SeverityWarning},
+	{0x80190031, "STATUS_CANT_RECOVER_WITH_HANDLE_OPEN", "", SeverityWarning},
+	{0x80190041, "STATUS_TXF_METADATA_ALREADY_PRESENT", "", SeverityWarning},
+	{0x80190042, "STATUS_TRANSACTION_SCOPE_CALLBACKS_NOT_SET", "", SeverityWarning},
+	{0x801B00EB, "STATUS_VIDEO_HUNG_DISPLAY_DRIVER_THREAD_RECOVERED", "", SeverityWarning},
+	{0x801C0001, "STATUS_FLT_BUFFER_TOO_SMALL", "", SeverityWarning},
+	{0x80210001, "STATUS_FVE_PARTIAL_METADATA", "", SeverityWarning},
+	{0x80210002, "STATUS_FVE_TRANSIENT_STATE", "", SeverityWarning},
+	{0x80370001, "STATUS_VID_REMOTE_NODE_PARENT_GPA_PAGES_USED", "", SeverityWarning},
+	{0x80380001, "STATUS_VOLMGR_INCOMPLETE_REGENERATION", "", SeverityWarning},
+	{0x80380002, "STATUS_VOLMGR_INCOMPLETE_DISK_MIGRATION", "", SeverityWarning},
+	{0x80390001, "STATUS_BCD_NOT_ALL_ENTRIES_IMPORTED", "", SeverityWarning},
+	{0x80390003, "STATUS_BCD_NOT_ALL_ENTRIES_SYNCHRONIZED", "", SeverityWarning},
+	{0x803A0001, "STATUS_QUERY_STORAGE_ERROR", "", SeverityWarning},
+	{0x803F0001, "STATUS_GDI_HANDLE_LEAK", "", SeverityWarning},
+	{0x80430006, "STATUS_SECUREBOOT_NOT_ENABLED", "", SeverityWarning},
+	{0xC0000001, "STATUS_UNSUCCESSFUL", "The requested operation was unsuccessful.", SeverityError},
+	{0xC0000002, "STATUS_NOT_IMPLEMENTED", "The requested operation is not implemented.", SeverityError},
+	{0xC0000003, "STATUS_INVALID_INFO_CLASS", "The specified information class is not a valid information class for the specified object.", SeverityError},
+	{0xC0000004, "STATUS_INFO_LENGTH_MISMATCH", "The specified information record length does not match the length that is required for the specified information class.", SeverityError},
+	{0xC0000005, "STATUS_ACCESS_VIOLATION", "The instruction caused a memory access violation.", SeverityError},
+	{0xC0000006, "STATUS_IN_PAGE_ERROR", "", SeverityError},
+	{0xC0000007, "STATUS_PAGEFILE_QUOTA", "", SeverityError},
+	{0xC0000008, "STATUS_INVALID_HANDLE", "An invalid HANDLE was specified.", SeverityError},
+	{0xC0000009, "STATUS_BAD_INITIAL_STACK", "", SeverityError},
+	{0xC000000A, "STATUS_BAD_INITIAL_PC", "", SeverityError},
+	{0xC000000B, "STATUS_INVALID_CID", "", SeverityError},
+	{0xC000000C, "STATUS_TIMER_NOT_CANCELED", "", SeverityError},
+	{0xC000000D, "STATUS_INVALID_PARAMETER", "An invalid parameter was passed to a service or function.", SeverityError},
+	{0xC000000E, "STATUS_NO_SUCH_DEVICE", "A device that does not exist was specified.", SeverityError},
+	{0xC000000F, "STATUS_NO_SUCH_FILE", "The file does not exist.", SeverityError},
+	{0xC0000010, "STATUS_INVALID_DEVICE_REQUEST", "The specified request is not a valid operation for the target device.", SeverityError},
+	{0xC0000011, "STATUS_END_OF_FILE", "The end-of-file marker has been reached. There is no valid data in the file beyond this marker.", SeverityError},
+	{0xC0000012, "STATUS_WRONG_VOLUME", "", SeverityError},
+	{0xC0000013, "STATUS_NO_MEDIA_IN_DEVICE", "", SeverityError},
+	{0xC0000014, "STATUS_UNRECOGNIZED_MEDIA", "", SeverityError},
+	{0xC0000015, "STATUS_NONEXISTENT_SECTOR", "", SeverityError},
+	{0xC0000016, "STATUS_MORE_PROCESSING_REQUIRED", "The specified buffer contains ill-formed data, or more processing is required to complete the request.", SeverityError},
+	{0xC0000017, "STATUS_NO_MEMORY", "Not enough virtual memory or paging file quota is available to complete the specified operation.", SeverityError},
+	{0xC0000018, "STATUS_CONFLICTING_ADDRESSES", "", SeverityError},
+	{0xC0000019, "STATUS_NOT_MAPPED_VIEW", "", SeverityError},
+	{0xC000001A, "STATUS_UNABLE_TO_FREE_VM", "", SeverityError},
+	{0xC000001B, "STATUS_UNABLE_TO_DELETE_SECTION", "", SeverityError},
+	{0xC000001C, "STATUS_INVALID_SYSTEM_SERVICE", "", SeverityError},
+	{0xC000001D, "STATUS_ILLEGAL_INSTRUCTION", "", SeverityError},
+	{0xC000001E, "STATUS_INVALID_LOCK_SEQUENCE", "", SeverityError},
+	{0xC000001F, "STATUS_INVALID_VIEW_SIZE", "", SeverityError},
+	{0xC0000020, "STATUS_INVALID_FILE_FOR_SECTION", "", SeverityError},
+	{0xC0000021, "STATUS_ALREADY_COMMITTED", "", SeverityError},
+	{0xC0000022, "STATUS_ACCESS_DENIED", "A process has requested access to an object but has not been granted those access rights.", SeverityError},
+	{0xC0000023, "STATUS_BUFFER_TOO_SMALL", "The buffer is too small to contain the entry. No information has been written to the buffer.", SeverityError},
+	{0xC0000024, "STATUS_OBJECT_TYPE_MISMATCH", "There is a mismatch between the type of object that is required by the requested operation and the type of object that is specified in the request.", SeverityError},
+	{0xC0000025, "STATUS_NONCONTINUABLE_EXCEPTION", "", SeverityError},
+	{0xC0000026, "STATUS_INVALID_DISPOSITION", "", SeverityError},
+	{0xC0000027, "STATUS_UNWIND", "", SeverityError},
+	{0xC0000028, "STATUS_BAD_STACK", "", SeverityError},
+	{0xC0000029, "STATUS_INVALID_UNWIND_TARGET", "", SeverityError},
+	{0xC000002A, "STATUS_NOT_LOCKED", "", SeverityError},
+	{0xC000002B, "STATUS_PARITY_ERROR", "", SeverityError},
+	{0xC000002C, "STATUS_UNABLE_TO_DECOMMIT_VM", "", SeverityError},
+	{0xC000002D, "STATUS_NOT_COMMITTED", "", SeverityError},
+	{0xC000002E, "STATUS_INVALID_PORT_ATTRIBUTES", "", SeverityError},
+	{0xC000002F, "STATUS_PORT_MESSAGE_TOO_LONG", "", SeverityError},
+	{0xC0000030, "STATUS_INVALID_PARAMETER_MIX", "", SeverityError},
+	{0xC0000031, "STATUS_INVALID_QUOTA_LOWER", "", SeverityError},
+	{0xC0000032, "STATUS_DISK_CORRUPT_ERROR", "", SeverityError},
+	{0xC0000033, "STATUS_OBJECT_NAME_INVALID", "The object name is invalid.", SeverityError},
+	{0xC0000034, "STATUS_OBJECT_NAME_NOT_FOUND", "The object name is not found.", SeverityError},
+	{0xC0000035, "STATUS_OBJECT_NAME_COLLISION", "The object name already exists.", SeverityError},
+	{0xC0000036, "STATUS_PORT_DO_NOT_DISTURB", "", SeverityError},
+	{0xC0000037, "STATUS_PORT_DISCONNECTED", "", SeverityError},
+	{0xC0000038, "STATUS_DEVICE_ALREADY_ATTACHED", "", SeverityError},
+	{0xC0000039, "STATUS_OBJECT_PATH_INVALID", "The object path component was not a directory object.", SeverityError},
+	{0xC000003A, "STATUS_OBJECT_PATH_NOT_FOUND", "The path does not exist.", SeverityError},
+	{0xC000003B, "STATUS_OBJECT_PATH_SYNTAX_BAD", "The object path component syntax is bad.", SeverityError},
+	{0xC000003C, "STATUS_DATA_OVERRUN", "", SeverityError},
+	{0xC000003D, "STATUS_DATA_LATE_ERROR", "", SeverityError},
+	{0xC000003E, "STATUS_DATA_ERROR", "", SeverityError},
+	{0xC000003F, "STATUS_CRC_ERROR", "", SeverityError},
+	{0xC0000040, "STATUS_SECTION_TOO_BIG", "", SeverityError},
+	{0xC0000041, "STATUS_PORT_CONNECTION_REFUSED", "", SeverityError},
+	{0xC0000042, "STATUS_INVALID_PORT_HANDLE", "", SeverityError},
+	{0xC0000043, "STATUS_SHARING_VIOLATION", "A file cannot be opened because the share access flags are incompatible.", SeverityError},
+	{0xC0000044, "STATUS_QUOTA_EXCEEDED", "", SeverityError},
+	{0xC0000045, "STATUS_INVALID_PAGE_PROTECTION", "", SeverityError},
+	{0xC0000046, "STATUS_MUTANT_NOT_OWNED", "", SeverityError},
+	{0xC0000047, "STATUS_SEMAPHORE_LIMIT_EXCEEDED", "", SeverityError},
+	{0xC0000048, "STATUS_PORT_ALREADY_SET", "", SeverityError},
+	{0xC0000049, "STATUS_SECTION_NOT_IMAGE", "", SeverityError},
+	{0xC000004A, "STATUS_SUSPEND_COUNT_EXCEEDED", "", SeverityError},
+	{0xC000004B, "STATUS_THREAD_IS_TERMINATING", "", SeverityError},
+	{0xC000004C, "STATUS_BAD_WORKING_SET_LIMIT", "", SeverityError},
+	{0xC000004D, "STATUS_INCOMPATIBLE_FILE_MAP", "", SeverityError},
+	{0xC000004E, "STATUS_SECTION_PROTECTION", "", SeverityError},
+	{0xC000004F, "STATUS_EAS_NOT_SUPPORTED", "An operation involving EAs failed because the file system does not support EAs.", SeverityError},
+	{0xC0000050, "STATUS_EA_TOO_LARGE", "", SeverityError},
+	{0xC0000051, "STATUS_NONEXISTENT_EA_ENTRY", "", SeverityError},
+	{0xC0000052, "STATUS_NO_EAS_ON_FILE", "", SeverityError},
+	{0xC0000053, "STATUS_EA_CORRUPT_ERROR", "", SeverityError},
+	{0xC0000054, "STATUS_FILE_LOCK_CONFLICT", "A requested read/write cannot be granted due to a conflicting file lock.", SeverityError},
+	{0xC0000055, "STATUS_LOCK_NOT_GRANTED", "A requested file lock cannot be granted due to other existing locks.", SeverityError},
+	{0xC0000056, "STATUS_DELETE_PENDING", "A non-close operation has been requested of a file object that has a delete pending.", SeverityError},
+	{0xC0000057, "STATUS_CTL_FILE_NOT_SUPPORTED", "", SeverityError},
+	{0xC0000058, "STATUS_UNKNOWN_REVISION", "", SeverityError},
+	{0xC0000059, "STATUS_REVISION_MISMATCH", "", SeverityError},
+	{0xC000005A, "STATUS_INVALID_OWNER", "", SeverityError},
+	{0xC000005B, "STATUS_INVALID_PRIMARY_GROUP", "", SeverityError},
+	{0xC000005C, "STATUS_NO_IMPERSONATION_TOKEN", "", SeverityError},
+	{0xC000005D, "STATUS_CANT_DISABLE_MANDATORY", "", SeverityError},
+	{0xC000005E, "STATUS_NO_LOGON_SERVERS", "No logon servers are currently available to service the logon request.", SeverityError},
+	{0xC000005F, "STATUS_NO_SUCH_LOGON_SESSION", "A specified logon session does not exist. It may already have been terminated.", SeverityError},
+	{0xC0000060, "STATUS_NO_SUCH_PRIVILEGE", "", SeverityError},
+	{0xC0000061, "STATUS_PRIVILEGE_NOT_HELD", "A required privilege is not held by the client.", SeverityError},
+	{0xC0000062, "STATUS_INVALID_ACCOUNT_NAME", "", SeverityError},
+	{0xC0000063, "STATUS_USER_EXISTS", "The specified account already exists.", SeverityError},
+	{0xC0000064, "STATUS_NO_SUCH_USER", "The specified account does not exist.", SeverityError},
+	{0xC0000065, "STATUS_GROUP_EXISTS", "", SeverityError},
+	{0xC0000066, "STATUS_NO_SUCH_GROUP", "", SeverityError},
+	{0xC0000067, "STATUS_MEMBER_IN_GROUP", "", SeverityError},
+	{0xC0000068, "STATUS_MEMBER_NOT_IN_GROUP", "", SeverityError},
+	{0xC0000069, "STATUS_LAST_ADMIN", "", SeverityError},
+	{0xC000006A, "STATUS_WRONG_PASSWORD", "When trying to update a password, this return status indicates that the value provided as the current password is not correct.", SeverityError},
+	{0xC000006B, "STATUS_ILL_FORMED_PASSWORD", "Unable to update the password. The value provided for the new password contains values that are not allowed in passwords.", SeverityError},
+	{0xC000006C, "STATUS_PASSWORD_RESTRICTION", "Unable to update the password. The value that was provided for the new password does not meet the length, complexity, or history requirements of the domain.", SeverityError},
+	{0xC000006D, "STATUS_LOGON_FAILURE", "The attempted logon is invalid. This is either due to a bad username or authentication information.", SeverityError},
+	{0xC000006E, "STATUS_ACCOUNT_RESTRICTION", "Indicates a referenced user name and authentication information are valid, but some user account restriction has prevented successful authentication.", SeverityError},
+	{0xC000006F, "STATUS_INVALID_LOGON_HOURS", "The user account has time restrictions and may not be logged onto at this time.", SeverityError},
+	{0xC0000070, "STATUS_INVALID_WORKSTATION", "The user account is restricted so that it may not be used to log on from the source workstation.", SeverityError},
+	{0xC0000071, "STATUS_PASSWORD_EXPIRED", "The user account password has expired.", SeverityError},
+	{0xC0000072, "STATUS_ACCOUNT_DISABLED", "The referenced account is currently disabled and may not be logged on to.", SeverityError},
+	{0xC0000073, "STATUS_NONE_MAPPED", "", SeverityError},
+	{0xC0000074, "STATUS_TOO_MANY_LUIDS_REQUESTED", "", SeverityError},
+	{0xC0000075, "STATUS_LUIDS_EXHAUSTED", "", SeverityError},
+	{0xC0000076, "STATUS_INVALID_SUB_AUTHORITY", "", SeverityError},
+	{0xC0000077, "STATUS_INVALID_ACL", "", SeverityError},
+	{0xC0000078, "STATUS_INVALID_SID", "The SID structure is not valid.", SeverityError},
+	{0xC0000079, "STATUS_INVALID_SECURITY_DESCR", "", SeverityError},
+	{0xC000007A, "STATUS_PROCEDURE_NOT_FOUND", "", SeverityError},
+	{0xC000007B, "STATUS_INVALID_IMAGE_FORMAT", "", SeverityError},
+	{0xC000007C, "STATUS_NO_TOKEN", "", SeverityError},
+	{0xC000007D, "STATUS_BAD_INHERITANCE_ACL", "", SeverityError},
+	{0xC000007E, "STATUS_RANGE_NOT_LOCKED", "The range specified in NtUnlockFile was not locked.", SeverityError},
+	{0xC000007F, "STATUS_DISK_FULL", "The disk is full.", SeverityError},
+	{0xC0000080, "STATUS_SERVER_DISABLED", "", SeverityError},
+	{0xC0000081, "STATUS_SERVER_NOT_DISABLED", "", SeverityError},
+	{0xC0000082, "STATUS_TOO_MANY_GUIDS_REQUESTED", "", SeverityError},
+	{0xC0000083, "STATUS_GUIDS_EXHAUSTED", "", SeverityError},
+	{0xC0000084, "STATUS_INVALID_ID_AUTHORITY", "", SeverityError},
+	{0xC0000085, "STATUS_AGENTS_EXHAUSTED", "", SeverityError},
+	{0xC0000086, "STATUS_INVALID_VOLUME_LABEL", "", SeverityError},
+	{0xC0000087, "STATUS_SECTION_NOT_EXTENDED", "", SeverityError},
+	{0xC0000088, "STATUS_NOT_MAPPED_DATA", "", SeverityError},
+	{0xC0000089, "STATUS_RESOURCE_DATA_NOT_FOUND", "", SeverityError},
+	{0xC000008A, "STATUS_RESOURCE_TYPE_NOT_FOUND", "", SeverityError},
+	{0xC000008B, "STATUS_RESOURCE_NAME_NOT_FOUND", "", SeverityError},
+	{0xC000008C, "STATUS_ARRAY_BOUNDS_EXCEEDED", "", SeverityError},
+	{0xC000008D, "STATUS_FLOAT_DENORMAL_OPERAND", "", SeverityError},
+	{0xC000008E, "STATUS_FLOAT_DIVIDE_BY_ZERO", "", SeverityError},
+	{0xC000008F, "STATUS_FLOAT_INEXACT_RESULT", "", SeverityError},
+	{0xC0000090, "STATUS_FLOAT_INVALID_OPERATION", "", SeverityError},
+	{0xC0000091, "STATUS_FLOAT_OVERFLOW", "", SeverityError},
+	{0xC0000092, "STATUS_FLOAT_STACK_CHECK", "", SeverityError},
+	{0xC0000093, "STATUS_FLOAT_UNDERFLOW", "", SeverityError},
+	{0xC0000094, "STATUS_INTEGER_DIVIDE_BY_ZERO", "", SeverityError},
+	{0xC0000095, "STATUS_INTEGER_OVERFLOW", "", SeverityError},
+	{0xC0000096, "STATUS_PRIVILEGED_INSTRUCTION", "", SeverityError},
+	{0xC0000097, "STATUS_TOO_MANY_PAGING_FILES", "", SeverityError},
+	{0xC0000098, "STATUS_FILE_INVALID", "", SeverityError},
+	{0xC0000099, "STATUS_ALLOTTED_SPACE_EXCEEDED", "", SeverityError},
+	{0xC000009A, "STATUS_INSUFFICIENT_RESOURCES", "Insufficient system resources exist to complete the API.", SeverityError},
+	{0xC000009B, "STATUS_DFS_EXIT_PATH_FOUND", "", SeverityError},
+	{0xC000009C, "STATUS_DEVICE_DATA_ERROR", "", SeverityError},
+	{0xC000009D, "STATUS_DEVICE_NOT_CONNECTED", "", SeverityError},
+	{0xC000009E, "STATUS_DEVICE_POWER_FAILURE", "", SeverityError},
+	{0xC000009F, "STATUS_FREE_VM_NOT_AT_BASE", "", SeverityError},
+	{0xC00000A0, "STATUS_MEMORY_NOT_ALLOCATED", "", SeverityError},
+	{0xC00000A1, "STATUS_WORKING_SET_QUOTA", "", SeverityError},
+	{0xC00000A2, "STATUS_MEDIA_WRITE_PROTECTED", "The media is write protected.", SeverityError},
+	{0xC00000A3, "STATUS_DEVICE_NOT_READY", "", SeverityError},
+	{0xC00000A4, "STATUS_INVALID_GROUP_ATTRIBUTES", "", SeverityError},
+	{0xC00000A5, "STATUS_BAD_IMPERSONATION_LEVEL", "A specified impersonation level is invalid. Also used to indicate that a required impersonation level was not provided.", SeverityError},
+	{0xC00000A6, "STATUS_CANT_OPEN_ANONYMOUS", "", SeverityError},
+	{0xC00000A7, "STATUS_BAD_VALIDATION_CLASS", "", SeverityError},
+	{0xC00000A8, "STATUS_BAD_TOKEN_TYPE", "", SeverityError},
+	{0xC00000A9, "STATUS_BAD_MASTER_BOOT_RECORD", "", SeverityError},
+	{0xC00000AA, "STATUS_INSTRUCTION_MISALIGNMENT", "", SeverityError},
+	{0xC00000AB, "STATUS_INSTANCE_NOT_AVAILABLE", "The maximum named pipe instance count has been reached.", SeverityError},
+	{0xC00000AC, "STATUS_PIPE_NOT_AVAILABLE", "An instance of a named pipe cannot be found in the listening state.", SeverityError},
+	{0xC00000AD, "STATUS_INVALID_PIPE_STATE", "The named pipe is not in the connected or closing state.", SeverityError},
+	{0xC00000AE, "STATUS_PIPE_BUSY", "The specified pipe is set to complete operations and there are current I/O operations queued so that it cannot be changed to queue operations.", SeverityError},
+	{0xC00000AF, "STATUS_ILLEGAL_FUNCTION", "", SeverityError},
+	{0xC00000B0, "STATUS_PIPE_DISCONNECTED", "The specified named pipe is in the disconnected state.", SeverityError},
+	{0xC00000B1, "STATUS_PIPE_CLOSING", "The specified named pipe is in the closing state.", SeverityError},
+	{0xC00000B2, "STATUS_PIPE_CONNECTED", "The specified named pipe is in the connected state.", SeverityError},
+	{0xC00000B3, "STATUS_PIPE_LISTENING", "The specified named pipe is in the listening state.", SeverityError},
+	{0xC00000B4, "STATUS_INVALID_READ_MODE", "The specified named pipe is not in message mode.", SeverityError},
+	{0xC00000B5, "STATUS_IO_TIMEOUT", "The specified I/O operation was not completed before the time-out period expired.", SeverityError},
+	{0xC00000B6, "STATUS_FILE_FORCED_CLOSED", "The specified file has been closed by another process.", SeverityError},
+	{0xC00000B7, "STATUS_PROFILING_NOT_STARTED", "", SeverityError},
+	{0xC00000B8, "STATUS_PROFILING_NOT_STOPPED", "", SeverityError},
+	{0xC00000B9, "STATUS_COULD_NOT_INTERPRET", "", SeverityError},
+	{0xC00000BA, "STATUS_FILE_IS_A_DIRECTORY", "The file that was specified as a target is a directory, and the caller specified that it could be anything but a directory.", SeverityError},
+	{0xC00000BB, "STATUS_NOT_SUPPORTED", "The request is not supported.", SeverityError},
+	{0xC00000BC, "STATUS_REMOTE_NOT_LISTENING", "This remote computer is not listening.", SeverityError},
+	{0xC00000BD, "STATUS_DUPLICATE_NAME", "A duplicate name exists on the network.", SeverityError},
+	{0xC00000BE, "STATUS_BAD_NETWORK_PATH", "The network path cannot be located.", SeverityError},
+	{0xC00000BF, "STATUS_NETWORK_BUSY", "The network is busy.", SeverityError},
+	{0xC00000C0, "STATUS_DEVICE_DOES_NOT_EXIST", "This device does not exist.", SeverityError},
+	{0xC00000C1, "STATUS_TOO_MANY_COMMANDS", "The network BIOS command limit has been reached.", SeverityError},
+	{0xC00000C2, "STATUS_ADAPTER_HARDWARE_ERROR", "An I/O adapter hardware error has occurred.", SeverityError},
+	{0xC00000C3, "STATUS_INVALID_NETWORK_RESPONSE", "The network responded incorrectly.", SeverityError},
+	{0xC00000C4, "STATUS_UNEXPECTED_NETWORK_ERROR", "An unexpected network error occurred.", SeverityError},
+	{0xC00000C5, "STATUS_BAD_REMOTE_ADAPTER", "The remote adapter is not compatible.", SeverityError},
+	{0xC00000C6, "STATUS_PRINT_QUEUE_FULL", "The print queue is full.", SeverityError},
+	{0xC00000C7, "STATUS_NO_SPOOL_SPACE", "Space to store the file that is waiting to be printed is not available on the server.", SeverityError},
+	{0xC00000C8, "STATUS_PRINT_CANCELLED", "The requested print file has been canceled.", SeverityError},
+	{0xC00000C9, "STATUS_NETWORK_NAME_DELETED", "The network name was deleted.", SeverityError},
+	{0xC00000CA, "STATUS_NETWORK_ACCESS_DENIED", "Network access is denied.", SeverityError},
+	{0xC00000CB, "STATUS_BAD_DEVICE_TYPE", "The network resource type is incorrect.", SeverityError},
+	{0xC00000CC, "STATUS_BAD_NETWORK_NAME", "The network name cannot be found.", SeverityError},
+	{0xC00000CD, "STATUS_TOO_MANY_NAMES", "The number of network names exceeds the limit.", SeverityError},
+	{0xC00000CE, "STATUS_TOO_MANY_SESSIONS", "The number of sessions exceeds the limit.", SeverityError},
+	{0xC00000CF, "STATUS_SHARING_PAUSED", "File sharing has been temporarily paused.", SeverityError},
+	{0xC00000D0, "STATUS_REQUEST_NOT_ACCEPTED", "No more connections can be made to this remote computer at this time because the computer has already accepted the maximum number of connections.", SeverityError},
+	{0xC00000D1, "STATUS_REDIRECTOR_PAUSED", "Print or disk redirection is temporarily paused.", SeverityError},
+	{0xC00000D2, "STATUS_NET_WRITE_FAULT", "A network data fault occurred.", SeverityError},
+	{0xC00000D3, "STATUS_PROFILING_AT_LIMIT", "", SeverityError},
+	{0xC00000D4, "STATUS_NOT_SAME_DEVICE", "The target file of a rename request is located on a different device than the source of the rename request.", SeverityError},
+	{0xC00000D5, "STATUS_FILE_RENAMED", "", SeverityError},
+	{0xC00000D6, "STATUS_VIRTUAL_CIRCUIT_CLOSED", "The virtual circuit was closed by the server.", SeverityError},
+	{0xC00000D7, "STATUS_NO_SECURITY_ON_OBJECT", "", SeverityError},
+	{0xC00000D8, "STATUS_CANT_WAIT", "", SeverityError},
+	{0xC00000D9, "STATUS_PIPE_EMPTY", "Used to indicate that a read operation was done on an empty pipe.", SeverityError},
+	{0xC00000DA, "STATUS_CANT_ACCESS_DOMAIN_INFO", "", SeverityError},
+	{0xC00000DB, "STATUS_CANT_TERMINATE_SELF", "", SeverityError},
+	{0xC00000DC, "STATUS_INVALID_SERVER_STATE", "The SAM server was in the wrong state to perform the desired operation.", SeverityError},
+	{0xC00000DD, "STATUS_INVALID_DOMAIN_STATE", "", SeverityError},
+	{0xC00000DE, "STATUS_INVALID_DOMAIN_ROLE", "", SeverityError},
+	{0xC00000DF, "STATUS_NO_SUCH_DOMAIN", "The specified domain did not exist.", SeverityError},
+	{0xC00000E0, "STATUS_DOMAIN_EXISTS", "", SeverityError},
+	{0xC00000E1, "STATUS_DOMAIN_LIMIT_EXCEEDED", "", SeverityError},
+	{0xC00000E2, "STATUS_OPLOCK_NOT_GRANTED", "", SeverityError},
+	{0xC00000E3, "STATUS_INVALID_OPLOCK_PROTOCOL", "", SeverityError},
+	{0xC00000E4, "STATUS_INTERNAL_DB_CORRUPTION", "", SeverityError},
+	{0xC00000E5, "STATUS_INTERNAL_ERROR", "An internal error occurred.", SeverityError},
+	{0xC00000E6, "STATUS_GENERIC_NOT_MAPPED", "", SeverityError},
+	{0xC00000E7, "STATUS_BAD_DESCRIPTOR_FORMAT", "", SeverityError},
+	{0xC00000E8, "STATUS_INVALID_USER_BUFFER", "", SeverityError},
+	{0xC00000E9, "STATUS_UNEXPECTED_IO_ERROR", "", SeverityError},
+	{0xC00000EA, "STATUS_UNEXPECTED_MM_CREATE_ERR", "", SeverityError},
+	{0xC00000EB, "STATUS_UNEXPECTED_MM_MAP_ERROR", "", SeverityError},
+	{0xC00000EC, "STATUS_UNEXPECTED_MM_EXTEND_ERR", "", SeverityError},
+	{0xC00000ED, "STATUS_NOT_LOGON_PROCESS", "The requested action is restricted for use by logon processes only.", SeverityError},
+	{0xC00000EE, "STATUS_LOGON_SESSION_EXISTS", "An attempt has been made to start a new session manager or LSA logon session by using an ID that is already in use.", SeverityError},
+	{0xC00000EF, "STATUS_INVALID_PARAMETER_1", "", SeverityError},
+	{0xC00000F0, "STATUS_INVALID_PARAMETER_2", "", SeverityError},
+	{0xC00000F1, "STATUS_INVALID_PARAMETER_3", "", SeverityError},
+	{0xC00000F2, "STATUS_INVALID_PARAMETER_4", "", SeverityError},
+	{0xC00000F3, "STATUS_INVALID_PARAMETER_5", "", SeverityError},
+	{0xC00000F4, "STATUS_INVALID_PARAMETER_6", "", SeverityError},
+	{0xC00000F5, "STATUS_INVALID_PARAMETER_7", "", SeverityError},
+	{0xC00000F6, "STATUS_INVALID_PARAMETER_8", "", SeverityError},
+	{0xC00000F7, "STATUS_INVALID_PARAMETER_9", "", SeverityError},
+	{0xC00000F8, "STATUS_INVALID_PARAMETER_10", "", SeverityError},
+	{0xC00000F9, "STATUS_INVALID_PARAMETER_11", "", SeverityError},
+	{0xC00000FA, "STATUS_INVALID_PARAMETER_12", "", SeverityError},
+	{0xC00000FB, "STATUS_REDIRECTOR_NOT_STARTED", "", SeverityError},
+	{0xC00000FC, "STATUS_REDIRECTOR_STARTED", "", SeverityError},
+	{0xC00000FD, "STATUS_STACK_OVERFLOW", "", SeverityError},
+	{0xC00000FE, "STATUS_NO_SUCH_PACKAGE", "", SeverityError},
+	{0xC00000FF, "STATUS_BAD_FUNCTION_TABLE", "", SeverityError},
+	{0xC0000100, "STATUS_VARIABLE_NOT_FOUND", "", SeverityError},
+	{0xC0000101, "STATUS_DIRECTORY_NOT_EMPTY", "The directory is not empty.", SeverityError},
+	{0xC0000102, "STATUS_FILE_CORRUPT_ERROR", "", SeverityError},
+	{0xC0000103, "STATUS_NOT_A_DIRECTORY", "A requested opened file is not a directory.", SeverityError},
+	{0xC0000104, "STATUS_BAD_LOGON_SESSION_STATE", "", SeverityError},
+	{0xC0000105, "STATUS_LOGON_SESSION_COLLISION", "", SeverityError},
+	{0xC0000106, "STATUS_NAME_TOO_LONG", "", SeverityError},
+	{0xC0000107, "STATUS_FILES_OPEN", "", SeverityError},
+	{0xC0000108, "STATUS_CONNECTION_IN_USE", "", SeverityError},
+	{0xC0000109, "STATUS_MESSAGE_NOT_FOUND", "", SeverityError},
+	{0xC000010A, "STATUS_PROCESS_IS_TERMINATING", "", SeverityError},
+	{0xC000010B, "STATUS_INVALID_LOGON_TYPE", "", SeverityError},
+	{0xC000010C, "STATUS_NO_GUID_TRANSLATION", "", SeverityError},
+	{0xC000010D, "STATUS_CANNOT_IMPERSONATE", "", SeverityError},
+	{0xC000010E, "STATUS_IMAGE_ALREADY_LOADED", "", SeverityError},
+	{0xC000010F, "STATUS_ABIOS_NOT_PRESENT", "", SeverityError},
+	{0xC0000110, "STATUS_ABIOS_LID_NOT_EXIST", "", SeverityError},
+	{0xC0000111, "STATUS_ABIOS_LID_ALREADY_OWNED", "", SeverityError},
+	{0xC0000112, "STATUS_ABIOS_NOT_LID_OWNER", "", SeverityError},
+	{0xC0000113, "STATUS_ABIOS_INVALID_COMMAND", "", SeverityError},
+	{0xC0000114, "STATUS_ABIOS_INVALID_LID", "", SeverityError},
+	{0xC0000115, "STATUS_ABIOS_SELECTOR_NOT_AVAILABLE", "", SeverityError},
+	{0xC0000116, "STATUS_ABIOS_INVALID_SELECTOR", "", SeverityError},
+	{0xC0000117, "STATUS_NO_LDT", "", SeverityError},
+	{0xC0000118, "STATUS_INVALID_LDT_SIZE", "", SeverityError},
+	{0xC0000119, "STATUS_INVALID_LDT_OFFSET", "", SeverityError},
+	{0xC000011A, "STATUS_INVALID_LDT_DESCRIPTOR", "", SeverityError},
+	{0xC000011B, "STATUS_INVALID_IMAGE_NE_FORMAT", "", SeverityError},
+	{0xC000011C, "STATUS_RXACT_INVALID_STATE", "", SeverityError},
+	{0xC000011D, "STATUS_RXACT_COMMIT_FAILURE", "", SeverityError},
+	{0xC000011E, "STATUS_MAPPED_FILE_SIZE_ZERO", "", SeverityError},
+	{0xC000011F, "STATUS_TOO_MANY_OPENED_FILES", "Too many files are opened on a remote server.", SeverityError},
+	{0xC0000120, "STATUS_CANCELLED", "The I/O request was canceled.", SeverityError},
+	{0xC0000121, "STATUS_CANNOT_DELETE", "An attempt has been made to remove a file or directory that cannot be deleted.", SeverityError},
+	{0xC0000122, "STATUS_INVALID_COMPUTER_NAME", "", SeverityError},
+	{0xC0000123, "STATUS_FILE_DELETED", "The file has been deleted.", SeverityError},
+	{0xC0000124, "STATUS_SPECIAL_ACCOUNT", "", SeverityError},
+	{0xC0000125, "STATUS_SPECIAL_GROUP", "", SeverityError},
+	{0xC0000126, "STATUS_SPECIAL_USER", "", SeverityError},
+	{0xC0000127, "STATUS_MEMBERS_PRIMARY_GROUP", "", SeverityError},
+	{0xC0000128, "STATUS_FILE_CLOSED", "An I/O request other than close and several other special case operations was attempted using a file object that had already been closed.", SeverityError},
+	{0xC0000129, "STATUS_TOO_MANY_THREADS", "", SeverityError},
+	{0xC000012A, "STATUS_THREAD_NOT_IN_PROCESS", "", SeverityError},
+	{0xC000012B, "STATUS_TOKEN_ALREADY_IN_USE", "", SeverityError},
+	{0xC000012C, "STATUS_PAGEFILE_QUOTA_EXCEEDED", "", SeverityError},
+	{0xC000012D, "STATUS_COMMITMENT_LIMIT", "", SeverityError},
+	{0xC000012E, "STATUS_INVALID_IMAGE_LE_FORMAT", "", SeverityError},
+	{0xC000012F, "STATUS_INVALID_IMAGE_NOT_MZ", "", SeverityError},
+	{0xC0000130, "STATUS_INVALID_IMAGE_PROTECT", "", SeverityError},
+	{0xC0000131, "STATUS_INVALID_IMAGE_WIN_16", "", SeverityError},
+	{0xC0000132, "STATUS_LOGON_SERVER_CONFLICT", "", SeverityError},
+	{0xC0000133, "STATUS_TIME_DIFFERENCE_AT_DC", "", SeverityError},
+	{0xC0000134, "STATUS_SYNCHRONIZATION_REQUIRED", "", SeverityError},
+	{0xC0000135, "STATUS_DLL_NOT_FOUND", "", SeverityError},
+	{0xC0000136, "STATUS_OPEN_FAILED", "", SeverityError},
+	{0xC0000137, "STATUS_IO_PRIVILEGE_FAILED", "", SeverityError},
+	{0xC0000138, "STATUS_ORDINAL_NOT_FOUND", "", SeverityError},
+	{0xC0000139, "STATUS_ENTRYPOINT_NOT_FOUND", "", SeverityError},
+	{0xC000013A, "STATUS_CONTROL_C_EXIT", "", SeverityError},
+	{0xC000013B, "STATUS_LOCAL_DISCONNECT", "", SeverityError},
+	{0xC000013C, "STATUS_REMOTE_DISCONNECT", "", SeverityError},
+	{0xC000013D, "STATUS_REMOTE_RESOURCES", "", SeverityError},
+	{0xC000013E, "STATUS_LINK_FAILED", "", SeverityError},
+	{0xC000013F, "STATUS_LINK_TIMEOUT", "", SeverityError},
+	{0xC0000140, "STATUS_INVALID_CONNECTION", "", SeverityError},
+	{0xC0000141, "STATUS_INVALID_ADDRESS", "", SeverityError},
+	{0xC0000142, "STATUS_DLL_INIT_FAILED", "", SeverityError},
+	{0xC0000143, "STATUS_MISSING_SYSTEMFILE", "", SeverityError},
+	{0xC0000144, "STATUS_UNHANDLED_EXCEPTION", "", SeverityError},
+	{0xC0000145, "STATUS_APP_INIT_FAILURE", "", SeverityError},
+	{0xC0000146, "STATUS_PAGEFILE_CREATE_FAILED", "", SeverityError},
+	{0xC0000147, "STATUS_NO_PAGEFILE", "", SeverityError},
+	{0xC0000148, "STATUS_INVALID_LEVEL", "", SeverityError},
+	{0xC0000149, "STATUS_WRONG_PASSWORD_CORE", "", SeverityError},
+	{0xC000014A, "STATUS_ILLEGAL_FLOAT_CONTEXT", "", SeverityError},
+	{0xC000014B, "STATUS_PIPE_BROKEN", "The pipe operation has failed because the other end of the pipe has been closed.", SeverityError},
+	{0xC000014C, "STATUS_REGISTRY_CORRUPT", "", SeverityError},
+	{0xC000014D, "STATUS_REGISTRY_IO_FAILED", "", SeverityError},
+	{0xC000014E, "STATUS_NO_EVENT_PAIR", "", SeverityError},
+	{0xC000014F, "STATUS_UNRECOGNIZED_VOLUME", "", SeverityError},
+	{0xC0000150, "STATUS_SERIAL_NO_DEVICE_INITED", "", SeverityError},
+	{0xC0000151, "STATUS_NO_SUCH_ALIAS", "", SeverityError},
+	{0xC0000152, "STATUS_MEMBER_NOT_IN_ALIAS", "", SeverityError},
+	{0xC0000153, "STATUS_MEMBER_IN_ALIAS", "", SeverityError},
+	{0xC0000154, "STATUS_ALIAS_EXISTS", "", SeverityError},
+	{0xC0000155, "STATUS_LOGON_NOT_GRANTED", "", SeverityError},
+	{0xC0000156, "STATUS_TOO_MANY_SECRETS", "", SeverityError},
+	{0xC0000157, "STATUS_SECRET_TOO_LONG", "", SeverityError},
+	{0xC0000158, "STATUS_INTERNAL_DB_ERROR", "", SeverityError},
+	{0xC0000159, "STATUS_FULLSCREEN_MODE", "", SeverityError},
+	{0xC000015A, "STATUS_TOO_MANY_CONTEXT_IDS", "", SeverityError},
+	{0xC000015B, "STATUS_LOGON_TYPE_NOT_GRANTED", "A user has requested a type of logon that has not been granted.", SeverityError},
+	{0xC000015C, "STATUS_NOT_REGISTRY_FILE", "", SeverityError},
+	{0xC000015D, "STATUS_NT_CROSS_ENCRYPTION_REQUIRED", "", SeverityError},
+	{0xC000015E, "STATUS_DOMAIN_CTRLR_CONFIG_ERROR", "", SeverityError},
+	{0xC000015F, "STATUS_FT_MISSING_MEMBER", "", SeverityError},
+	{0xC0000160, "STATUS_ILL_FORMED_SERVICE_ENTRY", "", SeverityError},
+	{0xC0000161, "STATUS_ILLEGAL_CHARACTER", "", SeverityError},
+	{0xC0000162, "STATUS_UNMAPPABLE_CHARACTER", "", SeverityError},
+	{0xC0000163, "STATUS_UNDEFINED_CHARACTER", "", SeverityError},
+	{0xC0000164, "STATUS_FLOPPY_VOLUME", "", SeverityError},
+	{0xC0000165, "STATUS_FLOPPY_ID_MARK_NOT_FOUND", "", SeverityError},
+	{0xC0000166, "STATUS_FLOPPY_WRONG_CYLINDER", "", SeverityError},
+	{0xC0000167, "STATUS_FLOPPY_UNKNOWN_ERROR", "", SeverityError},
+	{0xC0000168, "STATUS_FLOPPY_BAD_REGISTERS", "", SeverityError},
+	{0xC0000169, "STATUS_DISK_RECALIBRATE_FAILED", "", SeverityError},
+	{0xC000016A, "STATUS_DISK_OPERATION_FAILED", "", SeverityError},
+	{0xC000016B, "STATUS_DISK_RESET_FAILED", "", SeverityError},
+	{0xC000016C, "STATUS_SHARED_IRQ_BUSY", "", SeverityError},
+	{0xC000016D, "STATUS_FT_ORPHANING", "", SeverityError},
+	{0xC000016E, "STATUS_BIOS_FAILED_TO_CONNECT_INTERRUPT", "", SeverityError},
+	{0xC0000172, "STATUS_PARTITION_FAILURE", "", SeverityError},
+	{0xC0000173, "STATUS_INVALID_BLOCK_LENGTH", "", SeverityError},
+	{0xC0000174, "STATUS_DEVICE_NOT_PARTITIONED", "", SeverityError},
+	{0xC0000175, "STATUS_UNABLE_TO_LOCK_MEDIA", "", SeverityError},
+	{0xC0000176, "STATUS_UNABLE_TO_UNLOAD_MEDIA", "", SeverityError},
+	{0xC0000177, "STATUS_EOM_OVERFLOW", "", SeverityError},
+	{0xC0000178, "STATUS_NO_MEDIA", "", SeverityError},
+	{0xC000017A, "STATUS_NO_SUCH_MEMBER", "", SeverityError},
+	{0xC000017B, "STATUS_INVALID_MEMBER", "", SeverityError},
+	{0xC000017C, "STATUS_KEY_DELETED", "", SeverityError},
+	{0xC000017D, "STATUS_NO_LOG_SPACE", "", SeverityError},
+	{0xC000017E, "STATUS_TOO_MANY_SIDS", "", SeverityError},
+	{0xC000017F, "STATUS_LM_CROSS_ENCRYPTION_REQUIRED", "", SeverityError},
+	{0xC0000180, "STATUS_KEY_HAS_CHILDREN", "", SeverityError},
+	{0xC0000181, "STATUS_CHILD_MUST_BE_VOLATILE", "", SeverityError},
+	{0xC0000182, "STATUS_DEVICE_CONFIGURATION_ERROR", "", SeverityError},
+	{0xC0000183, "STATUS_DRIVER_INTERNAL_ERROR", "", SeverityError},
+	{0xC0000184, "STATUS_INVALID_DEVICE_STATE", "The device is not in a valid state to perform this request.", SeverityError},
+	{0xC0000185, "STATUS_IO_DEVICE_ERROR", "", SeverityError},
+	{0xC0000186, "STATUS_DEVICE_PROTOCOL_ERROR", "", SeverityError},
+	{0xC0000187, "STATUS_BACKUP_CONTROLLER", "", SeverityError},
+	{0xC0000188, "STATUS_LOG_FILE_FULL", "", SeverityError},
+	{0xC0000189, "STATUS_TOO_LATE", "", SeverityError},
+	{0xC000018A, "STATUS_NO_TRUST_LSA_SECRET", "", SeverityError},
+	{0xC000018B, "STATUS_NO_TRUST_SAM_ACCOUNT", "The SAM database on the Windows Server does not have a computer account for this workstation trust relationship.", SeverityError},
+	{0xC000018C, "STATUS_TRUSTED_DOMAIN_FAILURE", "The trust relationship between the primary domain and the trusted domain failed.", SeverityError},
+	{0xC000018D, "STATUS_TRUSTED_RELATIONSHIP_FAILURE", "The logon request failed because the trust relationship between this workstation and the primary domain failed.", SeverityError},
+	{0xC000018E, "STATUS_EVENTLOG_FILE_CORRUPT", "", SeverityError},
+	{0xC000018F, "STATUS_EVENTLOG_CANT_START", "", SeverityError},
+	{0xC0000190, "STATUS_TRUST_FAILURE", "", SeverityError},
+	{0xC0000191, "STATUS_MUTANT_LIMIT_EXCEEDED", "", SeverityError},
+	{0xC0000192, "STATUS_NETLOGON_NOT_STARTED", "An attempt was made to logon, but the NetLogon service was not started.", SeverityError},
+	{0xC0000193, "STATUS_ACCOUNT_EXPIRED", "The user account has expired.", SeverityError},
+	{0xC0000194, "STATUS_POSSIBLE_DEADLOCK", "", SeverityError},
+	{0xC0000195, "STATUS_NETWORK_CREDENTIAL_CONFLICT", "", SeverityError},
+	{0xC0000196, "STATUS_REMOTE_SESSION_LIMIT", "", SeverityError},
+	{0xC0000197, "STATUS_EVENTLOG_FILE_CHANGED", "", SeverityError},
+	{0xC0000198, "STATUS_NOLOGON_INTERDOMAIN_TRUST_ACCOUNT", "The account used is an interdomain trust account. Use your global user account or local user account to access this server.", SeverityError},
+	{0xC0000199, "STATUS_NOLOGON_WORKSTATION_TRUST_ACCOUNT", "The account used is a computer account. Use your global user account or local user account to access this server.", SeverityError},
+	{0xC000019A, "STATUS_NOLOGON_SERVER_TRUST_ACCOUNT", "The account used is a server trust account. Use your global user account or local user account to access this server.", SeverityError},
+	{0xC000019B, "STATUS_DOMAIN_TRUST_INCONSISTENT", "", SeverityError},
+	{0xC000019C, "STATUS_FS_DRIVER_REQUIRED", "A volume has been accessed for which a file system driver is required that has not yet been loaded.", SeverityError},
+	{0xC000019D, "STATUS_IMAGE_ALREADY_LOADED_AS_DLL", "", SeverityError},
+	{0xC000019E, "STATUS_INCOMPATIBLE_WITH_GLOBAL_SHORT_NAME_REGISTRY_SETTING", "", SeverityError},
+	{0xC000019F, "STATUS_SHORT_NAMES_NOT_ENABLED_ON_VOLUME", "", SeverityError},
+	{0xC00001A0, "STATUS_SECURITY_STREAM_IS_INCONSISTENT", "", SeverityError},
+	{0xC00001A1, "STATUS_INVALID_LOCK_RANGE", "", SeverityError},
+	{0xC00001A2, "STATUS_INVALID_ACE_CONDITION", "", SeverityError},
+	{0xC00001A3, "STATUS_IMAGE_SUBSYSTEM_NOT_PRESENT", "", SeverityError},
+	{0xC00001A4, "STATUS_NOTIFICATION_GUID_ALREADY_DEFINED", "", SeverityError},
+	{0xC00001A5, "STATUS_INVALID_EXCEPTION_HANDLER", "", SeverityError},
+	{0xC00001A6, "STATUS_DUPLICATE_PRIVILEGES", "", SeverityError},
+	{0xC00001A7, "STATUS_NOT_ALLOWED_ON_SYSTEM_FILE", "", SeverityError},
+	{0xC00001A8, "STATUS_REPAIR_NEEDED", "", SeverityError},
+	{0xC00001A9, "STATUS_QUOTA_NOT_ENABLED", "", SeverityError},
+	{0xC00001AA, "STATUS_NO_APPLICATION_PACKAGE", "", SeverityError},
+	{0xC00001AB, "STATUS_FILE_METADATA_OPTIMIZATION_IN_PROGRESS", "", SeverityError},
+	{0xC00001AC, "STATUS_NOT_SAME_OBJECT", "", SeverityError},
+	{0xC00001AD, "STATUS_FATAL_MEMORY_EXHAUSTION", "", SeverityError},
+	{0xC00001AE, "STATUS_ERROR_PROCESS_NOT_IN_JOB", "", SeverityError},
+	{0xC00001AF, "STATUS_CPU_SET_INVALID", "", SeverityError},
+	{0xC00001B0, "STATUS_IO_DEVICE_INVALID_DATA", "", SeverityError},
+	{0xC00001B1, "STATUS_IO_UNALIGNED_WRITE", "", SeverityError},
+	{0xC0000201, "STATUS_NETWORK_OPEN_RESTRICTION", "", SeverityError},
+	{0xC0000202, "STATUS_NO_USER_SESSION_KEY", "There is no user session key for the specified logon session.", SeverityError},
+	{0xC0000203, "STATUS_USER_SESSION_DELETED", "The remote user session has been deleted.", SeverityError},
+	{0xC0000204, "STATUS_RESOURCE_LANG_NOT_FOUND", "", SeverityError},
+	{0xC0000205, "STATUS_INSUFF_SERVER_RESOURCES", "", SeverityError},
+	{0xC0000206, "STATUS_INVALID_BUFFER_SIZE", "", SeverityError},
+	{0xC0000207, "STATUS_INVALID_ADDRESS_COMPONENT", "", SeverityError},
+	{0xC0000208, "STATUS_INVALID_ADDRESS_WILDCARD", "", SeverityError},
+	{0xC0000209, "STATUS_TOO_MANY_ADDRESSES", "", SeverityError},
+	{0xC000020A, "STATUS_ADDRESS_ALREADY_EXISTS", "", SeverityError},
+	{0xC000020B, "STATUS_ADDRESS_CLOSED", "", SeverityError},
+	{0xC000020C, "STATUS_CONNECTION_DISCONNECTED", "The transport connection is now disconnected.", SeverityError},
+	{0xC000020D, "STATUS_CONNECTION_RESET", "The transport connection has been reset.", SeverityError},
+	{0xC000020E, "STATUS_TOO_MANY_NODES", "", SeverityError},
+	{0xC000020F, "STATUS_TRANSACTION_ABORTED", "", SeverityError},
+	{0xC0000210, "STATUS_TRANSACTION_TIMED_OUT", "", SeverityError},
+	{0xC0000211, "STATUS_TRANSACTION_NO_RELEASE", "", SeverityError},
+	{0xC0000212, "STATUS_TRANSACTION_NO_MATCH", "", SeverityError},
+	{0xC0000213, "STATUS_TRANSACTION_RESPONDED", "", SeverityError},
+	{0xC0000214, "STATUS_TRANSACTION_INVALID_ID", "", SeverityError},
+	{0xC0000215, "STATUS_TRANSACTION_INVALID_TYPE", "", SeverityError},
+	{0xC0000216, "STATUS_NOT_SERVER_SESSION", "", SeverityError},
+	{0xC0000217, "STATUS_NOT_CLIENT_SESSION", "", SeverityError},
+	{0xC0000218, "STATUS_CANNOT_LOAD_REGISTRY_FILE", "", SeverityError},
+	{0xC0000219, "STATUS_DEBUG_ATTACH_FAILED", "", SeverityError},
+	{0xC000021A, "STATUS_SYSTEM_PROCESS_TERMINATED", "", SeverityError},
+	{0xC000021B, "STATUS_DATA_NOT_ACCEPTED", "", SeverityError},
+	{0xC000021C, "STATUS_NO_BROWSER_SERVERS_FOUND", "", SeverityError},
+	{0xC000021D, "STATUS_VDM_HARD_ERROR", "", SeverityError},
+	{0xC000021E, "STATUS_DRIVER_CANCEL_TIMEOUT", "", SeverityError},
+	{0xC000021F, "STATUS_REPLY_MESSAGE_MISMATCH", "", SeverityError},
+	{0xC0000220, "STATUS_MAPPED_ALIGNMENT", "", SeverityError},
+	{0xC0000221, "STATUS_IMAGE_CHECKSUM_MISMATCH", "", SeverityError},
+	{0xC0000222, "STATUS_LOST_WRITEBEHIND_DATA", "", SeverityError},
+	{0xC0000223, "STATUS_CLIENT_SERVER_PARAMETERS_INVALID", "", SeverityError},
+	{0xC0000224, "STATUS_PASSWORD_MUST_CHANGE", "The user password must be changed before logging on the first time.", SeverityError},
+	{0xC0000225, "STATUS_NOT_FOUND", "The object was not found.", SeverityError},
+	{0xC0000226, "STATUS_NOT_TINY_STREAM", "", SeverityError},
+	{0xC0000227, "STATUS_RECOVERY_FAILURE", "", SeverityError},
+	{0xC0000228, "STATUS_STACK_OVERFLOW_READ", "", SeverityError},
+	{0xC0000229, "STATUS_FAIL_CHECK", "", SeverityError},
+	{0xC000022A, "STATUS_DUPLICATE_OBJECTID", "", SeverityError},
+	{0xC000022B, "STATUS_OBJECTID_EXISTS", "", SeverityError},
+	{0xC000022C, "STATUS_CONVERT_TO_LARGE", "", SeverityError},
+	{0xC000022D, "STATUS_RETRY", "", SeverityError},
+	{0xC000022E, "STATUS_FOUND_OUT_OF_SCOPE", "", SeverityError},
+	{0xC000022F, "STATUS_ALLOCATE_BUCKET", "", SeverityError},
+	{0xC0000230, "STATUS_PROPSET_NOT_FOUND", "", SeverityError},
+	{0xC0000231, "STATUS_MARSHALL_OVERFLOW", "", SeverityError},
+	{0xC0000232, "STATUS_INVALID_VARIANT", "", SeverityError},
+	{0xC0000233, "STATUS_DOMAIN_CONTROLLER_NOT_FOUND", "", SeverityError},
+	{0xC0000234, "STATUS_ACCOUNT_LOCKED_OUT", "The user account has been automatically locked because too many invalid logon attempts or password change attempts have been requested.", SeverityError},
+	{0xC0000235, "STATUS_HANDLE_NOT_CLOSABLE", "", SeverityError},
+	{0xC0000236, "STATUS_CONNECTION_REFUSED", "The transport endpoint was refused by the remote system.", SeverityError},
+	{0xC0000237, "STATUS_GRACEFUL_DISCONNECT", "", SeverityError},
+	{0xC0000238, "STATUS_ADDRESS_ALREADY_ASSOCIATED", "", SeverityError},
+	{0xC0000239, "STATUS_ADDRESS_NOT_ASSOCIATED", "", SeverityError},
+	{0xC000023A, "STATUS_CONNECTION_INVALID", "", SeverityError},
+	{0xC000023B, "STATUS_CONNECTION_ACTIVE", "", SeverityError},
+	{0xC000023C, "STATUS_NETWORK_UNREACHABLE", "The remote network is not reachable by the transport.", SeverityError},
+	{0xC000023D, "STATUS_HOST_UNREACHABLE", "The remote system is not reachable by the transport.", SeverityError},
+	{0xC000023E, "STATUS_PROTOCOL_UNREACHABLE", "", SeverityError},
+	{0xC000023F, "STATUS_PORT_UNREACHABLE", "", SeverityError},
+	{0xC0000240, "STATUS_REQUEST_ABORTED", "", SeverityError},
+	{0xC0000241, "STATUS_CONNECTION_ABORTED", "", SeverityError},
+	{0xC0000242, "STATUS_BAD_COMPRESSION_BUFFER", "", SeverityError},
+	{0xC0000243, "STATUS_USER_MAPPED_FILE", "", SeverityError},
+	{0xC0000244, "STATUS_AUDIT_FAILED", "", SeverityError},
+	{0xC0000245, "STATUS_TIMER_RESOLUTION_NOT_SET", "", SeverityError},
+	{0xC0000246, "STATUS_CONNECTION_COUNT_LIMIT", "", SeverityError},
+	{0xC0000247, "STATUS_LOGIN_TIME_RESTRICTION", "", SeverityError},
+	{0xC0000248, "STATUS_LOGIN_WKSTA_RESTRICTION", "", SeverityError},
+	{0xC0000249, "STATUS_IMAGE_MP_UP_MISMATCH", "", SeverityError},
+	{0xC0000250, "STATUS_INSUFFICIENT_LOGON_INFO", "", SeverityError},
+	{0xC0000251, "STATUS_BAD_DLL_ENTRYPOINT", "", SeverityError},
+	{0xC0000252, "STATUS_BAD_SERVICE_ENTRYPOINT", "", SeverityError},
+	{0xC0000253, "STATUS_LPC_REPLY_LOST", "", SeverityError},
+	{0xC0000254, "STATUS_IP_ADDRESS_CONFLICT1", "", SeverityError},
+	{0xC0000255, "STATUS_IP_ADDRESS_CONFLICT2", "", SeverityError},
+	{0xC0000256, "STATUS_REGISTRY_QUOTA_LIMIT", "", SeverityError},
+	{0xC0000257, "STATUS_PATH_NOT_COVERED", "The contacted server does not support the indicated part of the DFS namespace.", SeverityError},
+	{0xC0000258, "STATUS_NO_CALLBACK_ACTIVE", "", SeverityError},
+	{0xC0000259, "STATUS_LICENSE_QUOTA_EXCEEDED", "", SeverityError},
+	{0xC000025A, "STATUS_PWD_TOO_SHORT", "", SeverityError},
+	{0xC000025B, "STATUS_PWD_TOO_RECENT", "", SeverityError},
+	{0xC000025C, "STATUS_PWD_HISTORY_CONFLICT", "", SeverityError},
+	{0xC000025E, "STATUS_PLUGPLAY_NO_DEVICE", "", SeverityError},
+	{0xC000025F, "STATUS_UNSUPPORTED_COMPRESSION", "", SeverityError},
+	{0xC0000260, "STATUS_INVALID_HW_PROFILE", "", SeverityError},
+	{0xC0000261, "STATUS_INVALID_PLUGPLAY_DEVICE_PATH", "", SeverityError},
+	{0xC0000262, "STATUS_DRIVER_ORDINAL_NOT_FOUND", "", SeverityError},
+	{0xC0000263, "STATUS_DRIVER_ENTRYPOINT_NOT_FOUND", "", SeverityError},
+	{0xC0000264, "STATUS_RESOURCE_NOT_OWNED", "", SeverityError},
+	{0xC0000265, "STATUS_TOO_MANY_LINKS", "", SeverityError},
+	{0xC0000266, "STATUS_QUOTA_LIST_INCONSISTENT", "", SeverityError},
+	{0xC0000267, "STATUS_FILE_IS_OFFLINE", "", SeverityError},
+	{0xC0000268, "STATUS_EVALUATION_EXPIRATION", "", SeverityError},
+	{0xC0000269, "STATUS_ILLEGAL_DLL_RELOCATION", "", SeverityError},
+	{0xC000026A, "STATUS_LICENSE_VIOLATION", "", SeverityError},
+	{0xC000026B, "STATUS_DLL_INIT_FAILED_LOGOFF", "", SeverityError},
+	{0xC000026C, "STATUS_DRIVER_UNABLE_TO_LOAD", "", SeverityError},
+	{0xC000026D, "STATUS_DFS_UNAVAILABLE", "", SeverityError},
+	{0xC000026E, "STATUS_VOLUME_DISMOUNTED", "", SeverityError},
+	{0xC000026F, "STATUS_WX86_INTERNAL_ERROR", "", SeverityError},
+	{0xC0000270, "STATUS_WX86_FLOAT_STACK_CHECK", "", SeverityError},
+	{0xC0000271, "STATUS_VALIDATE_CONTINUE", "", SeverityError},
+	{0xC0000272, "STATUS_NO_MATCH", "", SeverityError},
+	{0xC0000273, "STATUS_NO_MORE_MATCHES", "", SeverityError},
+	{0xC0000275, "STATUS_NOT_A_REPARSE_POINT", "The NTFS file or directory is not a reparse point.", SeverityError},
+	{0xC0000276, "STATUS_IO_REPARSE_TAG_INVALID", "", SeverityError},
+	{0xC0000277, "STATUS_IO_REPARSE_TAG_MISMATCH", "", SeverityError},
+	{0xC0000278, "STATUS_IO_REPARSE_DATA_INVALID", "", SeverityError},
+	{0xC0000279, "STATUS_IO_REPARSE_TAG_NOT_HANDLED", "", SeverityError},
+	{0xC000027A, "STATUS_PWD_TOO_LONG", "", SeverityError},
+	{0xC000027B, "STATUS_STOWED_EXCEPTION", "", SeverityError},
+	{0xC000027C, "STATUS_CONTEXT_STOWED_EXCEPTION", "", SeverityError},
+	{0xC0000280, "STATUS_REPARSE_POINT_NOT_RESOLVED", "", SeverityError},
+	{0xC0000281, "STATUS_DIRECTORY_IS_A_REPARSE_POINT", "", SeverityError},
+	{0xC0000282, "STATUS_RANGE_LIST_CONFLICT", "", SeverityError},
+	{0xC0000283, "STATUS_SOURCE_ELEMENT_EMPTY", "", SeverityError},
+	{0xC0000284, "STATUS_DESTINATION_ELEMENT_FULL", "", SeverityError},
+	{0xC0000285, "STATUS_ILLEGAL_ELEMENT_ADDRESS", "", SeverityError},
+	{0xC0000286, "STATUS_MAGAZINE_NOT_PRESENT", "", SeverityError},
+	{0xC0000287, "STATUS_REINITIALIZATION_NEEDED", "", SeverityError},
+	{0xC000028A, "STATUS_ENCRYPTION_FAILED", "The specified file could not be encrypted.", SeverityError},
+	{0xC000028B, "STATUS_DECRYPTION_FAILED", "The specified file could not be decrypted.", SeverityError},
+	{0xC000028C, "STATUS_RANGE_NOT_FOUND", "", SeverityError},
+	{0xC000028D, "STATUS_NO_RECOVERY_POLICY", "", SeverityError},
+	{0xC000028E, "STATUS_NO_EFS", "", SeverityError},
+	{0xC000028F, "STATUS_WRONG_EFS", "", SeverityError},
+	{0xC0000290, "STATUS_NO_USER_KEYS", "", SeverityError},
+	{0xC0000291, "STATUS_FILE_NOT_ENCRYPTED", "", SeverityError},
+	{0xC0000292, "STATUS_NOT_EXPORT_FORMAT", "", SeverityError},
+	{0xC0000293, "STATUS_FILE_ENCRYPTED", "", SeverityError},
+	{0xC0000295, "STATUS_WMI_GUID_NOT_FOUND", "", SeverityError},
+	{0xC0000296, "STATUS_WMI_INSTANCE_NOT_FOUND", "", SeverityError},
+	{0xC0000297, "STATUS_WMI_ITEMID_NOT_FOUND", "", SeverityError},
+	{0xC0000298, "STATUS_WMI_TRY_AGAIN", "", SeverityError},
+	{0xC0000299, "STATUS_SHARED_POLICY", "", SeverityError},
+	{0xC000029A, "STATUS_POLICY_OBJECT_NOT_FOUND", "", SeverityError},
+	{0xC000029B, "STATUS_POLICY_ONLY_IN_DS", "", SeverityError},
+	{0xC000029C, "STATUS_VOLUME_NOT_UPGRADED", "", SeverityError},
+	{0xC000029D, "STATUS_REMOTE_STORAGE_NOT_ACTIVE", "", SeverityError},
+	{0xC000029E, "STATUS_REMOTE_STORAGE_MEDIA_ERROR", "", SeverityError},
+	{0xC000029F, "STATUS_NO_TRACKING_SERVICE", "", SeverityError},
+	{0xC00002A0, "STATUS_SERVER_SID_MISMATCH", "", SeverityError},
+	{0xC00002A1, "STATUS_DS_NO_ATTRIBUTE_OR_VALUE", "", SeverityError},
+	{0xC00002A2, "STATUS_DS_INVALID_ATTRIBUTE_SYNTAX", "", SeverityError},
+	{0xC00002A3, "STATUS_DS_ATTRIBUTE_TYPE_UNDEFINED", "", SeverityError},
+	{0xC00002A4, "STATUS_DS_ATTRIBUTE_OR_VALUE_EXISTS", "", SeverityError},
+	{0xC00002A5, "STATUS_DS_BUSY", "", SeverityError},
+	{0xC00002A6, "STATUS_DS_UNAVAILABLE", "", SeverityError},
+	{0xC00002A7, "STATUS_DS_NO_RIDS_ALLOCATED", "", SeverityError},
+	{0xC00002A8, "STATUS_DS_NO_MORE_RIDS", "", SeverityError},
+	{0xC00002A9, "STATUS_DS_INCORRECT_ROLE_OWNER", "", SeverityError},
+	{0xC00002AA, "STATUS_DS_RIDMGR_INIT_ERROR", "", SeverityError},
+	{0xC00002AB, "STATUS_DS_OBJ_CLASS_VIOLATION", "", SeverityError},
+	{0xC00002AC, "STATUS_DS_CANT_ON_NON_LEAF", "", SeverityError},
+	{0xC00002AD, "STATUS_DS_CANT_ON_RDN", "", SeverityError},
+	{0xC00002AE, "STATUS_DS_CANT_MOD_OBJ_CLASS", "", SeverityError},
+	{0xC00002AF, "STATUS_DS_CROSS_DOM_MOVE_FAILED", "", SeverityError},
+	{0xC00002B0, "STATUS_DS_GC_NOT_AVAILABLE", "", SeverityError},
+	{0xC00002B1, "STATUS_DIRECTORY_SERVICE_REQUIRED", "", SeverityError},
+	{0xC00002B2, "STATUS_REPARSE_ATTRIBUTE_CONFLICT", "", SeverityError},
+	{0xC00002B3, "STATUS_CANT_ENABLE_DENY_ONLY", "", SeverityError},
+	{0xC00002B4, "STATUS_FLOAT_MULTIPLE_FAULTS", "", SeverityError},
+	{0xC00002B5, "STATUS_FLOAT_MULTIPLE_TRAPS", "", SeverityError},
+	{0xC00002B6, "STATUS_DEVICE_REMOVED", "", SeverityError},
+	{0xC00002B7, "STATUS_JOURNAL_DELETE_IN_PROGRESS", "", SeverityError},
+	{0xC00002B8, "STATUS_JOURNAL_NOT_ACTIVE", "", SeverityError},
+	{0xC00002B9, "STATUS_NOINTERFACE", "", SeverityError},
+	{0xC00002BA, "STATUS_DS_RIDMGR_DISABLED", "", SeverityError},
+	{0xC00002C1, "STATUS_DS_ADMIN_LIMIT_EXCEEDED", "", SeverityError},
+	{0xC00002C2, "STATUS_DRIVER_FAILED_SLEEP", "", SeverityError},
+	{0xC00002C3, "STATUS_MUTUAL_AUTHENTICATION_FAILED", "", SeverityError},
+	{0xC00002C4, "STATUS_CORRUPT_SYSTEM_FILE", "", SeverityError},
+	{0xC00002C5, "STATUS_DATATYPE_MISALIGNMENT_ERROR", "", SeverityError},
+	{0xC00002C6, "STATUS_WMI_READ_ONLY", "", SeverityError},
+	{0xC00002C7, "STATUS_WMI_SET_FAILURE", "", SeverityError},
+	{0xC00002C8, "STATUS_COMMITMENT_MINIMUM", "", SeverityError},
+	{0xC00002C9, "STATUS_REG_NAT_CONSUMPTION", "", SeverityError},
+	{0xC00002CA, "STATUS_TRANSPORT_FULL", "", SeverityError},
+	{0xC00002CB, "STATUS_DS_SAM_INIT_FAILURE", "", SeverityError},
+	{0xC00002CC, "STATUS_ONLY_IF_CONNECTED", "", SeverityError},
+	{0xC00002CD, "STATUS_DS_SENSITIVE_GROUP_VIOLATION", "", SeverityError},
+	{0xC00002CE, "STATUS_PNP_RESTART_ENUMERATION", "", SeverityError},
+	{0xC00002CF, "STATUS_JOURNAL_ENTRY_DELETED", "", SeverityError},
+	{0xC00002D0, "STATUS_DS_CANT_MOD_PRIMARYGROUPID", "", SeverityError},
+	{0xC00002D1, "STATUS_SYSTEM_IMAGE_BAD_SIGNATURE", "", SeverityError},
+	{0xC00002D2, "STATUS_PNP_REBOOT_REQUIRED", "", SeverityError},
+	{0xC00002D3, "STATUS_POWER_STATE_INVALID", "", SeverityError},
+	{0xC00002D4, "STATUS_DS_INVALID_GROUP_TYPE", "", SeverityError},
+	{0xC00002D5, "STATUS_DS_NO_NEST_GLOBALGROUP_IN_MIXEDDOMAIN", "", SeverityError},
+	{0xC00002D6, "STATUS_DS_NO_NEST_LOCALGROUP_IN_MIXEDDOMAIN", "", SeverityError},
+	{0xC00002D7, "STATUS_DS_GLOBAL_CANT_HAVE_LOCAL_MEMBER", "", SeverityError},
+	{0xC00002D8, "STATUS_DS_GLOBAL_CANT_HAVE_UNIVERSAL_MEMBER", "", SeverityError},
+	{0xC00002D9, "STATUS_DS_UNIVERSAL_CANT_HAVE_LOCAL_MEMBER", "", SeverityError},
+	{0xC00002DA, "STATUS_DS_GLOBAL_CANT_HAVE_CROSSDOMAIN_MEMBER", "", SeverityError},
+	{0xC00002DB, "STATUS_DS_LOCAL_CANT_HAVE_CROSSDOMAIN_LOCAL_MEMBER", "", SeverityError},
+	{0xC00002DC, "STATUS_DS_HAVE_PRIMARY_MEMBERS", "", SeverityError},
+	{0xC00002DD, "STATUS_WMI_NOT_SUPPORTED", "", SeverityError},
+	{0xC00002DE, "STATUS_INSUFFICIENT_POWER", "", SeverityError},
+	{0xC00002DF, "STATUS_SAM_NEED_BOOTKEY_PASSWORD", "", SeverityError},
+	{0xC00002E0, "STATUS_SAM_NEED_BOOTKEY_FLOPPY", "", SeverityError},
+	{0xC00002E1, "STATUS_DS_CANT_START", "", SeverityError},
+	{0xC00002E2, "STATUS_DS_INIT_FAILURE", "", SeverityError},
+	{0xC00002E3, "STATUS_SAM_INIT_FAILURE", "", SeverityError},
+	{0xC00002E4, "STATUS_DS_GC_REQUIRED", "", SeverityError},
+	{0xC00002E5, "STATUS_DS_LOCAL_MEMBER_OF_LOCAL_ONLY", "", SeverityError},
+	{0xC00002E6, "STATUS_DS_NO_FPO_IN_UNIVERSAL_GROUPS", "", SeverityError},
+	{0xC00002E7, "STATUS_DS_MACHINE_ACCOUNT_QUOTA_EXCEEDED", "", SeverityError},
+	{0xC00002E8, "STATUS_MULTIPLE_FAULT_VIOLATION", "", SeverityError},
+	{0xC00002E9, "STATUS_CURRENT_DOMAIN_NOT_ALLOWED", "", SeverityError},
+	{0xC00002EA, "STATUS_CANNOT_MAKE", "", SeverityError},
+	{0xC00002EB, "STATUS_SYSTEM_SHUTDOWN", "", SeverityError},
+	{0xC00002EC, "STATUS_DS_INIT_FAILURE_CONSOLE", "", SeverityError},
+	{0xC00002ED, "STATUS_DS_SAM_INIT_FAILURE_CONSOLE", "", SeverityError},
+	{0xC00002EE, "STATUS_UNFINISHED_CONTEXT_DELETED", "", SeverityError},
+	{0xC00002EF, "STATUS_NO_TGT_REPLY", "", SeverityError},
+	{0xC00002F0, "STATUS_OBJECTID_NOT_FOUND", "", SeverityError},
+	{0xC00002F1, "STATUS_NO_IP_ADDRESSES", "", SeverityError},
+	{0xC00002F2, "STATUS_WRONG_CREDENTIAL_HANDLE", "", SeverityError},
+	{0xC00002F3, "STATUS_CRYPTO_SYSTEM_INVALID", "", SeverityError},
+	{0xC00002F4, "STATUS_MAX_REFERRALS_EXCEEDED", "", SeverityError},
+	{0xC00002F5, "STATUS_MUST_BE_KDC", "", SeverityError},
+	{0xC00002F6, "STATUS_STRONG_CRYPTO_NOT_SUPPORTED", "", SeverityError},
+	{0xC00002F7, "STATUS_TOO_MANY_PRINCIPALS", "", SeverityError},
+	{0xC00002F8, "STATUS_NO_PA_DATA", "", SeverityError},
+	{0xC00002F9, "STATUS_PKINIT_NAME_MISMATCH", "", SeverityError},
+	{0xC00002FA, "STATUS_SMARTCARD_LOGON_REQUIRED", "Smart card logon is required and was not used.", SeverityError},
+	{0xC00002FB, "STATUS_KDC_INVALID_REQUEST", "", SeverityError},
+	{0xC00002FC, "STATUS_KDC_UNABLE_TO_REFER", "", SeverityError},
+	{0xC00002FD, "STATUS_KDC_UNKNOWN_ETYPE", "The encryption type requested is not supported by the KDC.", SeverityError},
+	{0xC00002FE, "STATUS_SHUTDOWN_IN_PROGRESS", "", SeverityError},
+	{0xC00002FF, "STATUS_SERVER_SHUTDOWN_IN_PROGRESS", "", SeverityError},
+	{0xC0000300, "STATUS_NOT_SUPPORTED_ON_SBS", "", SeverityError},
+	{0xC0000301, "STATUS_WMI_GUID_DISCONNECTED", "", SeverityError},
+	{0xC0000302, "STATUS_WMI_ALREADY_DISABLED", "", SeverityError},
+	{0xC0000303, "STATUS_WMI_ALREADY_ENABLED", "", SeverityError},
+	{0xC0000304, "STATUS_MFT_TOO_FRAGMENTED", "", SeverityError},
+	{0xC0000305, "STATUS_COPY_PROTECTION_FAILURE", "", SeverityError},
+	{0xC0000306, "STATUS_CSS_AUTHENTICATION_FAILURE", "", SeverityError},
+	{0xC0000307, "STATUS_CSS_KEY_NOT_PRESENT", "", SeverityError},
+	{0xC0000308, "STATUS_CSS_KEY_NOT_ESTABLISHED", "", SeverityError},
+	{0xC0000309, "STATUS_CSS_SCRAMBLED_SECTOR", "", SeverityError},
+	{0xC000030A, "STATUS_CSS_REGION_MISMATCH", "", SeverityError},
+	{0xC000030B, "STATUS_CSS_RESETS_EXHAUSTED", "", SeverityError},
+	{0xC000030C, "STATUS_PASSWORD_CHANGE_REQUIRED", "", SeverityError},
+	{0xC000030D, "STATUS_LOST_MODE_LOGON_RESTRICTION", "", SeverityError},
+	{0xC0000320, "STATUS_PKINIT_FAILURE", "", SeverityError},
+	{0xC0000321, "STATUS_SMARTCARD_SUBSYSTEM_FAILURE", "", SeverityError},
+	{0xC0000322, "STATUS_NO_KERB_KEY", "", SeverityError},
+	{0xC0000350, "STATUS_HOST_DOWN", "", SeverityError},
+	{0xC0000351, "STATUS_UNSUPPORTED_PREAUTH", "", SeverityError},
+	{0xC0000352, "STATUS_EFS_ALG_BLOB_TOO_BIG", "", SeverityError},
+	{0xC0000353, "STATUS_PORT_NOT_SET", "", SeverityError},
+	{0xC0000354, "STATUS_DEBUGGER_INACTIVE", "", SeverityError},
+	{0xC0000355, "STATUS_DS_VERSION_CHECK_FAILURE", "", SeverityError},
+	{0xC0000356, "STATUS_AUDITING_DISABLED", "", SeverityError},
+	{0xC0000357, "STATUS_PRENT4_MACHINE_ACCOUNT", "", SeverityError},
+	{0xC0000358, "STATUS_DS_AG_CANT_HAVE_UNIVERSAL_MEMBER", "", SeverityError},
+	{0xC0000359, "STATUS_INVALID_IMAGE_WIN_32", "", SeverityError},
+	{0xC000035A, "STATUS_INVALID_IMAGE_WIN_64", "", SeverityError},
+	{0xC000035B, "STATUS_BAD_BINDINGS", "", SeverityError},
+	{0xC000035C, "STATUS_NETWORK_SESSION_EXPIRED", "The client session has expired; the client must reauthenticate to continue accessing the remote resources.", SeverityError},
+	{0xC000035D, "STATUS_APPHELP_BLOCK", "", SeverityError},
+	{0xC000035E, "STATUS_ALL_SIDS_FILTERED", "", SeverityError},
+	{0xC000035F, "STATUS_NOT_SAFE_MODE_DRIVER", "", SeverityError},
+	{0xC0000361, "STATUS_ACCESS_DISABLED_BY_POLICY_DEFAULT", "", SeverityError},
+	{0xC0000362, "STATUS_ACCESS_DISABLED_BY_POLICY_PATH", "", SeverityError},
+	{0xC0000363, "STATUS_ACCESS_DISABLED_BY_POLICY_PUBLISHER", "", SeverityError},
+	{0xC0000364, "STATUS_ACCESS_DISABLED_BY_POLICY_OTHER", "", SeverityError},
+	{0xC0000365, "STATUS_FAILED_DRIVER_ENTRY", "", SeverityError},
+	{0xC0000366, "STATUS_DEVICE_ENUMERATION_ERROR", "", SeverityError},
+	{0xC0000368, "STATUS_MOUNT_POINT_NOT_RESOLVED", "", SeverityError},
+	{0xC0000369, "STATUS_INVALID_DEVICE_OBJECT_PARAMETER", "", SeverityError},
+	{0xC000036A, "STATUS_MCA_OCCURED", "", SeverityError},
+	{0xC000036B, "STATUS_DRIVER_BLOCKED_CRITICAL", "", SeverityError},
+	{0xC000036C, "STATUS_DRIVER_BLOCKED", "", SeverityError},
+	{0xC000036D, "STATUS_DRIVER_DATABASE_ERROR", "", SeverityError},
+	{0xC000036E, "STATUS_SYSTEM_HIVE_TOO_LARGE", "", SeverityError},
+	{0xC000036F, "STATUS_INVALID_IMPORT_OF_NON_DLL", "", SeverityError},
+	{0xC0000371, "STATUS_NO_SECRETS", "", SeverityError},
+	{0xC0000372, "STATUS_ACCESS_DISABLED_NO_SAFER_UI_BY_POLICY", "", SeverityError},
+	{0xC0000373, "STATUS_FAILED_STACK_SWITCH", "", SeverityError},
+	{0xC0000374, "STATUS_HEAP_CORRUPTION", "", SeverityError},
+	{0xC0000380, "STATUS_SMARTCARD_WRONG_PIN", "", SeverityError},
+	{0xC0000381, "STATUS_SMARTCARD_CARD_BLOCKED", "", SeverityError},
+	{0xC0000382, "STATUS_SMARTCARD_CARD_NOT_AUTHENTICATED", "", SeverityError},
+	{0xC0000383, "STATUS_SMARTCARD_NO_CARD", "", SeverityError},
+	{0xC0000384, "STATUS_SMARTCARD_NO_KEY_CONTAINER", "", SeverityError},
+	{0xC0000385, "STATUS_SMARTCARD_NO_CERTIFICATE", "", SeverityError},
+	{0xC0000386, "STATUS_SMARTCARD_NO_KEYSET", "", SeverityError},
+	{0xC0000387, "STATUS_SMARTCARD_IO_ERROR", "", SeverityError},
+	{0xC0000388, "STATUS_DOWNGRADE_DETECTED", "The system detected a possible attempt to compromise security.", SeverityError},
+	{0xC0000389, "STATUS_SMARTCARD_CERT_REVOKED", "", SeverityError},
+	{0xC000038A, "STATUS_ISSUING_CA_UNTRUSTED", "", SeverityError},
+	{0xC000038B, "STATUS_REVOCATION_OFFLINE_C", "", SeverityError},
+	{0xC000038C, "STATUS_PKINIT_CLIENT_FAILURE", "", SeverityError},
+	{0xC000038D, "STATUS_SMARTCARD_CERT_EXPIRED", "", SeverityError},
+	{0xC000038E, "STATUS_DRIVER_FAILED_PRIOR_UNLOAD", "", SeverityError},
+	{0xC000038F, "STATUS_SMARTCARD_SILENT_CONTEXT", "", SeverityError},
+	{0xC0000401, "STATUS_PER_USER_TRUST_QUOTA_EXCEEDED", "", SeverityError},
+	{0xC0000402, "STATUS_ALL_USER_TRUST_QUOTA_EXCEEDED", "", SeverityError},
+	{0xC0000403, "STATUS_USER_DELETE_TRUST_QUOTA_EXCEEDED", "", SeverityError},
+	{0xC0000404, "STATUS_DS_NAME_NOT_UNIQUE", "", SeverityError},
+	{0xC0000405, "STATUS_DS_DUPLICATE_ID_FOUND", "", SeverityError},
+	{0xC0000406, "STATUS_DS_GROUP_CONVERSION_ERROR", "", SeverityError},
+	{0xC0000407, "STATUS_VOLSNAP_PREPARE_HIBERNATE", "", SeverityError},
+	{0xC0000408, "STATUS_USER2USER_REQUIRED", "", SeverityError},
+	{0xC0000409, "STATUS_STACK_BUFFER_OVERRUN", "", SeverityError},
+	{0xC000040A, "STATUS_NO_S4U_PROT_SUPPORT", "", SeverityError},
+	{0xC000040B, "STATUS_CROSSREALM_DELEGATION_FAILURE", "", SeverityError},
+	{0xC000040C, "STATUS_REVOCATION_OFFLINE_KDC", "", SeverityError},
+	{0xC000040D, "STATUS_ISSUING_CA_UNTRUSTED_KDC", "", SeverityError},
+	{0xC000040E, "STATUS_KDC_CERT_EXPIRED", "", SeverityError},
+	{0xC000040F, "STATUS_KDC_CERT_REVOKED", "", SeverityError},
+	{0xC0000410, "STATUS_PARAMETER_QUOTA_EXCEEDED", "", SeverityError},
+	{0xC0000411, "STATUS_HIBERNATION_FAILURE", "", SeverityError},
+	{0xC0000412, "STATUS_DELAY_LOAD_FAILED", "", SeverityError},
+	{0xC0000413, "STATUS_AUTHENTICATION_FIREWALL_FAILED", "The computer you are logging onto is protected by an authentication firewall. The specified account is not allowed to authenticate to the computer.", SeverityError},
+	{0xC0000414, "STATUS_VDM_DISALLOWED", "", SeverityError},
+	{0xC0000415, "STATUS_HUNG_DISPLAY_DRIVER_THREAD", "", SeverityError},
+	{0xC0000416, "STATUS_INSUFFICIENT_RESOURCE_FOR_SPECIFIED_SHARED_SECTION_SIZE", "", SeverityError},
+	{0xC0000417, "STATUS_INVALID_CRUNTIME_PARAMETER", "", SeverityError},
+	{0xC0000418, "STATUS_NTLM_BLOCKED", "", SeverityError},
+	{0xC0000419, "STATUS_DS_SRC_SID_EXISTS_IN_FOREST", "", SeverityError},
+	{0xC000041A, "STATUS_DS_DOMAIN_NAME_EXISTS_IN_FOREST", "", SeverityError},
+	{0xC000041B, "STATUS_DS_FLAT_NAME_EXISTS_IN_FOREST", "", SeverityError},
+	{0xC000041C, "STATUS_INVALID_USER_PRINCIPAL_NAME", "", SeverityError},
+	{0xC000041D, "STATUS_FATAL_USER_CALLBACK_EXCEPTION", "", SeverityError},
+	{0xC0000420, "STATUS_ASSERTION_FAILURE", "", SeverityError},
+	{0xC0000421, "STATUS_VERIFIER_STOP", "", SeverityError},
+	{0xC0000423, "STATUS_CALLBACK_POP_STACK", "", SeverityError},
+	{0xC0000424, "STATUS_INCOMPATIBLE_DRIVER_BLOCKED", "", SeverityError},
+	{0xC0000425, "STATUS_HIVE_UNLOADED", "", SeverityError},
+	{0xC0000426, "STATUS_COMPRESSION_DISABLED", "", SeverityError},
+	{0xC0000427, "STATUS_FILE_SYSTEM_LIMITATION", "", SeverityError},
+	{0xC0000428, "STATUS_INVALID_IMAGE_HASH", "", SeverityError},
+	{0xC0000429, "STATUS_NOT_CAPABLE", "", SeverityError},
+	{0xC000042A, "STATUS_REQUEST_OUT_OF_SEQUENCE", "", SeverityError},
+	{0xC000042B, "STATUS_IMPLEMENTATION_LIMIT", "", SeverityError},
+	{0xC000042C, "STATUS_ELEVATION_REQUIRED", "", SeverityError},
+	{0xC000042D, "STATUS_NO_SECURITY_CONTEXT", "", SeverityError},
+	{0xC000042F, "STATUS_PKU2U_CERT_FAILURE", "", SeverityError},
+	{0xC0000432, "STATUS_BEYOND_VDL", "", SeverityError},
+	{0xC0000433, "STATUS_ENCOUNTERED_WRITE_IN_PROGRESS", "", SeverityError},
+	{0xC0000434, "STATUS_PTE_CHANGED", "", SeverityError},
+	{0xC0000435, "STATUS_PURGE_FAILED", "", SeverityError},
+	{0xC0000440, "STATUS_CRED_REQUIRES_CONFIRMATION", "", SeverityError},
+	{0xC0000441, "STATUS_CS_ENCRYPTION_INVALID_SERVER_RESPONSE", "", SeverityError},
+	{0xC0000442, "STATUS_CS_ENCRYPTION_UNSUPPORTED_SERVER", "", SeverityError},
+	{0xC0000443, "STATUS_CS_ENCRYPTION_EXISTING_ENCRYPTED_FILE", "", SeverityError},
+	{0xC0000444, "STATUS_CS_ENCRYPTION_NEW_ENCRYPTED_FILE", "", SeverityError},
+	{0xC0000445, "STATUS_CS_ENCRYPTION_FILE_NOT_CSE", "", SeverityError},
+	{0xC0000446, "STATUS_INVALID_LABEL", "", SeverityError},
+	{0xC0000450, "STATUS_DRIVER_PROCESS_TERMINATED", "", SeverityError},
+	{0xC0000451, "STATUS_AMBIGUOUS_SYSTEM_DEVICE", "", SeverityError},
+	{0xC0000452, "STATUS_SYSTEM_DEVICE_NOT_FOUND", "", SeverityError},
+	{0xC0000453, "STATUS_RESTART_BOOT_APPLICATION", "", SeverityError},
+	{0xC0000454, "STATUS_INSUFFICIENT_NVRAM_RESOURCES", "", SeverityError},
+	{0xC0000455, "STATUS_INVALID_SESSION", "", SeverityError},
+	{0xC0000456, "STATUS_THREAD_ALREADY_IN_SESSION", "", SeverityError},
+	{0xC0000457, "STATUS_THREAD_NOT_IN_SESSION", "", SeverityError},
+	{0xC0000458, "STATUS_INVALID_WEIGHT", "", SeverityError},
+	{0xC0000459, "STATUS_REQUEST_PAUSED", "", SeverityError},
+	{0xC0000460, "STATUS_NO_RANGES_PROCESSED", "", SeverityError},
+	{0xC0000461, "STATUS_DISK_RESOURCES_EXHAUSTED", "", SeverityError},
+	{0xC0000462, "STATUS_NEEDS_REMEDIATION", "", SeverityError},
+	{0xC0000463, "STATUS_DEVICE_FEATURE_NOT_SUPPORTED", "", SeverityError},
+	{0xC0000464, "STATUS_DEVICE_UNREACHABLE", "", SeverityError},
+	{0xC0000465, "STATUS_INVALID_TOKEN", "", SeverityError},
+	{0xC0000466, "STATUS_SERVER_UNAVAILABLE", "", SeverityError},
+	{0xC0000467, "STATUS_FILE_NOT_AVAILABLE", "", SeverityError},
+	{0xC0000468, "STATUS_DEVICE_INSUFFICIENT_RESOURCES", "", SeverityError},
+	{0xC0000469, "STATUS_PACKAGE_UPDATING", "", SeverityError},
+	{0xC000046A, "STATUS_NOT_READ_FROM_COPY", "", SeverityError},
+	{0xC000046B, "STATUS_FT_WRITE_FAILURE", "", SeverityError},
+	{0xC000046C, "STATUS_FT_DI_SCAN_REQUIRED", "", SeverityError},
+	{0xC000046D, "STATUS_OBJECT_NOT_EXTERNALLY_BACKED", "", SeverityError},
+	{0xC000046E, "STATUS_EXTERNAL_BACKING_PROVIDER_UNKNOWN", "", SeverityError},
+	{0xC000046F, "STATUS_COMPRESSION_NOT_BENEFICIAL", "", SeverityError},
+	{0xC0000470, "STATUS_DATA_CHECKSUM_ERROR", "", SeverityError},
+	{0xC0000471, "STATUS_INTERMIXED_KERNEL_EA_OPERATION", "", SeverityError},
+	{0xC0000472, "STATUS_TRIM_READ_ZERO_NOT_SUPPORTED", "", SeverityError},
+	{0xC0000473, "STATUS_TOO_MANY_SEGMENT_DESCRIPTORS", "", SeverityError},
+	{0xC0000474, "STATUS_INVALID_OFFSET_ALIGNMENT", "", SeverityError},
+	{0xC0000475, "STATUS_INVALID_FIELD_IN_PARAMETER_LIST", "", SeverityError},
+	{0xC0000476, "STATUS_OPERATION_IN_PROGRESS", "", SeverityError},
+	{0xC0000477, "STATUS_INVALID_INITIATOR_TARGET_PATH", "", SeverityError},
+	{0xC0000478, "STATUS_SCRUB_DATA_DISABLED", "", SeverityError},
+	{0xC0000479, "STATUS_NOT_REDUNDANT_STORAGE", "", SeverityError},
+	{0xC000047A, "STATUS_RESIDENT_FILE_NOT_SUPPORTED", "", SeverityError},
+	{0xC000047B, "STATUS_COMPRESSED_FILE_NOT_SUPPORTED", "", SeverityError},
+	{0xC000047C, "STATUS_DIRECTORY_NOT_SUPPORTED", "", SeverityError},
+	{0xC000047D, "STATUS_IO_OPERATION_TIMEOUT", "", SeverityError},
+	{0xC000047E, "STATUS_SYSTEM_NEEDS_REMEDIATION", "", SeverityError},
+	{0xC000047F, "STATUS_APPX_INTEGRITY_FAILURE_CLR_NGEN", "", SeverityError},
+	{0xC0000480, "STATUS_SHARE_UNAVAILABLE", "", SeverityError},
+	{0xC0000481, "STATUS_APISET_NOT_HOSTED", "", SeverityError},
+	{0xC0000482, "STATUS_APISET_NOT_PRESENT", "", SeverityError},
+	{0xC0000483, "STATUS_DEVICE_HARDWARE_ERROR", "", SeverityError},
+	{0xC0000484, "STATUS_FIRMWARE_SLOT_INVALID", "", SeverityError},
+	{0xC0000485, "STATUS_FIRMWARE_IMAGE_INVALID", "", SeverityError},
+	{0xC0000486, "STATUS_STORAGE_TOPOLOGY_ID_MISMATCH", "", SeverityError},
+	{0xC0000487, "STATUS_WIM_NOT_BOOTABLE", "", SeverityError},
+	{0xC0000488, "STATUS_BLOCKED_BY_PARENTAL_CONTROLS", "", SeverityError},
+	{0xC0000489, "STATUS_NEEDS_REGISTRATION", "", SeverityError},
+	{0xC000048A, "STATUS_QUOTA_ACTIVITY", "", SeverityError},
+	{0xC000048B, "STATUS_CALLBACK_INVOKE_INLINE", "", SeverityError},
+	{0xC000048C, "STATUS_BLOCK_TOO_MANY_REFERENCES", "", SeverityError},
+	{0xC000048D, "STATUS_MARKED_TO_DISALLOW_WRITES", "", SeverityError},
+	{0xC000048E, "STATUS_NETWORK_ACCESS_DENIED_EDP", "", SeverityError},
+	{0xC000048F, "STATUS_ENCLAVE_FAILURE", "", SeverityError},
+	{0xC0000490, "STATUS_PNP_NO_COMPAT_DRIVERS", "", SeverityError},
+	{0xC0000491, "STATUS_PNP_DRIVER_PACKAGE_NOT_FOUND", "", SeverityError},
+	{0xC0000492, "STATUS_PNP_DRIVER_CONFIGURATION_NOT_FOUND", "", SeverityError},
+	{0xC0000493, "STATUS_PNP_DRIVER_CONFIGURATION_INCOMPLETE", "", SeverityError},
+	{0xC0000494, "STATUS_PNP_FUNCTION_DRIVER_REQUIRED", "", SeverityError},
+	{0xC0000495, "STATUS_PNP_DEVICE_CONFIGURATION_PENDING", "", SeverityError},
+	{0xC0000496, "STATUS_DEVICE_HINT_NAME_BUFFER_TOO_SMALL", "", SeverityError},
+	{0xC0000497, "STATUS_PACKAGE_NOT_AVAILABLE", "", SeverityError},
+	{0xC0000499, "STATUS_DEVICE_IN_MAINTENANCE", "", SeverityError},
+	{0xC000049A, "STATUS_NOT_SUPPORTED_ON_DAX", "", SeverityError},
+	{0xC000049B, "STATUS_FREE_SPACE_TOO_FRAGMENTED", "", SeverityError},
+	{0xC000049C, "STATUS_DAX_MAPPING_EXISTS", "", SeverityError},
+	{0xC000049D, "STATUS_CHILD_PROCESS_BLOCKED", "", SeverityError},
+	{0xC000049E, "STATUS_STORAGE_LOST_DATA_PERSISTENCE", "", SeverityError},
+	{0xC000049F, "STATUS_VRF_CFG_ENABLED", "", SeverityError},
+	{0xC00004A0, "STATUS_PARTITION_TERMINATING", "", SeverityError},
+	{0xC00004A1, "STATUS_EXTERNAL_SYSKEY_NOT_SUPPORTED", "", SeverityError},
+	{0xC00004A2, "STATUS_ENCLAVE_VIOLATION", "", SeverityError},
+	{0xC00004A3, "STATUS_FILE_PROTECTED_UNDER_DPL", "", SeverityError},
+	{0xC00004A4, "STATUS_VOLUME_NOT_CLUSTER_ALIGNED", "", SeverityError},
+	{0xC00004A5, "STATUS_NO_PHYSICALLY_ALIGNED_FREE_SPACE_FOUND", "", SeverityError},
+	{0xC00004A6, "STATUS_APPX_FILE_NOT_ENCRYPTED", "", SeverityError},
+	{0xC00004A7, "STATUS_RWRAW_ENCRYPTED_FILE_NOT_ENCRYPTED", "", SeverityError},
+	{0xC00004A8, "STATUS_RWRAW_ENCRYPTED_INVALID_EDATAINFO_FILEOFFSET", "", SeverityError},
+	{0xC00004A9, "STATUS_RWRAW_ENCRYPTED_INVALID_EDATAINFO_FILERANGE", "", SeverityError},
+	{0xC00004AA, "STATUS_RWRAW_ENCRYPTED_INVALID_EDATAINFO_PARAMETER", "", SeverityError},
+	{0xC00004AB, "STATUS_FT_READ_FAILURE", "", SeverityError},
+	{0xC00004AC, "STATUS_PATCH_CONFLICT", "", SeverityError},
+	{0xC00004AD, "STATUS_STORAGE_RESERVE_ID_INVALID", "", SeverityError},
+	{0xC00004AE, "STATUS_STORAGE_RESERVE_DOES_NOT_EXIST", "", SeverityError},
+	{0xC00004AF, "STATUS_STORAGE_RESERVE_ALREADY_EXISTS", "", SeverityError},
+	{0xC00004B0, "STATUS_STORAGE_RESERVE_NOT_EMPTY", "", SeverityError},
+	{0xC00004B1, "STATUS_NOT_A_DAX_VOLUME", "", SeverityError},
+	{0xC00004B2, "STATUS_NOT_DAX_MAPPABLE", "", SeverityError},
+	{0xC00004B3, "STATUS_CASE_DIFFERING_NAMES_IN_DIR", "", SeverityError},
+	{0xC00004B4, "STATUS_FILE_NOT_SUPPORTED", "", SeverityError},
+	{0xC00004B5, "STATUS_NOT_SUPPORTED_WITH_BTT", "", SeverityError},
+	{0xC00004B6, "STATUS_ENCRYPTION_DISABLED", "", SeverityError},
+	{0xC00004B7, "STATUS_ENCRYPTING_METADATA_DISALLOWED", "", SeverityError},
+	{0xC00004B8, "STATUS_CANT_CLEAR_ENCRYPTION_FLAG", "", SeverityError},
+	{0xC0000500, "STATUS_INVALID_TASK_NAME", "", SeverityError},
+	{0xC0000501, "STATUS_INVALID_TASK_INDEX", "", SeverityError},
+	{0xC0000502, "STATUS_THREAD_ALREADY_IN_TASK", "", SeverityError},
+	{0xC0000503, "STATUS_CALLBACK_BYPASS", "", SeverityError},
+	{0xC0000504, "STATUS_UNDEFINED_SCOPE", "", SeverityError},
+	{0xC0000505, "STATUS_INVALID_CAP", "", SeverityError},
+	{0xC0000506, "STATUS_NOT_GUI_PROCESS", "", SeverityError},
+	{0xC0000507, "STATUS_DEVICE_HUNG", "", SeverityError},
+	{0xC0000508, "STATUS_CONTAINER_ASSIGNED", "", SeverityError},
+	{0xC0000509, "STATUS_JOB_NO_CONTAINER", "", SeverityError},
+	{0xC000050A, "STATUS_DEVICE_UNRESPONSIVE", "", SeverityError},
+	{0xC000050B, "STATUS_REPARSE_POINT_ENCOUNTERED", "", SeverityError},
+	{0xC000050C, "STATUS_ATTRIBUTE_NOT_PRESENT", "", SeverityError},
+	{0xC000050D, "STATUS_NOT_A_TIERED_VOLUME", "", SeverityError},
+	{0xC000050E, "STATUS_ALREADY_HAS_STREAM_ID", "", SeverityError},
+	{0xC000050F, "STATUS_JOB_NOT_EMPTY", "", SeverityError},
+	{0xC0000510, "STATUS_ALREADY_INITIALIZED", "", SeverityError},
+	{0xC0000511, "STATUS_ENCLAVE_NOT_TERMINATED", "", SeverityError},
+	{0xC0000512, "STATUS_ENCLAVE_IS_TERMINATING", "", SeverityError},
+	{0xC0000513, "STATUS_SMB1_NOT_AVAILABLE", "", SeverityError},
+	{0xC0000514, "STATUS_SMR_GARBAGE_COLLECTION_REQUIRED", "", SeverityError},
+	{0xC0000515, "STATUS_INTERRUPTED", "", SeverityError},
+	{0xC0000516, "STATUS_THREAD_NOT_RUNNING", "", SeverityError},
+	{0xC0000602, "STATUS_FAIL_FAST_EXCEPTION", "", SeverityError},
+	{0xC0000603, "STATUS_IMAGE_CERT_REVOKED", "", SeverityError},
+	{0xC0000604, "STATUS_DYNAMIC_CODE_BLOCKED", "", SeverityError},
+	{0xC0000605, "STATUS_IMAGE_CERT_EXPIRED", "", SeverityError},
+	{0xC0000606, "STATUS_STRICT_CFG_VIOLATION", "", SeverityError},
+	{0xC000060A, "STATUS_SET_CONTEXT_DENIED", "", SeverityError},
+	{0xC000060B, "STATUS_CROSS_PARTITION_VIOLATION", "", SeverityError},
+	{0xC0000700, "STATUS_PORT_CLOSED", "", SeverityError},
+	{0xC0000701, "STATUS_MESSAGE_LOST", "", SeverityError},
+	{0xC0000702, "STATUS_INVALID_MESSAGE", "", SeverityError},
+	{0xC0000703, "STATUS_REQUEST_CANCELED", "", SeverityError},
+	{0xC0000704, "STATUS_RECURSIVE_DISPATCH", "", SeverityError},
+	{0xC0000705, "STATUS_LPC_RECEIVE_BUFFER_EXPECTED", "", SeverityError},
+	{0xC0000706, "STATUS_LPC_INVALID_CONNECTION_USAGE", "", SeverityError},
+	{0xC0000707, "STATUS_LPC_REQUESTS_NOT_ALLOWED", "", SeverityError},
+	{0xC0000708, "STATUS_RESOURCE_IN_USE", "", SeverityError},
+	{0xC0000709, "STATUS_HARDWARE_MEMORY_ERROR", "", SeverityError},
+	{0xC000070A, "STATUS_THREADPOOL_HANDLE_EXCEPTION", "", SeverityError},
+	{0xC000070B, "STATUS_THREADPOOL_SET_EVENT_ON_COMPLETION_FAILED", "", SeverityError},
+	{0xC000070C, "STATUS_THREADPOOL_RELEASE_SEMAPHORE_ON_COMPLETION_FAILED", "", SeverityError},
+	{0xC000070D, "STATUS_THREADPOOL_RELEASE_MUTEX_ON_COMPLETION_FAILED", "", SeverityError},
+	{0xC000070E, "STATUS_THREADPOOL_FREE_LIBRARY_ON_COMPLETION_FAILED", "", SeverityError},
+	{0xC000070F, "STATUS_THREADPOOL_RELEASED_DURING_OPERATION", "", SeverityError},
+	{0xC0000710, "STATUS_CALLBACK_RETURNED_WHILE_IMPERSONATING", "", SeverityError},
+	{0xC0000711, "STATUS_APC_RETURNED_WHILE_IMPERSONATING", "", SeverityError},
+	{0xC0000712, "STATUS_PROCESS_IS_PROTECTED", "", SeverityError},
+	{0xC0000713, "STATUS_MCA_EXCEPTION", "", SeverityError},
+	{0xC0000714, "STATUS_CERTIFICATE_MAPPING_NOT_UNIQUE", "", SeverityError},
+	{0xC0000715, "STATUS_SYMLINK_CLASS_DISABLED", "", SeverityError},
+	{0xC0000716, "STATUS_INVALID_IDN_NORMALIZATION", "", SeverityError},
+	{0xC0000717, "STATUS_NO_UNICODE_TRANSLATION", "", SeverityError},
+	{0xC0000718, "STATUS_ALREADY_REGISTERED", "", SeverityError},
+	{0xC0000719, "STATUS_CONTEXT_MISMATCH", "", SeverityError},
+	{0xC000071A, "STATUS_PORT_ALREADY_HAS_COMPLETION_LIST", "", SeverityError},
+	{0xC000071B, "STATUS_CALLBACK_RETURNED_THREAD_PRIORITY", "", SeverityError},
+	{0xC000071C, "STATUS_INVALID_THREAD", "", SeverityError},
+	{0xC000071D, "STATUS_CALLBACK_RETURNED_TRANSACTION", "", SeverityError},
+	{0xC000071E, "STATUS_CALLBACK_RETURNED_LDR_LOCK", "", SeverityError},
+	{0xC000071F, "STATUS_CALLBACK_RETURNED_LANG", "", SeverityError},
+	{0xC0000720, "STATUS_CALLBACK_RETURNED_PRI_BACK", "", SeverityError},
+	{0xC0000721, "STATUS_CALLBACK_RETURNED_THREAD_AFFINITY", "", SeverityError},
+	{0xC0000722, "STATUS_LPC_HANDLE_COUNT_EXCEEDED", "", SeverityError},
+	{0xC0000723, "STATUS_EXECUTABLE_MEMORY_WRITE", "", SeverityError},
+	{0xC0000724, "STATUS_KERNEL_EXECUTABLE_MEMORY_WRITE", "", SeverityError},
+	{0xC0000725, "STATUS_ATTACHED_EXECUTABLE_MEMORY_WRITE", "", SeverityError},
+	{0xC0000726, "STATUS_TRIGGERED_EXECUTABLE_MEMORY_WRITE", "", SeverityError},
+	{0xC0000800, "STATUS_DISK_REPAIR_DISABLED", "", SeverityError},
+	{0xC0000801, "STATUS_DS_DOMAIN_RENAME_IN_PROGRESS", "", SeverityError},
+	{0xC0000802, "STATUS_DISK_QUOTA_EXCEEDED", "", SeverityError},
+	{0xC0000804, "STATUS_CONTENT_BLOCKED", "", SeverityError},
+	{0xC0000805, "STATUS_BAD_CLUSTERS", "", SeverityError},
+	{0xC0000806, "STATUS_VOLUME_DIRTY", "", SeverityError},
+	{0xC0000808, "STATUS_DISK_REPAIR_UNSUCCESSFUL", "", SeverityError},
+	{0xC0000809, "STATUS_CORRUPT_LOG_OVERFULL", "", SeverityError},
+	{0xC000080A, "STATUS_CORRUPT_LOG_CORRUPTED", "", SeverityError},
+	{0xC000080B, "STATUS_CORRUPT_LOG_UNAVAILABLE", "", SeverityError},
+	{0xC000080C, "STATUS_CORRUPT_LOG_DELETED_FULL", "", SeverityError},
+	{0xC000080D, "STATUS_CORRUPT_LOG_CLEARED", "", SeverityError},
+	{0xC000080E, "STATUS_ORPHAN_NAME_EXHAUSTED", "", SeverityError},
+	{0xC000080F, "STATUS_PROACTIVE_SCAN_IN_PROGRESS", "", SeverityError},
+	{0xC0000810, "STATUS_ENCRYPTED_IO_NOT_POSSIBLE", "", SeverityError},
+	{0xC0000811, "STATUS_CORRUPT_LOG_UPLEVEL_RECORDS", "", SeverityError},
+	{0xC0000901, "STATUS_FILE_CHECKED_OUT", "", SeverityError},
+	{0xC0000902, "STATUS_CHECKOUT_REQUIRED", "", SeverityError},
+	{0xC0000903, "STATUS_BAD_FILE_TYPE", "", SeverityError},
+	{0xC0000904, "STATUS_FILE_TOO_LARGE", "", SeverityError},
+	{0xC0000905, "STATUS_FORMS_AUTH_REQUIRED", "", SeverityError},
+	{0xC0000906, "STATUS_VIRUS_INFECTED", "", SeverityError},
+	{0xC0000907, "STATUS_VIRUS_DELETED", "", SeverityError},
+	{0xC0000908, "STATUS_BAD_MCFG_TABLE", "", SeverityError},
+	{0xC0000909, "STATUS_CANNOT_BREAK_OPLOCK", "", SeverityError},
+	{0xC000090A, "STATUS_BAD_KEY", "", SeverityError},
+	{0xC000090B, "STATUS_BAD_DATA", "", SeverityError},
+	{0xC000090C, "STATUS_NO_KEY", "", SeverityError},
+	{0xC0000910, "STATUS_FILE_HANDLE_REVOKED", "", SeverityError},
+	{0xC0009898, "STATUS_WOW_ASSERTION", "", SeverityError},
+	{0xC000A000, "STATUS_INVALID_SIGNATURE", "The cryptographic signature is invalid.", SeverityError},
+	{0xC000A001, "STATUS_HMAC_NOT_SUPPORTED", "", SeverityError},
+	{0xC000A002, "STATUS_AUTH_TAG_MISMATCH", "", SeverityError},
+	{0xC000A003, "STATUS_INVALID_STATE_TRANSITION", "", SeverityError},
+	{0xC000A004, "STATUS_INVALID_KERNEL_INFO_VERSION", "", SeverityError},
+	{0xC000A005, "STATUS_INVALID_PEP_INFO_VERSION", "", SeverityError},
+	{0xC000A006, "STATUS_HANDLE_REVOKED", "", SeverityError},
+	{0xC000A007, "STATUS_EOF_ON_GHOSTED_RANGE", "", SeverityError},
+	{0xC000A010, "STATUS_IPSEC_QUEUE_OVERFLOW", "", SeverityError},
+	{0xC000A011, "STATUS_ND_QUEUE_OVERFLOW", "", SeverityError},
+	{0xC000A012, "STATUS_HOPLIMIT_EXCEEDED", "", SeverityError},
+	{0xC000A013, "STATUS_PROTOCOL_NOT_SUPPORTED", "", SeverityError},
+	{0xC000A014, "STATUS_FASTPATH_REJECTED", "", SeverityError},
+	{0xC000A080, "STATUS_LOST_WRITEBEHIND_DATA_NETWORK_DISCONNECTED", "", SeverityError},
+	{0xC000A081, "STATUS_LOST_WRITEBEHIND_DATA_NETWORK_SERVER_ERROR", "", SeverityError},
+	{0xC000A082, "STATUS_LOST_WRITEBEHIND_DATA_LOCAL_DISK_ERROR", "", SeverityError},
+	{0xC000A083, "STATUS_XML_PARSE_ERROR", "", SeverityError},
+	{0xC000A084, "STATUS_XMLDSIG_ERROR", "", SeverityError},
+	{0xC000A085, "STATUS_WRONG_COMPARTMENT", "", SeverityError},
+	{0xC000A086, "STATUS_AUTHIP_FAILURE", "", SeverityError},
+	{0xC000A087, "STATUS_DS_OID_MAPPED_GROUP_CANT_HAVE_MEMBERS", "", SeverityError},
+	{0xC000A088, "STATUS_DS_OID_NOT_FOUND", "", SeverityError},
+	{0xC000A089, "STATUS_INCORRECT_ACCOUNT_TYPE", "", SeverityError},
+	{0xC000A100, "STATUS_HASH_NOT_SUPPORTED", "", SeverityError},
+	{0xC000A101, "STATUS_HASH_NOT_PRESENT", "", SeverityError},
+	{0xC000A121, "STATUS_SECONDARY_IC_PROVIDER_NOT_REGISTERED", "", SeverityError},
+	{0xC000A122, "STATUS_GPIO_CLIENT_INFORMATION_INVALID", "", SeverityError},
+	{0xC000A123, "STATUS_GPIO_VERSION_NOT_SUPPORTED", "", SeverityError},
+	{0xC000A124, "STATUS_GPIO_INVALID_REGISTRATION_PACKET", "", SeverityError},
+	{0xC000A125, "STATUS_GPIO_OPERATION_DENIED", "", SeverityError},
+	{0xC000A126, "STATUS_GPIO_INCOMPATIBLE_CONNECT_MODE", "", SeverityError},
+	{0xC000A141, "STATUS_CANNOT_SWITCH_RUNLEVEL", "", SeverityError},
+	{0xC000A142, "STATUS_INVALID_RUNLEVEL_SETTING", "", SeverityError},
+	{0xC000A143, "STATUS_RUNLEVEL_SWITCH_TIMEOUT", "", SeverityError},
+	{0xC000A145, "STATUS_RUNLEVEL_SWITCH_AGENT_TIMEOUT", "", SeverityError},
+	{0xC000A146, "STATUS_RUNLEVEL_SWITCH_IN_PROGRESS", "", SeverityError},
+	{0xC000A200, "STATUS_NOT_APPCONTAINER", "", SeverityError},
+	{0xC000A201, "STATUS_NOT_SUPPORTED_IN_APPCONTAINER", "", SeverityError},
+	{0xC000A202, "STATUS_INVALID_PACKAGE_SID_LENGTH", "", SeverityError},
+	{0xC000A203, "STATUS_LPAC_ACCESS_DENIED", "", SeverityError},
+	{0xC000A204, "STATUS_ADMINLESS_ACCESS_DENIED", "", SeverityError},
+	{0xC000A281, "STATUS_APP_DATA_NOT_FOUND", "", SeverityError},
+	{0xC000A282, "STATUS_APP_DATA_EXPIRED", "", SeverityError},
+	{0xC000A283, "STATUS_APP_DATA_CORRUPT", "", SeverityError},
+	{0xC000A284, "STATUS_APP_DATA_LIMIT_EXCEEDED", "", SeverityError},
+	{0xC000A285, "STATUS_APP_DATA_REBOOT_REQUIRED", "", SeverityError},
+	{0xC000A2A1, "STATUS_OFFLOAD_READ_FLT_NOT_SUPPORTED", "", SeverityError},
+	{0xC000A2A2, "STATUS_OFFLOAD_WRITE_FLT_NOT_SUPPORTED", "", SeverityError},
+	{0xC000A2A3, "STATUS_OFFLOAD_READ_FILE_NOT_SUPPORTED", "", SeverityError},
+	{0xC000A2A4, "STATUS_OFFLOAD_WRITE_FILE_NOT_SUPPORTED", "", SeverityError},
+	{0xC000A2A5, "STATUS_WOF_WIM_HEADER_CORRUPT", "", SeverityError},
+	{0xC000A2A6, "STATUS_WOF_WIM_RESOURCE_TABLE_CORRUPT", "", SeverityError},
+	{0xC000A2A7, "STATUS_WOF_FILE_RESOURCE_TABLE_CORRUPT", "", SeverityError},
+	{0xC000CE01, "STATUS_FILE_SYSTEM_VIRTUALIZATION_UNAVAILABLE", "", SeverityError},
+	{0xC000CE02, "STATUS_FILE_SYSTEM_VIRTUALIZATION_METADATA_CORRUPT", "", SeverityError},
+	{0xC000CE03, "STATUS_FILE_SYSTEM_VIRTUALIZATION_BUSY", "", SeverityError},
+	{0xC000CE04, "STATUS_FILE_SYSTEM_VIRTUALIZATION_PROVIDER_UNKNOWN", "", SeverityError},
+	{0xC000CE05, "STATUS_FILE_SYSTEM_VIRTUALIZATION_INVALID_OPERATION", "", SeverityError},
+	{0xC000CF00, "STATUS_CLOUD_FILE_SYNC_ROOT_METADATA_CORRUPT", "", SeverityError},
+	{0xC000CF01, "STATUS_CLOUD_FILE_PROVIDER_NOT_RUNNING", "", SeverityError},
+	{0xC000CF02, "STATUS_CLOUD_FILE_METADATA_CORRUPT", "", SeverityError},
+	{0xC000CF03, "STATUS_CLOUD_FILE_METADATA_TOO_LARGE", "", SeverityError},
+	{0xC000CF06, "STATUS_CLOUD_FILE_PROPERTY_VERSION_NOT_SUPPORTED", "", SeverityError},
+	{0xC000CF07, "STATUS_NOT_A_CLOUD_FILE", "", SeverityError},
+	{0xC000CF08, "STATUS_CLOUD_FILE_NOT_IN_SYNC", "", SeverityError},
+	{0xC000CF09, "STATUS_CLOUD_FILE_ALREADY_CONNECTED", "", SeverityError},
+	{0xC000CF0A, "STATUS_CLOUD_FILE_NOT_SUPPORTED", "", SeverityError},
+	{0xC000CF0B, "STATUS_CLOUD_FILE_INVALID_REQUEST", "", SeverityError},
+	{0xC000CF0C, "STATUS_CLOUD_FILE_READ_ONLY_VOLUME", "", SeverityError},
+	{0xC000CF0D, "STATUS_CLOUD_FILE_CONNECTED_PROVIDER_ONLY", "", SeverityError},
+	{0xC000CF0E, "STATUS_CLOUD_FILE_VALIDATION_FAILED", "", SeverityError},
+	{0xC000CF0F, "STATUS_CLOUD_FILE_AUTHENTICATION_FAILED", "", SeverityError},
+	{0xC000CF10, "STATUS_CLOUD_FILE_INSUFFICIENT_RESOURCES", "", SeverityError},
+	{0xC000CF11, "STATUS_CLOUD_FILE_NETWORK_UNAVAILABLE", "", SeverityError},
+	{0xC000CF12, "STATUS_CLOUD_FILE_UNSUCCESSFUL", "", SeverityError},
+	{0xC000CF13, "STATUS_CLOUD_FILE_NOT_UNDER_SYNC_ROOT", "", SeverityError},
+	{0xC000CF14, "STATUS_CLOUD_FILE_IN_USE", "", SeverityError},
+	{0xC000CF15, "STATUS_CLOUD_FILE_PINNED", "", SeverityError},
+	{0xC000CF16, "STATUS_CLOUD_FILE_REQUEST_ABORTED", "", SeverityError},
+	{0xC000CF17, "STATUS_CLOUD_FILE_PROPERTY_CORRUPT", "", SeverityError},
+	{0xC000CF18, "STATUS_CLOUD_FILE_ACCESS_DENIED", "", SeverityError},
+	{0xC000CF19, "STATUS_CLOUD_FILE_INCOMPATIBLE_HARDLINKS", "", SeverityError},
+	{0xC000CF1A, "STATUS_CLOUD_FILE_PROPERTY_LOCK_CONFLICT", "", SeverityError},
+	{0xC000CF1B, "STATUS_CLOUD_FILE_REQUEST_CANCELED", "", SeverityError},
+	{0xC000CF1D, "STATUS_CLOUD_FILE_PROVIDER_TERMINATED", "", SeverityError},
+	{0xC000CF1E, "STATUS_NOT_A_CLOUD_SYNC_ROOT", "", SeverityError},
+	{0xC000CF1F, "STATUS_CLOUD_FILE_REQUEST_TIMEOUT", "", SeverityError},
+	{0xC0040035, "STATUS_PNP_BAD_MPS_TABLE", "", SeverityError},
+	{0xC0040036, "STATUS_PNP_TRANSLATION_FAILED", "", SeverityError},
+	{0xC0040037, "STATUS_PNP_IRQ_TRANSLATION_FAILED", "", SeverityError},
+	{0xC0040038, "STATUS_PNP_INVALID_ID", "", SeverityError},
+	{0xC0040039, "STATUS_IO_REISSUE_AS_CACHED", "", SeverityError},
+	{0xC00A0001, "STATUS_CTX_WINSTATION_NAME_INVALID", "", SeverityError},
+	{0xC00A0002, "STATUS_CTX_INVALID_PD", "", SeverityError},
+	{0xC00A0003, "STATUS_CTX_PD_NOT_FOUND", "", SeverityError},
+	{0xC00A0006, "STATUS_CTX_CLOSE_PENDING", "", SeverityError},
+	{0xC00A0007, "STATUS_CTX_NO_OUTBUF", "", SeverityError},
+	{0xC00A0008, "STATUS_CTX_MODEM_INF_NOT_FOUND", "", SeverityError},
+	{0xC00A0009, "STATUS_CTX_INVALID_MODEMNAME", "", SeverityError},
+	{0xC00A000A, "STATUS_CTX_RESPONSE_ERROR", "", SeverityError},
+	{0xC00A000B, "STATUS_CTX_MODEM_RESPONSE_TIMEOUT", "", SeverityError},
+	{0xC00A000C, "STATUS_CTX_MODEM_RESPONSE_NO_CARRIER", "", SeverityError},
+	{0xC00A000D, "STATUS_CTX_MODEM_RESPONSE_NO_DIALTONE", "", SeverityError},
+	{0xC00A000E, "STATUS_CTX_MODEM_RESPONSE_BUSY", "", SeverityError},
+	{0xC00A000F, "STATUS_CTX_MODEM_RESPONSE_VOICE", "", SeverityError},
+	{0xC00A0010, "STATUS_CTX_TD_ERROR", "", SeverityError},
+	{0xC00A0012, "STATUS_CTX_LICENSE_CLIENT_INVALID", "", SeverityError},
+	{0xC00A0013, "STATUS_CTX_LICENSE_NOT_AVAILABLE", "", SeverityError},
+	{0xC00A0014, "STATUS_CTX_LICENSE_EXPIRED", "", SeverityError},
+	{0xC00A0015, "STATUS_CTX_WINSTATION_NOT_FOUND", "", SeverityError},
+	{0xC00A0016, "STATUS_CTX_WINSTATION_NAME_COLLISION", "", SeverityError},
+	{0xC00A0017, "STATUS_CTX_WINSTATION_BUSY", "", SeverityError},
+	{0xC00A0018, "STATUS_CTX_BAD_VIDEO_MODE", "", SeverityError},
+	{0xC00A0022, "STATUS_CTX_GRAPHICS_INVALID", "", SeverityError},
+	{0xC00A0024, "STATUS_CTX_NOT_CONSOLE", "", SeverityError},
+	{0xC00A0026, "STATUS_CTX_CLIENT_QUERY_TIMEOUT", "", SeverityError},
+	{0xC00A0027, "STATUS_CTX_CONSOLE_DISCONNECT", "", SeverityError},
+	{0xC00A0028, "STATUS_CTX_CONSOLE_CONNECT", "", SeverityError},
+	{0xC00A002A, "STATUS_CTX_SHADOW_DENIED", "", SeverityError},
+	{0xC00A002B, "STATUS_CTX_WINSTATION_ACCESS_DENIED", "", SeverityError},
+	{0xC00A002E, "STATUS_CTX_INVALID_WD", "", SeverityError},
+	{0xC00A002F, "STATUS_CTX_WD_NOT_FOUND", "", SeverityError},
+	{0xC00A0030, "STATUS_CTX_SHADOW_INVALID", "", SeverityError},
+	{0xC00A0031, "STATUS_CTX_SHADOW_DISABLED", "", SeverityError},
+	{0xC00A0032, "STATUS_RDP_PROTOCOL_ERROR", "", SeverityError},
+	{0xC00A0033, "STATUS_CTX_CLIENT_LICENSE_NOT_SET", "", SeverityError},
+	{0xC00A0034, "STATUS_CTX_CLIENT_LICENSE_IN_USE", "", SeverityError},
+	{0xC00A0035, "STATUS_CTX_SHADOW_ENDED_BY_MODE_CHANGE", "", SeverityError},
+	{0xC00A0036, "STATUS_CTX_SHADOW_NOT_RUNNING", "", SeverityError},
+	{0xC00A0037, "STATUS_CTX_LOGON_DISABLED", "", SeverityError},
+	{0xC00A0038, "STATUS_CTX_SECURITY_LAYER_ERROR", "", SeverityError},
+	{0xC00A0039, "STATUS_TS_INCOMPATIBLE_SESSIONS", "", SeverityError},
+	{0xC00A003A, "STATUS_TS_VIDEO_SUBSYSTEM_ERROR", "", SeverityError},
+	{0xC00B0001, "STATUS_MUI_FILE_NOT_FOUND", "", SeverityError},
+	{0xC00B0002, "STATUS_MUI_INVALID_FILE", "", SeverityError},
+	{0xC00B0003, "STATUS_MUI_INVALID_RC_CONFIG", "", SeverityError},
+	{0xC00B0004, "STATUS_MUI_INVALID_LOCALE_NAME", "", SeverityError},
+	{0xC00B0005, "STATUS_MUI_INVALID_ULTIMATEFALLBACK_NAME", "", SeverityError},
+	{0xC00B0006, "STATUS_MUI_FILE_NOT_LOADED", "", SeverityError},
+	{0xC00B0007, "STATUS_RESOURCE_ENUM_USER_STOP", "", SeverityError},
+	{0xC0130001, "STATUS_CLUSTER_INVALID_NODE", "", SeverityError},
+	{0xC0130002, "STATUS_CLUSTER_NODE_EXISTS", "", SeverityError},
+	{0xC0130003, "STATUS_CLUSTER_JOIN_IN_PROGRESS", "", SeverityError},
+	{0xC0130004, "STATUS_CLUSTER_NODE_NOT_FOUND", "", SeverityError},
+	{0xC0130005, "STATUS_CLUSTER_LOCAL_NODE_NOT_FOUND", "", SeverityError},
+	{0xC0130006, "STATUS_CLUSTER_NETWORK_EXISTS", "", SeverityError},
+	{0xC0130007, "STATUS_CLUSTER_NETWORK_NOT_FOUND", "", SeverityError},
+	{0xC0130008, "STATUS_CLUSTER_NETINTERFACE_EXISTS", "", SeverityError},
+	{0xC0130009, "STATUS_CLUSTER_NETINTERFACE_NOT_FOUND", "", SeverityError},
+	{0xC013000A, "STATUS_CLUSTER_INVALID_REQUEST", "", SeverityError},
+	{0xC013000B, "STATUS_CLUSTER_INVALID_NETWORK_PROVIDER", "", SeverityError},
+	{0xC013000C, "STATUS_CLUSTER_NODE_DOWN", "", SeverityError},
+	{0xC013000D, "STATUS_CLUSTER_NODE_UNREACHABLE", "", SeverityError},
+	{0xC013000E, "STATUS_CLUSTER_NODE_NOT_MEMBER", "", SeverityError},
+	{0xC013000F, "STATUS_CLUSTER_JOIN_NOT_IN_PROGRESS", "", SeverityError},
+	{0xC0130010, "STATUS_CLUSTER_INVALID_NETWORK", "", SeverityError},
+	{0xC0130011, "STATUS_CLUSTER_NO_NET_ADAPTERS", "", SeverityError},
+	{0xC0130012, "STATUS_CLUSTER_NODE_UP", "", SeverityError},
+	{0xC0130013, "STATUS_CLUSTER_NODE_PAUSED", "", SeverityError},
+	{0xC0130014, "STATUS_CLUSTER_NODE_NOT_PAUSED", "", SeverityError},
+	{0xC0130015, "STATUS_CLUSTER_NO_SECURITY_CONTEXT", "", SeverityError},
+	{0xC0130016, "STATUS_CLUSTER_NETWORK_NOT_INTERNAL", "", SeverityError},
+	{0xC0130017, "STATUS_CLUSTER_POISONED", "", SeverityError},
+	{0xC0130018, "STATUS_CLUSTER_NON_CSV_PATH", "", SeverityError},
+	{0xC0130019, "STATUS_CLUSTER_CSV_VOLUME_NOT_LOCAL", "", SeverityError},
+	{0xC0130020, "STATUS_CLUSTER_CSV_READ_OPLOCK_BREAK_IN_PROGRESS", "", SeverityError},
+	{0xC0130021, "STATUS_CLUSTER_CSV_AUTO_PAUSE_ERROR", "", SeverityError},
+	{0xC0130022, "STATUS_CLUSTER_CSV_REDIRECTED", "", SeverityError},
+	{0xC0130023, "STATUS_CLUSTER_CSV_NOT_REDIRECTED", "", SeverityError},
+	{0xC0130024, "STATUS_CLUSTER_CSV_VOLUME_DRAINING", "", SeverityError},
+	{0xC0130025, "STATUS_CLUSTER_CSV_SNAPSHOT_CREATION_IN_PROGRESS", "", SeverityError},
+	{0xC0130026, "STATUS_CLUSTER_CSV_VOLUME_DRAINING_SUCCEEDED_DOWNLEVEL", "", SeverityError},
+	{0xC0130027, "STATUS_CLUSTER_CSV_NO_SNAPSHOTS", "", SeverityError},
+	{0xC0130028, "STATUS_CSV_IO_PAUSE_TIMEOUT", "", SeverityError},
+	{0xC0130029, "STATUS_CLUSTER_CSV_INVALID_HANDLE", "", SeverityError},
+	{0xC0130030, "STATUS_CLUSTER_CSV_SUPPORTED_ONLY_ON_COORDINATOR", "", SeverityError},
+	{0xC0130031, "STATUS_CLUSTER_CAM_TICKET_REPLAY_DETECTED", "", SeverityError},
+	{0xC0140001, "STATUS_ACPI_INVALID_OPCODE", "", SeverityError},
+	{0xC0140002, "STATUS_ACPI_STACK_OVERFLOW", "", SeverityError},
+	{0xC0140003, "STATUS_ACPI_ASSERT_FAILED", "", SeverityError},
+	{0xC0140004, "STATUS_ACPI_INVALID_INDEX", "", SeverityError},
+	{0xC0140005, "STATUS_ACPI_INVALID_ARGUMENT", "", SeverityError},
+	{0xC0140006, "STATUS_ACPI_FATAL", "", SeverityError},
+	{0xC0140007, "STATUS_ACPI_INVALID_SUPERNAME", "", SeverityError},
+	{0xC0140008, "STATUS_ACPI_INVALID_ARGTYPE", "", SeverityError},
+	{0xC0140009, "STATUS_ACPI_INVALID_OBJTYPE", "", SeverityError},
+	{0xC014000A, "STATUS_ACPI_INVALID_TARGETTYPE", "", SeverityError},
+	{0xC014000B, "STATUS_ACPI_INCORRECT_ARGUMENT_COUNT", "", SeverityError},
+	{0xC014000C, "STATUS_ACPI_ADDRESS_NOT_MAPPED", "", SeverityError},
+	{0xC014000D, "STATUS_ACPI_INVALID_EVENTTYPE", "", SeverityError},
+	{0xC014000E, "STATUS_ACPI_HANDLER_COLLISION", "", SeverityError},
+	{0xC014000F, "STATUS_ACPI_INVALID_DATA", "", SeverityError},
+	{0xC0140010, "STATUS_ACPI_INVALID_REGION", "", SeverityError},
+	{0xC0140011, "STATUS_ACPI_INVALID_ACCESS_SIZE", "", SeverityError},
+	{0xC0140012, "STATUS_ACPI_ACQUIRE_GLOBAL_LOCK", "", SeverityError},
+	{0xC0140013, "STATUS_ACPI_ALREADY_INITIALIZED", "", SeverityError},
+	{0xC0140014, "STATUS_ACPI_NOT_INITIALIZED", "", SeverityError},
+	{0xC0140015, "STATUS_ACPI_INVALID_MUTEX_LEVEL", "", SeverityError},
+	{0xC0140016, "STATUS_ACPI_MUTEX_NOT_OWNED", "", SeverityError},
+	{0xC0140017, "STATUS_ACPI_MUTEX_NOT_OWNER", "", SeverityError},
+	{0xC0140018, "STATUS_ACPI_RS_ACCESS", "", SeverityError},
+	{0xC0140019, "STATUS_ACPI_INVALID_TABLE", "", SeverityError},
+	{0xC0140020, "STATUS_ACPI_REG_HANDLER_FAILED", "", SeverityError},
+	{0xC0140021, "STATUS_ACPI_POWER_REQUEST_FAILED", "", SeverityError},
+	{0xC0150001, "STATUS_SXS_SECTION_NOT_FOUND", "", SeverityError},
+	{0xC0150002, "STATUS_SXS_CANT_GEN_ACTCTX", "", SeverityError},
+	{0xC0150003, "STATUS_SXS_INVALID_ACTCTXDATA_FORMAT", "", SeverityError},
+	{0xC0150004, "STATUS_SXS_ASSEMBLY_NOT_FOUND", "", SeverityError},
+	{0xC0150005, "STATUS_SXS_MANIFEST_FORMAT_ERROR", "", SeverityError},
+	{0xC0150006, "STATUS_SXS_MANIFEST_PARSE_ERROR", "", SeverityError},
+	{0xC0150007, "STATUS_SXS_ACTIVATION_CONTEXT_DISABLED", "", SeverityError},
+	{0xC0150008, "STATUS_SXS_KEY_NOT_FOUND", "", SeverityError},
+	{0xC0150009, "STATUS_SXS_VERSION_CONFLICT", "", SeverityError},
+	{0xC015000A, "STATUS_SXS_WRONG_SECTION_TYPE", "", SeverityError},
+	{0xC015000B, "STATUS_SXS_THREAD_QUERIES_DISABLED", "", SeverityError},
+	{0xC015000C, "STATUS_SXS_ASSEMBLY_MISSING", "", SeverityError},
+	{0xC015000E, "STATUS_SXS_PROCESS_DEFAULT_ALREADY_SET", "", SeverityError},
+	{0xC015000F, "STATUS_SXS_EARLY_DEACTIVATION", "", SeverityError},
+	{0xC0150010, "STATUS_SXS_INVALID_DEACTIVATION", "", SeverityError},
+	{0xC0150011, "STATUS_SXS_MULTIPLE_DEACTIVATION", "", SeverityError},
+	{0xC0150012, "STATUS_SXS_SYSTEM_DEFAULT_ACTIVATION_CONTEXT_EMPTY", "", SeverityError},
+	{0xC0150013, "STATUS_SXS_PROCESS_TERMINATION_REQUESTED", "", SeverityError},
+	{0xC0150014, "STATUS_SXS_CORRUPT_ACTIVATION_STACK", "", SeverityError},
+	{0xC0150015, "STATUS_SXS_CORRUPTION", "", SeverityError},
+	{0xC0150016, "STATUS_SXS_INVALID_IDENTITY_ATTRIBUTE_VALUE", "", SeverityError},
+	{0xC0150017, "STATUS_SXS_INVALID_IDENTITY_ATTRIBUTE_NAME", "", SeverityError},
+	{0xC0150018, "STATUS_SXS_IDENTITY_DUPLICATE_ATTRIBUTE", "", SeverityError},
+	{0xC0150019, "STATUS_SXS_IDENTITY_PARSE_ERROR", "", SeverityError},
+	{0xC015001A, "STATUS_SXS_COMPONENT_STORE_CORRUPT", "", SeverityError},
+	{0xC015001B, "STATUS_SXS_FILE_HASH_MISMATCH", "", SeverityError},
+	{0xC015001C, "STATUS_SXS_MANIFEST_IDENTITY_SAME_BUT_CONTENTS_DIFFERENT", "", SeverityError},
+	{0xC015001D, "STATUS_SXS_IDENTITIES_DIFFERENT", "", SeverityError},
+	{0xC015001E, "STATUS_SXS_ASSEMBLY_IS_NOT_A_DEPLOYMENT", "", SeverityError},
+	{0xC015001F, "STATUS_SXS_FILE_NOT_PART_OF_ASSEMBLY", "", SeverityError},
+	{0xC0150020, "STATUS_ADVANCED_INSTALLER_FAILED", "", SeverityError},
+	{0xC0150021, "STATUS_XML_ENCODING_MISMATCH", "", SeverityError},
+	{0xC0150022, "STATUS_SXS_MANIFEST_TOO_BIG", "", SeverityError},
+	{0xC0150023, "STATUS_SXS_SETTING_NOT_REGISTERED", "", SeverityError},
+	{0xC0150024, "STATUS_SXS_TRANSACTION_CLOSURE_INCOMPLETE", "", SeverityError},
+	{0xC0150025, "STATUS_SMI_PRIMITIVE_INSTALLER_FAILED", "", SeverityError},
+	{0xC0150026, "STATUS_GENERIC_COMMAND_FAILED", "", SeverityError},
+	{0xC0150027, "STATUS_SXS_FILE_HASH_MISSING", "", SeverityError},
+	{0xC0190001, "STATUS_TRANSACTIONAL_CONFLICT", "", SeverityError},
+	{0xC0190002, "STATUS_INVALID_TRANSACTION", "", SeverityError},
+	{0xC0190003, "STATUS_TRANSACTION_NOT_ACTIVE", "", SeverityError},
+	{0xC0190004, "STATUS_TM_INITIALIZATION_FAILED", "", SeverityError},
+	{0xC0190005, "STATUS_RM_NOT_ACTIVE", "", SeverityError},
+	{0xC0190006, "STATUS_RM_METADATA_CORRUPT", "", SeverityError},
+	{0xC0190007, "STATUS_TRANSACTION_NOT_JOINED", "", SeverityError},
+	{0xC0190008, "STATUS_DIRECTORY_NOT_RM", "", SeverityError},
+	{0xC019000A, "STATUS_TRANSACTIONS_UNSUPPORTED_REMOTE", "", SeverityError},
+	{0xC019000B, "STATUS_LOG_RESIZE_INVALID_SIZE", "", SeverityError},
+	{0xC019000C, "STATUS_REMOTE_FILE_VERSION_MISMATCH", "", SeverityError},
+	{0xC019000F, "STATUS_CRM_PROTOCOL_ALREADY_EXISTS", "", SeverityError},
+	{0xC0190010, "STATUS_TRANSACTION_PROPAGATION_FAILED", "", SeverityError},
+	{0xC0190011, "STATUS_CRM_PROTOCOL_NOT_FOUND", "", SeverityError},
+	{0xC0190012, "STATUS_TRANSACTION_SUPERIOR_EXISTS", "", SeverityError},
+	{0xC0190013, "STATUS_TRANSACTION_REQUEST_NOT_VALID", "", SeverityError},
+	{0xC0190014, "STATUS_TRANSACTION_NOT_REQUESTED", "", SeverityError},
+	{0xC0190015, "STATUS_TRANSACTION_ALREADY_ABORTED", "", SeverityError},
+	{0xC0190016, "STATUS_TRANSACTION_ALREADY_COMMITTED", "", SeverityError},
+	{0xC0190017, "STATUS_TRANSACTION_INVALID_MARSHALL_BUFFER", "", SeverityError},
+	{0xC0190018, "STATUS_CURRENT_TRANSACTION_NOT_VALID", "", SeverityError},
+	{0xC0190019, "STATUS_LOG_GROWTH_FAILED", "", SeverityError},
+	{0xC0190021, "STATUS_OBJECT_NO_LONGER_EXISTS", "", SeverityError},
+	{0xC0190022, "STATUS_STREAM_MINIVERSION_NOT_FOUND", "", SeverityError},
+	{0xC0190023, "STATUS_STREAM_MINIVERSION_NOT_VALID", "", SeverityError},
+	{0xC0190024, "STATUS_MINIVERSION_INACCESSIBLE_FROM_SPECIFIED_TRANSACTION", "", SeverityError},
+	{0xC0190025, "STATUS_CANT_OPEN_MINIVERSION_WITH_MODIFY_INTENT", "", SeverityError},
+	{0xC0190026, "STATUS_CANT_CREATE_MORE_STREAM_MINIVERSIONS", "", SeverityError},
+	{0xC0190028, "STATUS_HANDLE_NO_LONGER_VALID", "", SeverityError},
+	{0xC0190030, "STATUS_LOG_CORRUPTION_DETECTED", "", SeverityError},
+	{0xC0190032, "STATUS_RM_DISCONNECTED", "", SeverityError},
+	{0xC0190033, "STATUS_ENLISTMENT_NOT_SUPERIOR", "", SeverityError},
+	{0xC0190036, "STATUS_FILE_IDENTITY_NOT_PERSISTENT", "", SeverityError},
+	{0xC0190037, "STATUS_CANT_BREAK_TRANSACTIONAL_DEPENDENCY", "", SeverityError},
+	{0xC0190038, "STATUS_CANT_CROSS_RM_BOUNDARY", "", SeverityError},
+	{0xC0190039, "STATUS_TXF_DIR_NOT_EMPTY", "", SeverityError},
+	{0xC019003A, "STATUS_INDOUBT_TRANSACTIONS_EXIST", "", SeverityError},
+	{0xC019003B, "STATUS_TM_VOLATILE", "", SeverityError},
+	{0xC019003C, "STATUS_ROLLBACK_TIMER_EXPIRED", "", SeverityError},
+	{0xC019003D, "STATUS_TXF_ATTRIBUTE_CORRUPT", "", SeverityError},
+	{0xC019003E, "STATUS_EFS_NOT_ALLOWED_IN_TRANSACTION", "", SeverityError},
+	{0xC019003F, "STATUS_TRANSACTIONAL_OPEN_NOT_ALLOWED", "", SeverityError},
+	{0xC0190040, "STATUS_TRANSACTED_MAPPING_UNSUPPORTED_REMOTE", "", SeverityError},
+	{0xC0190043, "STATUS_TRANSACTION_REQUIRED_PROMOTION", "", SeverityError},
+	{0xC0190044, "STATUS_CANNOT_EXECUTE_FILE_IN_TRANSACTION", "", SeverityError},
+	{0xC0190045, "STATUS_TRANSACTIONS_NOT_FROZEN", "", SeverityError},
+	{0xC0190046, "STATUS_TRANSACTION_FREEZE_IN_PROGRESS", "", SeverityError},
+	{0xC0190047, "STATUS_NOT_SNAPSHOT_VOLUME", "", SeverityError},
+	{0xC0190048, "STATUS_NO_SAVEPOINT_WITH_OPEN_FILES", "", SeverityError},
+	{0xC0190049, "STATUS_SPARSE_NOT_ALLOWED_IN_TRANSACTION", "", SeverityError},
+	{0xC019004A, "STATUS_TM_IDENTITY_MISMATCH", "", SeverityError},
+	{0xC019004B, "STATUS_FLOATED_SECTION", "", SeverityError},
+	{0xC019004C, "STATUS_CANNOT_ACCEPT_TRANSACTED_WORK", "", SeverityError},
+	{0xC019004D, "STATUS_CANNOT_ABORT_TRANSACTIONS", "", SeverityError},
+	{0xC019004E, "STATUS_TRANSACTION_NOT_FOUND", "", SeverityError},
+	{0xC019004F, "STATUS_RESOURCEMANAGER_NOT_FOUND", "", SeverityError},
+	{0xC0190050, "STATUS_ENLISTMENT_NOT_FOUND", "", SeverityError},
+	{0xC0190051, "STATUS_TRANSACTIONMANAGER_NOT_FOUND", "", SeverityError},
+	{0xC0190052, "STATUS_TRANSACTIONMANAGER_NOT_ONLINE", "", SeverityError},
+	{0xC0190053, "STATUS_TRANSACTIONMANAGER_RECOVERY_NAME_COLLISION", "", SeverityError},
+	{0xC0190054, "STATUS_TRANSACTION_NOT_ROOT", "", SeverityError},
+	{0xC0190055, "STATUS_TRANSACTION_OBJECT_EXPIRED", "", SeverityError},
+	{0xC0190056, "STATUS_COMPRESSION_NOT_ALLOWED_IN_TRANSACTION", "", SeverityError},
+	{0xC0190057, "STATUS_TRANSACTION_RESPONSE_NOT_ENLISTED", "", SeverityError},
+	{0xC0190058, "STATUS_TRANSACTION_RECORD_TOO_LONG", "", SeverityError},
+	{0xC0190059, "STATUS_NO_LINK_TRACKING_IN_TRANSACTION", "", SeverityError},
+	{0xC019005A, "STATUS_OPERATION_NOT_SUPPORTED_IN_TRANSACTION", "", SeverityError},
+	{0xC019005B, "STATUS_TRANSACTION_INTEGRITY_VIOLATED", "", SeverityError},
+	{0xC019005C, "STATUS_TRANSACTIONMANAGER_IDENTITY_MISMATCH", "", SeverityError},
+	{0xC019005D, "STATUS_RM_CANNOT_BE_FROZEN_FOR_SNAPSHOT", "", SeverityError},
+	{0xC019005E, "STATUS_TRANSACTION_MUST_WRITETHROUGH", "", SeverityError},
+	{0xC019005F, "STATUS_TRANSACTION_NO_SUPERIOR", "", SeverityError},
+	{0xC0190060, "STATUS_EXPIRED_HANDLE", "", SeverityError},
+	{0xC0190061, "STATUS_TRANSACTION_NOT_ENLISTED", "", SeverityError},
+	{0xC01A0001, "STATUS_LOG_SECTOR_INVALID", "", SeverityError},
+	{0xC01A0002, "STATUS_LOG_SECTOR_PARITY_INVALID", "", SeverityError},
+	{0xC01A0003, "STATUS_LOG_SECTOR_REMAPPED", "", SeverityError},
+	{0xC01A0004, "STATUS_LOG_BLOCK_INCOMPLETE", "", SeverityError},
+	{0xC01A0005, "STATUS_LOG_INVALID_RANGE", "", SeverityError},
+	{0xC01A0006, "STATUS_LOG_BLOCKS_EXHAUSTED", "", SeverityError},
+	{0xC01A0007, "STATUS_LOG_READ_CONTEXT_INVALID", "", SeverityError},
+	{0xC01A0008, "STATUS_LOG_RESTART_INVALID", "", SeverityError},
+	{0xC01A0009, "STATUS_LOG_BLOCK_VERSION", "", SeverityError},
+	{0xC01A000A, "STATUS_LOG_BLOCK_INVALID", "", SeverityError},
+	{0xC01A000B, "STATUS_LOG_READ_MODE_INVALID", "", SeverityError},
+	{0xC01A000D, "STATUS_LOG_METADATA_CORRUPT", "", SeverityError},
+	{0xC01A000E, "STATUS_LOG_METADATA_INVALID", "", SeverityError},
+	{0xC01A000F, "STATUS_LOG_METADATA_INCONSISTENT", "", SeverityError},
+	{0xC01A0010, "STATUS_LOG_RESERVATION_INVALID", "", SeverityError},
+	{0xC01A0011, "STATUS_LOG_CANT_DELETE", "", SeverityError},
+	{0xC01A0012, "STATUS_LOG_CONTAINER_LIMIT_EXCEEDED", "", SeverityError},
+	{0xC01A0013, "STATUS_LOG_START_OF_LOG", "", SeverityError},
+	{0xC01A0014, "STATUS_LOG_POLICY_ALREADY_INSTALLED", "", SeverityError},
+	{0xC01A0015, "STATUS_LOG_POLICY_NOT_INSTALLED", "", SeverityError},
+	{0xC01A0016, "STATUS_LOG_POLICY_INVALID", "", SeverityError},
+	{0xC01A0017, "STATUS_LOG_POLICY_CONFLICT", "", SeverityError},
+	{0xC01A0018, "STATUS_LOG_PINNED_ARCHIVE_TAIL", "", SeverityError},
+	{0xC01A0019, "STATUS_LOG_RECORD_NONEXISTENT", "", SeverityError},
+	{0xC01A001A, "STATUS_LOG_RECORDS_RESERVED_INVALID", "", SeverityError},
+	{0xC01A001B, "STATUS_LOG_SPACE_RESERVED_INVALID", "", SeverityError},
+	{0xC01A001C, "STATUS_LOG_TAIL_INVALID", "", SeverityError},
+	{0xC01A001D, "STATUS_LOG_FULL", "", SeverityError},
+	{0xC01A001E, "STATUS_LOG_MULTIPLEXED", "", SeverityError},
+	{0xC01A001F, "STATUS_LOG_DEDICATED", "", SeverityError},
+	{0xC01A0020, "STATUS_LOG_ARCHIVE_NOT_IN_PROGRESS", "", SeverityError},
+	{0xC01A0021, "STATUS_LOG_ARCHIVE_IN_PROGRESS", "", SeverityError},
+	{0xC01A0022, "STATUS_LOG_EPHEMERAL", "", SeverityError},
+	{0xC01A0023, "STATUS_LOG_NOT_ENOUGH_CONTAINERS", "", SeverityError},
+	{0xC01A0024, "STATUS_LOG_CLIENT_ALREADY_REGISTERED", "", SeverityError},
+	{0xC01A0025, "STATUS_LOG_CLIENT_NOT_REGISTERED", "", SeverityError},
+	{0xC01A0026, "STATUS_LOG_FULL_HANDLER_IN_PROGRESS", "", SeverityError},
+	{0xC01A0027, "STATUS_LOG_CONTAINER_READ_FAILED", "", SeverityError},
+	{0xC01A0028, "STATUS_LOG_CONTAINER_WRITE_FAILED", "", SeverityError},
+	{0xC01A0029, "STATUS_LOG_CONTAINER_OPEN_FAILED", "", SeverityError},
+	{0xC01A002A, "STATUS_LOG_CONTAINER_STATE_INVALID", "", SeverityError},
+	{0xC01A002B, "STATUS_LOG_STATE_INVALID", "", SeverityError},
+	{0xC01A002C, "STATUS_LOG_PINNED", "", SeverityError},
+	{0xC01A002D, "STATUS_LOG_METADATA_FLUSH_FAILED", "", SeverityError},
+	{0xC01A002E, "STATUS_LOG_INCONSISTENT_SECURITY", "", SeverityError},
+	{0xC01A002F, "STATUS_LOG_APPENDED_FLUSH_FAILED", "", SeverityError},
+	{0xC01A0030, "STATUS_LOG_PINNED_RESERVATION", "", SeverityError},
+	{0xC01B00EA, "STATUS_VIDEO_HUNG_DISPLAY_DRIVER_THREAD", "", SeverityError},
+	{0xC01C0001, "STATUS_FLT_NO_HANDLER_DEFINED", "", SeverityError},
+	{0xC01C0002, "STATUS_FLT_CONTEXT_ALREADY_DEFINED", "", SeverityError},
+	{0xC01C0003, "STATUS_FLT_INVALID_ASYNCHRONOUS_REQUEST", "", SeverityError},
+	{0xC01C0004, "STATUS_FLT_DISALLOW_FAST_IO", "", SeverityError},
+	{0xC01C0005, "STATUS_FLT_INVALID_NAME_REQUEST", "", SeverityError},
+	{0xC01C0006, "STATUS_FLT_NOT_SAFE_TO_POST_OPERATION", "", SeverityError},
+	{0xC01C0007, "STATUS_FLT_NOT_INITIALIZED", "", SeverityError},
+	{0xC01C0008, "STATUS_FLT_FILTER_NOT_READY", "", SeverityError},
+	{0xC01C0009, "STATUS_FLT_POST_OPERATION_CLEANUP", "", SeverityError},
+	{0xC01C000A, "STATUS_FLT_INTERNAL_ERROR", "", SeverityError},
+	{0xC01C000B, "STATUS_FLT_DELETING_OBJECT", "", SeverityError},
+	{0xC01C000C, "STATUS_FLT_MUST_BE_NONPAGED_POOL", "", SeverityError},
+	{0xC01C000D, "STATUS_FLT_DUPLICATE_ENTRY", "", SeverityError},
+	{0xC01C000E, "STATUS_FLT_CBDQ_DISABLED", "", SeverityError},
+	{0xC01C000F, "STATUS_FLT_DO_NOT_ATTACH", "", SeverityError},
+	{0xC01C0010, "STATUS_FLT_DO_NOT_DETACH", "", SeverityError},
+	{0xC01C0011, "STATUS_FLT_INSTANCE_ALTITUDE_COLLISION", "", SeverityError},
+	{0xC01C0012, "STATUS_FLT_INSTANCE_NAME_COLLISION", "", SeverityError},
+	{0xC01C0013, "STATUS_FLT_FILTER_NOT_FOUND", "", SeverityError},
+	{0xC01C0014, "STATUS_FLT_VOLUME_NOT_FOUND", "", SeverityError},
+	{0xC01C0015, "STATUS_FLT_INSTANCE_NOT_FOUND", "", SeverityError},
+	{0xC01C0016, "STATUS_FLT_CONTEXT_ALLOCATION_NOT_FOUND", "", SeverityError},
+	{0xC01C0017, "STATUS_FLT_INVALID_CONTEXT_REGISTRATION", "", SeverityError},
+	{0xC01C0018, "STATUS_FLT_NAME_CACHE_MISS", "", SeverityError},
+	{0xC01C0019, "STATUS_FLT_NO_DEVICE_OBJECT", "", SeverityError},
+	{0xC01C001A, "STATUS_FLT_VOLUME_ALREADY_MOUNTED", "", SeverityError},
+	{0xC01C001B, "STATUS_FLT_ALREADY_ENLISTED", "", SeverityError},
+	{0xC01C001C, "STATUS_FLT_CONTEXT_ALREADY_LINKED", "", SeverityError},
+	{0xC01C0020, "STATUS_FLT_NO_WAITER_FOR_REPLY", "", SeverityError},
+	{0xC01C0023, "STATUS_FLT_REGISTRATION_BUSY", "", SeverityError},
+	{0xC01D0001, "STATUS_MONITOR_NO_DESCRIPTOR", "", SeverityError},
+	{0xC01D0002, "STATUS_MONITOR_UNKNOWN_DESCRIPTOR_FORMAT", "", SeverityError},
+	{0xC01D0003, "STATUS_MONITOR_INVALID_DESCRIPTOR_CHECKSUM", "", SeverityError},
+	{0xC01D0004, "STATUS_MONITOR_INVALID_STANDARD_TIMING_BLOCK", "", SeverityError},
+	{0xC01D0005, "STATUS_MONITOR_WMI_DATABLOCK_REGISTRATION_FAILED", "", SeverityError},
+	{0xC01D0006, "STATUS_MONITOR_INVALID_SERIAL_NUMBER_MONDSC_BLOCK", "", SeverityError},
+	{0xC01D0007, "STATUS_MONITOR_INVALID_USER_FRIENDLY_MONDSC_BLOCK", "", SeverityError},
+	{0xC01D0008, "STATUS_MONITOR_NO_MORE_DESCRIPTOR_DATA", "", SeverityError},
+	{0xC01D0009, "STATUS_MONITOR_INVALID_DETAILED_TIMING_BLOCK", "", SeverityError},
+	{0xC01D000A, "STATUS_MONITOR_INVALID_MANUFACTURE_DATE", "", SeverityError},
+	{0xC01E0000, "STATUS_GRAPHICS_NOT_EXCLUSIVE_MODE_OWNER", "", SeverityError},
+	{0xC01E0001, "STATUS_GRAPHICS_INSUFFICIENT_DMA_BUFFER", "", SeverityError},
+	{0xC01E0002, "STATUS_GRAPHICS_INVALID_DISPLAY_ADAPTER", "", SeverityError},
+	{0xC01E0003, "STATUS_GRAPHICS_ADAPTER_WAS_RESET", "", SeverityError},
+	{0xC01E0004, "STATUS_GRAPHICS_INVALID_DRIVER_MODEL", "", SeverityError},
+	{0xC01E0005, "STATUS_GRAPHICS_PRESENT_MODE_CHANGED", "", SeverityError},
+	{0xC01E0006, "STATUS_GRAPHICS_PRESENT_OCCLUDED", "", SeverityError},
+	{0xC01E0007, "STATUS_GRAPHICS_PRESENT_DENIED", "", SeverityError},
+	{0xC01E0008, "STATUS_GRAPHICS_CANNOTCOLORCONVERT", "", SeverityError},
+	{0xC01E0009, "STATUS_GRAPHICS_DRIVER_MISMATCH", "", SeverityError},
+	{0xC01E000B, "STATUS_GRAPHICS_PRESENT_REDIRECTION_DISABLED", "", SeverityError},
+	{0xC01E000C, "STATUS_GRAPHICS_PRESENT_UNOCCLUDED", "", SeverityError},
+	{0xC01E000D, "STATUS_GRAPHICS_WINDOWDC_NOT_AVAILABLE", "", SeverityError},
+	{0xC01E000E, "STATUS_GRAPHICS_WINDOWLESS_PRESENT_DISABLED", "", SeverityError},
+	{0xC01E000F, "STATUS_GRAPHICS_PRESENT_INVALID_WINDOW", "", SeverityError},
+	{0xC01E0010, "STATUS_GRAPHICS_PRESENT_BUFFER_NOT_BOUND", "", SeverityError},
+	{0xC01E0011, "STATUS_GRAPHICS_VAIL_STATE_CHANGED", "", SeverityError},
+	{0xC01E0012, "STATUS_GRAPHICS_INDIRECT_DISPLAY_ABANDON_SWAPCHAIN", "", SeverityError},
+	{0xC01E0013, "STATUS_GRAPHICS_INDIRECT_DISPLAY_DEVICE_STOPPED", "", SeverityError},
+	{0xC01E0100, "STATUS_GRAPHICS_NO_VIDEO_MEMORY", "", SeverityError},
+	{0xC01E0101, "STATUS_GRAPHICS_CANT_LOCK_MEMORY", "", SeverityError},
+	{0xC01E0102, "STATUS_GRAPHICS_ALLOCATION_BUSY", "", SeverityError},
+	{0xC01E0103, "STATUS_GRAPHICS_TOO_MANY_REFERENCES", "", SeverityError},
+	{0xC01E0104, "STATUS_GRAPHICS_TRY_AGAIN_LATER", "", SeverityError},
+	{0xC01E0105, "STATUS_GRAPHICS_TRY_AGAIN_NOW", "", SeverityError},
+	{0xC01E0106, "STATUS_GRAPHICS_ALLOCATION_INVALID", "", SeverityError},
+	{0xC01E0107, "STATUS_GRAPHICS_UNSWIZZLING_APERTURE_UNAVAILABLE", "", SeverityError},
+	{0xC01E0108, "STATUS_GRAPHICS_UNSWIZZLING_APERTURE_UNSUPPORTED", "", SeverityError},
+	{0xC01E0109, "STATUS_GRAPHICS_CANT_EVICT_PINNED_ALLOCATION", "", SeverityError},
+	{0xC01E0110, "STATUS_GRAPHICS_INVALID_ALLOCATION_USAGE", "", SeverityError},
+	{0xC01E0111, "STATUS_GRAPHICS_CANT_RENDER_LOCKED_ALLOCATION", "", SeverityError},
+	{0xC01E0112, "STATUS_GRAPHICS_ALLOCATION_CLOSED", "", SeverityError},
+	{0xC01E0113, "STATUS_GRAPHICS_INVALID_ALLOCATION_INSTANCE", "", SeverityError},
+	{0xC01E0114, "STATUS_GRAPHICS_INVALID_ALLOCATION_HANDLE", "", SeverityError},
+	{0xC01E0115, "STATUS_GRAPHICS_WRONG_ALLOCATION_DEVICE", "", SeverityError},
+	{0xC01E0116, "STATUS_GRAPHICS_ALLOCATION_CONTENT_LOST", "", SeverityError},
+	{0xC01E0200, "STATUS_GRAPHICS_GPU_EXCEPTION_ON_DEVICE", "", SeverityError},
+	{0xC01E0300, "STATUS_GRAPHICS_INVALID_VIDPN_TOPOLOGY", "", SeverityError},
+	{0xC01E0301, "STATUS_GRAPHICS_VIDPN_TOPOLOGY_NOT_SUPPORTED", "", SeverityError},
+	{0xC01E0302, "STATUS_GRAPHICS_VIDPN_TOPOLOGY_CURRENTLY_NOT_SUPPORTED", "", SeverityError},
+	{0xC01E0303, "STATUS_GRAPHICS_INVALID_VIDPN", "", SeverityError},
+	{0xC01E0304, "STATUS_GRAPHICS_INVALID_VIDEO_PRESENT_SOURCE", "", SeverityError},
+	{0xC01E0305, "STATUS_GRAPHICS_INVALID_VIDEO_PRESENT_TARGET", "", SeverityError},
+	{0xC01E0306, "STATUS_GRAPHICS_VIDPN_MODALITY_NOT_SUPPORTED", "", SeverityError},
+	{0xC01E0308, "STATUS_GRAPHICS_INVALID_VIDPN_SOURCEMODESET", "", SeverityError},
+	{0xC01E0309, "STATUS_GRAPHICS_INVALID_VIDPN_TARGETMODESET", "", SeverityError},
+	{0xC01E030A, "STATUS_GRAPHICS_INVALID_FREQUENCY", "", SeverityError},
+	{0xC01E030B, "STATUS_GRAPHICS_INVALID_ACTIVE_REGION", "", SeverityError},
+	{0xC01E030C, "STATUS_GRAPHICS_INVALID_TOTAL_REGION", "", SeverityError},
+	{0xC01E0310, "STATUS_GRAPHICS_INVALID_VIDEO_PRESENT_SOURCE_MODE", "", SeverityError},
+	{0xC01E0311, "STATUS_GRAPHICS_INVALID_VIDEO_PRESENT_TARGET_MODE", "", SeverityError},
+	{0xC01E0312, "STATUS_GRAPHICS_PINNED_MODE_MUST_REMAIN_IN_SET", "", SeverityError},
+	{0xC01E0313, "STATUS_GRAPHICS_PATH_ALREADY_IN_TOPOLOGY", "", SeverityError},
+	{0xC01E0314, "STATUS_GRAPHICS_MODE_ALREADY_IN_MODESET", "", SeverityError},
+	{0xC01E0315, "STATUS_GRAPHICS_INVALID_VIDEOPRESENTSOURCESET", "", SeverityError},
+	{0xC01E0316, "STATUS_GRAPHICS_INVALID_VIDEOPRESENTTARGETSET", "", SeverityError},
+	{0xC01E0317, "STATUS_GRAPHICS_SOURCE_ALREADY_IN_SET", "", SeverityError},
+	{0xC01E0318, "STATUS_GRAPHICS_TARGET_ALREADY_IN_SET", "", SeverityError},
+	{0xC01E0319, "STATUS_GRAPHICS_INVALID_VIDPN_PRESENT_PATH", "", SeverityError},
+	{0xC01E031A, "STATUS_GRAPHICS_NO_RECOMMENDED_VIDPN_TOPOLOGY", "", SeverityError},
+	{0xC01E031B, "STATUS_GRAPHICS_INVALID_MONITOR_FREQUENCYRANGESET", "", SeverityError},
+	{0xC01E031C, "STATUS_GRAPHICS_INVALID_MONITOR_FREQUENCYRANGE", "", SeverityError},
+	{0xC01E031D, "STATUS_GRAPHICS_FREQUENCYRANGE_NOT_IN_SET", "", SeverityError},
+	{0xC01E031F, "STATUS_GRAPHICS_FREQUENCYRANGE_ALREADY_IN_SET", "", SeverityError},
+	{0xC01E0320, "STATUS_GRAPHICS_STALE_MODESET", "", SeverityError},
+	{0xC01E0321, "STATUS_GRAPHICS_INVALID_MONITOR_SOURCEMODESET", "", SeverityError},
+	{0xC01E0322, "STATUS_GRAPHICS_INVALID_MONITOR_SOURCE_MODE", "", SeverityError},
+	{0xC01E0323, "STATUS_GRAPHICS_NO_RECOMMENDED_FUNCTIONAL_VIDPN", "", SeverityError},
+	{0xC01E0324, "STATUS_GRAPHICS_MODE_ID_MUST_BE_UNIQUE", "", SeverityError},
+	{0xC01E0325, "STATUS_GRAPHICS_EMPTY_ADAPTER_MONITOR_MODE_SUPPORT_INTERSECTION", "", SeverityError},
+	{0xC01E0326, "STATUS_GRAPHICS_VIDEO_PRESENT_TARGETS_LESS_THAN_SOURCES", "", SeverityError},
+	{0xC01E0327, "STATUS_GRAPHICS_PATH_NOT_IN_TOPOLOGY", "", SeverityError},
+	{0xC01E0328, "STATUS_GRAPHICS_ADAPTER_MUST_HAVE_AT_LEAST_ONE_SOURCE", "", SeverityError},
+	{0xC01E0329, "STATUS_GRAPHICS_ADAPTER_MUST_HAVE_AT_LEAST_ONE_TARGET", "", SeverityError},
+	{0xC01E032A, "STATUS_GRAPHICS_INVALID_MONITORDESCRIPTORSET", "", SeverityError},
+	{0xC01E032B, "STATUS_GRAPHICS_INVALID_MONITORDESCRIPTOR", "", SeverityError},
+	{0xC01E032C, "STATUS_GRAPHICS_MONITORDESCRIPTOR_NOT_IN_SET", "", SeverityError},
+	{0xC01E032D, "STATUS_GRAPHICS_MONITORDESCRIPTOR_ALREADY_IN_SET", "", SeverityError},
+	{0xC01E032E, "STATUS_GRAPHICS_MONITORDESCRIPTOR_ID_MUST_BE_UNIQUE", "", SeverityError},
+	{0xC01E032F, "STATUS_GRAPHICS_INVALID_VIDPN_TARGET_SUBSET_TYPE", "", SeverityError},
+	{0xC01E0330, "STATUS_GRAPHICS_RESOURCES_NOT_RELATED", "", SeverityError},
+	{0xC01E0331, "STATUS_GRAPHICS_SOURCE_ID_MUST_BE_UNIQUE", "", SeverityError},
+	{0xC01E0332, "STATUS_GRAPHICS_TARGET_ID_MUST_BE_UNIQUE", "", SeverityError},
+	{0xC01E0333, "STATUS_GRAPHICS_NO_AVAILABLE_VIDPN_TARGET", "", SeverityError},
+	{0xC01E0334, "STATUS_GRAPHICS_MONITOR_COULD_NOT_BE_ASSOCIATED_WITH_ADAPTER", "", SeverityError},
+	{0xC01E0335, "STATUS_GRAPHICS_NO_VIDPNMGR", "", SeverityError},
+	{0xC01E0336, "STATUS_GRAPHICS_NO_ACTIVE_VIDPN", "", SeverityError},
+	{0xC01E0337, "STATUS_GRAPHICS_STALE_VIDPN_TOPOLOGY", "", SeverityError},
+	{0xC01E0338, "STATUS_GRAPHICS_MONITOR_NOT_CONNECTED", "", SeverityError},
+	{0xC01E0339, "STATUS_GRAPHICS_SOURCE_NOT_IN_TOPOLOGY", "", SeverityError},
+	{0xC01E033A, "STATUS_GRAPHICS_INVALID_PRIMARYSURFACE_SIZE", "", SeverityError},
+	{0xC01E033B, "STATUS_GRAPHICS_INVALID_VISIBLEREGION_SIZE", "", SeverityError},
+	{0xC01E033C, "STATUS_GRAPHICS_INVALID_STRIDE", "", SeverityError},
+	{0xC01E033D, "STATUS_GRAPHICS_INVALID_PIXELFORMAT", "", SeverityError},
+	{0xC01E033E, "STATUS_GRAPHICS_INVALID_COLORBASIS", "", SeverityError},
+	{0xC01E033F, "STATUS_GRAPHICS_INVALID_PIXELVALUEACCESSMODE", "", SeverityError},
+	{0xC01E0340, "STATUS_GRAPHICS_TARGET_NOT_IN_TOPOLOGY", "", SeverityError},
+	{0xC01E0341, "STATUS_GRAPHICS_NO_DISPLAY_MODE_MANAGEMENT_SUPPORT", "", SeverityError},
+	{0xC01E0342, "STATUS_GRAPHICS_VIDPN_SOURCE_IN_USE", "", SeverityError},
+	{0xC01E0343, "STATUS_GRAPHICS_CANT_ACCESS_ACTIVE_VIDPN", "", SeverityError},
+	{0xC01E0344, "STATUS_GRAPHICS_INVALID_PATH_IMPORTANCE_ORDINAL", "", SeverityError},
+	{0xC01E0345, "STATUS_GRAPHICS_INVALID_PATH_CONTENT_GEOMETRY_TRANSFORMATION", "", SeverityError},
+	{0xC01E0346, "STATUS_GRAPHICS_PATH_CONTENT_GEOMETRY_TRANSFORMATION_NOT_SUPPORTED", "", SeverityError},
+	{0xC01E0347, "STATUS_GRAPHICS_INVALID_GAMMA_RAMP", "", SeverityError},
+	{0xC01E0348, "STATUS_GRAPHICS_GAMMA_RAMP_NOT_SUPPORTED", "", SeverityError},
+	{0xC01E0349, "STATUS_GRAPHICS_MULTISAMPLING_NOT_SUPPORTED", "", SeverityError},
+	{0xC01E034A, "STATUS_GRAPHICS_MODE_NOT_IN_MODESET", "", SeverityError},
+	{0xC01E034D, "STATUS_GRAPHICS_INVALID_VIDPN_TOPOLOGY_RECOMMENDATION_REASON", "", SeverityError},
+	{0xC01E034E, "STATUS_GRAPHICS_INVALID_PATH_CONTENT_TYPE", "", SeverityError},
+	{0xC01E034F, "STATUS_GRAPHICS_INVALID_COPYPROTECTION_TYPE", "", SeverityError},
+	{0xC01E0350, "STATUS_GRAPHICS_UNASSIGNED_MODESET_ALREADY_EXISTS", "", SeverityError},
+	{0xC01E0352, "STATUS_GRAPHICS_INVALID_SCANLINE_ORDERING", "", SeverityError},
+	{0xC01E0353, "STATUS_GRAPHICS_TOPOLOGY_CHANGES_NOT_ALLOWED", "", SeverityError},
+	{0xC01E0354, "STATUS_GRAPHICS_NO_AVAILABLE_IMPORTANCE_ORDINALS", "", SeverityError},
+	{0xC01E0355, "STATUS_GRAPHICS_INCOMPATIBLE_PRIVATE_FORMAT", "", SeverityError},
+	{0xC01E0356, "STATUS_GRAPHICS_INVALID_MODE_PRUNING_ALGORITHM", "", SeverityError},
+	{0xC01E0357, "STATUS_GRAPHICS_INVALID_MONITOR_CAPABILITY_ORIGIN", "", SeverityError},
+	{0xC01E0358, "STATUS_GRAPHICS_INVALID_MONITOR_FREQUENCYRANGE_CONSTRAINT", "", SeverityError},
+	{0xC01E0359, "STATUS_GRAPHICS_MAX_NUM_PATHS_REACHED", "", SeverityError},
+	{0xC01E035A, "STATUS_GRAPHICS_CANCEL_VIDPN_TOPOLOGY_AUGMENTATION", "", SeverityError},
+	{0xC01E035B, "STATUS_GRAPHICS_INVALID_CLIENT_TYPE", "", SeverityError},
+	{0xC01E035C, "STATUS_GRAPHICS_CLIENTVIDPN_NOT_SET", "", SeverityError},
+	{0xC01E0400, "STATUS_GRAPHICS_SPECIFIED_CHILD_ALREADY_CONNECTED", "", SeverityError},
+	{0xC01E0401, "STATUS_GRAPHICS_CHILD_DESCRIPTOR_NOT_SUPPORTED", "", SeverityError},
+	{0xC01E0430, "STATUS_GRAPHICS_NOT_A_LINKED_ADAPTER", "", SeverityError},
+	{0xC01E0431, "STATUS_GRAPHICS_LEADLINK_NOT_ENUMERATED", "", SeverityError},
+	{0xC01E0432, "STATUS_GRAPHICS_CHAINLINKS_NOT_ENUMERATED", "", SeverityError},
+	{0xC01E0433, "STATUS_GRAPHICS_ADAPTER_CHAIN_NOT_READY", "", SeverityError},
+	{0xC01E0434, "STATUS_GRAPHICS_CHAINLINKS_NOT_STARTED", "", SeverityError},
+	{0xC01E0435, "STATUS_GRAPHICS_CHAINLINKS_NOT_POWERED_ON", "", SeverityError},
+	{0xC01E0436, "STATUS_GRAPHICS_INCONSISTENT_DEVICE_LINK_STATE", "", SeverityError},
+	{0xC01E0438, "STATUS_GRAPHICS_NOT_POST_DEVICE_DRIVER", "", SeverityError},
+	{0xC01E043B, "STATUS_GRAPHICS_ADAPTER_ACCESS_NOT_EXCLUDED", "", SeverityError},
+	{0xC01E0500, "STATUS_GRAPHICS_OPM_NOT_SUPPORTED", "", SeverityError},
+	{0xC01E0501, "STATUS_GRAPHICS_COPP_NOT_SUPPORTED", "", SeverityError},
+	{0xC01E0502, "STATUS_GRAPHICS_UAB_NOT_SUPPORTED", "", SeverityError},
+	{0xC01E0503, "STATUS_GRAPHICS_OPM_INVALID_ENCRYPTED_PARAMETERS", "", SeverityError},
+	{0xC01E0505, "STATUS_GRAPHICS_OPM_NO_PROTECTED_OUTPUTS_EXIST", "", SeverityError},
+	{0xC01E050B, "STATUS_GRAPHICS_OPM_INTERNAL_ERROR", "", SeverityError},
+	{0xC01E050C, "STATUS_GRAPHICS_OPM_INVALID_HANDLE", "", SeverityError},
+	{0xC01E050E, "STATUS_GRAPHICS_PVP_INVALID_CERTIFICATE_LENGTH", "", SeverityError},
+	{0xC01E050F, "STATUS_GRAPHICS_OPM_SPANNING_MODE_ENABLED", "", SeverityError},
+	{0xC01E0510, "STATUS_GRAPHICS_OPM_THEATER_MODE_ENABLED", "", SeverityError},
+	{0xC01E0511, "STATUS_GRAPHICS_PVP_HFS_FAILED", "", SeverityError},
+	{0xC01E0512, "STATUS_GRAPHICS_OPM_INVALID_SRM", "", SeverityError},
+	{0xC01E0513, "STATUS_GRAPHICS_OPM_OUTPUT_DOES_NOT_SUPPORT_HDCP", "", SeverityError},
+	{0xC01E0514, "STATUS_GRAPHICS_OPM_OUTPUT_DOES_NOT_SUPPORT_ACP", "", SeverityError},
+	{0xC01E0515, "STATUS_GRAPHICS_OPM_OUTPUT_DOES_NOT_SUPPORT_CGMSA", "", SeverityError},
+	{0xC01E0516, "STATUS_GRAPHICS_OPM_HDCP_SRM_NEVER_SET", "", SeverityError},
+	{0xC01E0517, "STATUS_GRAPHICS_OPM_RESOLUTION_TOO_HIGH", "", SeverityError},
+	{0xC01E0518, "STATUS_GRAPHICS_OPM_ALL_HDCP_HARDWARE_ALREADY_IN_USE", "", SeverityError},
+	{0xC01E051A, "STATUS_GRAPHICS_OPM_PROTECTED_OUTPUT_NO_LONGER_EXISTS", "", SeverityError},
+	{0xC01E051C, "STATUS_GRAPHICS_OPM_PROTECTED_OUTPUT_DOES_NOT_HAVE_COPP_SEMANTICS", "", SeverityError},
+	{0xC01E051D, "STATUS_GRAPHICS_OPM_INVALID_INFORMATION_REQUEST", "", SeverityError},
+	{0xC01E051E, "STATUS_GRAPHICS_OPM_DRIVER_INTERNAL_ERROR", "", SeverityError},
+	{0xC01E051F, "STATUS_GRAPHICS_OPM_PROTECTED_OUTPUT_DOES_NOT_HAVE_OPM_SEMANTICS", "", SeverityError},
+	{0xC01E0520, "STATUS_GRAPHICS_OPM_SIGNALING_NOT_SUPPORTED", "", SeverityError},
+	{0xC01E0521, "STATUS_GRAPHICS_OPM_INVALID_CONFIGURATION_REQUEST", "", SeverityError},
+	{0xC01E0580, "STATUS_GRAPHICS_I2C_NOT_SUPPORTED", "", SeverityError},
+	{0xC01E0581, "STATUS_GRAPHICS_I2C_DEVICE_DOES_NOT_EXIST", "", SeverityError},
+	{0xC01E0582, "STATUS_GRAPHICS_I2C_ERROR_TRANSMITTING_DATA", "", SeverityError},
+	{0xC01E0583, "STATUS_GRAPHICS_I2C_ERROR_RECEIVING_DATA", "", SeverityError},
+	{0xC01E0584, "STATUS_GRAPHICS_DDCCI_VCP_NOT_SUPPORTED", "", SeverityError},
+	{0xC01E0585, "STATUS_GRAPHICS_DDCCI_INVALID_DATA", "", SeverityError},
+	{0xC01E0586, "STATUS_GRAPHICS_DDCCI_MONITOR_RETURNED_INVALID_TIMING_STATUS_BYTE", "", SeverityError},
+	{0xC01E0587, "STATUS_GRAPHICS_DDCCI_INVALID_CAPABILITIES_STRING", "", SeverityError},
+	{0xC01E0588, "STATUS_GRAPHICS_MCA_INTERNAL_ERROR", "", SeverityError},
+	{0xC01E0589, "STATUS_GRAPHICS_DDCCI_INVALID_MESSAGE_COMMAND", "", SeverityError},
+	{0xC01E058A, "STATUS_GRAPHICS_DDCCI_INVALID_MESSAGE_LENGTH", "", SeverityError},
+	{0xC01E058B, "STATUS_GRAPHICS_DDCCI_INVALID_MESSAGE_CHECKSUM", "", SeverityError},
+	{0xC01E058C, "STATUS_GRAPHICS_INVALID_PHYSICAL_MONITOR_HANDLE", "", SeverityError},
+	{0xC01E058D, "STATUS_GRAPHICS_MONITOR_NO_LONGER_EXISTS", "", SeverityError},
+	{0xC01E05E0, "STATUS_GRAPHICS_ONLY_CONSOLE_SESSION_SUPPORTED", "", SeverityError},
+	{0xC01E05E1, "STATUS_GRAPHICS_NO_DISPLAY_DEVICE_CORRESPONDS_TO_NAME", "", SeverityError},
+	{0xC01E05E2, "STATUS_GRAPHICS_DISPLAY_DEVICE_NOT_ATTACHED_TO_DESKTOP", "", SeverityError},
+	{0xC01E05E3, "STATUS_GRAPHICS_MIRRORING_DEVICES_NOT_SUPPORTED", "", SeverityError},
+	{0xC01E05E4, "STATUS_GRAPHICS_INVALID_POINTER", "", SeverityError},
+	{0xC01E05E5, "STATUS_GRAPHICS_NO_MONITORS_CORRESPOND_TO_DISPLAY_DEVICE", "", SeverityError},
+	{0xC01E05E6, "STATUS_GRAPHICS_PARAMETER_ARRAY_TOO_SMALL", "", SeverityError},
+	{0xC01E05E7, "STATUS_GRAPHICS_INTERNAL_ERROR", "", SeverityError},
+	{0xC01E05E8, "STATUS_GRAPHICS_SESSION_TYPE_CHANGE_IN_PROGRESS", "", SeverityError},
+	{0xC0210000, "STATUS_FVE_LOCKED_VOLUME", "", SeverityError},
+	{0xC0210001, "STATUS_FVE_NOT_ENCRYPTED", "", SeverityError},
+	{0xC0210002, "STATUS_FVE_BAD_INFORMATION", "", SeverityError},
+	{0xC0210003, "STATUS_FVE_TOO_SMALL", "", SeverityError},
+	{0xC0210004, "STATUS_FVE_FAILED_WRONG_FS", "", SeverityError},
+	{0xC0210005, "STATUS_FVE_BAD_PARTITION_SIZE", "", SeverityError},
+	{0xC0210006, "STATUS_FVE_FS_NOT_EXTENDED", "", SeverityError},
+	{0xC0210007, "STATUS_FVE_FS_MOUNTED", "", SeverityError},
+	{0xC0210008, "STATUS_FVE_NO_LICENSE", "", SeverityError},
+	{0xC0210009, "STATUS_FVE_ACTION_NOT_ALLOWED", "", SeverityError},
+	{0xC021000A, "STATUS_FVE_BAD_DATA", "", SeverityError},
+	{0xC021000B, "STATUS_FVE_VOLUME_NOT_BOUND", "", SeverityError},
+	{0xC021000C, "STATUS_FVE_NOT_DATA_VOLUME", "", SeverityError},
+	{0xC021000D, "STATUS_FVE_CONV_READ_ERROR", "", SeverityError},
+	{0xC021000E, "STATUS_FVE_CONV_WRITE_ERROR", "", SeverityError},
+	{0xC021000F, "STATUS_FVE_OVERLAPPED_UPDATE", "", SeverityError},
+	{0xC0210010, "STATUS_FVE_FAILED_SECTOR_SIZE", "", SeverityError},
+	{0xC0210011, "STATUS_FVE_FAILED_AUTHENTICATION", "", SeverityError},
+	{0xC0210012, "STATUS_FVE_NOT_OS_VOLUME", "", SeverityError},
+	{0xC0210013, "STATUS_FVE_KEYFILE_NOT_FOUND", "", SeverityError},
+	{0xC0210014, "STATUS_FVE_KEYFILE_INVALID", "", SeverityError},
+	{0xC0210015, "STATUS_FVE_KEYFILE_NO_VMK", "", SeverityError},
+	{0xC0210016, "STATUS_FVE_TPM_DISABLED", "", SeverityError},
+	{0xC0210017, "STATUS_FVE_TPM_SRK_AUTH_NOT_ZERO", "", SeverityError},
+	{0xC0210018, "STATUS_FVE_TPM_INVALID_PCR", "", SeverityError},
+	{0xC0210019, "STATUS_FVE_TPM_NO_VMK", "", SeverityError},
+	{0xC021001A, "STATUS_FVE_PIN_INVALID", "", SeverityError},
+	{0xC021001B, "STATUS_FVE_AUTH_INVALID_APPLICATION", "", SeverityError},
+	{0xC021001C, "STATUS_FVE_AUTH_INVALID_CONFIG", "", SeverityError},
+	{0xC021001D, "STATUS_FVE_DEBUGGER_ENABLED", "", SeverityError},
+	{0xC021001E, "STATUS_FVE_DRY_RUN_FAILED", "", SeverityError},
+	{0xC021001F, "STATUS_FVE_BAD_METADATA_POINTER", "", SeverityError},
+	{0xC0210020, "STATUS_FVE_OLD_METADATA_COPY", "", SeverityError},
+	{0xC0210021, "STATUS_FVE_REBOOT_REQUIRED", "", SeverityError},
+	{0xC0210022, "STATUS_FVE_RAW_ACCESS", "", SeverityError},
+	{0xC0210023, "STATUS_FVE_RAW_BLOCKED", "", SeverityError},
+	{0xC0210024, "STATUS_FVE_NO_AUTOUNLOCK_MASTER_KEY", "", SeverityError},
+	{0xC0210025, "STATUS_FVE_MOR_FAILED", "", SeverityError},
+	{0xC0210026, "STATUS_FVE_NO_FEATURE_LICENSE", "", SeverityError},
+	{0xC0210027, "STATUS_FVE_POLICY_USER_DISABLE_RDV_NOT_ALLOWED", "", SeverityError},
+	{0xC0210028, "STATUS_FVE_CONV_RECOVERY_FAILED", "", SeverityError},
+	{0xC0210029, "STATUS_FVE_VIRTUALIZED_SPACE_TOO_BIG", "", SeverityError},
+	{0xC021002A, "STATUS_FVE_INVALID_DATUM_TYPE", "", SeverityError},
+	{0xC0210030, "STATUS_FVE_VOLUME_TOO_SMALL", "", SeverityError},
+	{0xC0210031, "STATUS_FVE_ENH_PIN_INVALID", "", SeverityError},
+	{0xC0210032, "STATUS_FVE_FULL_ENCRYPTION_NOT_ALLOWED_ON_TP_STORAGE", "", SeverityError},
+	{0xC0210033, "STATUS_FVE_WIPE_NOT_ALLOWED_ON_TP_STORAGE", "", SeverityError},
+	{0xC0210034, "STATUS_FVE_NOT_ALLOWED_ON_CSV_STACK", "", SeverityError},
+	{0xC0210035, "STATUS_FVE_NOT_ALLOWED_ON_CLUSTER", "", SeverityError},
+	{0xC0210036, "STATUS_FVE_NOT_ALLOWED_TO_UPGRADE_WHILE_CONVERTING", "", SeverityError},
+	{0xC0210037, "STATUS_FVE_WIPE_CANCEL_NOT_APPLICABLE", "", SeverityError},
+	{0xC0210038, "STATUS_FVE_EDRIVE_DRY_RUN_FAILED", "", SeverityError},
+	{0xC0210039, "STATUS_FVE_SECUREBOOT_DISABLED", "", SeverityError},
+	{0xC021003A, "STATUS_FVE_SECUREBOOT_CONFIG_CHANGE", "", SeverityError},
+	{0xC021003B, "STATUS_FVE_DEVICE_LOCKEDOUT", "", SeverityError},
+	{0xC021003C, "STATUS_FVE_VOLUME_EXTEND_PREVENTS_EOW_DECRYPT", "", SeverityError},
+	{0xC021003D, "STATUS_FVE_NOT_DE_VOLUME", "", SeverityError},
+	{0xC021003E, "STATUS_FVE_PROTECTION_DISABLED", "", SeverityError},
+	{0xC021003F, "STATUS_FVE_PROTECTION_CANNOT_BE_DISABLED", "", SeverityError},
+	{0xC0210040, "STATUS_FVE_OSV_KSR_NOT_ALLOWED", "", SeverityError},
+	{0xC0220001, "STATUS_FWP_CALLOUT_NOT_FOUND", "", SeverityError},
+	{0xC0220002, "STATUS_FWP_CONDITION_NOT_FOUND", "", SeverityError},
+	{0xC0220003, "STATUS_FWP_FILTER_NOT_FOUND", "", SeverityError},
+	{0xC0220004, "STATUS_FWP_LAYER_NOT_FOUND", "", SeverityError},
+	{0xC0220005, "STATUS_FWP_PROVIDER_NOT_FOUND", "", SeverityError},
+	{0xC0220006, "STATUS_FWP_PROVIDER_CONTEXT_NOT_FOUND", "", SeverityError},
+	{0xC0220007, "STATUS_FWP_SUBLAYER_NOT_FOUND", "", SeverityError},
+	{0xC0220008, "STATUS_FWP_NOT_FOUND", "", SeverityError},
+	{0xC0220009, "STATUS_FWP_ALREADY_EXISTS", "", SeverityError},
+	{0xC022000A, "STATUS_FWP_IN_USE", "", SeverityError},
+	{0xC022000B, "STATUS_FWP_DYNAMIC_SESSION_IN_PROGRESS", "", SeverityError},
+	{0xC022000C, "STATUS_FWP_WRONG_SESSION", "", SeverityError},
+	{0xC022000D, "STATUS_FWP_NO_TXN_IN_PROGRESS", "", SeverityError},
+	{0xC022000E, "STATUS_FWP_TXN_IN_PROGRESS", "", SeverityError},
+	{0xC022000F, "STATUS_FWP_TXN_ABORTED", "", SeverityError},
+	{0xC0220010, "STATUS_FWP_SESSION_ABORTED", "", SeverityError},
+	{0xC0220011, "STATUS_FWP_INCOMPATIBLE_TXN", "", SeverityError},
+	{0xC0220012, "STATUS_FWP_TIMEOUT", "", SeverityError},
+	{0xC0220013, "STATUS_FWP_NET_EVENTS_DISABLED", "", SeverityError},
+	{0xC0220014, "STATUS_FWP_INCOMPATIBLE_LAYER", "", SeverityError},
+	{0xC0220015, "STATUS_FWP_KM_CLIENTS_ONLY", "", SeverityError},
+	{0xC0220016, "STATUS_FWP_LIFETIME_MISMATCH", "", SeverityError},
+	{0xC0220017, "STATUS_FWP_BUILTIN_OBJECT", "", SeverityError},
+	{0xC0220018, "STATUS_FWP_TOO_MANY_CALLOUTS", "", SeverityError},
+	{0xC0220019, "STATUS_FWP_NOTIFICATION_DROPPED", "", SeverityError},
+	{0xC022001A, "STATUS_FWP_TRAFFIC_MISMATCH", "", SeverityError},
+	{0xC022001B, "STATUS_FWP_INCOMPATIBLE_SA_STATE", "", SeverityError},
+	{0xC022001C, "STATUS_FWP_NULL_POINTER", "", SeverityError},
+	{0xC022001D, "STATUS_FWP_INVALID_ENUMERATOR", "", SeverityError},
+	{0xC022001E, "STATUS_FWP_INVALID_FLAGS", "", SeverityError},
+	{0xC022001F, "STATUS_FWP_INVALID_NET_MASK", "", SeverityError},
+	{0xC0220020, "STATUS_FWP_INVALID_RANGE", "", SeverityError},
+	{0xC0220021, "STATUS_FWP_INVALID_INTERVAL", "", SeverityError},
+	{0xC0220022, "STATUS_FWP_ZERO_LENGTH_ARRAY", "", SeverityError},
+	{0xC0220023, "STATUS_FWP_NULL_DISPLAY_NAME", "", SeverityError},
+	{0xC0220024, "STATUS_FWP_INVALID_ACTION_TYPE", "", SeverityError},
+	{0xC0220025, "STATUS_FWP_INVALID_WEIGHT", "", SeverityError},
+	{0xC0220026, "STATUS_FWP_MATCH_TYPE_MISMATCH", "", SeverityError},
+	{0xC0220027, "STATUS_FWP_TYPE_MISMATCH", "", SeverityError},
+	{0xC0220028, "STATUS_FWP_OUT_OF_BOUNDS", "", SeverityError},
+	{0xC0220029, "STATUS_FWP_RESERVED", "", SeverityError},
+	{0xC022002A, "STATUS_FWP_DUPLICATE_CONDITION", "", SeverityError},
+	{0xC022002B, "STATUS_FWP_DUPLICATE_KEYMOD", "", SeverityError},
+	{0xC022002C, "STATUS_FWP_ACTION_INCOMPATIBLE_WITH_LAYER", "", SeverityError},
+	{0xC022002D, "STATUS_FWP_ACTION_INCOMPATIBLE_WITH_SUBLAYER", "", SeverityError},
+	{0xC022002E, "STATUS_FWP_CONTEXT_INCOMPATIBLE_WITH_LAYER", "", SeverityError},
+	{0xC022002F, "STATUS_FWP_CONTEXT_INCOMPATIBLE_WITH_CALLOUT", "", SeverityError},
+	{0xC0220030, "STATUS_FWP_INCOMPATIBLE_AUTH_METHOD", "", SeverityError},
+	{0xC0220031, "STATUS_FWP_INCOMPATIBLE_DH_GROUP", "", SeverityError},
+	{0xC0220032, "STATUS_FWP_EM_NOT_SUPPORTED", "", SeverityError},
+	{0xC0220033, "STATUS_FWP_NEVER_MATCH", "", SeverityError},
+	{0xC0220034, "STATUS_FWP_PROVIDER_CONTEXT_MISMATCH", "", SeverityError},
+	{0xC0220035, "STATUS_FWP_INVALID_PARAMETER", "", SeverityError},
+	{0xC0220036, "STATUS_FWP_TOO_MANY_SUBLAYERS", "", SeverityError},
+	{0xC0220037, "STATUS_FWP_CALLOUT_NOTIFICATION_FAILED", "", SeverityError},
+	{0xC0220038, "STATUS_FWP_INVALID_AUTH_TRANSFORM", "", SeverityError},
+	{0xC0220039, "STATUS_FWP_INVALID_CIPHER_TRANSFORM", "", SeverityError},
+	{0xC022003A, "STATUS_FWP_INCOMPATIBLE_CIPHER_TRANSFORM", "", SeverityError},
+	{0xC022003B, "STATUS_FWP_INVALID_TRANSFORM_COMBINATION", "", SeverityError},
+	{0xC022003C, "STATUS_FWP_DUPLICATE_AUTH_METHOD", "", SeverityError},
+	{0xC022003D, "STATUS_FWP_INVALID_TUNNEL_ENDPOINT", "", SeverityError},
+	{0xC022003E, "STATUS_FWP_L2_DRIVER_NOT_READY", "", SeverityError},
+	{0xC022003F, "STATUS_FWP_KEY_DICTATOR_ALREADY_REGISTERED", "", SeverityError},
+	{0xC0220040, "STATUS_FWP_KEY_DICTATION_INVALID_KEYING_MATERIAL", "", SeverityError},
+	{0xC0220041, "STATUS_FWP_CONNECTIONS_DISABLED", "", SeverityError},
+	{0xC0220042, "STATUS_FWP_INVALID_DNS_NAME", "", SeverityError},
+	{0xC0220043, "STATUS_FWP_STILL_ON", "", SeverityError},
+	{0xC0220044, "STATUS_FWP_IKEEXT_NOT_RUNNING", "", SeverityError},
+	{0xC0220100, "STATUS_FWP_TCPIP_NOT_READY", "", SeverityError},
+	{0xC0220101, "STATUS_FWP_INJECT_HANDLE_CLOSING", "", SeverityError},
+	{0xC0220102, "STATUS_FWP_INJECT_HANDLE_STALE", "", SeverityError},
+	{0xC0220103, "STATUS_FWP_CANNOT_PEND", "", SeverityError},
+	{0xC0220104, "STATUS_FWP_DROP_NOICMP", "", SeverityError},
+	{0xC0230002, "STATUS_NDIS_CLOSING", "", SeverityError},
+	{0xC0230004, "STATUS_NDIS_BAD_VERSION", "", SeverityError},
+	{0xC0230005, "STATUS_NDIS_BAD_CHARACTERISTICS", "", SeverityError},
+	{0xC0230006, "STATUS_NDIS_ADAPTER_NOT_FOUND", "", SeverityError},
+	{0xC0230007, "STATUS_NDIS_OPEN_FAILED", "", SeverityError},
+	{0xC0230008, "STATUS_NDIS_DEVICE_FAILED", "", SeverityError},
+	{0xC0230009, "STATUS_NDIS_MULTICAST_FULL", "", SeverityError},
+	{0xC023000A, "STATUS_NDIS_MULTICAST_EXISTS", "", SeverityError},
+	{0xC023000B, "STATUS_NDIS_MULTICAST_NOT_FOUND", "", SeverityError},
+	{0xC023000C, "STATUS_NDIS_REQUEST_ABORTED", "", SeverityError},
+	{0xC023000D, "STATUS_NDIS_RESET_IN_PROGRESS", "", SeverityError},
+	{0xC023000F, "STATUS_NDIS_INVALID_PACKET", "", SeverityError},
+	{0xC0230010, "STATUS_NDIS_INVALID_DEVICE_REQUEST", "", SeverityError},
+	{0xC0230011, "STATUS_NDIS_ADAPTER_NOT_READY", "", SeverityError},
+	{0xC0230014, "STATUS_NDIS_INVALID_LENGTH", "", SeverityError},
+	{0xC0230015, "STATUS_NDIS_INVALID_DATA", "", SeverityError},
+	{0xC0230016, "STATUS_NDIS_BUFFER_TOO_SHORT", "", SeverityError},
+	{0xC0230017, "STATUS_NDIS_INVALID_OID", "", SeverityError},
+	{0xC0230018, "STATUS_NDIS_ADAPTER_REMOVED", "", SeverityError},
+	{0xC0230019, "STATUS_NDIS_UNSUPPORTED_MEDIA", "", SeverityError},
+	{0xC023001A, "STATUS_NDIS_GROUP_ADDRESS_IN_USE", "", SeverityError},
+	{0xC023001B, "STATUS_NDIS_FILE_NOT_FOUND", "", SeverityError},
+	{0xC023001C, "STATUS_NDIS_ERROR_READING_FILE", "", SeverityError},
+	{0xC023001D, "STATUS_NDIS_ALREADY_MAPPED", "", SeverityError},
+	{0xC023001E, "STATUS_NDIS_RESOURCE_CONFLICT", "", SeverityError},
+	{0xC023001F, "STATUS_NDIS_MEDIA_DISCONNECTED", "", SeverityError},
+	{0xC0230022, "STATUS_NDIS_INVALID_ADDRESS", "", SeverityError},
+	{0xC023002A, "STATUS_NDIS_PAUSED", "", SeverityError},
+	{0xC023002B, "STATUS_NDIS_INTERFACE_NOT_FOUND", "", SeverityError},
+	{0xC023002C, "STATUS_NDIS_UNSUPPORTED_REVISION", "", SeverityError},
+	{0xC023002D, "STATUS_NDIS_INVALID_PORT", "", SeverityError},
+	{0xC023002E, "STATUS_NDIS_INVALID_PORT_STATE", "", SeverityError},
+	{0xC023002F, "STATUS_NDIS_LOW_POWER_STATE", "", SeverityError},
+	{0xC0230030, "STATUS_NDIS_REINIT_REQUIRED", "", SeverityError},
+	{0xC0230031, "STATUS_NDIS_NO_QUEUES", "", SeverityError},
+	{0xC02300BB, "STATUS_NDIS_NOT_SUPPORTED", "", SeverityError},
+	{0xC023100F, "STATUS_NDIS_OFFLOAD_POLICY", "", SeverityError},
+	{0xC0231012, "STATUS_NDIS_OFFLOAD_CONNECTION_REJECTED", "", SeverityError},
+	{0xC0231013, "STATUS_NDIS_OFFLOAD_PATH_REJECTED", "", SeverityError},
+	{0xC0232000, "STATUS_NDIS_DOT11_AUTO_CONFIG_ENABLED", "", SeverityError},
+	{0xC0232001, "STATUS_NDIS_DOT11_MEDIA_IN_USE", "", SeverityError},
+	{0xC0232002, "STATUS_NDIS_DOT11_POWER_STATE_INVALID", "", SeverityError},
+	{0xC0232003, "STATUS_NDIS_PM_WOL_PATTERN_LIST_FULL", "", SeverityError},
+	{0xC0232004, "STATUS_NDIS_PM_PROTOCOL_OFFLOAD_LIST_FULL", "", SeverityError},
+	{0xC0232005, "STATUS_NDIS_DOT11_AP_CHANNEL_CURRENTLY_NOT_AVAILABLE", "", SeverityError},
+	{0xC0232006, "STATUS_NDIS_DOT11_AP_BAND_CURRENTLY_NOT_AVAILABLE", "", SeverityError},
+	{0xC0232007, "STATUS_NDIS_DOT11_AP_CHANNEL_NOT_ALLOWED", "", SeverityError},
+	{0xC0232008, "STATUS_NDIS_DOT11_AP_BAND_NOT_ALLOWED", "", SeverityError},
+	{0xC0290000, "STATUS_TPM_ERROR_MASK", "", SeverityError},
+	{0xC0290001, "STATUS_TPM_AUTHFAIL", "", SeverityError},
+	{0xC0290002, "STATUS_TPM_BADINDEX", "", SeverityError},
+	{0xC0290003, "STATUS_TPM_BAD_PARAMETER", "", SeverityError},
+	{0xC0290004, "STATUS_TPM_AUDITFAILURE", "", SeverityError},
+	{0xC0290005, "STATUS_TPM_CLEAR_DISABLED", "", SeverityError},
+	{0xC0290006, "STATUS_TPM_DEACTIVATED", "", SeverityError},
+	{0xC0290007, "STATUS_TPM_DISABLED", "", SeverityError},
+	{0xC0290008, "STATUS_TPM_DISABLED_CMD", "", SeverityError},
+	{0xC0290009, "STATUS_TPM_FAIL", "", SeverityError},
+	{0xC029000A, "STATUS_TPM_BAD_ORDINAL", "", SeverityError},
+	{0xC029000B, "STATUS_TPM_INSTALL_DISABLED", "", SeverityError},
+	{0xC029000C, "STATUS_TPM_INVALID_KEYHANDLE", "", SeverityError},
+	{0xC029000D, "STATUS_TPM_KEYNOTFOUND", "", SeverityError},
+	{0xC029000E, "STATUS_TPM_INAPPROPRIATE_ENC", "", SeverityError},
+	{0xC029000F, "STATUS_TPM_MIGRATEFAIL", "", SeverityError},
+	{0xC0290010, "STATUS_TPM_INVALID_PCR_INFO", "", SeverityError},
+	{0xC0290011, "STATUS_TPM_NOSPACE", "", SeverityError},
+	{0xC0290012, "STATUS_TPM_NOSRK", "", SeverityError},
+	{0xC0290013, "STATUS_TPM_NOTSEALED_BLOB", "", SeverityError},
+	{0xC0290014, "STATUS_TPM_OWNER_SET", "", SeverityError},
+	{0xC0290015, "STATUS_TPM_RESOURCES", "", SeverityError},
+	{0xC0290016, "STATUS_TPM_SHORTRANDOM", "", SeverityError},
+	{0xC0290017, "STATUS_TPM_SIZE", "", SeverityError},
+	{0xC0290018, "STATUS_TPM_WRONGPCRVAL", "", SeverityError},
+	{0xC0290019, "STATUS_TPM_BAD_PARAM_SIZE", "", SeverityError},
+	{0xC029001A, "STATUS_TPM_SHA_THREAD", "", SeverityError},
+	{0xC029001B, "STATUS_TPM_SHA_ERROR", "", SeverityError},
+	{0xC029001C, "STATUS_TPM_FAILEDSELFTEST", "", SeverityError},
+	{0xC029001D, "STATUS_TPM_AUTH2FAIL", "", SeverityError},
+	{0xC029001E, "STATUS_TPM_BADTAG", "", SeverityError},
+	{0xC029001F, "STATUS_TPM_IOERROR", "", SeverityError},
+	{0xC0290020, "STATUS_TPM_ENCRYPT_ERROR", "", SeverityError},
+	{0xC0290021, "STATUS_TPM_DECRYPT_ERROR", "", SeverityError},
+	{0xC0290022, "STATUS_TPM_INVALID_AUTHHANDLE", "", SeverityError},
+	{0xC0290023, "STATUS_TPM_NO_ENDORSEMENT", "", SeverityError},
+	{0xC0290024, "STATUS_TPM_INVALID_KEYUSAGE", "", SeverityError},
+	{0xC0290025, "STATUS_TPM_WRONG_ENTITYTYPE", "", SeverityError},
+	{0xC0290026, "STATUS_TPM_INVALID_POSTINIT", "", SeverityError},
+	{0xC0290027, "STATUS_TPM_INAPPROPRIATE_SIG", "", SeverityError},
+	{0xC0290028, "STATUS_TPM_BAD_KEY_PROPERTY", "", SeverityError},
+	{0xC0290029, "STATUS_TPM_BAD_MIGRATION", "", SeverityError},
+	{0xC029002A, "STATUS_TPM_BAD_SCHEME", "", SeverityError},
+	{0xC029002B, "STATUS_TPM_BAD_DATASIZE", "", SeverityError},
+	{0xC029002C, "STATUS_TPM_BAD_MODE", "", SeverityError},
+	{0xC029002D, "STATUS_TPM_BAD_PRESENCE", "", SeverityError},
+	{0xC029002E, "STATUS_TPM_BAD_VERSION", "", SeverityError},
+	{0xC029002F, "STATUS_TPM_NO_WRAP_TRANSPORT", "", SeverityError},
+	{0xC0290030, "STATUS_TPM_AUDITFAIL_UNSUCCESSFUL", "", SeverityError},
+	{0xC0290031, "STATUS_TPM_AUDITFAIL_SUCCESSFUL", "", SeverityError},
+	{0xC0290032, "STATUS_TPM_NOTRESETABLE", "", SeverityError},
+	{0xC0290033, "STATUS_TPM_NOTLOCAL", "", SeverityError},
+	{0xC0290034, "STATUS_TPM_BAD_TYPE", "", SeverityError},
+	{0xC0290035, "STATUS_TPM_INVALID_RESOURCE", "", SeverityError},
+	{0xC0290036, "STATUS_TPM_NOTFIPS", "", SeverityError},
+	{0xC0290037, "STATUS_TPM_INVALID_FAMILY", "", SeverityError},
+	{0xC0290038, "STATUS_TPM_NO_NV_PERMISSION", "", SeverityError},
+	{0xC0290039, "STATUS_TPM_REQUIRES_SIGN", "", SeverityError},
+	{0xC029003A, "STATUS_TPM_KEY_NOTSUPPORTED", "", SeverityError},
+	{0xC029003B, "STATUS_TPM_AUTH_CONFLICT", "", SeverityError},
+	{0xC029003C, "STATUS_TPM_AREA_LOCKED", "", SeverityError},
+	{0xC029003D, "STATUS_TPM_BAD_LOCALITY", "", SeverityError},
+	{0xC029003E, "STATUS_TPM_READ_ONLY", "", SeverityError},
+	{0xC029003F, "STATUS_TPM_PER_NOWRITE", "", SeverityError},
+	{0xC0290040, "STATUS_TPM_FAMILYCOUNT", "", SeverityError},
+	{0xC0290041, "STATUS_TPM_WRITE_LOCKED", "", SeverityError},
+	{0xC0290042, "STATUS_TPM_BAD_ATTRIBUTES", "", SeverityError},
+	{0xC0290043, "STATUS_TPM_INVALID_STRUCTURE", "", SeverityError},
+	{0xC0290044, "STATUS_TPM_KEY_OWNER_CONTROL", "", SeverityError},
+	{0xC0290045, "STATUS_TPM_BAD_COUNTER", "", SeverityError},
+	{0xC0290046, "STATUS_TPM_NOT_FULLWRITE", "", SeverityError},
+	{0xC0290047, "STATUS_TPM_CONTEXT_GAP", "", SeverityError},
+	{0xC0290048, "STATUS_TPM_MAXNVWRITES", "", SeverityError},
+	{0xC0290049, "STATUS_TPM_NOOPERATOR", "", SeverityError},
+	{0xC029004A, "STATUS_TPM_RESOURCEMISSING", "", SeverityError},
+	{0xC029004B, "STATUS_TPM_DELEGATE_LOCK", "", SeverityError},
+	{0xC029004C, "STATUS_TPM_DELEGATE_FAMILY", "", SeverityError},
+	{0xC029004D, "STATUS_TPM_DELEGATE_ADMIN", "", SeverityError},
+	{0xC029004E, "STATUS_TPM_TRANSPORT_NOTEXCLUSIVE", "", SeverityError},
+	{0xC029004F, "STATUS_TPM_OWNER_CONTROL", "", SeverityError},
+	{0xC0290050, "STATUS_TPM_DAA_RESOURCES", "", SeverityError},
+	{0xC0290051, "STATUS_TPM_DAA_INPUT_DATA0", "", SeverityError},
+	{0xC0290052, "STATUS_TPM_DAA_INPUT_DATA1", "", SeverityError},
+	{0xC0290053, "STATUS_TPM_DAA_ISSUER_SETTINGS", "", SeverityError},
+	{0xC0290054, "STATUS_TPM_DAA_TPM_SETTINGS", "", SeverityError},
+	{0xC0290055, "STATUS_TPM_DAA_STAGE", "", SeverityError},
+	{0xC0290056, "STATUS_TPM_DAA_ISSUER_VALIDITY", "", SeverityError},
+	{0xC0290057, "STATUS_TPM_DAA_WRONG_W", "", SeverityError},
+	{0xC0290058, "STATUS_TPM_BAD_HANDLE", "", SeverityError},
+	{0xC0290059, "STATUS_TPM_BAD_DELEGATE", "", SeverityError},
+	{0xC029005A, "STATUS_TPM_BADCONTEXT", "", SeverityError},
+	{0xC029005B, "STATUS_TPM_TOOMANYCONTEXTS", "", SeverityError},
+	{0xC029005C, "STATUS_TPM_MA_TICKET_SIGNATURE", "", SeverityError},
+	{0xC029005D, "STATUS_TPM_MA_DESTINATION", "", SeverityError},
+	{0xC029005E, "STATUS_TPM_MA_SOURCE", "", SeverityError},
+	{0xC029005F, "STATUS_TPM_MA_AUTHORITY", "", SeverityError},
+	{0xC0290061, "STATUS_TPM_PERMANENTEK", "", SeverityError},
+	{0xC0290062, "STATUS_TPM_BAD_SIGNATURE", "", SeverityError},
+	{0xC0290063, "STATUS_TPM_NOCONTEXTSPACE", "", SeverityError},
+	{0xC0290081, "STATUS_TPM_20_E_ASYMMETRIC", "", SeverityError},
+	{0xC0290082, "STATUS_TPM_20_E_ATTRIBUTES", "", SeverityError},
+	{0xC0290083, "STATUS_TPM_20_E_HASH", "", SeverityError},
+	{0xC0290084, "STATUS_TPM_20_E_VALUE", "", SeverityError},
+	{0xC0290085, "STATUS_TPM_20_E_HIERARCHY", "", SeverityError},
+	{0xC0290087, "STATUS_TPM_20_E_KEY_SIZE", "", SeverityError},
+	{0xC0290088, "STATUS_TPM_20_E_MGF", "", SeverityError},
+	{0xC0290089, "STATUS_TPM_20_E_MODE", "", SeverityError},
+	{0xC029008A, "STATUS_TPM_20_E_TYPE", "", SeverityError},
+	{0xC029008B, "STATUS_TPM_20_E_HANDLE", "", SeverityError},
+	{0xC029008C, "STATUS_TPM_20_E_KDF", "", SeverityError},
+	{0xC029008D, "STATUS_TPM_20_E_RANGE", "", SeverityError},
+	{0xC029008E, "STATUS_TPM_20_E_AUTH_FAIL", "", SeverityError},
+	{0xC029008F, "STATUS_TPM_20_E_NONCE", "", SeverityError},
+	{0xC0290090, "STATUS_TPM_20_E_PP", "", SeverityError},
+	{0xC0290092, "STATUS_TPM_20_E_SCHEME", "", SeverityError},
+	{0xC0290095, "STATUS_TPM_20_E_SIZE", "", SeverityError},
+	{0xC0290096, "STATUS_TPM_20_E_SYMMETRIC", "", SeverityError},
+	{0xC0290097, "STATUS_TPM_20_E_TAG", "", SeverityError},
+	{0xC0290098, "STATUS_TPM_20_E_SELECTOR", "", SeverityError},
+	{0xC029009A, "STATUS_TPM_20_E_INSUFFICIENT", "", SeverityError},
+	{0xC029009B, "STATUS_TPM_20_E_SIGNATURE", "", SeverityError},
+	{0xC029009C, "STATUS_TPM_20_E_KEY", "", SeverityError},
+	{0xC029009D, "STATUS_TPM_20_E_POLICY_FAIL", "", SeverityError},
+	{0xC029009F, "STATUS_TPM_20_E_INTEGRITY", "", SeverityError},
+	{0xC02900A0, "STATUS_TPM_20_E_TICKET", "", SeverityError},
+	{0xC02900A1, "STATUS_TPM_20_E_RESERVED_BITS", "", SeverityError},
+	{0xC02900A2, "STATUS_TPM_20_E_BAD_AUTH", "", SeverityError},
+	{0xC02900A3, "STATUS_TPM_20_E_EXPIRED", "", SeverityError},
+	{0xC02900A4, "STATUS_TPM_20_E_POLICY_CC", "", SeverityError},
+	{0xC02900A5, "STATUS_TPM_20_E_BINDING", "", SeverityError},
+	{0xC02900A6, "STATUS_TPM_20_E_CURVE", "", SeverityError},
+	{0xC02900A7, "STATUS_TPM_20_E_ECC_POINT", "", SeverityError},
+	{0xC0290100, "STATUS_TPM_20_E_INITIALIZE", "", SeverityError},
+	{0xC0290101, "STATUS_TPM_20_E_FAILURE", "", SeverityError},
+	{0xC0290103, "STATUS_TPM_20_E_SEQUENCE", "", SeverityError},
+	{0xC029010B, "STATUS_TPM_20_E_PRIVATE", "", SeverityError},
+	{0xC0290119, "STATUS_TPM_20_E_HMAC", "", SeverityError},
+	{0xC0290120, "STATUS_TPM_20_E_DISABLED", "", SeverityError},
+	{0xC0290121, "STATUS_TPM_20_E_EXCLUSIVE", "", SeverityError},
+	{0xC0290123, "STATUS_TPM_20_E_ECC_CURVE", "", SeverityError},
+	{0xC0290124, "STATUS_TPM_20_E_AUTH_TYPE", "", SeverityError},
+	{0xC0290125, "STATUS_TPM_20_E_AUTH_MISSING", "", SeverityError},
+	{0xC0290126, "STATUS_TPM_20_E_POLICY", "", SeverityError},
+	{0xC0290127, "STATUS_TPM_20_E_PCR", "", SeverityError},
+	{0xC0290128, "STATUS_TPM_20_E_PCR_CHANGED", "", SeverityError},
+	{0xC029012D, "STATUS_TPM_20_E_UPGRADE", "", SeverityError},
+	{0xC029012E, "STATUS_TPM_20_E_TOO_MANY_CONTEXTS", "", SeverityError},
+	{0xC029012F, "STATUS_TPM_20_E_AUTH_UNAVAILABLE", "", SeverityError},
+	{0xC0290130, "STATUS_TPM_20_E_REBOOT", "", SeverityError},
+	{0xC0290131, "STATUS_TPM_20_E_UNBALANCED", "", SeverityError},
+	{0xC0290142, "STATUS_TPM_20_E_COMMAND_SIZE", "", SeverityError},
+	{0xC0290143, "STATUS_TPM_20_E_COMMAND_CODE", "", SeverityError},
+	{0xC0290144, "STATUS_TPM_20_E_AUTHSIZE", "", SeverityError},
+	{0xC0290145, "STATUS_TPM_20_E_AUTH_CONTEXT", "", SeverityError},
+	{0xC0290146, "STATUS_TPM_20_E_NV_RANGE", "", SeverityError},
+	{0xC0290147, "STATUS_TPM_20_E_NV_SIZE", "", SeverityError},
+	{0xC0290148, "STATUS_TPM_20_E_NV_LOCKED", "", SeverityError},
+	{0xC0290149, "STATUS_TPM_20_E_NV_AUTHORIZATION", "", SeverityError},
+	{0xC029014A, "STATUS_TPM_20_E_NV_UNINITIALIZED", "", SeverityError},
+	{0xC029014B, "STATUS_TPM_20_E_NV_SPACE", "", SeverityError},
+	{0xC029014C, "STATUS_TPM_20_E_NV_DEFINED", "", SeverityError},
+	{0xC0290150, "STATUS_TPM_20_E_BAD_CONTEXT", "", SeverityError},
+	{0xC0290151, "STATUS_TPM_20_E_CPHASH", "", SeverityError},
+	{0xC0290152, "STATUS_TPM_20_E_PARENT", "", SeverityError},
+	{0xC0290153, "STATUS_TPM_20_E_NEEDS_TEST", "", SeverityError},
+	{0xC0290154, "STATUS_TPM_20_E_NO_RESULT", "", SeverityError},
+	{0xC0290155, "STATUS_TPM_20_E_SENSITIVE", "", SeverityError},
+	{0xC0290400, "STATUS_TPM_COMMAND_BLOCKED", "", SeverityError},
+	{0xC0290401, "STATUS_TPM_INVALID_HANDLE", "", SeverityError},
+	{0xC0290402, "STATUS_TPM_DUPLICATE_VHANDLE", "", SeverityError},
+	{0xC0290403, "STATUS_TPM_EMBEDDED_COMMAND_BLOCKED", "", SeverityError},
+	{0xC0290404, "STATUS_TPM_EMBEDDED_COMMAND_UNSUPPORTED", "", SeverityError},
+	{0xC0290800, "STATUS_TPM_RETRY", "", SeverityError},
+	{0xC0290801, "STATUS_TPM_NEEDS_SELFTEST", "", SeverityError},
+	{0xC0290802, "STATUS_TPM_DOING_SELFTEST", "", SeverityError},
+	{0xC0290803, "STATUS_TPM_DEFEND_LOCK_RUNNING", "", SeverityError},
+	{0xC0291001, "STATUS_TPM_COMMAND_CANCELED", "", SeverityError},
+	{0xC0291002, "STATUS_TPM_TOO_MANY_CONTEXTS", "", SeverityError},
+	{0xC0291003, "STATUS_TPM_NOT_FOUND", "", SeverityError},
+	{0xC0291004, "STATUS_TPM_ACCESS_DENIED", "", SeverityError},
+	{0xC0291005, "STATUS_TPM_INSUFFICIENT_BUFFER", "", SeverityError},
+	{0xC0291006, "STATUS_TPM_PPI_FUNCTION_UNSUPPORTED", "", SeverityError},
+	{0xC0292000, "STATUS_PCP_ERROR_MASK", "", SeverityError},
+	{0xC0292001, "STATUS_PCP_DEVICE_NOT_READY", "", SeverityError},
+	{0xC0292002, "STATUS_PCP_INVALID_HANDLE", "", SeverityError},
+	{0xC0292003, "STATUS_PCP_INVALID_PARAMETER", "", SeverityError},
+	{0xC0292004, "STATUS_PCP_FLAG_NOT_SUPPORTED", "", SeverityError},
+	{0xC0292005, "STATUS_PCP_NOT_SUPPORTED", "", SeverityError},
+	{0xC0292006, "STATUS_PCP_BUFFER_TOO_SMALL", "", SeverityError},
+	{0xC0292007, "STATUS_PCP_INTERNAL_ERROR", "", SeverityError},
+	{0xC0292008, "STATUS_PCP_AUTHENTICATION_FAILED", "", SeverityError},
+	{0xC0292009, "STATUS_PCP_AUTHENTICATION_IGNORED", "", SeverityError},
+	{0xC029200A, "STATUS_PCP_POLICY_NOT_FOUND", "", SeverityError},
+	{0xC029200B, "STATUS_PCP_PROFILE_NOT_FOUND", "", SeverityError},
+	{0xC029200C, "STATUS_PCP_VALIDATION_FAILED", "", SeverityError},
+	{0xC029200D, "STATUS_PCP_DEVICE_NOT_FOUND", "", SeverityError},
+	{0xC029200E, "STATUS_PCP_WRONG_PARENT", "", SeverityError},
+	{0xC029200F, "STATUS_PCP_KEY_NOT_LOADED", "", SeverityError},
+	{0xC0292010, "STATUS_PCP_NO_KEY_CERTIFICATION", "", SeverityError},
+	{0xC0292011, "STATUS_PCP_KEY_NOT_FINALIZED", "", SeverityError},
+	{0xC0292012, "STATUS_PCP_ATTESTATION_CHALLENGE_NOT_SET", "", SeverityError},
+	{0xC0292013, "STATUS_PCP_NOT_PCR_BOUND", "", SeverityError},
+	{0xC0292014, "STATUS_PCP_KEY_ALREADY_FINALIZED", "", SeverityError},
+	{0xC0292015, "STATUS_PCP_KEY_USAGE_POLICY_NOT_SUPPORTED", "", SeverityError},
+	{0xC0292016, "STATUS_PCP_KEY_USAGE_POLICY_INVALID", "", SeverityError},
+	{0xC0292017, "STATUS_PCP_SOFT_KEY_ERROR", "", SeverityError},
+	{0xC0292018, "STATUS_PCP_KEY_NOT_AUTHENTICATED", "", SeverityError},
+	{0xC0292019, "STATUS_PCP_KEY_NOT_AIK", "", SeverityError},
+	{0xC029201A, "STATUS_PCP_KEY_NOT_SIGNING_KEY", "", SeverityError},
+	{0xC029201B, "STATUS_PCP_LOCKED_OUT", "", SeverityError},
+	{0xC029201C, "STATUS_PCP_CLAIM_TYPE_NOT_SUPPORTED", "", SeverityError},
+	{0xC029201D, "STATUS_PCP_TPM_VERSION_NOT_SUPPORTED", "", SeverityError},
+	{0xC029201E, "STATUS_PCP_BUFFER_LENGTH_MISMATCH", "", SeverityError},
+	{0xC029201F, "STATUS_PCP_IFX_RSA_KEY_CREATION_BLOCKED", "", SeverityError},
+	{0xC0292020, "STATUS_PCP_TICKET_MISSING", "", SeverityError},
+	{0xC0292021, "STATUS_PCP_RAW_POLICY_NOT_SUPPORTED", "", SeverityError},
+	{0xC0292022, "STATUS_PCP_KEY_HANDLE_INVALIDATED", "", SeverityError},
+	{0xC0293002, "STATUS_RTPM_NO_RESULT", "", SeverityError},
+	{0xC0293003, "STATUS_RTPM_PCR_READ_INCOMPLETE", "", SeverityError},
+	{0xC0293004, "STATUS_RTPM_INVALID_CONTEXT", "", SeverityError},
+	{0xC0293005, "STATUS_RTPM_UNSUPPORTED_CMD", "", SeverityError},
+	{0xC0294000, "STATUS_TPM_ZERO_EXHAUST_ENABLED", "", SeverityError},
+	{0xC0350002, "STATUS_HV_INVALID_HYPERCALL_CODE", "", SeverityError},
+	{0xC0350003, "STATUS_HV_INVALID_HYPERCALL_INPUT", "", SeverityError},
+	{0xC0350004, "STATUS_HV_INVALID_ALIGNMENT", "", SeverityError},
+	{0xC0350005, "STATUS_HV_INVALID_PARAMETER", "", SeverityError},
+	{0xC0350006, "STATUS_HV_ACCESS_DENIED", "", SeverityError},
+	{0xC0350007, "STATUS_HV_INVALID_PARTITION_STATE", "", SeverityError},
+	{0xC0350008, "STATUS_HV_OPERATION_DENIED", "", SeverityError},
+	{0xC0350009, "STATUS_HV_UNKNOWN_PROPERTY", "", SeverityError},
+	{0xC035000A, "STATUS_HV_PROPERTY_VALUE_OUT_OF_RANGE", "", SeverityError},
+	{0xC035000B, "STATUS_HV_INSUFFICIENT_MEMORY", "", SeverityError},
+	{0xC035000C, "STATUS_HV_PARTITION_TOO_DEEP", "", SeverityError},
+	{0xC035000D, "STATUS_HV_INVALID_PARTITION_ID", "", SeverityError},
+	{0xC035000E, "STATUS_HV_INVALID_VP_INDEX", "", SeverityError},
+	{0xC0350011, "STATUS_HV_INVALID_PORT_ID", "", SeverityError},
+	{0xC0350012, "STATUS_HV_INVALID_CONNECTION_ID", "", SeverityError},
+	{0xC0350013, "STATUS_HV_INSUFFICIENT_BUFFERS", "", SeverityError},
+	{0xC0350014, "STATUS_HV_NOT_ACKNOWLEDGED", "", SeverityError},
+	{0xC0350015, "STATUS_HV_INVALID_VP_STATE", "", SeverityError},
+	{0xC0350016, "STATUS_HV_ACKNOWLEDGED", "", SeverityError},
+	{0xC0350017, "STATUS_HV_INVALID_SAVE_RESTORE_STATE", "", SeverityError},
+	{0xC0350018, "STATUS_HV_INVALID_SYNIC_STATE", "", SeverityError},
+	{0xC0350019, "STATUS_HV_OBJECT_IN_USE", "", SeverityError},
+	{0xC035001A, "STATUS_HV_INVALID_PROXIMITY_DOMAIN_INFO", "", SeverityError},
+	{0xC035001B, "STATUS_HV_NO_DATA", "", SeverityError},
+	{0xC035001C, "STATUS_HV_INACTIVE", "", SeverityError},
+	{0xC035001D, "STATUS_HV_NO_RESOURCES", "", SeverityError},
+	{0xC035001E, "STATUS_HV_FEATURE_UNAVAILABLE", "", SeverityError},
+	{0xC0350033, "STATUS_HV_INSUFFICIENT_BUFFER", "", SeverityError},
+	{0xC0350038, "STATUS_HV_INSUFFICIENT_DEVICE_DOMAINS", "", SeverityError},
+	{0xC035003C, "STATUS_HV_CPUID_FEATURE_VALIDATION_ERROR", "", SeverityError},
+	{0xC035003D, "STATUS_HV_CPUID_XSAVE_FEATURE_VALIDATION_ERROR", "", SeverityError},
+	{0xC035003E, "STATUS_HV_PROCESSOR_STARTUP_TIMEOUT", "", SeverityError},
+	{0xC035003F, "STATUS_HV_SMX_ENABLED", "", SeverityError},
+	{0xC0350041, "STATUS_HV_INVALID_LP_INDEX", "", SeverityError},
+	{0xC0350050, "STATUS_HV_INVALID_REGISTER_VALUE", "", SeverityError},
+	{0xC0350051, "STATUS_HV_INVALID_VTL_STATE", "", SeverityError},
+	{0xC0350055, "STATUS_HV_NX_NOT_DETECTED", "", SeverityError},
+	{0xC0350057, "STATUS_HV_INVALID_DEVICE_ID", "", SeverityError},
+	{0xC0350058, "STATUS_HV_INVALID_DEVICE_STATE", "", SeverityError},
+	{0xC0350060, "STATUS_HV_PAGE_REQUEST_INVALID", "", SeverityError},
+	{0xC035006F, "STATUS_HV_INVALID_CPU_GROUP_ID", "", SeverityError},
+	{0xC0350070, "STATUS_HV_INVALID_CPU_GROUP_STATE", "", SeverityError},
+	{0xC0350071, "STATUS_HV_OPERATION_FAILED", "", SeverityError},
+	{0xC0350072, "STATUS_HV_NOT_ALLOWED_WITH_NESTED_VIRT_ACTIVE", "", SeverityError},
+	{0xC0350073, "STATUS_HV_INSUFFICIENT_ROOT_MEMORY", "", SeverityError},
+	{0xC0351000, "STATUS_HV_NOT_PRESENT", "", SeverityError},
+	{0xC0360001, "STATUS_IPSEC_BAD_SPI", "", SeverityError},
+	{0xC0360002, "STATUS_IPSEC_SA_LIFETIME_EXPIRED", "", SeverityError},
+	{0xC0360003, "STATUS_IPSEC_WRONG_SA", "", SeverityError},
+	{0xC0360004, "STATUS_IPSEC_REPLAY_CHECK_FAILED", "", SeverityError},
+	{0xC0360005, "STATUS_IPSEC_INVALID_PACKET", "", SeverityError},
+	{0xC0360006, "STATUS_IPSEC_INTEGRITY_CHECK_FAILED", "", SeverityError},
+	{0xC0360007, "STATUS_IPSEC_CLEAR_TEXT_DROP", "", SeverityError},
+	{0xC0360008, "STATUS_IPSEC_AUTH_FIREWALL_DROP", "", SeverityError},
+	{0xC0360009, "STATUS_IPSEC_THROTTLE_DROP", "", SeverityError},
+	{0xC0368000, "STATUS_IPSEC_DOSP_BLOCK", "", SeverityError},
+	{0xC0368001, "STATUS_IPSEC_DOSP_RECEIVED_MULTICAST", "", SeverityError},
+	{0xC0368002, "STATUS_IPSEC_DOSP_INVALID_PACKET", "", SeverityError},
+	{0xC0368003, "STATUS_IPSEC_DOSP_STATE_LOOKUP_FAILED", "", SeverityError},
+	{0xC0368004, "STATUS_IPSEC_DOSP_MAX_ENTRIES", "", SeverityError},
+	{0xC0368005, "STATUS_IPSEC_DOSP_KEYMOD_NOT_ALLOWED", "", SeverityError},
+	{0xC0368006, "STATUS_IPSEC_DOSP_MAX_PER_IP_RATELIMIT_QUEUES", "", SeverityError},
+	{0xC0370001, "STATUS_VID_DUPLICATE_HANDLER", "", SeverityError},
+	{0xC0370002, "STATUS_VID_TOO_MANY_HANDLERS", "", SeverityError},
+	{0xC0370003, "STATUS_VID_QUEUE_FULL", "", SeverityError},
+	{0xC0370004, "STATUS_VID_HANDLER_NOT_PRESENT", "", SeverityError},
+	{0xC0370005, "STATUS_VID_INVALID_OBJECT_NAME", "", SeverityError},
+	{0xC0370006, "STATUS_VID_PARTITION_NAME_TOO_LONG", "", SeverityError},
+	{0xC0370007, "STATUS_VID_MESSAGE_QUEUE_NAME_TOO_LONG", "", SeverityError},
+	{0xC0370008, "STATUS_VID_PARTITION_ALREADY_EXISTS", "", SeverityError},
+	{0xC0370009, "STATUS_VID_PARTITION_DOES_NOT_EXIST", "", SeverityError},
+	{0xC037000A, "STATUS_VID_PARTITION_NAME_NOT_FOUND", "", SeverityError},
+	{0xC037000B, "STATUS_VID_MESSAGE_QUEUE_ALREADY_EXISTS", "", SeverityError},
+	{0xC037000C, "STATUS_VID_EXCEEDED_MBP_ENTRY_MAP_LIMIT", "", SeverityError},
+	{0xC037000D, "STATUS_VID_MB_STILL_REFERENCED", "", SeverityError},
+	{0xC037000E, "STATUS_VID_CHILD_GPA_PAGE_SET_CORRUPTED", "", SeverityError},
+	{0xC037000F, "STATUS_VID_INVALID_NUMA_SETTINGS", "", SeverityError},
+	{0xC0370010, "STATUS_VID_INVALID_NUMA_NODE_INDEX", "", SeverityError},
+	{0xC0370011, "STATUS_VID_NOTIFICATION_QUEUE_ALREADY_ASSOCIATED", "", SeverityError},
+	{0xC0370012, "STATUS_VID_INVALID_MEMORY_BLOCK_HANDLE", "", SeverityError},
+	{0xC0370013, "STATUS_VID_PAGE_RANGE_OVERFLOW", "", SeverityError},
+	{0xC0370014, "STATUS_VID_INVALID_MESSAGE_QUEUE_HANDLE", "", SeverityError},
+	{0xC0370015, "STATUS_VID_INVALID_GPA_RANGE_HANDLE", "", SeverityError},
+	{0xC0370016, "STATUS_VID_NO_MEMORY_BLOCK_NOTIFICATION_QUEUE", "", SeverityError},
+	{0xC0370017, "STATUS_VID_MEMORY_BLOCK_LOCK_COUNT_EXCEEDED", "", SeverityError},
+	{0xC0370018, "STATUS_VID_INVALID_PPM_HANDLE", "", SeverityError},
+	{0xC0370019, "STATUS_VID_MBPS_ARE_LOCKED", "", SeverityError},
+	{0xC037001A, "STATUS_VID_MESSAGE_QUEUE_CLOSED", "", SeverityError},
+	{0xC037001B, "STATUS_VID_VIRTUAL_PROCESSOR_LIMIT_EXCEEDED", "", SeverityError},
+	{0xC037001C, "STATUS_VID_STOP_PENDING", "", SeverityError},
+	{0xC037001D, "STATUS_VID_INVALID_PROCESSOR_STATE", "", SeverityError},
+	{0xC037001E, "STATUS_VID_EXCEEDED_KM_CONTEXT_COUNT_LIMIT", "", SeverityError},
+	{0xC037001F, "STATUS_VID_KM_INTERFACE_ALREADY_INITIALIZED", "", SeverityError},
+	{0xC0370020, "STATUS_VID_MB_PROPERTY_ALREADY_SET_RESET", "", SeverityError},
+	{0xC0370021, "STATUS_VID_MMIO_RANGE_DESTROYED", "", SeverityError},
+	{0xC0370022, "STATUS_VID_INVALID_CHILD_GPA_PAGE_SET", "", SeverityError},
+	{0xC0370023, "STATUS_VID_RESERVE_PAGE_SET_IS_BEING_USED", "", SeverityError},
+	{0xC0370024, "STATUS_VID_RESERVE_PAGE_SET_TOO_SMALL", "", SeverityError},
+	{0xC0370025, "STATUS_VID_MBP_ALREADY_LOCKED_USING_RESERVED_PAGE", "", SeverityError},
+	{0xC0370026, "STATUS_VID_MBP_COUNT_EXCEEDED_LIMIT", "", SeverityError},
+	{0xC0370027, "STATUS_VID_SAVED_STATE_CORRUPT", "", SeverityError},
+	{0xC0370028, "STATUS_VID_SAVED_STATE_UNRECOGNIZED_ITEM", "", SeverityError},
+	{0xC0370029, "STATUS_VID_SAVED_STATE_INCOMPATIBLE", "", SeverityError},
+	{0xC037002A, "STATUS_VID_VTL_ACCESS_DENIED", "", SeverityError},
+	{0xC0380001, "STATUS_VOLMGR_DATABASE_FULL", "", SeverityError},
+	{0xC0380002, "STATUS_VOLMGR_DISK_CONFIGURATION_CORRUPTED", "", SeverityError},
+	{0xC0380003, "STATUS_VOLMGR_DISK_CONFIGURATION_NOT_IN_SYNC", "", SeverityError},
+	{0xC0380004, "STATUS_VOLMGR_PACK_CONFIG_UPDATE_FAILED", "", SeverityError},
+	{0xC0380005, "STATUS_VOLMGR_DISK_CONTAINS_NON_SIMPLE_VOLUME", "", SeverityError},
+	{0xC0380006, "STATUS_VOLMGR_DISK_DUPLICATE", "", SeverityError},
+	{0xC0380007, "STATUS_VOLMGR_DISK_DYNAMIC", "", SeverityError},
+	{0xC0380008, "STATUS_VOLMGR_DISK_ID_INVALID", "", SeverityError},
+	{0xC0380009, "STATUS_VOLMGR_DISK_INVALID", "", SeverityError},
+	{0xC038000A, "STATUS_VOLMGR_DISK_LAST_VOTER", "", SeverityError},
+	{0xC038000B, "STATUS_VOLMGR_DISK_LAYOUT_INVALID", "", SeverityError},
+	{0xC038000C, "STATUS_VOLMGR_DISK_LAYOUT_NON_BASIC_BETWEEN_BASIC_PARTITIONS", "", SeverityError},
+	{0xC038000D, "STATUS_VOLMGR_DISK_LAYOUT_NOT_CYLINDER_ALIGNED", "", SeverityError},
+	{0xC038000E, "STATUS_VOLMGR_DISK_LAYOUT_PARTITIONS_TOO_SMALL", "", SeverityError},
+	{0xC038000F, "STATUS_VOLMGR_DISK_LAYOUT_PRIMARY_BETWEEN_LOGICAL_PARTITIONS", "", SeverityError},
+	{0xC0380010, "STATUS_VOLMGR_DISK_LAYOUT_TOO_MANY_PARTITIONS", "", SeverityError},
+	{0xC0380011, "STATUS_VOLMGR_DISK_MISSING", "", SeverityError},
+	{0xC0380012, "STATUS_VOLMGR_DISK_NOT_EMPTY", "", SeverityError},
+	{0xC0380013, "STATUS_VOLMGR_DISK_NOT_ENOUGH_SPACE", "", SeverityError},
+	{0xC0380014, "STATUS_VOLMGR_DISK_REVECTORING_FAILED", "", SeverityError},
+	{0xC0380015, "STATUS_VOLMGR_DISK_SECTOR_SIZE_INVALID", "", SeverityError},
+	{0xC0380016, "STATUS_VOLMGR_DISK_SET_NOT_CONTAINED", "", SeverityError},
+	{0xC0380017, "STATUS_VOLMGR_DISK_USED_BY_MULTIPLE_MEMBERS", "", SeverityError},
+	{0xC0380018, "STATUS_VOLMGR_DISK_USED_BY_MULTIPLE_PLEXES", "", SeverityError},
+	{0xC0380019, "STATUS_VOLMGR_DYNAMIC_DISK_NOT_SUPPORTED", "", SeverityError},
+	{0xC038001A, "STATUS_VOLMGR_EXTENT_ALREADY_USED", "", SeverityError},
+	{0xC038001B, "STATUS_VOLMGR_EXTENT_NOT_CONTIGUOUS", "", SeverityError},
+	{0xC038001C, "STATUS_VOLMGR_EXTENT_NOT_IN_PUBLIC_REGION", "", SeverityError},
+	{0xC038001D, "STATUS_VOLMGR_EXTENT_NOT_SECTOR_ALIGNED", "", SeverityError},
+	{0xC038001E, "STATUS_VOLMGR_EXTENT_OVERLAPS_EBR_PARTITION", "", SeverityError},
+	{0xC038001F, "STATUS_VOLMGR_EXTENT_VOLUME_LENGTHS_DO_NOT_MATCH", "", SeverityError},
+	{0xC0380020, "STATUS_VOLMGR_FAULT_TOLERANT_NOT_SUPPORTED", "", SeverityError},
+	{0xC0380021, "STATUS_VOLMGR_INTERLEAVE_LENGTH_INVALID", "", SeverityError},
+	{0xC0380022, "STATUS_VOLMGR_MAXIMUM_REGISTERED_USERS", "", SeverityError},
+	{0xC0380023, "STATUS_VOLMGR_MEMBER_IN_SYNC", "", SeverityError},
+	{0xC0380024, "STATUS_VOLMGR_MEMBER_INDEX_DUPLICATE", "", SeverityError},
+	{0xC0380025, "STATUS_VOLMGR_MEMBER_INDEX_INVALID", "", SeverityError},
+	{0xC0380026, "STATUS_VOLMGR_MEMBER_MISSING", "", SeverityError},
+	{0xC0380027, "STATUS_VOLMGR_MEMBER_NOT_DETACHED", "", SeverityError},
+	{0xC0380028, "STATUS_VOLMGR_MEMBER_REGENERATING", "", SeverityError},
+	{0xC0380029, "STATUS_VOLMGR_ALL_DISKS_FAILED", "", SeverityError},
+	{0xC038002A, "STATUS_VOLMGR_NO_REGISTERED_USERS", "", SeverityError},
+	{0xC038002B, "STATUS_VOLMGR_NO_SUCH_USER", "", SeverityError},
+	{0xC038002C, "STATUS_VOLMGR_NOTIFICATION_RESET", "", SeverityError},
+	{0xC038002D, "STATUS_VOLMGR_NUMBER_OF_MEMBERS_INVALID", "", SeverityError},
+	{0xC038002E, "STATUS_VOLMGR_NUMBER_OF_PLEXES_INVALID", "", SeverityError},
+	{0xC038002F, "STATUS_VOLMGR_PACK_DUPLICATE", "", SeverityError},
+	{0xC0380030, "STATUS_VOLMGR_PACK_ID_INVALID", "", SeverityError},
+	{0xC0380031, "STATUS_VOLMGR_PACK_INVALID", "", SeverityError},
+	{0xC0380032, "STATUS_VOLMGR_PACK_NAME_INVALID", "", SeverityError},
+	{0xC0380033, "STATUS_VOLMGR_PACK_OFFLINE", "", SeverityError},
+	{0xC0380034, "STATUS_VOLMGR_PACK_HAS_QUORUM", "", SeverityError},
+	{0xC0380035, "STATUS_VOLMGR_PACK_WITHOUT_QUORUM", "", SeverityError},
+	{0xC0380036, "STATUS_VOLMGR_PARTITION_STYLE_INVALID", "", SeverityError},
+	{0xC0380037, "STATUS_VOLMGR_PARTITION_UPDATE_FAILED", "", SeverityError},
+	{0xC0380038, "STATUS_VOLMGR_PLEX_IN_SYNC", "", SeverityError},
+	{0xC0380039, "STATUS_VOLMGR_PLEX_INDEX_DUPLICATE", "", SeverityError},
+	{0xC038003A, "STATUS_VOLMGR_PLEX_INDEX_INVALID", "", SeverityError},
+	{0xC038003B, "STATUS_VOLMGR_PLEX_LAST_ACTIVE", "", SeverityError},
+	{0xC038003C, "STATUS_VOLMGR_PLEX_MISSING", "", SeverityError},
+	{0xC038003D, "STATUS_VOLMGR_PLEX_REGENERATING", "", SeverityError},
+	{0xC038003E, "STATUS_VOLMGR_PLEX_TYPE_INVALID", "", SeverityError},
+	{0xC038003F, "STATUS_VOLMGR_PLEX_NOT_RAID5", "", SeverityError},
+	{0xC0380040, "STATUS_VOLMGR_PLEX_NOT_SIMPLE", "", SeverityError},
+	{0xC0380041, "STATUS_VOLMGR_STRUCTURE_SIZE_INVALID", "", SeverityError},
+	{0xC0380042, "STATUS_VOLMGR_TOO_MANY_NOTIFICATION_REQUESTS", "", SeverityError},
+	{0xC0380043, "STATUS_VOLMGR_TRANSACTION_IN_PROGRESS", "", SeverityError},
+	{0xC0380044, "STATUS_VOLMGR_UNEXPECTED_DISK_LAYOUT_CHANGE", "", SeverityError},
+	{0xC0380045, "STATUS_VOLMGR_VOLUME_CONTAINS_MISSING_DISK", "", SeverityError},
+	{0xC0380046, "STATUS_VOLMGR_VOLUME_ID_INVALID", "", SeverityError},
+	{0xC0380047, "STATUS_VOLMGR_VOLUME_LENGTH_INVALID", "", SeverityError},
+	{0xC0380048, "STATUS_VOLMGR_VOLUME_LENGTH_NOT_SECTOR_SIZE_MULTIPLE", "", SeverityError},
+	{0xC0380049, "STATUS_VOLMGR_VOLUME_NOT_MIRRORED", "", SeverityError},
+	{0xC038004A, "STATUS_VOLMGR_VOLUME_NOT_RETAINED", "", SeverityError},
+	{0xC038004B, "STATUS_VOLMGR_VOLUME_OFFLINE", "", SeverityError},
+	{0xC038004C, "STATUS_VOLMGR_VOLUME_RETAINED", "", SeverityError},
+	{0xC038004D, "STATUS_VOLMGR_NUMBER_OF_EXTENTS_INVALID", "", SeverityError},
+	{0xC038004E, "STATUS_VOLMGR_DIFFERENT_SECTOR_SIZE", "", SeverityError},
+	{0xC038004F, "STATUS_VOLMGR_BAD_BOOT_DISK", "", SeverityError},
+	{0xC0380050, "STATUS_VOLMGR_PACK_CONFIG_OFFLINE", "", SeverityError},
+	{0xC0380051, "STATUS_VOLMGR_PACK_CONFIG_ONLINE", "", SeverityError},
+	{0xC0380052, "STATUS_VOLMGR_NOT_PRIMARY_PACK", "", SeverityError},
+	{0xC0380053, "STATUS_VOLMGR_PACK_LOG_UPDATE_FAILED", "", SeverityError},
+	{0xC0380054, "STATUS_VOLMGR_NUMBER_OF_DISKS_IN_PLEX_INVALID", "", SeverityError},
+	{0xC0380055, "STATUS_VOLMGR_NUMBER_OF_DISKS_IN_MEMBER_INVALID", "", SeverityError},
+	{0xC0380056, "STATUS_VOLMGR_VOLUME_MIRRORED", "", SeverityError},
+	{0xC0380057, "STATUS_VOLMGR_PLEX_NOT_SIMPLE_SPANNED", "", SeverityError},
+	{0xC0380058, "STATUS_VOLMGR_NO_VALID_LOG_COPIES", "", SeverityError},
+	{0xC0380059, "STATUS_VOLMGR_PRIMARY_PACK_PRESENT", "", SeverityError},
+	{0xC038005A, "STATUS_VOLMGR_NUMBER_OF_DISKS_INVALID", "", SeverityError},
+	{0xC038005B, "STATUS_VOLMGR_MIRROR_NOT_SUPPORTED", "", SeverityError},
+	{0xC038005C, "STATUS_VOLMGR_RAID5_NOT_SUPPORTED", "", SeverityError},
+	{0xC0390002, "STATUS_BCD_TOO_MANY_ELEMENTS", "", SeverityError},
+	{0xC03A0001, "STATUS_VHD_DRIVE_FOOTER_MISSING", "", SeverityError},
+	{0xC03A0002, "STATUS_VHD_DRIVE_FOOTER_CHECKSUM_MISMATCH", "", SeverityError},
+	{0xC03A0003, "STATUS_VHD_DRIVE_FOOTER_CORRUPT", "", SeverityError},
+	{0xC03A0004, "STATUS_VHD_FORMAT_UNKNOWN", "", SeverityError},
+	{0xC03A0005, "STATUS_VHD_FORMAT_UNSUPPORTED_VERSION", "", SeverityError},
+	{0xC03A0006, "STATUS_VHD_SPARSE_HEADER_CHECKSUM_MISMATCH", "", SeverityError},
+	{0xC03A0007, "STATUS_VHD_SPARSE_HEADER_UNSUPPORTED_VERSION", "", SeverityError},
+	{0xC03A0008, "STATUS_VHD_SPARSE_HEADER_CORRUPT", "", SeverityError},
+	{0xC03A0009, "STATUS_VHD_BLOCK_ALLOCATION_FAILURE", "", SeverityError},
+	{0xC03A000A, "STATUS_VHD_BLOCK_ALLOCATION_TABLE_CORRUPT", "", SeverityError},
+	{0xC03A000B, "STATUS_VHD_INVALID_BLOCK_SIZE", "", SeverityError},
+	{0xC03A000C, "STATUS_VHD_BITMAP_MISMATCH", "", SeverityError},
+	{0xC03A000D, "STATUS_VHD_PARENT_VHD_NOT_FOUND", "", SeverityError},
+	{0xC03A000E, "STATUS_VHD_CHILD_PARENT_ID_MISMATCH", "", SeverityError},
+	{0xC03A000F, "STATUS_VHD_CHILD_PARENT_TIMESTAMP_MISMATCH", "", SeverityError},
+	{0xC03A0010, "STATUS_VHD_METADATA_READ_FAILURE", "", SeverityError},
+	{0xC03A0011, "STATUS_VHD_METADATA_WRITE_FAILURE", "", SeverityError},
+	{0xC03A0012, "STATUS_VHD_INVALID_SIZE", "", SeverityError},
+	{0xC03A0013, "STATUS_VHD_INVALID_FILE_SIZE", "", SeverityError},
+	{0xC03A0014, "STATUS_VIRTDISK_PROVIDER_NOT_FOUND", "", SeverityError},
+	{0xC03A0015, "STATUS_VIRTDISK_NOT_VIRTUAL_DISK", "", SeverityError},
+	{0xC03A0016, "STATUS_VHD_PARENT_VHD_ACCESS_DENIED", "", SeverityError},
+	{0xC03A0017, "STATUS_VHD_CHILD_PARENT_SIZE_MISMATCH", "", SeverityError},
+	{0xC03A0018, "STATUS_VHD_DIFFERENCING_CHAIN_CYCLE_DETECTED", "", SeverityError},
+	{0xC03A0019, "STATUS_VHD_DIFFERENCING_CHAIN_ERROR_IN_PARENT", "", SeverityError},
+	{0xC03A001A, "STATUS_VIRTUAL_DISK_LIMITATION", "", SeverityError},
+	{0xC03A001B, "STATUS_VHD_INVALID_TYPE", "", SeverityError},
+	{0xC03A001C, "STATUS_VHD_INVALID_STATE", "", SeverityError},
+	{0xC03A001D, "STATUS_VIRTDISK_UNSUPPORTED_DISK_SECTOR_SIZE", "", SeverityError},
+	{0xC03A001E, "STATUS_VIRTDISK_DISK_ALREADY_OWNED", "", SeverityError},
+	{0xC03A001F, "STATUS_VIRTDISK_DISK_ONLINE_AND_WRITABLE", "", SeverityError},
+	{0xC03A0020, "STATUS_CTLOG_TRACKING_NOT_INITIALIZED", "", SeverityError},
+	{0xC03A0021, "STATUS_CTLOG_LOGFILE_SIZE_EXCEEDED_MAXSIZE", "", SeverityError},
+	{0xC03A0022, "STATUS_CTLOG_VHD_CHANGED_OFFLINE", "", SeverityError},
+	{0xC03A0023, "STATUS_CTLOG_INVALID_TRACKING_STATE", "", SeverityError},
+	{0xC03A0024, "STATUS_CTLOG_INCONSISTENT_TRACKING_FILE", "", SeverityError},
+	{0xC03A0028, "STATUS_VHD_METADATA_FULL", "", SeverityError},
+	{0xC03A0029, "STATUS_VHD_INVALID_CHANGE_TRACKING_ID", "", SeverityError},
+	{0xC03A002A, "STATUS_VHD_CHANGE_TRACKING_DISABLED", "", SeverityError},
+	{0xC03A0030, "STATUS_VHD_MISSING_CHANGE_TRACKING_INFORMATION", "", SeverityError},
+	{0xC03A0031, "STATUS_VHD_RESIZE_WOULD_TRUNCATE_DATA", "", SeverityError},
+	{0xC03A0032, "STATUS_VHD_COULD_NOT_COMPUTE_MINIMUM_VIRTUAL_SIZE", "", SeverityError},
+	{0xC03A0033, "STATUS_VHD_ALREADY_AT_OR_BELOW_MINIMUM_VIRTUAL_SIZE", "", SeverityError},
+	{0xC0400001, "STATUS_RKF_KEY_NOT_FOUND", "", SeverityError},
+	{0xC0400002, "STATUS_RKF_DUPLICATE_KEY", "", SeverityError},
+	{0xC0400003, "STATUS_RKF_BLOB_FULL", "", SeverityError},
+	{0xC0400004, "STATUS_RKF_STORE_FULL", "", SeverityError},
+	{0xC0400005, "STATUS_RKF_FILE_BLOCKED", "", SeverityError},
+	{0xC0400006, "STATUS_RKF_ACTIVE_KEY", "", SeverityError},
+	{0xC0410001, "STATUS_RDBSS_RESTART_OPERATION", "", SeverityError},
+	{0xC0410002, "STATUS_RDBSS_CONTINUE_OPERATION", "", SeverityError},
+	{0xC0410003, "STATUS_RDBSS_POST_OPERATION", "", SeverityError},
+	{0xC0410004, "STATUS_RDBSS_RETRY_LOOKUP", "", SeverityError},
+	{0xC0420001, "STATUS_BTH_ATT_INVALID_HANDLE", "", SeverityError},
+	{0xC0420002, "STATUS_BTH_ATT_READ_NOT_PERMITTED", "", SeverityError},
+	{0xC0420003, "STATUS_BTH_ATT_WRITE_NOT_PERMITTED", "", SeverityError},
+	{0xC0420004, "STATUS_BTH_ATT_INVALID_PDU", "", SeverityError},
+	{0xC0420005, "STATUS_BTH_ATT_INSUFFICIENT_AUTHENTICATION", "", SeverityError},
+	{0xC0420006, "STATUS_BTH_ATT_REQUEST_NOT_SUPPORTED", "", SeverityError},
+	{0xC0420007, "STATUS_BTH_ATT_INVALID_OFFSET", "", SeverityError},
+	{0xC0420008, "STATUS_BTH_ATT_INSUFFICIENT_AUTHORIZATION", "", SeverityError},
+	{0xC0420009, "STATUS_BTH_ATT_PREPARE_QUEUE_FULL", "", SeverityError},
+	{0xC042000A, "STATUS_BTH_ATT_ATTRIBUTE_NOT_FOUND", "", SeverityError},
+	{0xC042000B, "STATUS_BTH_ATT_ATTRIBUTE_NOT_LONG", "", SeverityError},
+	{0xC042000C, "STATUS_BTH_ATT_INSUFFICIENT_ENCRYPTION_KEY_SIZE", "", SeverityError},
+	{0xC042000D, "STATUS_BTH_ATT_INVALID_ATTRIBUTE_VALUE_LENGTH", "", SeverityError},
+	{0xC042000E, "STATUS_BTH_ATT_UNLIKELY", "", SeverityError},
+	{0xC042000F, "STATUS_BTH_ATT_INSUFFICIENT_ENCRYPTION", "", SeverityError},
+	{0xC0420010, "STATUS_BTH_ATT_UNSUPPORTED_GROUP_TYPE", "", SeverityError},
+	{0xC0420011, "STATUS_BTH_ATT_INSUFFICIENT_RESOURCES", "", SeverityError},
+	{0xC0421000, "STATUS_BTH_ATT_UNKNOWN_ERROR", "", SeverityError},
+	{0xC0430001, "STATUS_SECUREBOOT_ROLLBACK_DETECTED", "", SeverityError},
+	{0xC0430002, "STATUS_SECUREBOOT_POLICY_VIOLATION", "", SeverityError},
+	{0xC0430003, "STATUS_SECUREBOOT_INVALID_POLICY", "", SeverityError},
+	{0xC0430004, "STATUS_SECUREBOOT_POLICY_PUBLISHER_NOT_FOUND", "", SeverityError},
+	{0xC0430005, "STATUS_SECUREBOOT_POLICY_NOT_SIGNED", "", SeverityError},
+	{0xC0430007, "STATUS_SECUREBOOT_FILE_REPLACED", "", SeverityError},
+	{0xC0430008, "STATUS_SECUREBOOT_POLICY_NOT_AUTHORIZED", "", SeverityError},
+	{0xC0430009, "STATUS_SECUREBOOT_POLICY_UNKNOWN", "", SeverityError},
+	{0xC043000A, "STATUS_SECUREBOOT_POLICY_MISSING_ANTIROLLBACKVERSION", "", SeverityError},
+	{0xC043000B, "STATUS_SECUREBOOT_PLATFORM_ID_MISMATCH", "", SeverityError},
+	{0xC043000C, "STATUS_SECUREBOOT_POLICY_ROLLBACK_DETECTED", "", SeverityError},
+	{0xC043000D, "STATUS_SECUREBOOT_POLICY_UPGRADE_MISMATCH", "", SeverityError},
+	{0xC043000E, "STATUS_SECUREBOOT_REQUIRED_POLICY_FILE_MISSING", "", SeverityError},
+	{0xC043000F, "STATUS_SECUREBOOT_NOT_BASE_POLICY", "", SeverityError},
+	{0xC0430010, "STATUS_SECUREBOOT_NOT_SUPPLEMENTAL_POLICY", "", SeverityError},
+	{0xC0440001, "STATUS_AUDIO_ENGINE_NODE_NOT_FOUND", "", SeverityError},
+	{0xC0440002, "STATUS_HDAUDIO_EMPTY_CONNECTION_LIST", "", SeverityError},
+	{0xC0440003, "STATUS_HDAUDIO_CONNECTION_LIST_NOT_SUPPORTED", "", SeverityError},
+	{0xC0440004, "STATUS_HDAUDIO_NO_LOGICAL_DEVICES_CREATED", "", SeverityError},
+	{0xC0440005, "STATUS_HDAUDIO_NULL_LINKED_LIST_ENTRY", "", SeverityError},
+	{0xC0450000, "STATUS_VSM_NOT_INITIALIZED", "", SeverityError},
+	{0xC0450001, "STATUS_VSM_DMA_PROTECTION_NOT_IN_USE", "", SeverityError},
+	{0xC0500003, "STATUS_VOLSNAP_BOOTFILE_NOT_VALID", "", SeverityError},
+	{0xC0500004, "STATUS_VOLSNAP_ACTIVATION_TIMEOUT", "", SeverityError},
+	{0xC0510001, "STATUS_IO_PREEMPTED", "", SeverityError},
+	{0xC05C0000, "STATUS_SVHDX_ERROR_STORED", "", SeverityError},
+	{0xC05CFF00, "STATUS_SVHDX_ERROR_NOT_AVAILABLE", "", SeverityError},
+	{0xC05CFF01, "STATUS_SVHDX_UNIT_ATTENTION_AVAILABLE", "", SeverityError},
+	{0xC05CFF02, "STATUS_SVHDX_UNIT_ATTENTION_CAPACITY_DATA_CHANGED", "", SeverityError},
+	{0xC05CFF03, "STATUS_SVHDX_UNIT_ATTENTION_RESERVATIONS_PREEMPTED", "", SeverityError},
+	{0xC05CFF04, "STATUS_SVHDX_UNIT_ATTENTION_RESERVATIONS_RELEASED", "", SeverityError},
+	{0xC05CFF05, "STATUS_SVHDX_UNIT_ATTENTION_REGISTRATIONS_PREEMPTED", "", SeverityError},
+	{0xC05CFF06, "STATUS_SVHDX_UNIT_ATTENTION_OPERATING_DEFINITION_CHANGED", "", SeverityError},
+	{0xC05CFF07, "STATUS_SVHDX_RESERVATION_CONFLICT", "", SeverityError},
+	{0xC05CFF08, "STATUS_SVHDX_WRONG_FILE_TYPE", "", SeverityError},
+	{0xC05CFF09, "STATUS_SVHDX_VERSION_MISMATCH", "", SeverityError},
+	{0xC05CFF0A, "STATUS_VHD_SHARED", "", SeverityError},
+	{0xC05CFF0B, "STATUS_SVHDX_NO_INITIATOR", "", SeverityError},
+	{0xC05CFF0C, "STATUS_VHDSET_BACKING_STORAGE_NOT_FOUND", "", SeverityError},
+	{0xC05D0000, "STATUS_SMB_NO_PREAUTH_INTEGRITY_HASH_OVERLAP", "The client and server have no common preauthentication integrity hash algorithm.", SeverityError},
+	{0xC05D0001, "STATUS_SMB_BAD_CLUSTER_DIALECT", "The server does not support the requested SMB dialect.", SeverityError},
+	{0xC05D0002, "STATUS_SMB_GUEST_LOGON_BLOCKED", "The server blocked an unauthenticated guest logon.", SeverityError},
+	{0xC0E70001, "STATUS_SPACES_FAULT_DOMAIN_TYPE_INVALID", "", SeverityError},
+	{0xC0E70003, "STATUS_SPACES_RESILIENCY_TYPE_INVALID", "", SeverityError},
+	{0xC0E70004, "STATUS_SPACES_DRIVE_SECTOR_SIZE_INVALID", "", SeverityError},
+	{0xC0E70006, "STATUS_SPACES_DRIVE_REDUNDANCY_INVALID", "", SeverityError},
+	{0xC0E70007, "STATUS_SPACES_NUMBER_OF_DATA_COPIES_INVALID", "", SeverityError},
+	{0xC0E70009, "STATUS_SPACES_INTERLEAVE_LENGTH_INVALID", "", SeverityError},
+	{0xC0E7000A, "STATUS_SPACES_NUMBER_OF_COLUMNS_INVALID", "", SeverityError},
+	{0xC0E7000B, "STATUS_SPACES_NOT_ENOUGH_DRIVES", "", SeverityError},
+	{0xC0E7000C, "STATUS_SPACES_EXTENDED_ERROR", "", SeverityError},
+	{0xC0E7000D, "STATUS_SPACES_PROVISIONING_TYPE_INVALID", "", SeverityError},
+	{0xC0E7000E, "STATUS_SPACES_ALLOCATION_SIZE_INVALID", "", SeverityError},
+	{0xC0E7000F, "STATUS_SPACES_ENCLOSURE_AWARE_INVALID", "", SeverityError},
+	{0xC0E70010, "STATUS_SPACES_WRITE_CACHE_SIZE_INVALID", "", SeverityError},
+	{0xC0E70011, "STATUS_SPACES_NUMBER_OF_GROUPS_INVALID", "", SeverityError},
+	{0xC0E70012, "STATUS_SPACES_DRIVE_OPERATIONAL_STATE_INVALID", "", SeverityError},
+	{0xC0E70013, "STATUS_SPACES_UPDATE_COLUMN_STATE", "", SeverityError},
+	{0xC0E70014, "STATUS_SPACES_MAP_REQUIRED", "", SeverityError},
+	{0xC0E70015, "STATUS_SPACES_UNSUPPORTED_VERSION", "", SeverityError},
+	{0xC0E70016, "STATUS_SPACES_CORRUPT_METADATA", "", SeverityError},
+	{0xC0E70017, "STATUS_SPACES_DRT_FULL", "", SeverityError},
+	{0xC0E70018, "STATUS_SPACES_INCONSISTENCY", "", SeverityError},
+	{0xC0E70019, "STATUS_SPACES_LOG_NOT_READY", "", SeverityError},
+	{0xC0E7001A, "STATUS_SPACES_NO_REDUNDANCY", "", SeverityError},
+	{0xC0E7001B, "STATUS_SPACES_DRIVE_NOT_READY", "", SeverityError},
+	{0xC0E7001C, "STATUS_SPACES_DRIVE_SPLIT", "", SeverityError},
+	{0xC0E7001D, "STATUS_SPACES_DRIVE_LOST_DATA", "", SeverityError},
+	{0xC0E7001E, "STATUS_SPACES_ENTRY_INCOMPLETE", "", SeverityError},
+	{0xC0E7001F, "STATUS_SPACES_ENTRY_INVALID", "", SeverityError},
+	{0xC0E70020, "STATUS_SPACES_MARK_DIRTY", "", SeverityError},
+	{0xC0E80000, "STATUS_SECCORE_INVALID_COMMAND", "", SeverityError},
+	{0xC0E90001, "STATUS_SYSTEM_INTEGRITY_ROLLBACK_DETECTED", "", SeverityError},
+	{0xC0E90002, "STATUS_SYSTEM_INTEGRITY_POLICY_VIOLATION", "", SeverityError},
+	{0xC0E90003, "STATUS_SYSTEM_INTEGRITY_INVALID_POLICY", "", SeverityError},
+	{0xC0E90004, "STATUS_SYSTEM_INTEGRITY_POLICY_NOT_SIGNED", "", SeverityError},
+	{0xC0E90005, "STATUS_SYSTEM_INTEGRITY_TOO_MANY_POLICIES", "", SeverityError},
+	{0xC0E90006, "STATUS_SYSTEM_INTEGRITY_SUPPLEMENTAL_POLICY_NOT_AUTHORIZED", "", SeverityError},
+	{0xC0EA0001, "STATUS_NO_APPLICABLE_APP_LICENSES_FOUND", "", SeverityError},
+	{0xC0EA0002, "STATUS_CLIP_LICENSE_NOT_FOUND", "", SeverityError},
+	{0xC0EA0003, "STATUS_CLIP_DEVICE_LICENSE_MISSING", "", SeverityError},
+	{0xC0EA0004, "STATUS_CLIP_LICENSE_INVALID_SIGNATURE", "", SeverityError},
+	{0xC0EA0005, "STATUS_CLIP_KEYHOLDER_LICENSE_MISSING_OR_INVALID", "", SeverityError},
+	{0xC0EA0006, "STATUS_CLIP_LICENSE_EXPIRED", "", SeverityError},
+	{0xC0EA0007, "STATUS_CLIP_LICENSE_SIGNED_BY_UNKNOWN_SOURCE", "", SeverityError},
+	{0xC0EA0008, "STATUS_CLIP_LICENSE_NOT_SIGNED", "", SeverityError},
+	{0xC0EA0009, "STATUS_CLIP_LICENSE_HARDWARE_ID_OUT_OF_TOLERANCE", "", SeverityError},
+	{0xC0EA000A, "STATUS_CLIP_LICENSE_DEVICE_ID_MISMATCH", "", SeverityError},
+	{0xC0EB0001, "STATUS_PLATFORM_MANIFEST_NOT_AUTHORIZED", "", SeverityError},
+	{0xC0EB0002, "STATUS_PLATFORM_MANIFEST_INVALID", "", SeverityError},
+	{0xC0EB0003, "STATUS_PLATFORM_MANIFEST_FILE_NOT_AUTHORIZED", "", SeverityError},
+	{0xC0EB0004, "STATUS_PLATFORM_MANIFEST_CATALOG_NOT_AUTHORIZED", "", SeverityError},
+	{0xC0EB0005, "STATUS_PLATFORM_MANIFEST_BINARY_ID_NOT_FOUND", "", SeverityError},
+	{0xC0EB0006, "STATUS_PLATFORM_MANIFEST_NOT_ACTIVE", "", SeverityError},
+	{0xC0EB0007, "STATUS_PLATFORM_MANIFEST_NOT_SIGNED", "", SeverityError},
+	{0xC0EC0000, "STATUS_APPEXEC_CONDITION_NOT_SATISFIED", "", SeverityError},
+	{0xC0EC0001, "STATUS_APPEXEC_HANDLE_INVALIDATED", "", SeverityError},
+	{0xC0EC0002, "STATUS_APPEXEC_INVALID_HOST_GENERATION", "", SeverityError},
+	{0xC0EC0003, "STATUS_APPEXEC_UNEXPECTED_PROCESS_REGISTRATION", "", SeverityError},
+	{0xC0EC0004, "STATUS_APPEXEC_INVALID_HOST_STATE", "", SeverityError},
+	{0xC0EC0005, "STATUS_APPEXEC_NO_DONOR", "", SeverityError},
+	{0xC0EC0006, "STATUS_APPEXEC_HOST_ID_MISMATCH", "", SeverityError},
+	{0xC0EC0007, "STATUS_APPEXEC_UNKNOWN_USER", "", SeverityError},
+}
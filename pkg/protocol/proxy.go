@@ -0,0 +1,226 @@
+package protocol
+
+import (
+	"bufio"
+	"context"
+	"crypto/tls"
+	"encoding/base64"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"io"
+	"net"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+	"time"
+
+	"golang.org/x/net/proxy"
+)
+
+// proxyHandshakeTimeout bounds the CONNECT or SOCKS4 exchange with a proxy.
+const proxyHandshakeTimeout = 10 * time.Second
+
+// socks4Granted is the SOCKS4 reply code for a granted request.
+const socks4Granted = 90
+
+// proxyDialer returns a dialer that goes through each proxy in list, a
+// comma-separated chain of proxy URLs, in order: the first is dialed
+// directly with forward and every later one through its predecessor.
+// SOCKS4 proxies are given target addresses resolved through r.
+func proxyDialer(list string, forward proxy.Dialer, r *Resolver) (proxy.Dialer, error) {
+	dialer := forward
+	for addr := range strings.SplitSeq(list, ",") {
+		if addr = strings.TrimSpace(addr); addr == "" {
+			continue
+		}
+		proxyURL, err := url.Parse(addr)
+		if err != nil {
+			return nil, fmt.Errorf("invalid proxy address: %w", err)
+		}
+		// HTTP and SOCKS4 are handled here rather than registered with
+		// the proxy package, which would change them for the whole program.
+		switch proxyURL.Scheme {
+		case "http", "https":
+			dialer = newHTTPConnectDialer(proxyURL, dialer)
+		case "socks4", "socks4a":
+			dialer = newSOCKS4Dialer(proxyURL, dialer, r)
+		default:
+			if dialer, err = proxy.FromURL(proxyURL, dialer); err != nil {
+				return nil, fmt.Errorf("failed to create proxy dialer for %s: %w", proxyURL.Redacted(), err)
+			}
+		}
+	}
+	return dialer, nil
+}
+
+// httpConnectDialer tunnels through an HTTP proxy with CONNECT, over TLS
+// for https:// proxies. Credentials in the URL are sent as Basic auth.
+type httpConnectDialer struct {
+	addr    string
+	tls     *tls.Config
+	auth    string
+	forward proxy.Dialer
+}
+
+func newHTTPConnectDialer(u *url.URL, forward proxy.Dialer) *httpConnectDialer {
+	d := &httpConnectDialer{addr: u.Host, forward: forward}
+	port := "80"
+	if u.Scheme == "https" {
+		port = "443"
+		d.tls = &tls.Config{ServerName: u.Hostname()}
+	}
+	if u.Port() == "" {
+		d.addr = net.JoinHostPort(u.Hostname(), port)
+	}
+	if u.User != nil {
+		password, _ := u.User.Password()
+		d.auth = base64.StdEncoding.EncodeToString([]byte(u.User.Username() + ":" + password))
+	}
+	return d
+}
+
+func (d *httpConnectDialer) Dial(network, addr string) (net.Conn, error) {
+	if network != "tcp" && network != "tcp4" && network != "tcp6" {
+		return nil, fmt.Errorf("HTTP proxy does not support network %q", network)
+	}
+	conn, err := d.forward.Dial("tcp", d.addr)
+	if err != nil {
+		return nil, err
+	}
+	_ = conn.SetDeadline(time.Now().Add(proxyHandshakeTimeout))
+	if d.tls != nil {
+		tlsConn := tls.Client(conn, d.tls)
+		if err := tlsConn.Handshake(); err != nil {
+			_ = conn.Close()
+			return nil, fmt.Errorf("HTTP proxy TLS handshake failed: %w", err)
+		}
+		conn = tlsConn
+	}
+
+	req := fmt.Sprintf("CONNECT %s HTTP/1.1\r\nHost: %s\r\n", addr, addr)
+	if d.auth != "" {
+		req += "Proxy-Authorization: Basic " + d.auth + "\r\n"
+	}
+	if _, err := io.WriteString(conn, req+"\r\n"); err != nil {
+		_ = conn.Close()
+		return nil, err
+	}
+
+	br := bufio.NewReader(conn)
+	resp, err := http.ReadResponse(br, &http.Request{Method: http.MethodConnect})
+	if err != nil {
+		_ = conn.Close()
+		return nil, fmt.Errorf("HTTP proxy CONNECT: %w", err)
+	}
+	_ = resp.Body.Close()
+	if resp.StatusCode != http.StatusOK {
+		_ = conn.Close()
+		return nil, fmt.Errorf("HTTP proxy CONNECT to %s: %s", addr, resp.Status)
+	}
+	_ = conn.SetDeadline(time.Time{})
+	if br.Buffered() > 0 {
+		return &bufferedConn{Conn: conn, r: br}, nil
+	}
+	return conn, nil
+}
+
+// bufferedConn returns data the proxy sent right after its CONNECT reply
+// before reading from the connection again.
+type bufferedConn struct {
+	net.Conn
+	r *bufio.Reader
+}
+
+func (c *bufferedConn) Read(b []byte) (int, error) {
+	return c.r.Read(b)
+}
+
+// socks4Dialer speaks SOCKS4, which resolves the target locally and
+// needs an IPv4 address, or SOCKS4a, which lets the proxy resolve names.
+// The URL user name is sent as the SOCKS user ID.
+type socks4Dialer struct {
+	addr          string
+	userID        string
+	remoteResolve bool
+	resolver      *Resolver
+	forward       proxy.Dialer
+}
+
+func newSOCKS4Dialer(u *url.URL, forward proxy.Dialer, r *Resolver) *socks4Dialer {
+	d := &socks4Dialer{addr: u.Host, remoteResolve: u.Scheme == "socks4a", resolver: r, forward: forward}
+	if u.Port() == "" {
+		d.addr = net.JoinHostPort(u.Hostname(), "1080")
+	}
+	if u.User != nil {
+		d.userID = u.User.Username()
+	}
+	return d
+}
+
+func (d *socks4Dialer) Dial(network, addr string) (net.Conn, error) {
+	if network != "tcp" && network != "tcp4" {
+		return nil, fmt.Errorf("SOCKS4 proxy does not support network %q", network)
+	}
+	host, portStr, err := net.SplitHostPort(addr)
+	if err != nil {
+		return nil, err
+	}
+	port, err := strconv.ParseUint(portStr, 10, 16)
+	if err != nil {
+		return nil, fmt.Errorf("invalid port %q", portStr)
+	}
+
+	// SOCKS4a marks a name to be resolved by the proxy with 0.0.0.x.
+	ip := net.ParseIP(host).To4()
+	var name string
+	if ip == nil {
+		if d.remoteResolve {
+			ip, name = net.IPv4(0, 0, 0, 1).To4(), host
+		} else {
+			addrs, err := d.resolver.LookupNetIP(context.Background(), "ip4", host)
+			if err != nil {
+				return nil, err
+			}
+			if len(addrs) == 0 {
+				return nil, fmt.Errorf("no IPv4 address for %s", host)
+			}
+			ip4 := addrs[0].Unmap().As4()
+			ip = ip4[:]
+		}
+	}
+
+	req := []byte{4, 1}
+	req = binary.BigEndian.AppendUint16(req, uint16(port))
+	req = append(req, ip...)
+	req = append(append(req, d.userID...), 0)
+	if name != "" {
+		req = append(append(req, name...), 0)
+	}
+
+	conn, err := d.forward.Dial("tcp", d.addr)
+	if err != nil {
+		return nil, err
+	}
+	_ = conn.SetDeadline(time.Now().Add(proxyHandshakeTimeout))
+	if _, err := conn.Write(req); err != nil {
+		_ = conn.Close()
+		return nil, err
+	}
+	reply := make([]byte, 8)
+	if _, err := io.ReadFull(conn, reply); err != nil {
+		_ = conn.Close()
+		return nil, fmt.Errorf("SOCKS4 proxy: %w", err)
+	}
+	if reply[0] != 0 {
+		_ = conn.Close()
+		return nil, errors.New("SOCKS4 proxy: invalid reply")
+	}
+	if reply[1] != socks4Granted {
+		_ = conn.Close()
+		return nil, fmt.Errorf("SOCKS4 proxy rejected the request to %s (code %d)", addr, reply[1])
+	}
+	_ = conn.SetDeadline(time.Time{})
+	return conn, nil
+}
@@ -0,0 +1,208 @@
+package protocol_test
+
+import (
+	"bufio"
+	"encoding/base64"
+	"encoding/binary"
+	"fmt"
+	"io"
+	"net"
+	"net/http"
+	"net/url"
+	"strconv"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"golang.org/x/net/proxy"
+
+	"github.com/d0rvin/winscope-smb/pkg/protocol"
+)
+
+func listen(t *testing.T) net.Listener {
+	t.Helper()
+	ln, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		t.Fatal(err)
+	}
+	t.Cleanup(func() { _ = ln.Close() })
+	return ln
+}
+
+// accept runs handle for every connection on ln.
+func accept(ln net.Listener, handle func(net.Conn)) {
+	go func() {
+		for {
+			conn, err := ln.Accept()
+			if err != nil {
+				return
+			}
+			go func() {
+				defer conn.Close()
+				handle(conn)
+			}()
+		}
+	}()
+}
+
+// echoServer returns the address of a server echoing everything back.
+func echoServer(t *testing.T) (string, uint16) {
+	t.Helper()
+	ln := listen(t)
+	accept(ln, func(conn net.Conn) { _, _ = io.Copy(conn, conn) })
+	host, portStr, _ := net.SplitHostPort(ln.Addr().String())
+	port, _ := strconv.Atoi(portStr)
+	return host, uint16(port)
+}
+
+func relay(client net.Conn, target string) {
+	upstream, err := net.Dial("tcp", target)
+	if err != nil {
+		return
+	}
+	defer upstream.Close()
+	go func() { _, _ = io.Copy(upstream, client) }()
+	_, _ = io.Copy(client, upstream)
+}
+
+// httpProxy is a CONNECT proxy requiring Basic credentials when auth is
+// set. The CONNECT targets are sent to targets.
+func httpProxy(t *testing.T, auth string, targets chan<- string) string {
+	t.Helper()
+	ln := listen(t)
+	accept(ln, func(conn net.Conn) {
+		req, err := http.ReadRequest(bufio.NewReader(conn))
+		if err != nil || req.Method != http.MethodConnect {
+			_, _ = io.WriteString(conn, "HTTP/1.1 405 Method Not Allowed\r\n\r\n")
+			return
+		}
+		if auth != "" && req.Header.Get("Proxy-Authorization") != "Basic "+base64.StdEncoding.EncodeToString([]byte(auth)) {
+			_, _ = io.WriteString(conn, "HTTP/1.1 407 Proxy Authentication Required\r\nProxy-Authenticate: Basic realm=\"jump\"\r\n\r\n")
+			return
+		}
+		targets <- req.Host
+		_, _ = io.WriteString(conn, "HTTP/1.1 200 Connection established\r\n\r\n")
+		relay(conn, req.Host)
+	})
+	return ln.Addr().String()
+}
+
+// socks4Request is what a SOCKS4 proxy received.
+type socks4Request struct {
+	Addr   string
+	UserID string
+	Name   string
+}
+
+func readString(r *bufio.Reader) string {
+	s, _ := r.ReadString(0)
+	if len(s) > 0 {
+		s = s[:len(s)-1]
+	}
+	return s
+}
+
+// socks4Proxy accepts SOCKS4 and SOCKS4a CONNECT requests, resolving
+// SOCKS4a names itself.
+func socks4Proxy(t *testing.T, requests chan<- socks4Request) string {
+	t.Helper()
+	ln := listen(t)
+	accept(ln, func(conn net.Conn) {
+		r := bufio.NewReader(conn)
+		hdr := make([]byte, 8)
+		if _, err := io.ReadFull(r, hdr); err != nil || hdr[0] != 4 || hdr[1] != 1 {
+			return
+		}
+		req := socks4Request{UserID: readString(r)}
+		port := binary.BigEndian.Uint16(hdr[2:])
+		host := net.IP(hdr[4:8]).String()
+		if hdr[4] == 0 && hdr[5] == 0 && hdr[6] == 0 && hdr[7] != 0 {
+			req.Name = readString(r)
+			host = req.Name
+		}
+		req.Addr = net.JoinHostPort(host, fmt.Sprint(port))
+		requests <- req
+		_, _ = conn.Write([]byte{0, 90, 0, 0, 0, 0, 0, 0})
+		relay(conn, req.Addr)
+	})
+	return ln.Addr().String()
+}
+
+func roundTrip(t *testing.T, host string, port uint16, proxy string) error {
+	t.Helper()
+	c, err := protocol.NewConnection(host, port, protocol.WithProxy(proxy))
+	if err != nil {
+		return err
+	}
+	if err := c.Dial("tcp"); err != nil {
+		return err
+	}
+	defer c.Close()
+	if _, err := c.Write([]byte("ping")); err != nil {
+		return err
+	}
+	buf := make([]byte, 4)
+	if _, err := io.ReadFull(c, buf); err != nil {
+		return err
+	}
+	assert.Equal(t, "ping", string(buf))
+	return nil
+}
+
+func TestProxyHTTPConnect(t *testing.T) {
+	host, port := echoServer(t)
+	targets := make(chan string, 1)
+	addr := httpProxy(t, "alice:s3cret", targets)
+
+	assert.NoError(t, roundTrip(t, host, port, "http://alice:s3cret@"+addr))
+	assert.Equal(t, net.JoinHostPort(host, fmt.Sprint(port)), <-targets)
+
+	err := roundTrip(t, host, port, "http://alice:wrong@"+addr)
+	assert.ErrorContains(t, err, "407")
+}
+
+func TestProxyRegistry(t *testing.T) {
+	// Importing protocol leaves the proxy package's schemes alone.
+	u, _ := url.Parse("http://127.0.0.1:3128")
+	_, err := proxy.FromURL(u, proxy.Direct)
+	assert.ErrorContains(t, err, "unknown scheme")
+}
+
+func TestProxySOCKS4(t *testing.T) {
+	_, port := echoServer(t)
+	requests := make(chan socks4Request, 1)
+	addr := socks4Proxy(t, requests)
+
+	assert.NoError(t, roundTrip(t, "127.0.0.1", port, "socks4://scanner@"+addr))
+	req := <-requests
+	assert.Equal(t, net.JoinHostPort("127.0.0.1", fmt.Sprint(port)), req.Addr)
+	assert.Equal(t, "scanner", req.UserID)
+	assert.Empty(t, req.Name)
+
+	// SOCKS4a hands the name to the proxy.
+	assert.NoError(t, roundTrip(t, "localhost", port, "socks4a://"+addr))
+	req = <-requests
+	assert.Equal(t, "localhost", req.Name)
+
+	// SOCKS4 resolves names itself, through the resolver given.
+	c, err := protocol.NewConnection("files.corp.example", port, protocol.WithProxy("socks4://"+addr),
+		protocol.WithResolver(&protocol.Resolver{Server: dnsServer(t)}))
+	if assert.NoError(t, err) && assert.NoError(t, c.Dial("tcp")) {
+		defer c.Close()
+		req = <-requests
+		assert.Equal(t, net.JoinHostPort("10.1.2.3", fmt.Sprint(port)), req.Addr)
+		assert.Empty(t, req.Name)
+	}
+}
+
+func TestProxyChain(t *testing.T) {
+	host, port := echoServer(t)
+	targets := make(chan string, 1)
+	requests := make(chan socks4Request, 1)
+	first := httpProxy(t, "", targets)
+	second := socks4Proxy(t, requests)
+
+	assert.NoError(t, roundTrip(t, host, port, "http://"+first+", socks4a://"+second))
+	// The HTTP proxy tunnels to the SOCKS4 proxy, which reaches the target.
+	assert.Equal(t, second, <-targets)
+	assert.Equal(t, net.JoinHostPort(host, fmt.Sprint(port)), (<-requests).Addr)
+}
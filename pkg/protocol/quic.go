@@ -0,0 +1,106 @@
+package protocol
+
+import (
+	"context"
+	"crypto/tls"
+	"errors"
+	"fmt"
+	"net"
+
+	"github.com/quic-go/quic-go"
+)
+
+// ALPNSMB is the ALPN protocol ID negotiated for SMB over QUIC.
+const ALPNSMB = "smb"
+
+// WithQUIC carries the connection over a single bidirectional QUIC stream
+// (SMB over QUIC, usually UDP 443) instead of TCP. config may be nil; the
+// ALPN, server name and TLS 1.3 minimum are filled in on dial.
+func WithQUIC(config *tls.Config) Option {
+	return func(c *Connection) {
+		c.QUIC = true
+		c.TLSConfig = config
+	}
+}
+
+// quicConn adapts a QUIC stream and its connection to net.Conn. The UDP
+// socket it runs on is owned by the connection.
+type quicConn struct {
+	*quic.Stream
+	conn  *quic.Conn
+	pconn net.PacketConn
+}
+
+func (q *quicConn) LocalAddr() net.Addr {
+	return q.conn.LocalAddr()
+}
+
+func (q *quicConn) RemoteAddr() net.Addr {
+	return q.conn.RemoteAddr()
+}
+
+func (q *quicConn) Close() error {
+	_ = q.Stream.Close()
+	err := q.conn.CloseWithError(0, "")
+	_ = q.pconn.Close()
+	return err
+}
+
+func (c *Connection) dialQUIC() error {
+	if c.ProxyAddr != "" {
+		return errors.New("QUIC is not supported through a proxy")
+	}
+
+	config := &tls.Config{}
+	if c.TLSConfig != nil {
+		config = c.TLSConfig.Clone()
+	}
+	config.NextProtos = []string{ALPNSMB}
+	config.MinVersion = tls.VersionTLS13
+	if config.ServerName == "" {
+		config.ServerName = c.Host
+	}
+
+	ctx := context.Background()
+	if c.DialTimeout > 0 {
+		var cancel context.CancelFunc
+		ctx, cancel = context.WithTimeout(ctx, c.DialTimeout)
+		defer cancel()
+	}
+
+	remote := &net.UDPAddr{IP: c.Addr.IP, Zone: c.Addr.Zone, Port: int(c.Port)}
+	local, err := c.localAddr(remote.IP)
+	if err != nil {
+		return err
+	}
+	laddr := &net.UDPAddr{}
+	if local != nil {
+		laddr = &net.UDPAddr{IP: local.IP, Zone: local.Zone}
+	}
+	pconn, err := net.ListenUDP("udp", laddr)
+	if err != nil {
+		return err
+	}
+	conn, err := quic.Dial(ctx, pconn, remote, config, &quic.Config{
+		HandshakeIdleTimeout: c.DialTimeout,
+		KeepAlivePeriod:      c.DialKeepAlive,
+	})
+	if err != nil {
+		_ = pconn.Close()
+		return err
+	}
+	stream, err := conn.OpenStreamSync(ctx)
+	if err != nil {
+		closeErr := conn.CloseWithError(0, "")
+		_ = pconn.Close()
+		return fmt.Errorf("open QUIC stream failed: %w (connection closed: %v)", err, closeErr)
+	}
+
+	state := conn.ConnectionState().TLS
+	c.tlsState = &state
+	c.conn = &quicConn{Stream: stream, conn: conn, pconn: pconn}
+	if c.Recorder != nil {
+		c.conn = c.record(c.conn, c.Port)
+	}
+	return nil
+}
@@ -0,0 +1,216 @@
+package rdp
+
+import (
+	"encoding/asn1"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"io"
+)
+
+const DefaultPort = 3389
+
+const (
+	tpktVersion    uint8 = 0x03
+	tpktHeaderSize       = 4
+)
+
+const (
+	X224ConnectionRequest uint8 = 0xe0
+	X224ConnectionConfirm uint8 = 0xd0
+)
+
+const (
+	TypeNegReq     uint8 = 0x01
+	TypeNegRsp     uint8 = 0x02
+	TypeNegFailure uint8 = 0x03
+)
+
+// Security protocols requested in RDP_NEG_REQ and selected in RDP_NEG_RSP.
+const (
+	ProtocolRDP      uint32 = 0x00000000
+	ProtocolSSL      uint32 = 0x00000001
+	ProtocolHybrid   uint32 = 0x00000002
+	ProtocolRDSTLS   uint32 = 0x00000004
+	ProtocolHybridEx uint32 = 0x00000008
+)
+
+// RDP_NEG_FAILURE codes.
+const (
+	FailureSSLRequired         uint32 = 0x00000001
+	FailureSSLNotAllowed       uint32 = 0x00000002
+	FailureSSLCertNotOnServer  uint32 = 0x00000003
+	FailureInconsistentFlags   uint32 = 0x00000004
+	FailureHybridRequired      uint32 = 0x00000005
+	FailureSSLWithUserAuthReqd uint32 = 0x00000006
+)
+
+// TSRequestVersion is the CredSSP version announced by the client.
+const TSRequestVersion = 6
+
+func ProtocolName(p uint32) string {
+	switch p {
+	case ProtocolRDP:
+		return "Standard RDP Security"
+	case ProtocolSSL:
+		return "TLS"
+	case ProtocolHybrid:
+		return "CredSSP (NLA)"
+	case ProtocolRDSTLS:
+		return "RDSTLS"
+	case ProtocolHybridEx:
+		return "CredSSP with Early User Authorization (NLA)"
+	}
+	return fmt.Sprintf("0x%08x", p)
+}
+
+// NegotiationFailure is an RDP_NEG_FAILURE returned in the Connection Confirm.
+type NegotiationFailure struct {
+	Code uint32
+}
+
+func (e *NegotiationFailure) Error() string {
+	switch e.Code {
+	case FailureSSLRequired:
+		return "RDP negotiation failed: server requires TLS"
+	case FailureSSLNotAllowed:
+		return "RDP negotiation failed: server only allows Standard RDP Security"
+	case FailureSSLCertNotOnServer:
+		return "RDP negotiation failed: server has no TLS certificate"
+	case FailureInconsistentFlags:
+		return "RDP negotiation failed: inconsistent flags"
+	case FailureHybridRequired:
+		return "RDP negotiation failed: server requires CredSSP"
+	case FailureSSLWithUserAuthReqd:
+		return "RDP negotiation failed: server requires TLS with user authentication"
+	}
+	return fmt.Sprintf("RDP negotiation failed: code 0x%08x", e.Code)
+}
+
+// MarshalConnectionRequest encodes a TPKT-framed X.224 Connection Request
+// carrying an RDP_NEG_REQ for the given protocols.
+func MarshalConnectionRequest(protocols uint32) []byte {
+	negReq := []byte{TypeNegReq, 0x00}
+	negReq = binary.LittleEndian.AppendUint16(negReq, 8)
+	negReq = binary.LittleEndian.AppendUint32(negReq, protocols)
+
+	// LI, CR code, DST-REF, SRC-REF, class option
+	x224 := []byte{0, X224ConnectionRequest, 0, 0, 0, 0, 0}
+	x224 = append(x224, negReq...)
+	x224[0] = uint8(len(x224) - 1)
+
+	buf := []byte{tpktVersion, 0, 0, 0}
+	binary.BigEndian.PutUint16(buf[2:], uint16(tpktHeaderSize+len(x224)))
+	return append(buf, x224...)
+}
+
+// ParseConnectionConfirm decodes the X.224 Connection Confirm payload of a
+// TPKT and returns the selected protocol. A confirm without RDP_NEG_RSP
+// comes from a server that only supports Standard RDP Security.
+func ParseConnectionConfirm(buf []byte) (uint32, error) {
+	if len(buf) < 7 {
+		return 0, errors.New("X.224 Connection Confirm truncated")
+	}
+	if buf[1]&0xf0 != X224ConnectionConfirm {
+		return 0, fmt.Errorf("unexpected X.224 TPDU code 0x%02x", buf[1])
+	}
+	neg := buf[7:]
+	if len(neg) == 0 {
+		return ProtocolRDP, nil
+	}
+	if len(neg) < 8 {
+		return 0, errors.New("RDP negotiation response truncated")
+	}
+	switch neg[0] {
+	case TypeNegRsp:
+		return binary.LittleEndian.Uint32(neg[4:]), nil
+	case TypeNegFailure:
+		return 0, &NegotiationFailure{Code: binary.LittleEndian.Uint32(neg[4:])}
+	}
+	return 0, fmt.Errorf("unexpected RDP negotiation type 0x%02x", neg[0])
+}
+
+// ReadTPKT reads a single TPKT and returns its payload.
+func ReadTPKT(r io.Reader) ([]byte, error) {
+	hdr := make([]byte, tpktHeaderSize)
+	if _, err := io.ReadFull(r, hdr); err != nil {
+		return nil, err
+	}
+	if hdr[0] != tpktVersion {
+		return nil, fmt.Errorf("unexpected TPKT version 0x%02x", hdr[0])
+	}
+	length := int(binary.BigEndian.Uint16(hdr[2:]))
+	if length < tpktHeaderSize {
+		return nil, errors.New("invalid TPKT length")
+	}
+	payload := make([]byte, length-tpktHeaderSize)
+	if _, err := io.ReadFull(r, payload); err != nil {
+		return nil, err
+	}
+	return payload, nil
+}
+
+type NegoToken struct {
+	Token []byte `asn1:"explicit,tag:0"`
+}
+
+// TSRequest is the CredSSP message exchanged over TLS. Only the fields used
+// up to the NTLM challenge are modelled.
+type TSRequest struct {
+	Version    int         `asn1:"explicit,tag:0"`
+	NegoTokens []NegoToken `asn1:"explicit,optional,omitempty,tag:1"`
+	AuthInfo   []byte      `asn1:"explicit,optional,omitempty,tag:2"`
+	PubKeyAuth []byte      `asn1:"explicit,optional,omitempty,tag:3"`
+	ErrorCode  int         `asn1:"explicit,optional,tag:4"`
+}
+
+func (t *TSRequest) MarshalBinary() ([]byte, error) {
+	return asn1.Marshal(*t)
+}
+
+func (t *TSRequest) UnmarshalBinary(buf []byte) error {
+	var req TSRequest
+	if _, err := asn1.Unmarshal(buf, &req); err != nil {
+		return fmt.Errorf("invalid TSRequest: %w", err)
+	}
+	*t = req
+	return nil
+}
+
+// ReadTSRequest reads one DER-encoded TSRequest. CredSSP has no framing of
+// its own, so the length is taken from the outer SEQUENCE header.
+func ReadTSRequest(r io.Reader) (*TSRequest, error) {
+	hdr := make([]byte, 2)
+	if _, err := io.ReadFull(r, hdr); err != nil {
+		return nil, err
+	}
+	if hdr[0] != 0x30 {
+		return nil, fmt.Errorf("unexpected TSRequest tag 0x%02x", hdr[0])
+	}
+	length := int(hdr[1])
+	if length&0x80 != 0 {
+		n := length & 0x7f
+		if n == 0 || n > 3 {
+			return nil, errors.New("invalid TSRequest length")
+		}
+		ext := make([]byte, n)
+		if _, err := io.ReadFull(r, ext); err != nil {
+			return nil, err
+		}
+		hdr = append(hdr, ext...)
+		length = 0
+		for _, b := range ext {
+			length = length<<8 | int(b)
+		}
+	}
+	buf := make([]byte, len(hdr)+length)
+	copy(buf, hdr)
+	if _, err := io.ReadFull(r, buf[len(hdr):]); err != nil {
+		return nil, err
+	}
+	req := &TSRequest{}
+	if err := req.UnmarshalBinary(buf); err != nil {
+		return nil, err
+	}
+	return req, nil
+}
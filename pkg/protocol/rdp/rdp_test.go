@@ -0,0 +1,143 @@
+package rdp_test
+
+import (
+	"crypto/tls"
+	"encoding/binary"
+	"net"
+	"strconv"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/d0rvin/winscope-smb/internal/testutil"
+	"github.com/d0rvin/winscope-smb/pkg/protocol"
+	"github.com/d0rvin/winscope-smb/pkg/protocol/ntlmssp"
+	"github.com/d0rvin/winscope-smb/pkg/protocol/rdp"
+)
+
+// connectionConfirm builds a TPKT X.224 Connection Confirm with an optional
+// negotiation response or failure.
+func connectionConfirm(negType uint8, value uint32) []byte {
+	x224 := []byte{6, rdp.X224ConnectionConfirm, 0, 0, 0x12, 0x34, 0}
+	if negType != 0 {
+		x224 = append(x224, negType, 0, 8, 0)
+		x224 = binary.LittleEndian.AppendUint32(x224, value)
+		x224[0] = uint8(len(x224) - 1)
+	}
+	buf := []byte{3, 0, 0, 0}
+	binary.BigEndian.PutUint16(buf[2:], uint16(4+len(x224)))
+	return append(buf, x224...)
+}
+
+// serveRDP is a stand-in RDP server. It answers the Connection Request with
+// confirm and, if CredSSP was selected, replies to the first TSRequest with
+// an NTLM challenge. The client's negoToken is sent to tokens.
+func serveRDP(t *testing.T, ln net.Listener, confirm []byte, challenge []byte, tokens chan<- []byte) {
+	conn, err := ln.Accept()
+	if err != nil {
+		return
+	}
+	defer conn.Close()
+
+	req, err := rdp.ReadTPKT(conn)
+	if err != nil {
+		t.Error(err)
+		return
+	}
+	assert.Equal(t, rdp.X224ConnectionRequest, req[1])
+	assert.Equal(t, rdp.TypeNegReq, req[7])
+	assert.NotZero(t, binary.LittleEndian.Uint32(req[11:])&rdp.ProtocolHybrid)
+	if _, err := conn.Write(confirm); err != nil || challenge == nil {
+		return
+	}
+
+	tlsConn := tls.Server(conn, &tls.Config{Certificates: []tls.Certificate{testutil.SelfSignedCert(t, "ws01.corp.example")}})
+	ts, err := rdp.ReadTSRequest(tlsConn)
+	if err != nil {
+		t.Error(err)
+		return
+	}
+	if assert.Len(t, ts.NegoTokens, 1) {
+		tokens <- ts.NegoTokens[0].Token
+	}
+	resp, err := (&rdp.TSRequest{Version: 6, NegoTokens: []rdp.NegoToken{{Token: challenge}}}).MarshalBinary()
+	if err != nil {
+		t.Error(err)
+		return
+	}
+	_, _ = tlsConn.Write(resp)
+}
+
+func dial(t *testing.T, ln net.Listener) *rdp.Session {
+	t.Helper()
+	host, portStr, _ := net.SplitHostPort(ln.Addr().String())
+	port, _ := strconv.Atoi(portStr)
+	s, err := rdp.NewSession(protocol.Config{Host: host, Port: uint16(port)})
+	if err != nil {
+		t.Fatal(err)
+	}
+	return s
+}
+
+func TestChallenge(t *testing.T) {
+	ln, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer ln.Close()
+	tokens := make(chan []byte, 1)
+	go serveRDP(t, ln, connectionConfirm(rdp.TypeNegRsp, rdp.ProtocolHybrid), testutil.Challenge(t, "WS01", ntlmssp.Version{Major: 10, Build: 19045}), tokens)
+
+	s := dial(t, ln)
+	defer s.Close()
+
+	selected, err := s.Negotiate()
+	assert.NoError(t, err)
+	assert.Equal(t, rdp.ProtocolHybrid, selected)
+
+	challenge, err := s.Challenge()
+	if !assert.NoError(t, err) {
+		return
+	}
+	assert.Equal(t, uint16(19045), challenge.Version.Build)
+	assert.Equal(t, "WS01", challenge.TargetInfo.Parse().NBComputerName)
+
+	token := <-tokens
+	assert.Equal(t, []byte(ntlmssp.Signature), token[:8])
+	assert.Equal(t, ntlmssp.TypeNtLmNegotiate, binary.LittleEndian.Uint32(token[8:]))
+
+	state, ok := s.ConnectionState()
+	if assert.True(t, ok) {
+		assert.Equal(t, "ws01.corp.example", state.PeerCertificates[0].Subject.CommonName)
+	}
+}
+
+func TestNegotiateWithoutNLA(t *testing.T) {
+	tests := []struct {
+		name    string
+		confirm []byte
+		err     string
+	}{
+		{name: "TLS only", confirm: connectionConfirm(rdp.TypeNegRsp, rdp.ProtocolSSL), err: "NLA is not enabled (server selected TLS)"},
+		{name: "legacy", confirm: connectionConfirm(0, 0), err: "NLA is not enabled (server selected Standard RDP Security)"},
+		{name: "failure", confirm: connectionConfirm(rdp.TypeNegFailure, rdp.FailureSSLNotAllowed), err: "server only allows Standard RDP Security"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ln, err := net.Listen("tcp", "127.0.0.1:0")
+			if err != nil {
+				t.Fatal(err)
+			}
+			defer ln.Close()
+			go serveRDP(t, ln, tt.confirm, nil, nil)
+
+			s := dial(t, ln)
+			defer s.Close()
+
+			if _, err = s.Negotiate(); err == nil {
+				_, err = s.Challenge()
+			}
+			assert.ErrorContains(t, err, tt.err)
+		})
+	}
+}
@@ -0,0 +1,113 @@
+package rdp
+
+import (
+	"crypto/tls"
+	"errors"
+	"fmt"
+
+	"github.com/d0rvin/winscope-smb/pkg/encoding"
+	"github.com/d0rvin/winscope-smb/pkg/protocol"
+	"github.com/d0rvin/winscope-smb/pkg/protocol/gss"
+	"github.com/d0rvin/winscope-smb/pkg/protocol/ntlmssp"
+)
+
+type Session struct {
+	conn     *protocol.Connection
+	selected uint32
+}
+
+func NewSession(cfg protocol.Config) (*Session, error) {
+	c, err := protocol.NewConnection(cfg.Host, cfg.Port, cfg.Options...)
+	if err != nil {
+		return nil, err
+	}
+	if err := c.Dial("tcp"); err != nil {
+		_ = c.Close()
+		return nil, err
+	}
+	return NewSessionConn(c), nil
+}
+
+// NewSessionConn starts an RDP session on a dialed connection that is still
+// in cleartext: the X.224 negotiation comes first, and Challenge upgrades
+// the connection to TLS itself.
+func NewSessionConn(c *protocol.Connection) *Session {
+	return &Session{conn: c}
+}
+
+func (s *Session) Close() error {
+	return s.conn.Close()
+}
+
+// Negotiate sends an X.224 Connection Request offering TLS and CredSSP and
+// returns the protocol the server selected.
+func (s *Session) Negotiate() (uint32, error) {
+	if _, err := s.conn.Write(MarshalConnectionRequest(ProtocolSSL | ProtocolHybrid)); err != nil {
+		return 0, err
+	}
+	buf, err := ReadTPKT(s.conn)
+	if err != nil {
+		return 0, err
+	}
+	selected, err := ParseConnectionConfirm(buf)
+	if err != nil {
+		return 0, err
+	}
+	s.selected = selected
+	return selected, nil
+}
+
+// Challenge upgrades the connection to TLS and sends a TSRequest whose
+// negoToken holds an NTLM NEGOTIATE message, returning the CHALLENGE from
+// the server's reply. The server must have selected CredSSP in Negotiate.
+func (s *Session) Challenge() (*ntlmssp.Challenge, error) {
+	if s.selected != ProtocolHybrid && s.selected != ProtocolHybridEx {
+		return nil, fmt.Errorf("NLA is not enabled (server selected %s)", ProtocolName(s.selected))
+	}
+
+	if err := s.conn.StartTLS(&tls.Config{
+		InsecureSkipVerify: true,
+		// Windows 7 and Server 2008 R2 only speak TLS 1.0 out of the box.
+		MinVersion: tls.VersionTLS10,
+	}); err != nil {
+		return nil, err
+	}
+
+	negotiate, err := encoding.Marshal(ntlmssp.NewNegotiate("", ""))
+	if err != nil {
+		return nil, err
+	}
+	req := &TSRequest{
+		Version:    TSRequestVersion,
+		NegoTokens: []NegoToken{{Token: negotiate}},
+	}
+	buf, err := req.MarshalBinary()
+	if err != nil {
+		return nil, err
+	}
+	if _, err := s.conn.Write(buf); err != nil {
+		return nil, err
+	}
+
+	resp, err := ReadTSRequest(s.conn)
+	if err != nil {
+		return nil, err
+	}
+	if resp.ErrorCode != 0 {
+		return nil, fmt.Errorf("CredSSP error 0x%08x", uint32(resp.ErrorCode))
+	}
+	if len(resp.NegoTokens) == 0 {
+		return nil, errors.New("no negoToken in TSRequest")
+	}
+	token, err := gss.ResponseToken(resp.NegoTokens[0].Token)
+	if err != nil {
+		return nil, err
+	}
+	return ntlmssp.ParseChallenge(token)
+}
+
+// ConnectionState reports the TLS state, including the server certificate,
+// once Challenge has upgraded the connection.
+func (s *Session) ConnectionState() (tls.ConnectionState, bool) {
+	return s.conn.ConnectionState()
+}
@@ -0,0 +1,116 @@
+package protocol
+
+import (
+	"net"
+	"net/netip"
+)
+
+// Recorder receives the traffic of connections, e.g. to write a packet
+// capture. It sees the application data read and written through the
+// Connection, after TLS and QUIC decryption, so protocols can be dissected.
+type Recorder interface {
+	// Stream starts recording a connection from local to remote.
+	Stream(local, remote netip.AddrPort) RecordStream
+}
+
+// RecordStream records the payload of one connection.
+type RecordStream interface {
+	Sent(data []byte)
+	Received(data []byte)
+	// Close records the end of the connection. It may be called more
+	// than once.
+	Close()
+}
+
+// WithRecorder tees everything read and written on the connection to r.
+// Given more than once, every recorder sees the traffic.
+func WithRecorder(r Recorder) Option {
+	return func(c *Connection) {
+		if c.Recorder != nil {
+			c.Recorder = teeRecorder{c.Recorder, r}
+			return
+		}
+		c.Recorder = r
+	}
+}
+
+type teeRecorder []Recorder
+
+func (t teeRecorder) Stream(local, remote netip.AddrPort) RecordStream {
+	streams := make(teeStream, len(t))
+	for i, r := range t {
+		streams[i] = r.Stream(local, remote)
+	}
+	return streams
+}
+
+type teeStream []RecordStream
+
+func (t teeStream) Sent(data []byte) {
+	for _, s := range t {
+		s.Sent(data)
+	}
+}
+
+func (t teeStream) Received(data []byte) {
+	for _, s := range t {
+		s.Received(data)
+	}
+}
+
+func (t teeStream) Close() {
+	for _, s := range t {
+		s.Close()
+	}
+}
+
+// recordingConn tees a connection's traffic to a RecordStream.
+type recordingConn struct {
+	net.Conn
+	stream RecordStream
+}
+
+func (r *recordingConn) Read(b []byte) (int, error) {
+	n, err := r.Conn.Read(b)
+	if n > 0 {
+		r.stream.Received(b[:n])
+	}
+	return n, err
+}
+
+func (r *recordingConn) Write(b []byte) (int, error) {
+	n, err := r.Conn.Write(b)
+	if n > 0 {
+		r.stream.Sent(b[:n])
+	}
+	return n, err
+}
+
+func (r *recordingConn) Close() error {
+	r.stream.Close()
+	return r.Conn.Close()
+}
+
+// record starts recording conn, established to port. Through a proxy the
+// remote end is recorded with the proxy's address but the target port, so
+// that the payload is dissected as the target protocol.
+func (c *Connection) record(conn net.Conn, port uint16) net.Conn {
+	local, remote := addrPort(conn.LocalAddr()), addrPort(conn.RemoteAddr())
+	if c.ProxyAddr != "" {
+		remote = netip.AddrPortFrom(remote.Addr(), port)
+	}
+	return &recordingConn{Conn: conn, stream: c.Recorder.Stream(local, remote)}
+}
+
+func addrPort(addr net.Addr) netip.AddrPort {
+	var ap netip.AddrPort
+	switch a := addr.(type) {
+	case *net.TCPAddr:
+		ap = a.AddrPort()
+	case *net.UDPAddr:
+		ap = a.AddrPort()
+	default:
+		ap, _ = netip.ParseAddrPort(addr.String())
+	}
+	return netip.AddrPortFrom(ap.Addr().Unmap(), ap.Port())
+}
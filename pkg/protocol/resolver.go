@@ -0,0 +1,86 @@
+package protocol
+
+import (
+	"context"
+	"errors"
+	"net"
+	"net/netip"
+	"strings"
+	"time"
+)
+
+// Resolver sends DNS queries to a single server instead of the system
+// resolver, as needed in isolated networks. The zero value and a nil
+// *Resolver use the system resolver.
+type Resolver struct {
+	// Server is the DNS server as host or host:port; port 53 by default.
+	Server string
+	// Timeout bounds each query; zero means no limit beyond the context.
+	Timeout time.Duration
+	// TCP sends queries over TCP instead of UDP.
+	TCP bool
+}
+
+// WithResolver resolves the host, proxy host names and the PTR records used
+// to derive NetBIOS names through r.
+func WithResolver(r *Resolver) Option {
+	return func(c *Connection) {
+		c.Resolver = r
+	}
+}
+
+func (r *Resolver) resolver() *net.Resolver {
+	if r == nil || r.Server == "" {
+		return net.DefaultResolver
+	}
+	server := r.Server
+	if _, _, err := net.SplitHostPort(server); err != nil {
+		server = net.JoinHostPort(strings.Trim(server, "[]"), "53")
+	}
+	network := "udp"
+	if r.TCP {
+		network = "tcp"
+	}
+	return &net.Resolver{
+		PreferGo: true,
+		Dial: func(ctx context.Context, _, _ string) (net.Conn, error) {
+			d := net.Dialer{Timeout: r.Timeout}
+			return d.DialContext(ctx, network, server)
+		},
+	}
+}
+
+func (r *Resolver) withTimeout(ctx context.Context) (context.Context, context.CancelFunc) {
+	if r == nil || r.Timeout <= 0 {
+		return ctx, func() {}
+	}
+	return context.WithTimeout(ctx, r.Timeout)
+}
+
+// LookupNetIP returns the addresses of host for network "ip", "ip4" or
+// "ip6", with IPv4-mapped addresses unmapped. Literal addresses are
+// returned as is.
+func (r *Resolver) LookupNetIP(ctx context.Context, network, host string) ([]netip.Addr, error) {
+	ctx, cancel := r.withTimeout(ctx)
+	defer cancel()
+	addrs, err := r.resolver().LookupNetIP(ctx, network, host)
+	for i := range addrs {
+		addrs[i] = addrs[i].Unmap()
+	}
+	return addrs, err
+}
+
+// LookupAddr returns the PTR names of addr without the trailing dot.
+func (r *Resolver) LookupAddr(ctx context.Context, addr netip.Addr) ([]string, error) {
+	ctx, cancel := r.withTimeout(ctx)
+	defer cancel()
+	names, err := r.resolver().LookupAddr(ctx, addr.WithZone("").String())
+	for i, name := range names {
+		names[i] = strings.TrimSuffix(name, ".")
+	}
+	var dnsErr *net.DNSError
+	if errors.As(err, &dnsErr) && dnsErr.IsNotFound {
+		return nil, nil
+	}
+	return names, err
+}
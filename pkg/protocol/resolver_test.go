@@ -0,0 +1,119 @@
+package protocol_test
+
+import (
+	"context"
+	"encoding/binary"
+	"io"
+	"net"
+	"net/netip"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"golang.org/x/net/dns/dnsmessage"
+
+	"github.com/d0rvin/winscope-smb/pkg/protocol"
+)
+
+// dnsAnswer answers A and PTR queries for one record and NXDOMAIN for
+// anything else.
+func dnsAnswer(t *testing.T, query []byte) []byte {
+	var msg dnsmessage.Message
+	if err := msg.Unpack(query); err != nil || len(msg.Questions) != 1 {
+		return nil
+	}
+	q := msg.Questions[0]
+	reply := dnsmessage.Message{
+		Header:    dnsmessage.Header{ID: msg.ID, Response: true, Authoritative: true, RCode: dnsmessage.RCodeNameError},
+		Questions: msg.Questions,
+	}
+	hdr := dnsmessage.ResourceHeader{Name: q.Name, Class: dnsmessage.ClassINET, TTL: 60}
+	switch {
+	case q.Type == dnsmessage.TypeA && q.Name.String() == "files.corp.example.":
+		reply.RCode = dnsmessage.RCodeSuccess
+		reply.Answers = append(reply.Answers, dnsmessage.Resource{Header: hdr, Body: &dnsmessage.AResource{A: [4]byte{10, 1, 2, 3}}})
+	case q.Type == dnsmessage.TypeAAAA && q.Name.String() == "files.corp.example.":
+		reply.RCode = dnsmessage.RCodeSuccess
+	case q.Type == dnsmessage.TypePTR && q.Name.String() == "3.2.1.10.in-addr.arpa.":
+		reply.RCode = dnsmessage.RCodeSuccess
+		ptr := dnsmessage.MustNewName("files01.corp.example.")
+		reply.Answers = append(reply.Answers, dnsmessage.Resource{Header: hdr, Body: &dnsmessage.PTRResource{PTR: ptr}})
+	}
+	buf, err := reply.Pack()
+	if err != nil {
+		t.Error(err)
+	}
+	return buf
+}
+
+func dnsServer(t *testing.T) string {
+	t.Helper()
+	pc, err := net.ListenPacket("udp", "127.0.0.1:0")
+	if err != nil {
+		t.Fatal(err)
+	}
+	t.Cleanup(func() { _ = pc.Close() })
+	go func() {
+		buf := make([]byte, 512)
+		for {
+			n, addr, err := pc.ReadFrom(buf)
+			if err != nil {
+				return
+			}
+			if reply := dnsAnswer(t, buf[:n]); reply != nil {
+				_, _ = pc.WriteTo(reply, addr)
+			}
+		}
+	}()
+
+	// The TCP server shares the port, with each message length-prefixed.
+	ln, err := net.Listen("tcp", pc.LocalAddr().String())
+	if err != nil {
+		t.Fatal(err)
+	}
+	t.Cleanup(func() { _ = ln.Close() })
+	accept(ln, func(conn net.Conn) {
+		for {
+			var size uint16
+			if err := binary.Read(conn, binary.BigEndian, &size); err != nil {
+				return
+			}
+			query := make([]byte, size)
+			if _, err := io.ReadFull(conn, query); err != nil {
+				return
+			}
+			reply := dnsAnswer(t, query)
+			_, _ = conn.Write(binary.BigEndian.AppendUint16(nil, uint16(len(reply))))
+			_, _ = conn.Write(reply)
+		}
+	})
+	return pc.LocalAddr().String()
+}
+
+func TestResolver(t *testing.T) {
+	server := dnsServer(t)
+	for _, tcp := range []bool{false, true} {
+		r := &protocol.Resolver{Server: server, TCP: tcp}
+		ctx := context.Background()
+
+		addrs, err := r.LookupNetIP(ctx, "ip", "files.corp.example")
+		if assert.NoError(t, err) {
+			assert.Equal(t, []netip.Addr{netip.MustParseAddr("10.1.2.3")}, addrs)
+		}
+		names, err := r.LookupAddr(ctx, netip.MustParseAddr("10.1.2.3"))
+		if assert.NoError(t, err) {
+			assert.Equal(t, []string{"files01.corp.example"}, names)
+		}
+		names, err = r.LookupAddr(ctx, netip.MustParseAddr("10.1.2.4"))
+		assert.NoError(t, err)
+		assert.Empty(t, names)
+
+		c, err := protocol.NewConnection("files.corp.example", 445, protocol.WithResolver(r))
+		if assert.NoError(t, err) {
+			assert.Equal(t, "10.1.2.3", c.Addr.String())
+		}
+		targets, err := protocol.ExpandTargets(ctx, r, "files.corp.example", 0, true)
+		if assert.NoError(t, err) && assert.Len(t, targets, 1) {
+			assert.Equal(t, "files.corp.example (10.1.2.3)", targets[0].String())
+		}
+	}
+}
@@ -5,7 +5,10 @@ import (
 	"bytes"
 	"encoding/binary"
 	"errors"
+	"fmt"
 	"io"
+
+	"github.com/d0rvin/winscope-smb/pkg/protocol"
 )
 
 type NetBIOSConn interface {
@@ -35,14 +38,22 @@ func SendNetBIOSMessage(conn NetBIOSConn, data []byte) error {
 	return nil
 }
 
+const netBIOSKeepAlive = 0x85
+
 func ReceiveNetBIOSMessage(conn NetBIOSConn) ([]byte, error) {
 	var size uint32
-	if err := binary.Read(conn, binary.BigEndian, &size); err != nil {
-		return nil, err
+	for {
+		if err := binary.Read(conn, binary.BigEndian, &size); err != nil {
+			return nil, err
+		}
+		// Sessions on port 139 may interleave NBSS keep-alives with messages.
+		if size != netBIOSKeepAlive<<24 {
+			break
+		}
 	}
 
 	if size > maxNetBIOSSize {
-		return nil, errors.New("invalid NetBIOS session message")
+		return nil, fmt.Errorf("%w: invalid NetBIOS session message", protocol.ErrNotSMB)
 	}
 
 	data := make([]byte, size)
@@ -2,9 +2,9 @@ package common
 
 import (
 	"encoding/asn1"
-	"errors"
 	"fmt"
 
+	"github.com/d0rvin/winscope-smb/pkg/protocol"
 	"github.com/d0rvin/winscope-smb/pkg/protocol/gss"
 )
 
@@ -39,5 +39,5 @@ func CheckNTLMSSPSupport(blob SecurityBlob) error {
 		}
 	}
 
-	return errors.New("server does not support NTLMSSP")
+	return fmt.Errorf("%w: server does not support NTLMSSP", protocol.ErrNoNTLM)
 }
@@ -1,7 +1,6 @@
 package v1
 
 import (
-	"bytes"
 	"encoding/asn1"
 	"errors"
 	"fmt"
@@ -9,6 +8,7 @@ import (
 	"github.com/d0rvin/winscope-smb/pkg/protocol"
 	"github.com/d0rvin/winscope-smb/pkg/protocol/gss"
 	"github.com/d0rvin/winscope-smb/pkg/protocol/ntlmssp"
+	"github.com/d0rvin/winscope-smb/pkg/protocol/ntstatus"
 	"github.com/d0rvin/winscope-smb/pkg/protocol/smb/common"
 
 	"github.com/d0rvin/winscope-smb/pkg/encoding"
@@ -31,14 +31,18 @@ func NewSession(cfg protocol.Config) (s *Session, err error) {
 		return nil, err
 	}
 
-	s = &Session{
-		conn: c,
-	}
-	if err := s.conn.Dial("tcp"); err != nil {
+	if err := c.Dial("tcp"); err != nil {
 		_ = c.Close()
 		return nil, err
 	}
-	return s, nil
+	return NewSessionConn(c), nil
+}
+
+// NewSessionConn starts an SMBv1 session on a dialed TCP connection. On port
+// 139 the NBSS session must already be set up, as WithNetBIOSSession does
+// when dialing.
+func NewSessionConn(c *protocol.Connection) *Session {
+	return &Session{conn: c}
 }
 
 func (s *Session) Negotiate() error {
@@ -50,10 +54,10 @@ func (s *Session) Negotiate() error {
 
 	negRes := NewNegotiateRes()
 	if err := encoding.Unmarshal(buf, &negRes); err != nil {
-		return err
+		return &protocol.ErrDecode{Field: "SMB_COM_NEGOTIATE response", Err: err}
 	}
-	if negRes.Status != common.StatusOk {
-		return fmt.Errorf("NT status error: %d", negRes.Status)
+	if negRes.Status != ntstatus.Success {
+		return common.StatusError(negRes.Status)
 	}
 
 	if err := common.CheckNTLMSSPSupport(negotiateResAdapter{negRes}); err != nil {
@@ -72,26 +76,29 @@ func (s *Session) SessionSetupAndX() (*SessionSetupAndXRes, *ntlmssp.Challenge,
 	if err != nil {
 		return nil, nil, err
 	}
+	return ParseSessionSetupAndXRes(buf)
+}
 
+// ParseSessionSetupAndXRes decodes the response to the first
+// SESSION_SETUP_ANDX request and the NTLM challenge it carries.
+func ParseSessionSetupAndXRes(buf []byte) (*SessionSetupAndXRes, *ntlmssp.Challenge, error) {
 	ssres, err := NewSessionSetupAndXRes()
 	if err != nil {
 		return nil, nil, err
 	}
 	if err := encoding.Unmarshal(buf, &ssres); err != nil {
-		return nil, nil, err
+		return nil, nil, &protocol.ErrDecode{Field: "SMB_COM_SESSION_SETUP_ANDX response", Err: err}
 	}
-
-	challenge := ntlmssp.NewChallenge()
-	resp := ssres.SecurityBlob
-	if err := encoding.Unmarshal(resp.ResponseToken, &challenge); err != nil {
-		return nil, nil, err
+	if ssres.Status != ntstatus.MoreProcessingRequired {
+		return nil, nil, common.StatusError(ssres.Status)
 	}
 
-	if ssres.Status != common.StatusMoreProcessingRequired {
-		return nil, nil, fmt.Errorf("NT status error: %s", common.StatusMap[ssres.Status])
+	challenge, err := ntlmssp.ParseChallenge(ssres.SecurityBlob.ResponseToken)
+	if err != nil {
+		return nil, nil, &protocol.ErrDecode{Field: "NTLM challenge", Err: err}
 	}
 
-	return &ssres, &challenge, nil
+	return &ssres, challenge, nil
 }
 
 func (s *Session) Close() error {
@@ -153,9 +160,15 @@ func (s *Session) send(req any) (res []byte, err error) {
 		return nil, err
 	}
 
-	protID := data[0:4]
-	if !bytes.Contains(protID, []byte(ProtocolSmb)) {
-		return nil, errors.New("protocol not implemented")
+	if len(data) < 4 {
+		return nil, protocol.ErrNotSMB
+	}
+	switch string(data[0:4]) {
+	case ProtocolSmb:
+	case "\xfeSMB":
+		return nil, errors.New("server answered with SMB2")
+	default:
+		return nil, protocol.ErrNotSMB
 	}
 
 	return data, nil
@@ -1,7 +1,13 @@
 package v1
 
 import (
+	"bytes"
+	"encoding/binary"
+	"errors"
+
+	"github.com/d0rvin/winscope-smb/pkg/encoding"
 	"github.com/d0rvin/winscope-smb/pkg/protocol/gss"
+	"github.com/d0rvin/winscope-smb/pkg/protocol/ntstatus"
 )
 
 const ProtocolSmb = "\xFFSMB"
@@ -14,6 +20,7 @@ const (
 const (
 	FlagsCaseInsensitive    = 0x08
 	FlagsCanonicalizedPaths = 0x10
+	FlagsReply              = 0x80
 )
 
 const (
@@ -36,10 +43,17 @@ var (
 	}
 )
 
+// Dialect strings by which an SMBv1 NEGOTIATE offers SMB2: 2.0.2 only, or
+// any SMB2 dialect, to be negotiated again over SMB2.
+const (
+	DialectSmb2002     = "SMB 2.002"
+	DialectSmb2Unknown = "SMB 2.???"
+)
+
 type Header struct {
 	Protocol         []byte `smb:"fixed:4"`
 	Command          uint8
-	Status           uint32
+	Status           ntstatus.Status
 	Flags            uint8
 	Flags2           uint16
 	PIDHigh          uint16
@@ -87,6 +101,48 @@ func NewNegotiateReq() NegotiateReq {
 	}
 }
 
+// ParseNegotiateReq decodes an SMBv1 NEGOTIATE request as a server
+// receives it.
+func ParseNegotiateReq(msg []byte) (*NegotiateReq, error) {
+	const headerSize = 32
+	if len(msg) < headerSize+3 || string(msg[:4]) != ProtocolSmb {
+		return nil, errors.New("not an SMBv1 message")
+	}
+	var req NegotiateReq
+	if err := encoding.Unmarshal(msg, &req.Header); err != nil {
+		return nil, err
+	}
+	if req.Command != CommandNegotiate {
+		return nil, errors.New("not an SMBv1 NEGOTIATE request")
+	}
+	req.WordCount = msg[headerSize]
+	i := headerSize + 1 + 2*int(req.WordCount)
+	if len(msg) < i+2 {
+		return nil, errors.New("SMBv1 NEGOTIATE request too short")
+	}
+	req.ByteCount = binary.LittleEndian.Uint16(msg[i:])
+	req.Dialects = msg[i+2:]
+	if int(req.ByteCount) < len(req.Dialects) {
+		req.Dialects = req.Dialects[:req.ByteCount]
+	}
+	return &req, nil
+}
+
+// DialectStrings returns the dialects offered, in the client's order.
+func (r *NegotiateReq) DialectStrings() []string {
+	var dialects []string
+	data := r.Dialects
+	for len(data) > 1 && data[0] == 0x02 {
+		end := bytes.IndexByte(data[1:], 0)
+		if end < 0 {
+			break
+		}
+		dialects = append(dialects, string(data[1:1+end]))
+		data = data[2+end:]
+	}
+	return dialects
+}
+
 type NegotiateRes struct {
 	Header
 	WordCount       uint8
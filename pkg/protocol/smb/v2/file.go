@@ -0,0 +1,179 @@
+package v2
+
+import (
+	"github.com/d0rvin/winscope-smb/pkg/encoding"
+	"github.com/d0rvin/winscope-smb/pkg/protocol/ntstatus"
+)
+
+// maxIOSize caps a single READ, WRITE or IOCTL payload so that every request
+// costs exactly one credit.
+const maxIOSize = 65536
+
+type File struct {
+	ID   []byte
+	Name string
+	tree *Tree
+}
+
+// Create opens the existing file or named pipe called name on tree.
+func (s *Session) Create(tree *Tree, name string, desiredAccess uint32) (*File, error) {
+	header := newHeader()
+	header.Command = CommandCreate
+	header.CreditCharge = 1
+	header.TreeID = tree.ID
+
+	nameBytes := encoding.ToUnicode(name)
+	if len(nameBytes) == 0 {
+		// The buffer must be at least one byte long even for an empty name.
+		nameBytes = []byte{0x00}
+	}
+	req := CreateReq{
+		Header:             header,
+		StructureSize:      57,
+		ImpersonationLevel: ImpersonationImpersonation,
+		DesiredAccess:      desiredAccess,
+		ShareAccess:        FileShareRead | FileShareWrite,
+		CreateDisposition:  FileOpen,
+		Name:               nameBytes,
+	}
+
+	buf, err := s.send(&req)
+	if err != nil {
+		return nil, err
+	}
+	if err := checkStatus(buf, ntstatus.Success); err != nil {
+		return nil, err
+	}
+
+	var res CreateRes
+	if err := encoding.Unmarshal(buf, &res); err != nil {
+		return nil, err
+	}
+
+	return &File{
+		ID:   res.FileID,
+		Name: name,
+		tree: tree,
+	}, nil
+}
+
+func (s *Session) CloseFile(f *File) error {
+	header := newHeader()
+	header.Command = CommandClose
+	header.CreditCharge = 1
+	header.TreeID = f.tree.ID
+	req := CloseReq{
+		Header:        header,
+		StructureSize: 24,
+		FileID:        f.ID,
+	}
+
+	buf, err := s.send(&req)
+	if err != nil {
+		return err
+	}
+	return checkStatus(buf, ntstatus.Success)
+}
+
+// Read reads up to length bytes at offset. On a message mode pipe a partial
+// message is returned without error and the remainder comes with the next
+// Read.
+func (s *Session) Read(f *File, offset uint64, length uint32) ([]byte, error) {
+	data, _, err := s.read(f, offset, length)
+	return data, err
+}
+
+func (s *Session) read(f *File, offset uint64, length uint32) ([]byte, ntstatus.Status, error) {
+	header := newHeader()
+	header.Command = CommandRead
+	header.CreditCharge = 1
+	header.TreeID = f.tree.ID
+	req := ReadReq{
+		Header:        header,
+		StructureSize: 49,
+		Padding:       0x50,
+		Length:        min(length, s.maxReadSize, maxIOSize),
+		Offset:        offset,
+		FileID:        f.ID,
+		Buffer:        []byte{0x00},
+	}
+
+	buf, err := s.send(&req)
+	if err != nil {
+		return nil, 0, err
+	}
+	if err := checkStatus(buf, ntstatus.Success, ntstatus.BufferOverflow); err != nil {
+		return nil, 0, err
+	}
+
+	var res ReadRes
+	if err := encoding.Unmarshal(buf, &res); err != nil {
+		return nil, 0, err
+	}
+	return res.Data, res.Status, nil
+}
+
+func (s *Session) Write(f *File, offset uint64, data []byte) (int, error) {
+	header := newHeader()
+	header.Command = CommandWrite
+	header.CreditCharge = 1
+	header.TreeID = f.tree.ID
+	req := WriteReq{
+		Header:        header,
+		StructureSize: 49,
+		Offset:        offset,
+		FileID:        f.ID,
+		Data:          data[:min(len(data), int(s.maxWriteSize), maxIOSize)],
+	}
+
+	buf, err := s.send(&req)
+	if err != nil {
+		return 0, err
+	}
+	if err := checkStatus(buf, ntstatus.Success); err != nil {
+		return 0, err
+	}
+
+	var res WriteRes
+	if err := encoding.Unmarshal(buf, &res); err != nil {
+		return 0, err
+	}
+	return int(res.Count), nil
+}
+
+// Ioctl issues the FSCTL ctlCode against f. As with Read, a truncated pipe
+// message is returned without error.
+func (s *Session) Ioctl(f *File, ctlCode uint32, input []byte, maxOutput uint32) ([]byte, error) {
+	data, _, err := s.ioctl(f, ctlCode, input, maxOutput)
+	return data, err
+}
+
+func (s *Session) ioctl(f *File, ctlCode uint32, input []byte, maxOutput uint32) ([]byte, ntstatus.Status, error) {
+	header := newHeader()
+	header.Command = CommandIoctl
+	header.CreditCharge = 1
+	header.TreeID = f.tree.ID
+	req := IoctlReq{
+		Header:            header,
+		StructureSize:     57,
+		CtlCode:           ctlCode,
+		FileID:            f.ID,
+		MaxOutputResponse: min(maxOutput, s.maxTransactSize, maxIOSize),
+		Flags:             IoctlIsFsctl,
+		Input:             input,
+	}
+
+	buf, err := s.send(&req)
+	if err != nil {
+		return nil, 0, err
+	}
+	if err := checkStatus(buf, ntstatus.Success, ntstatus.BufferOverflow); err != nil {
+		return nil, 0, err
+	}
+
+	var res IoctlRes
+	if err := encoding.Unmarshal(buf, &res); err != nil {
+		return nil, 0, err
+	}
+	return res.Output, res.Status, nil
+}
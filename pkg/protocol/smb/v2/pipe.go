@@ -0,0 +1,70 @@
+package v2
+
+import (
+	"io"
+
+	"github.com/d0rvin/winscope-smb/pkg/protocol/ntstatus"
+)
+
+const pipeAccess = FileReadData | FileWriteData | FileAppendData | FileReadEA | FileWriteEA |
+	FileReadAttributes | FileWriteAttributes | ReadControl | Synchronize
+
+// Pipe is a named pipe opened on an IPC$ tree. Read and Write follow the
+// io.ReadWriteCloser contract, so a Pipe can carry DCE/RPC traffic directly.
+type Pipe struct {
+	s    *Session
+	file *File
+}
+
+func (s *Session) OpenPipe(tree *Tree, name string) (*Pipe, error) {
+	f, err := s.Create(tree, name, pipeAccess)
+	if err != nil {
+		return nil, err
+	}
+	return &Pipe{s: s, file: f}, nil
+}
+
+func (p *Pipe) Read(b []byte) (int, error) {
+	data, err := p.s.Read(p.file, 0, uint32(len(b)))
+	if err != nil {
+		return 0, err
+	}
+	return copy(b, data), nil
+}
+
+func (p *Pipe) Write(b []byte) (int, error) {
+	written := 0
+	for written < len(b) {
+		n, err := p.s.Write(p.file, 0, b[written:])
+		if err != nil {
+			return written, err
+		}
+		if n == 0 {
+			return written, io.ErrShortWrite
+		}
+		written += n
+	}
+	return written, nil
+}
+
+// Transact writes in as one message and returns the complete reply using
+// FSCTL_PIPE_TRANSCEIVE, reading any remainder the server could not fit.
+func (p *Pipe) Transact(in []byte) ([]byte, error) {
+	out, status, err := p.s.ioctl(p.file, FsctlPipeTransceive, in, maxIOSize)
+	if err != nil {
+		return nil, err
+	}
+	for status == ntstatus.BufferOverflow {
+		var more []byte
+		more, status, err = p.s.read(p.file, 0, maxIOSize)
+		if err != nil {
+			return nil, err
+		}
+		out = append(out, more...)
+	}
+	return out, nil
+}
+
+func (p *Pipe) Close() error {
+	return p.s.CloseFile(p.file)
+}
@@ -0,0 +1,152 @@
+package v2_test
+
+import (
+	"context"
+	"crypto/tls"
+	"encoding/binary"
+	"io"
+	"net"
+	"testing"
+
+	"github.com/quic-go/quic-go"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/d0rvin/winscope-smb/internal/testutil"
+	"github.com/d0rvin/winscope-smb/pkg/encoding"
+	"github.com/d0rvin/winscope-smb/pkg/protocol"
+	"github.com/d0rvin/winscope-smb/pkg/protocol/gss"
+	"github.com/d0rvin/winscope-smb/pkg/protocol/ntlmssp"
+	"github.com/d0rvin/winscope-smb/pkg/protocol/ntstatus"
+	"github.com/d0rvin/winscope-smb/pkg/protocol/smb/common"
+	v2 "github.com/d0rvin/winscope-smb/pkg/protocol/smb/v2"
+)
+
+// serveSMBQUIC is a stand-in SMB over QUIC server answering NEGOTIATE and
+// the first SESSION_SETUP on the first stream of each connection.
+func serveSMBQUIC(t *testing.T, ln *quic.Listener) {
+	conn, err := ln.Accept(context.Background())
+	if err != nil {
+		return
+	}
+	stream, err := conn.AcceptStream(context.Background())
+	if err != nil {
+		return
+	}
+
+	for {
+		hdr := make([]byte, 4)
+		if _, err := io.ReadFull(stream, hdr); err != nil {
+			return
+		}
+		// Direct TCP transport header: a zero byte and a 24-bit length,
+		// never preceded by an NBSS session request.
+		if hdr[0] != 0 {
+			t.Errorf("unexpected transport header %x", hdr)
+			return
+		}
+		msg := make([]byte, int(binary.BigEndian.Uint32(hdr)))
+		if _, err := io.ReadFull(stream, msg); err != nil {
+			return
+		}
+
+		var reqHeader v2.Header
+		if err := encoding.Unmarshal(msg, &reqHeader); err != nil {
+			t.Error(err)
+			return
+		}
+		res := v2.Header{
+			ProtocolID:    []byte(v2.ProtocolSmb2),
+			StructureSize: 64,
+			Command:       reqHeader.Command,
+			Credits:       1,
+			Flags:         v2.FlagsServerToRedir,
+			MessageID:     reqHeader.MessageID,
+			Signature:     make([]byte, 16),
+		}
+
+		var out any
+		switch reqHeader.Command {
+		case v2.CommandNegotiate:
+			dialectCount := binary.LittleEndian.Uint16(msg[66:])
+			assert.Equal(t, uint16(1), dialectCount)
+			assert.Equal(t, uint16(v2.DialectSmb_3_1_1), binary.LittleEndian.Uint16(msg[100:]))
+			ctxOffset := binary.LittleEndian.Uint32(msg[92:])
+			assert.Equal(t, uint32(104), ctxOffset)
+			assert.Equal(t, uint16(3), binary.LittleEndian.Uint16(msg[96:]))
+			assert.Equal(t, v2.NegotiateContextPreauthIntegrity, binary.LittleEndian.Uint16(msg[ctxOffset:]))
+
+			init, _ := gss.NewNegTokenInit()
+			negRes := v2.NewNegotiateRes()
+			negRes.Header = res
+			negRes.StructureSize = 65
+			negRes.DialectRevision = v2.DialectSmb_3_1_1
+			negRes.MaxTransactSize = 65536
+			negRes.MaxReadSize = 65536
+			negRes.MaxWriteSize = 65536
+			negRes.SecurityBlob = &init
+			out = &negRes
+		case v2.CommandSessionSetup:
+			res.Status = ntstatus.MoreProcessingRequired
+			res.SessionID = 0x1234
+			ssRes, _ := v2.NewSessionSetup1Res()
+			ssRes.Header = res
+			ssRes.StructureSize = 9
+			ssRes.SecurityBlob.ResponseToken = testutil.Challenge(t, "SRV", ntlmssp.Version{Major: 10, Build: 20348})
+			out = &ssRes
+		default:
+			t.Errorf("unexpected command %d", reqHeader.Command)
+			return
+		}
+
+		buf, err := encoding.Marshal(out)
+		if err != nil {
+			t.Error(err)
+			return
+		}
+		if err := common.SendNetBIOSMessage(stream, buf); err != nil {
+			return
+		}
+	}
+}
+
+func TestSessionOverQUIC(t *testing.T) {
+	ln, err := quic.ListenAddr("127.0.0.1:0", &tls.Config{
+		Certificates: []tls.Certificate{testutil.SelfSignedCert(t, "smbquic.test")},
+		NextProtos:   []string{protocol.ALPNSMB},
+	}, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer ln.Close()
+	go serveSMBQUIC(t, ln)
+
+	port := ln.Addr().(*net.UDPAddr).Port
+	s, err := v2.NewSession(protocol.Config{
+		Host:    "127.0.0.1",
+		Port:    uint16(port),
+		Options: []protocol.Option{protocol.WithQUIC(&tls.Config{InsecureSkipVerify: true})},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer s.Close()
+
+	assert.NoError(t, s.Negotiate())
+	assert.Equal(t, uint16(v2.DialectSmb_3_1_1), s.Dialect())
+
+	challenge, err := s.Setup1()
+	if !assert.NoError(t, err) {
+		return
+	}
+	assert.Equal(t, uint8(10), challenge.Version.Major)
+	assert.Equal(t, uint16(20348), challenge.Version.Build)
+	assert.Equal(t, "SRV", challenge.TargetInfo.Parse().NBComputerName)
+
+	state, ok := s.ConnectionState()
+	assert.True(t, ok)
+	assert.Equal(t, protocol.ALPNSMB, state.NegotiatedProtocol)
+	assert.Equal(t, uint16(tls.VersionTLS13), state.Version)
+	if assert.Len(t, state.PeerCertificates, 1) {
+		assert.Equal(t, "smbquic.test", state.PeerCertificates[0].Subject.CommonName)
+	}
+}
@@ -2,23 +2,55 @@ package v2
 
 import (
 	"bufio"
+	"crypto/hmac"
+	"crypto/sha256"
+	"crypto/tls"
 	"encoding/asn1"
+	"encoding/binary"
 	"errors"
 	"fmt"
+	"slices"
+	"strings"
 
 	"github.com/d0rvin/winscope-smb/pkg/protocol"
 	"github.com/d0rvin/winscope-smb/pkg/protocol/gss"
 	"github.com/d0rvin/winscope-smb/pkg/protocol/ntlmssp"
+	"github.com/d0rvin/winscope-smb/pkg/protocol/ntstatus"
 	"github.com/d0rvin/winscope-smb/pkg/protocol/smb/common"
 
 	"github.com/d0rvin/winscope-smb/pkg/encoding"
 )
 
+// creditRequest is the number of credits asked for with every request. It is
+// enough to keep a handful of requests in flight without the server throttling.
+const creditRequest = 64
+
 type Session struct {
-	conn      *protocol.Connection
-	rw        *bufio.ReadWriter
-	messageID uint64
-	sessionID uint64
+	conn            *protocol.Connection
+	rw              *bufio.ReadWriter
+	messageID       uint64
+	sessionID       uint64
+	credits         uint16
+	dialect         uint16
+	signingRequired bool
+	maxTransactSize uint32
+	maxReadSize     uint32
+	maxWriteSize    uint32
+	sessionFlags    uint16
+	sessionKey      []byte
+}
+
+type Tree struct {
+	ID            uint32
+	Path          string
+	ShareType     uint8
+	ShareFlags    uint32
+	Capabilities  uint32
+	MaximalAccess uint32
+}
+
+type request interface {
+	header() *Header
 }
 
 func NewSession(cfg protocol.Config) (s *Session, err error) {
@@ -27,36 +59,61 @@ func NewSession(cfg protocol.Config) (s *Session, err error) {
 		return nil, err
 	}
 
-	s = &Session{
-		conn: c,
-		rw:   bufio.NewReadWriter(bufio.NewReader(c), bufio.NewWriter(c)),
-	}
-	if err := s.conn.Dial("tcp"); err != nil {
+	if err := c.Dial("tcp"); err != nil {
 		_ = c.Close()
 		return nil, err
 	}
-	return s, nil
+	return NewSessionConn(c), nil
+}
+
+// NewSessionConn starts an SMB2 session on a dialed connection: direct TCP,
+// TCP with the NBSS session already set up by WithNetBIOSSession, or a
+// QUIC stream, on which SMB 3.1.1 is negotiated.
+func NewSessionConn(c *protocol.Connection) *Session {
+	return &Session{
+		conn:    c,
+		rw:      bufio.NewReadWriter(bufio.NewReader(c), bufio.NewWriter(c)),
+		credits: 1,
+	}
 }
 
 func (s *Session) Negotiate() error {
-	negReq := NewNegotiateReq(s.messageID)
-	buf, err := s.send(negReq)
+	var req request
+	if s.conn.QUIC {
+		negReq, err := NewNegotiateReq311(s.messageID)
+		if err != nil {
+			return err
+		}
+		req = &negReq
+	} else {
+		negReq := NewNegotiateReq(s.messageID)
+		req = &negReq
+	}
+	buf, err := s.send(req)
 	if err != nil {
 		return err
 	}
 
 	negRes := NewNegotiateRes()
 	if err := encoding.Unmarshal(buf, &negRes); err != nil {
-		return err
+		return &protocol.ErrDecode{Field: "SMB2 NEGOTIATE response", Err: err}
 	}
-	if negRes.Status != common.StatusOk {
-		return fmt.Errorf("NT status error: %d", negRes.Status)
+	if negRes.Status != ntstatus.Success {
+		return common.StatusError(negRes.Status)
 	}
 
 	if err := common.CheckNTLMSSPSupport(negotiateResAdapter{negRes}); err != nil {
 		return err
 	}
 
+	if s.conn.QUIC && negRes.DialectRevision != DialectSmb_3_1_1 {
+		return fmt.Errorf("server selected dialect 0x%04x, SMB over QUIC requires 3.1.1", negRes.DialectRevision)
+	}
+	s.dialect = negRes.DialectRevision
+	s.signingRequired = negRes.SecurityMode&SecurityModeSigningRequired != 0
+	s.maxTransactSize = negRes.MaxTransactSize
+	s.maxReadSize = negRes.MaxReadSize
+	s.maxWriteSize = negRes.MaxWriteSize
 	return nil
 }
 
@@ -64,44 +121,195 @@ func (s *Session) Close() error {
 	return s.conn.Close()
 }
 
+func (s *Session) Dialect() uint16 {
+	return s.dialect
+}
+
+// ConnectionState reports the TLS state, including the server certificate,
+// of sessions carried over TLS or QUIC.
+func (s *Session) ConnectionState() (tls.ConnectionState, bool) {
+	return s.conn.ConnectionState()
+}
+
 func (s *Session) Setup1() (*ntlmssp.Challenge, error) {
 	ssreq, err := s.NewSessionSetup1Req()
 	if err != nil {
 		return nil, err
 	}
 
-	buf, err := s.send(ssreq)
+	buf, err := s.send(&ssreq)
 	if err != nil {
 		return nil, err
 	}
 
-	ssRes, err := NewSessionSetup1Res()
+	ssRes, challenge, err := ParseSessionSetup1Res(buf)
 	if err != nil {
 		return nil, err
 	}
+	s.sessionID = ssRes.SessionID
+	return challenge, nil
+}
+
+// ParseSessionSetup1Res decodes the response to the first SESSION_SETUP
+// request and the NTLM challenge it carries.
+func ParseSessionSetup1Res(buf []byte) (*SessionSetup1Res, *ntlmssp.Challenge, error) {
+	ssRes, err := NewSessionSetup1Res()
+	if err != nil {
+		return nil, nil, err
+	}
 	if err := encoding.Unmarshal(buf, &ssRes); err != nil {
+		return nil, nil, &protocol.ErrDecode{Field: "SMB2 SESSION_SETUP response", Err: err}
+	}
+	if ssRes.Status != ntstatus.MoreProcessingRequired {
+		return nil, nil, common.StatusError(ssRes.Status)
+	}
+
+	challenge, err := ntlmssp.ParseChallenge(ssRes.SecurityBlob.ResponseToken)
+	if err != nil {
+		return nil, nil, &protocol.ErrDecode{Field: "NTLM challenge", Err: err}
+	}
+	return &ssRes, challenge, nil
+}
+
+// Setup2 completes the session started by Setup1 by answering its challenge
+// with cred. Leaving cred.User empty sets up an anonymous session.
+func (s *Session) Setup2(challenge *ntlmssp.Challenge, cred ntlmssp.Credentials) error {
+	auth, sessionKey, err := ntlmssp.NewAuthenticate(challenge, cred)
+	if err != nil {
+		return err
+	}
+	data, err := encoding.Marshal(auth)
+	if err != nil {
+		return err
+	}
+
+	resp, err := gss.NewNegTokenResp()
+	if err != nil {
+		return err
+	}
+	resp.ResponseToken = data
+
+	header := newHeader()
+	header.Command = CommandSessionSetup
+	header.CreditCharge = 1
+	ssreq := SessionSetup2Req{
+		Header:               header,
+		StructureSize:        25,
+		SecurityMode:         byte(SecurityModeSigningEnabled),
+		SecurityBufferOffset: 88,
+		SecurityBlob:         &resp,
+	}
+
+	buf, err := s.send(&ssreq)
+	if err != nil {
+		return err
+	}
+	if err := checkStatus(buf, ntstatus.Success); err != nil {
+		return err
+	}
+
+	var ssRes SessionSetup2Res
+	if err := encoding.Unmarshal(buf, &ssRes); err != nil {
+		return err
+	}
+
+	s.sessionFlags = ssRes.Flags
+	s.sessionKey = sessionKey
+	return nil
+}
+
+func (s *Session) IsGuest() bool {
+	return s.sessionFlags&SessionFlagIsGuest != 0
+}
+
+func (s *Session) IsNull() bool {
+	return s.sessionFlags&SessionFlagIsNull != 0
+}
+
+func (s *Session) Logoff() error {
+	header := newHeader()
+	header.Command = CommandLogoff
+	header.CreditCharge = 1
+	req := LogoffReq{
+		Header:        header,
+		StructureSize: 4,
+	}
+
+	buf, err := s.send(&req)
+	if err != nil {
+		return err
+	}
+	if err := checkStatus(buf, ntstatus.Success); err != nil {
+		return err
+	}
+
+	s.sessionID = 0
+	s.sessionFlags = 0
+	s.sessionKey = nil
+	return nil
+}
+
+// TreeConnect connects to share, given either as a bare share name such as
+// "IPC$" or as a full \\server\share path.
+func (s *Session) TreeConnect(share string) (*Tree, error) {
+	path := share
+	if !strings.HasPrefix(path, `\\`) {
+		path = fmt.Sprintf(`\\%s\%s`, s.conn.Host, share)
+	}
+
+	header := newHeader()
+	header.Command = CommandTreeConnect
+	header.CreditCharge = 1
+	req := TreeConnectReq{
+		Header:        header,
+		StructureSize: 9,
+		Path:          encoding.ToUnicode(path),
+	}
+
+	buf, err := s.send(&req)
+	if err != nil {
+		return nil, err
+	}
+	if err := checkStatus(buf, ntstatus.Success); err != nil {
 		return nil, err
 	}
 
-	challenge := ntlmssp.NewChallenge()
-	resp := ssRes.SecurityBlob
-	if err := encoding.Unmarshal(resp.ResponseToken, &challenge); err != nil {
+	var res TreeConnectRes
+	if err := encoding.Unmarshal(buf, &res); err != nil {
 		return nil, err
 	}
 
-	if ssRes.Status != common.StatusMoreProcessingRequired {
-		return nil, fmt.Errorf("NT status error: %s", common.StatusMap[ssRes.Status])
+	return &Tree{
+		ID:            res.TreeID,
+		Path:          path,
+		ShareType:     res.ShareType,
+		ShareFlags:    res.ShareFlags,
+		Capabilities:  res.Capabilities,
+		MaximalAccess: res.MaximalAccess,
+	}, nil
+}
+
+func (s *Session) TreeDisconnect(tree *Tree) error {
+	header := newHeader()
+	header.Command = CommandTreeDisconnect
+	header.CreditCharge = 1
+	header.TreeID = tree.ID
+	req := TreeDisconnectReq{
+		Header:        header,
+		StructureSize: 4,
 	}
 
-	return &challenge, nil
+	buf, err := s.send(&req)
+	if err != nil {
+		return err
+	}
+	return checkStatus(buf, ntstatus.Success)
 }
 
 func (s *Session) NewSessionSetup1Req() (SessionSetup1Req, error) {
 	header := newHeader()
 	header.Command = CommandSessionSetup
 	header.CreditCharge = 1
-	header.MessageID = s.messageID
-	header.SessionID = s.sessionID
 
 	ntlmsspNeg := ntlmssp.NewNegotiate("", "")
 	data, err := encoding.Marshal(ntlmsspNeg)
@@ -129,30 +337,87 @@ func (s *Session) NewSessionSetup1Req() (SessionSetup1Req, error) {
 	}, nil
 }
 
-func (s *Session) send(req any) (res []byte, err error) {
+func (s *Session) send(req request) (res []byte, err error) {
+	h := req.header()
+	charge := max(h.CreditCharge, 1)
+	if s.credits < charge {
+		return nil, fmt.Errorf("insufficient credits: have %d, need %d", s.credits, charge)
+	}
+	h.MessageID = s.messageID
+	h.SessionID = s.sessionID
+	h.Credits = creditRequest
+
 	buf, err := encoding.Marshal(req)
 	if err != nil {
 		return nil, err
 	}
+	if s.shouldSign(h) {
+		// SMB 3.x signs with AES-CMAC under keys derived from the preauth
+		// integrity hash, which this client does not implement.
+		if s.dialect >= DialectSmb_3_0 {
+			return nil, errors.New("signing is not supported for SMB 3.x dialects")
+		}
+		s.sign(buf)
+	}
 
 	if err := common.SendNetBIOSMessage(s.rw, buf); err != nil {
 		return nil, err
 	}
+	s.messageID += uint64(charge)
+	s.credits -= charge
 
-	data, err := common.ReceiveNetBIOSMessage(s.rw)
-	if err != nil {
-		return nil, err
+	for {
+		data, err := common.ReceiveNetBIOSMessage(s.rw)
+		if err != nil {
+			return nil, err
+		}
+
+		if len(data) < 64 || string(data[0:4]) != ProtocolSmb2 {
+			return nil, protocol.ErrNotSMB
+		}
+
+		var resHeader Header
+		if err := encoding.Unmarshal(data, &resHeader); err != nil {
+			return nil, &protocol.ErrDecode{Field: "SMB2 header", Err: err}
+		}
+		s.credits += resHeader.Credits
+
+		// An interim response only grants credits; the final one follows
+		// on the same message ID.
+		if resHeader.Status == ntstatus.Pending && resHeader.Flags&FlagsAsyncCommand != 0 {
+			continue
+		}
+		return data, nil
 	}
+}
 
-	protID := data[0:4]
-	switch string(protID) {
-	default:
-		return nil, errors.New("protocol not implemented")
-	case ProtocolSmb2:
-		s.messageID++
+func (s *Session) shouldSign(h *Header) bool {
+	if !s.signingRequired || s.sessionKey == nil || s.IsGuest() || s.IsNull() {
+		return false
 	}
+	return h.Command != CommandNegotiate && h.Command != CommandSessionSetup
+}
 
-	return data, nil
+// sign signs an encoded request in place as described in MS-SMB2 3.1.4.1.
+func (s *Session) sign(buf []byte) {
+	flags := binary.LittleEndian.Uint32(buf[16:20])
+	binary.LittleEndian.PutUint32(buf[16:20], flags|FlagsSigned)
+	clear(buf[48:64])
+
+	mac := hmac.New(sha256.New, s.sessionKey)
+	mac.Write(buf)
+	copy(buf[48:64], mac.Sum(nil))
+}
+
+func checkStatus(buf []byte, want ...ntstatus.Status) error {
+	var h Header
+	if err := encoding.Unmarshal(buf, &h); err != nil {
+		return err
+	}
+	if !slices.Contains(want, h.Status) {
+		return common.StatusError(h.Status)
+	}
+	return nil
 }
 
 type negotiateResAdapter struct {
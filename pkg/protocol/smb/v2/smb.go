@@ -1,7 +1,15 @@
 package v2
 
 import (
+	"crypto/rand"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"slices"
+
+	"github.com/d0rvin/winscope-smb/pkg/encoding"
 	"github.com/d0rvin/winscope-smb/pkg/protocol/gss"
+	"github.com/d0rvin/winscope-smb/pkg/protocol/ntstatus"
 )
 
 const ProtocolSmb2 = "\xFESMB"
@@ -9,10 +17,73 @@ const ProtocolSmb2 = "\xFESMB"
 const (
 	CommandNegotiate uint16 = iota
 	CommandSessionSetup
+	CommandLogoff
+	CommandTreeConnect
+	CommandTreeDisconnect
+	CommandCreate
+	CommandClose
+	CommandFlush
+	CommandRead
+	CommandWrite
+	CommandLock
+	CommandIoctl
 )
 
 const (
-	DialectSmb_2_1 = 0x0210
+	FlagsServerToRedir uint32 = 1 << iota
+	FlagsAsyncCommand
+	FlagsRelatedOperations
+	FlagsSigned
+)
+
+const (
+	DialectSmb_2_0_2 = 0x0202
+	DialectSmb_2_1   = 0x0210
+	DialectSmb_3_0   = 0x0300
+	DialectSmb_3_0_2 = 0x0302
+	DialectSmb_3_1_1 = 0x0311
+	// DialectSmb_2_Wildcard answers an SMBv1 NEGOTIATE offering "SMB 2.???"
+	// and asks the client to negotiate again over SMB2.
+	DialectSmb_2_Wildcard = 0x02ff
+)
+
+// DialectString names a dialect as Windows does, e.g. "2.1" or "3.1.1",
+// and unknown ones by their code.
+func DialectString(d uint16) string {
+	switch d {
+	case DialectSmb_2_0_2, DialectSmb_3_0_2, DialectSmb_3_1_1:
+		return fmt.Sprintf("%d.%d.%d", d>>8, d>>4&0xf, d&0xf)
+	case DialectSmb_2_1, DialectSmb_3_0:
+		return fmt.Sprintf("%d.%d", d>>8, d>>4&0xf)
+	case DialectSmb_2_Wildcard:
+		return "2.???"
+	}
+	return fmt.Sprintf("0x%04x", d)
+}
+
+const (
+	NegotiateContextPreauthIntegrity uint16 = 0x0001
+	NegotiateContextEncryption       uint16 = 0x0002
+	NegotiateContextCompression      uint16 = 0x0003
+	NegotiateContextNetname          uint16 = 0x0005
+	NegotiateContextTransport        uint16 = 0x0006
+	NegotiateContextRDMATransform    uint16 = 0x0007
+	NegotiateContextSigning          uint16 = 0x0008
+)
+
+const (
+	HashAlgorithmSHA512 uint16 = 0x0001
+)
+
+const (
+	CipherAES128CCM uint16 = 0x0001
+	CipherAES128GCM uint16 = 0x0002
+	CipherAES256CCM uint16 = 0x0003
+	CipherAES256GCM uint16 = 0x0004
+)
+
+const (
+	TransportAcceptTransportLevelSecurity uint32 = 0x00000001
 )
 
 const (
@@ -21,11 +92,102 @@ const (
 	SecurityModeSigningRequired
 )
 
+const (
+	SessionFlagIsGuest uint16 = 1 << iota
+	SessionFlagIsNull
+	SessionFlagEncryptData
+)
+
+const (
+	ShareTypeDisk  uint8 = 0x01
+	ShareTypePipe  uint8 = 0x02
+	ShareTypePrint uint8 = 0x03
+)
+
+const (
+	ShareFlagManualCaching            uint32 = 0x00000000
+	ShareFlagAutoCaching              uint32 = 0x00000010
+	ShareFlagVdoCaching               uint32 = 0x00000020
+	ShareFlagNoCaching                uint32 = 0x00000030
+	ShareFlagDFS                      uint32 = 0x00000001
+	ShareFlagDFSRoot                  uint32 = 0x00000002
+	ShareFlagRestrictExclusiveOpens   uint32 = 0x00000100
+	ShareFlagForceSharedDelete        uint32 = 0x00000200
+	ShareFlagAllowNamespaceCaching    uint32 = 0x00000400
+	ShareFlagAccessBasedDirectoryEnum uint32 = 0x00000800
+	ShareFlagForceLevelIIOplock       uint32 = 0x00001000
+	ShareFlagEnableHashV1             uint32 = 0x00002000
+	ShareFlagEnableHashV2             uint32 = 0x00004000
+	ShareFlagEncryptData              uint32 = 0x00008000
+	ShareFlagIdentityRemoting         uint32 = 0x00040000
+	ShareFlagCompressData             uint32 = 0x00100000
+)
+
+const (
+	ShareCapDFS                    uint32 = 0x00000008
+	ShareCapContinuousAvailability uint32 = 0x00000010
+	ShareCapScaleout               uint32 = 0x00000020
+	ShareCapCluster                uint32 = 0x00000040
+	ShareCapAsymmetric             uint32 = 0x00000080
+	ShareCapRedirectToOwner        uint32 = 0x00000100
+)
+
+const (
+	FileReadData        uint32 = 0x00000001
+	FileWriteData       uint32 = 0x00000002
+	FileAppendData      uint32 = 0x00000004
+	FileReadEA          uint32 = 0x00000008
+	FileWriteEA         uint32 = 0x00000010
+	FileExecute         uint32 = 0x00000020
+	FileReadAttributes  uint32 = 0x00000080
+	FileWriteAttributes uint32 = 0x00000100
+	Delete              uint32 = 0x00010000
+	ReadControl         uint32 = 0x00020000
+	WriteDAC            uint32 = 0x00040000
+	WriteOwner          uint32 = 0x00080000
+	Synchronize         uint32 = 0x00100000
+	MaximumAllowed      uint32 = 0x02000000
+	GenericAll          uint32 = 0x10000000
+	GenericExecute      uint32 = 0x20000000
+	GenericWrite        uint32 = 0x40000000
+	GenericRead         uint32 = 0x80000000
+)
+
+const (
+	FileShareRead uint32 = 1 << iota
+	FileShareWrite
+	FileShareDelete
+)
+
+const (
+	FileSupersede uint32 = iota
+	FileOpen
+	FileCreate
+	FileOpenIf
+	FileOverwrite
+	FileOverwriteIf
+)
+
+const (
+	ImpersonationAnonymous uint32 = iota
+	ImpersonationIdentification
+	ImpersonationImpersonation
+	ImpersonationDelegate
+)
+
+const (
+	FsctlPipeTransceive uint32 = 0x0011c017
+)
+
+const (
+	IoctlIsFsctl uint32 = 0x00000001
+)
+
 type Header struct {
 	ProtocolID    []byte `smb:"fixed:4"`
 	StructureSize uint16
 	CreditCharge  uint16
-	Status        uint32
+	Status        ntstatus.Status
 	Command       uint16
 	Credits       uint16
 	Flags         uint32
@@ -37,6 +199,10 @@ type Header struct {
 	Signature     []byte `smb:"fixed:16"`
 }
 
+func (h *Header) header() *Header {
+	return h
+}
+
 func newHeader() Header {
 	return Header{
 		ProtocolID:    []byte(ProtocolSmb2),
@@ -75,6 +241,174 @@ func NewNegotiateReq(messageID uint64) NegotiateReq {
 	}
 }
 
+// NegotiateReq311 is the SMB 3.1.1 form of NEGOTIATE, where
+// ClientStartTime is replaced by the negotiate context list location.
+type NegotiateReq311 struct {
+	Header
+	StructureSize          uint16
+	DialectCount           uint16 `smb:"count:Dialects"`
+	SecurityMode           uint16
+	Reserved               uint16
+	Capabilities           uint32
+	ClientGuid             []byte `smb:"fixed:16"`
+	NegotiateContextOffset uint32
+	NegotiateContextCount  uint16
+	Reserved2              uint16
+	Dialects               []uint16
+	Padding                []byte
+	NegotiateContextList   []byte
+}
+
+// NewNegotiateReq311 offers only SMB 3.1.1, as required by transports such
+// as QUIC, with preauth integrity, encryption and transport contexts.
+func NewNegotiateReq311(messageID uint64) (NegotiateReq311, error) {
+	header := newHeader()
+	header.Command = CommandNegotiate
+	header.CreditCharge = 1
+
+	salt := make([]byte, 32)
+	if _, err := rand.Read(salt); err != nil {
+		return NegotiateReq311{}, err
+	}
+	preauth := binary.LittleEndian.AppendUint16(nil, 1)
+	preauth = binary.LittleEndian.AppendUint16(preauth, uint16(len(salt)))
+	preauth = binary.LittleEndian.AppendUint16(preauth, HashAlgorithmSHA512)
+	preauth = append(preauth, salt...)
+
+	ciphers := []uint16{CipherAES128GCM, CipherAES128CCM, CipherAES256GCM, CipherAES256CCM}
+	encryption := binary.LittleEndian.AppendUint16(nil, uint16(len(ciphers)))
+	for _, c := range ciphers {
+		encryption = binary.LittleEndian.AppendUint16(encryption, c)
+	}
+
+	transport := binary.LittleEndian.AppendUint32(nil, TransportAcceptTransportLevelSecurity)
+
+	var contexts []byte
+	for i, ctx := range []struct {
+		typ  uint16
+		data []byte
+	}{
+		{NegotiateContextPreauthIntegrity, preauth},
+		{NegotiateContextEncryption, encryption},
+		{NegotiateContextTransport, transport},
+	} {
+		if i > 0 {
+			contexts = append(contexts, make([]byte, (8-len(contexts)%8)%8)...)
+		}
+		contexts = binary.LittleEndian.AppendUint16(contexts, ctx.typ)
+		contexts = binary.LittleEndian.AppendUint16(contexts, uint16(len(ctx.data)))
+		contexts = append(contexts, 0, 0, 0, 0)
+		contexts = append(contexts, ctx.data...)
+	}
+
+	dialects := []uint16{DialectSmb_3_1_1}
+	end := 64 + 36 + 2*len(dialects)
+	padding := make([]byte, (8-end%8)%8)
+	return NegotiateReq311{
+		Header:                 header,
+		StructureSize:          36,
+		DialectCount:           uint16(len(dialects)),
+		SecurityMode:           SecurityModeSigningEnabled,
+		ClientGuid:             make([]byte, 16),
+		NegotiateContextOffset: uint32(end + len(padding)),
+		NegotiateContextCount:  3,
+		Dialects:               dialects,
+		Padding:                padding,
+		NegotiateContextList:   contexts,
+	}, nil
+}
+
+// ParseNegotiateReq decodes an SMB2 NEGOTIATE request as a server
+// receives it. Unless SMB 3.1.1 is offered, NegotiateContextOffset,
+// NegotiateContextCount and Reserved2 hold the ClientStartTime instead and
+// there is no context list.
+func ParseNegotiateReq(msg []byte) (*NegotiateReq311, error) {
+	const body = 64
+	if len(msg) < body+36 || string(msg[:4]) != ProtocolSmb2 {
+		return nil, errors.New("not an SMB2 NEGOTIATE request")
+	}
+	var req NegotiateReq311
+	if err := encoding.Unmarshal(msg, &req.Header); err != nil {
+		return nil, err
+	}
+	if req.Command != CommandNegotiate {
+		return nil, errors.New("not an SMB2 NEGOTIATE request")
+	}
+	le := binary.LittleEndian
+	req.StructureSize = le.Uint16(msg[body:])
+	req.DialectCount = le.Uint16(msg[body+2:])
+	req.SecurityMode = le.Uint16(msg[body+4:])
+	req.Reserved = le.Uint16(msg[body+6:])
+	req.Capabilities = le.Uint32(msg[body+8:])
+	req.ClientGuid = msg[body+12 : body+28]
+	req.NegotiateContextOffset = le.Uint32(msg[body+28:])
+	req.NegotiateContextCount = le.Uint16(msg[body+32:])
+	req.Reserved2 = le.Uint16(msg[body+34:])
+
+	end := body + 36 + 2*int(req.DialectCount)
+	if len(msg) < end {
+		return nil, errors.New("SMB2 NEGOTIATE request too short")
+	}
+	req.Dialects = make([]uint16, req.DialectCount)
+	for i := range req.Dialects {
+		req.Dialects[i] = le.Uint16(msg[body+36+2*i:])
+	}
+	off := int(req.NegotiateContextOffset)
+	if slices.Contains(req.Dialects, DialectSmb_3_1_1) && off >= end && off <= len(msg) {
+		req.Padding = msg[end:off]
+		req.NegotiateContextList = msg[off:]
+	}
+	return &req, nil
+}
+
+// NegotiateContext is one context of an SMB 3.1.1 NEGOTIATE.
+type NegotiateContext struct {
+	Type uint16
+	Data []byte
+}
+
+// Contexts returns the negotiate contexts in the order sent, as far as
+// they can be read.
+func (r *NegotiateReq311) Contexts() []NegotiateContext {
+	var contexts []NegotiateContext
+	list := r.NegotiateContextList
+	for i := range int(r.NegotiateContextCount) {
+		if i > 0 {
+			// Each context starts on an 8-byte boundary.
+			list = list[min((8-(len(r.NegotiateContextList)-len(list))%8)%8, len(list)):]
+		}
+		if len(list) < 8 {
+			break
+		}
+		n := int(binary.LittleEndian.Uint16(list[2:]))
+		if len(list) < 8+n {
+			break
+		}
+		contexts = append(contexts, NegotiateContext{
+			Type: binary.LittleEndian.Uint16(list),
+			Data: list[8 : 8+n],
+		})
+		list = list[8+n:]
+	}
+	return contexts
+}
+
+// Ciphers returns the ciphers offered by an encryption context, in the
+// client's order of preference.
+func (c NegotiateContext) Ciphers() []uint16 {
+	if c.Type != NegotiateContextEncryption || len(c.Data) < 2 {
+		return nil
+	}
+	var ciphers []uint16
+	for i := range int(binary.LittleEndian.Uint16(c.Data)) {
+		if len(c.Data) < 4+2*i {
+			break
+		}
+		ciphers = append(ciphers, binary.LittleEndian.Uint16(c.Data[2+2*i:]))
+	}
+	return ciphers
+}
+
 type NegotiateRes struct {
 	Header
 	StructureSize        uint16
@@ -135,3 +469,213 @@ func NewSessionSetup1Res() (SessionSetup1Res, error) {
 	}
 	return ret, nil
 }
+
+type SessionSetup2Req struct {
+	Header
+	StructureSize        uint16
+	Flags                byte
+	SecurityMode         byte
+	Capabilities         uint32
+	Channel              uint32
+	SecurityBufferOffset uint16 `smb:"offset:SecurityBlob"`
+	SecurityBufferLength uint16 `smb:"len:SecurityBlob"`
+	PreviousSessionID    uint64
+	SecurityBlob         *gss.NegTokenResp
+}
+
+type SessionSetup2Res struct {
+	Header
+	StructureSize        uint16
+	Flags                uint16
+	SecurityBufferOffset uint16 `smb:"offset:SecurityBlob"`
+	SecurityBufferLength uint16 `smb:"len:SecurityBlob"`
+	SecurityBlob         []byte
+}
+
+type LogoffReq struct {
+	Header
+	StructureSize uint16
+	Reserved      uint16
+}
+
+type LogoffRes struct {
+	Header
+	StructureSize uint16
+	Reserved      uint16
+}
+
+type TreeConnectReq struct {
+	Header
+	StructureSize uint16
+	Reserved      uint16
+	PathOffset    uint16 `smb:"offset:Path"`
+	PathLength    uint16 `smb:"len:Path"`
+	Path          []byte
+}
+
+type TreeConnectRes struct {
+	Header
+	StructureSize uint16
+	ShareType     uint8
+	Reserved      uint8
+	ShareFlags    uint32
+	Capabilities  uint32
+	MaximalAccess uint32
+}
+
+type TreeDisconnectReq struct {
+	Header
+	StructureSize uint16
+	Reserved      uint16
+}
+
+type TreeDisconnectRes struct {
+	Header
+	StructureSize uint16
+	Reserved      uint16
+}
+
+type CreateReq struct {
+	Header
+	StructureSize        uint16
+	SecurityFlags        uint8
+	RequestedOplockLevel uint8
+	ImpersonationLevel   uint32
+	SmbCreateFlags       uint64
+	Reserved             uint64
+	DesiredAccess        uint32
+	FileAttributes       uint32
+	ShareAccess          uint32
+	CreateDisposition    uint32
+	CreateOptions        uint32
+	NameOffset           uint16 `smb:"offset:Name"`
+	NameLength           uint16 `smb:"len:Name"`
+	CreateContextsOffset uint32
+	CreateContextsLength uint32
+	Name                 []byte
+}
+
+type CreateRes struct {
+	Header
+	StructureSize        uint16
+	OplockLevel          uint8
+	Flags                uint8
+	CreateAction         uint32
+	CreationTime         uint64
+	LastAccessTime       uint64
+	LastWriteTime        uint64
+	ChangeTime           uint64
+	AllocationSize       uint64
+	EndOfFile            uint64
+	FileAttributes       uint32
+	Reserved2            uint32
+	FileID               []byte `smb:"fixed:16"`
+	CreateContextsOffset uint32
+	CreateContextsLength uint32
+}
+
+type CloseReq struct {
+	Header
+	StructureSize uint16
+	Flags         uint16
+	Reserved      uint32
+	FileID        []byte `smb:"fixed:16"`
+}
+
+type CloseRes struct {
+	Header
+	StructureSize  uint16
+	Flags          uint16
+	Reserved       uint32
+	CreationTime   uint64
+	LastAccessTime uint64
+	LastWriteTime  uint64
+	ChangeTime     uint64
+	AllocationSize uint64
+	EndOfFile      uint64
+	FileAttributes uint32
+}
+
+type ReadReq struct {
+	Header
+	StructureSize         uint16
+	Padding               uint8
+	Flags                 uint8
+	Length                uint32
+	Offset                uint64
+	FileID                []byte `smb:"fixed:16"`
+	MinimumCount          uint32
+	Channel               uint32
+	RemainingBytes        uint32
+	ReadChannelInfoOffset uint16
+	ReadChannelInfoLength uint16
+	Buffer                []byte `smb:"fixed:1"`
+}
+
+type ReadRes struct {
+	Header
+	StructureSize uint16
+	DataOffset    uint8
+	Reserved      uint8
+	DataLength    uint32 `smb:"len:Data"`
+	DataRemaining uint32
+	Reserved2     uint32
+	Data          []byte
+}
+
+type WriteReq struct {
+	Header
+	StructureSize          uint16
+	DataOffset             uint16 `smb:"offset:Data"`
+	Length                 uint32 `smb:"len:Data"`
+	Offset                 uint64
+	FileID                 []byte `smb:"fixed:16"`
+	Channel                uint32
+	RemainingBytes         uint32
+	WriteChannelInfoOffset uint16
+	WriteChannelInfoLength uint16
+	Flags                  uint32
+	Data                   []byte
+}
+
+type WriteRes struct {
+	Header
+	StructureSize          uint16
+	Reserved               uint16
+	Count                  uint32
+	Remaining              uint32
+	WriteChannelInfoOffset uint16
+	WriteChannelInfoLength uint16
+}
+
+type IoctlReq struct {
+	Header
+	StructureSize     uint16
+	Reserved          uint16
+	CtlCode           uint32
+	FileID            []byte `smb:"fixed:16"`
+	InputOffset       uint32 `smb:"offset:Input"`
+	InputCount        uint32 `smb:"len:Input"`
+	MaxInputResponse  uint32
+	OutputOffset      uint32
+	OutputCount       uint32
+	MaxOutputResponse uint32
+	Flags             uint32
+	Reserved2         uint32
+	Input             []byte
+}
+
+type IoctlRes struct {
+	Header
+	StructureSize uint16
+	Reserved      uint16
+	CtlCode       uint32
+	FileID        []byte `smb:"fixed:16"`
+	InputOffset   uint32
+	InputCount    uint32
+	OutputOffset  uint32 `smb:"offset:Output"`
+	OutputCount   uint32 `smb:"len:Output"`
+	Flags         uint32
+	Reserved2     uint32
+	Output        []byte
+}
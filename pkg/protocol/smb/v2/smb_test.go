@@ -0,0 +1,53 @@
+package v2_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/d0rvin/winscope-smb/pkg/encoding"
+	v2 "github.com/d0rvin/winscope-smb/pkg/protocol/smb/v2"
+)
+
+func TestParseNegotiateReq(t *testing.T) {
+	req311, err := v2.NewNegotiateReq311(0)
+	if err != nil {
+		t.Fatal(err)
+	}
+	req311.ClientGuid = []byte("0123456789abcdef")
+	buf, err := encoding.Marshal(&req311)
+	if err != nil {
+		t.Fatal(err)
+	}
+	got, err := v2.ParseNegotiateReq(buf)
+	if !assert.NoError(t, err) {
+		return
+	}
+	assert.Equal(t, []uint16{v2.DialectSmb_3_1_1}, got.Dialects)
+	assert.Equal(t, "0123456789abcdef", string(got.ClientGuid))
+	assert.Equal(t, uint16(v2.SecurityModeSigningEnabled), got.SecurityMode)
+	contexts := got.Contexts()
+	if assert.Len(t, contexts, 3) {
+		assert.Equal(t, v2.NegotiateContextPreauthIntegrity, contexts[0].Type)
+		assert.Len(t, contexts[0].Data, 38)
+		assert.Equal(t, []uint16{v2.CipherAES128GCM, v2.CipherAES128CCM, v2.CipherAES256GCM, v2.CipherAES256CCM}, contexts[1].Ciphers())
+		assert.Equal(t, v2.NegotiateContextTransport, contexts[2].Type)
+		assert.Nil(t, contexts[2].Ciphers())
+	}
+
+	// Before SMB 3.1.1 there are no contexts.
+	buf, err = encoding.Marshal(v2.NewNegotiateReq(0))
+	if err != nil {
+		t.Fatal(err)
+	}
+	got, err = v2.ParseNegotiateReq(buf)
+	if assert.NoError(t, err) {
+		assert.Equal(t, []uint16{v2.DialectSmb_2_1}, got.Dialects)
+		assert.Empty(t, got.Contexts())
+	}
+
+	_, err = v2.ParseNegotiateReq(buf[:80])
+	assert.Error(t, err)
+	_, err = v2.ParseNegotiateReq(buf[:len(buf)-1])
+	assert.EqualError(t, err, "SMB2 NEGOTIATE request too short")
+}
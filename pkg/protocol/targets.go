@@ -0,0 +1,114 @@
+package protocol
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/netip"
+	"strings"
+)
+
+// maxRangeBits caps a CIDR range at 65536 addresses.
+const maxRangeBits = 16
+
+// Target is one address to scan. Host is the name the address was
+// resolved from, or the address itself for literals and ranges.
+type Target struct {
+	Host string
+	// Addr is invalid when the name was left for a proxy to resolve.
+	Addr netip.Addr
+}
+
+func (t Target) String() string {
+	if !t.Addr.IsValid() || t.Host == t.Addr.String() {
+		return t.Host
+	}
+	return fmt.Sprintf("%s (%s)", t.Host, t.Addr)
+}
+
+// ExpandTargets expands a comma-separated list of host names, IPv4 and
+// IPv6 addresses (optionally bracketed, with a zone) and CIDR ranges. A
+// host name yields every A and AAAA record. version 4 or 6 keeps only
+// that family; 0 keeps both. Names are resolved through r, which may be
+// nil for the system resolver. When resolve is false, as when a proxy
+// resolves names, host names are returned unresolved. Duplicates are
+// dropped.
+func ExpandTargets(ctx context.Context, r *Resolver, spec string, version int, resolve bool) ([]Target, error) {
+	if _, err := ipNetwork(version); err != nil {
+		return nil, err
+	}
+	var targets []Target
+	seen := make(map[Target]struct{})
+	add := func(t Target) {
+		if _, ok := seen[t]; !ok {
+			seen[t] = struct{}{}
+			targets = append(targets, t)
+		}
+	}
+	for item := range strings.SplitSeq(spec, ",") {
+		item = strings.TrimSpace(item)
+		if item == "" {
+			continue
+		}
+
+		if strings.Contains(item, "/") {
+			prefix, err := netip.ParsePrefix(item)
+			if err != nil {
+				return nil, fmt.Errorf("invalid range %q: %w", item, err)
+			}
+			if !matchVersion(prefix.Addr(), version) {
+				return nil, fmt.Errorf("range %s is not IPv%d", item, version)
+			}
+			if prefix.Addr().BitLen()-prefix.Bits() > maxRangeBits {
+				return nil, fmt.Errorf("range %s is larger than /%d", item, prefix.Addr().BitLen()-maxRangeBits)
+			}
+			prefix = prefix.Masked()
+			for addr := prefix.Addr(); addr.IsValid() && prefix.Contains(addr); addr = addr.Next() {
+				add(Target{Host: addr.String(), Addr: addr})
+			}
+			continue
+		}
+
+		if addr, err := netip.ParseAddr(strings.Trim(item, "[]")); err == nil {
+			addr = addr.Unmap()
+			if !matchVersion(addr, version) {
+				return nil, fmt.Errorf("%s is not an IPv%d address", item, version)
+			}
+			add(Target{Host: addr.String(), Addr: addr})
+			continue
+		}
+
+		if !resolve {
+			add(Target{Host: item})
+			continue
+		}
+		addrs, err := r.LookupNetIP(ctx, "ip", item)
+		if err != nil {
+			return nil, err
+		}
+		found := false
+		for _, addr := range addrs {
+			if matchVersion(addr, version) {
+				add(Target{Host: item, Addr: addr})
+				found = true
+			}
+		}
+		if !found {
+			return nil, fmt.Errorf("no IPv%d address for %s", version, item)
+		}
+	}
+	if len(targets) == 0 {
+		return nil, errors.New("no targets")
+	}
+	return targets, nil
+}
+
+func matchVersion(addr netip.Addr, version int) bool {
+	switch version {
+	case 4:
+		return addr.Is4()
+	case 6:
+		return addr.Is6()
+	}
+	return true
+}
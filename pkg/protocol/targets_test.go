@@ -0,0 +1,91 @@
+package protocol_test
+
+import (
+	"context"
+	"net"
+	"net/netip"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/d0rvin/winscope-smb/pkg/protocol"
+)
+
+func TestExpandTargets(t *testing.T) {
+	ctx := context.Background()
+	hosts := func(targets []protocol.Target) []string {
+		var out []string
+		for _, t := range targets {
+			out = append(out, t.String())
+		}
+		return out
+	}
+
+	targets, err := protocol.ExpandTargets(ctx, nil, "192.0.2.8/30, [2001:db8::1], 192.0.2.9", 0, true)
+	if assert.NoError(t, err) {
+		assert.Equal(t, []string{"192.0.2.8", "192.0.2.9", "192.0.2.10", "192.0.2.11", "2001:db8::1"}, hosts(targets))
+	}
+
+	targets, err = protocol.ExpandTargets(ctx, nil, "2001:db8::/126,fe80::1%eth0", 6, true)
+	if assert.NoError(t, err) {
+		assert.Equal(t, []string{"2001:db8::", "2001:db8::1", "2001:db8::2", "2001:db8::3", "fe80::1%eth0"}, hosts(targets))
+		assert.Equal(t, "eth0", targets[4].Addr.Zone())
+	}
+
+	// The largest range allowed, overlapping a smaller one.
+	targets, err = protocol.ExpandTargets(ctx, nil, "10.1.0.0/16,10.1.2.0/24", 4, true)
+	if assert.NoError(t, err) && assert.Len(t, targets, 1<<16) {
+		assert.Equal(t, "10.1.255.255", targets[len(targets)-1].String())
+	}
+
+	targets, err = protocol.ExpandTargets(ctx, nil, "localhost", 4, true)
+	if assert.NoError(t, err) && assert.Len(t, targets, 1) {
+		assert.Equal(t, "localhost (127.0.0.1)", targets[0].String())
+	}
+
+	// A proxy resolves names itself.
+	targets, err = protocol.ExpandTargets(ctx, nil, "files.corp.example", 0, false)
+	if assert.NoError(t, err) && assert.Len(t, targets, 1) {
+		assert.False(t, targets[0].Addr.IsValid())
+		assert.Equal(t, "files.corp.example", targets[0].String())
+	}
+
+	_, err = protocol.ExpandTargets(ctx, nil, "192.0.2.10", 6, true)
+	assert.ErrorContains(t, err, "not an IPv6 address")
+	_, err = protocol.ExpandTargets(ctx, nil, "10.0.0.0/8", 0, true)
+	assert.ErrorContains(t, err, "larger than /16")
+	_, err = protocol.ExpandTargets(ctx, nil, "2001:db8::/64", 0, true)
+	assert.ErrorContains(t, err, "larger than /112")
+	_, err = protocol.ExpandTargets(ctx, nil, " , ", 0, true)
+	assert.Error(t, err)
+}
+
+func TestLocalAddr(t *testing.T) {
+	ln := listen(t)
+	remotes := make(chan string, 1)
+	accept(ln, func(conn net.Conn) {
+		host, _, _ := net.SplitHostPort(conn.RemoteAddr().String())
+		remotes <- host
+	})
+	port := uint16(ln.Addr().(*net.TCPAddr).Port)
+	target := netip.MustParseAddr("127.0.0.1")
+
+	// The resolved address is dialed, not the name.
+	c, err := protocol.NewConnection("files.corp.invalid", port,
+		protocol.WithAddr(target), protocol.WithLocalAddr(netip.MustParseAddr("127.0.0.2")))
+	if !assert.NoError(t, err) {
+		return
+	}
+	if assert.NoError(t, c.Dial("tcp")) {
+		assert.Equal(t, "127.0.0.2", <-remotes)
+		_ = c.Close()
+	}
+
+	c, err = protocol.NewConnection("127.0.0.1", port, protocol.WithLocalAddr(netip.MustParseAddr("::1")))
+	if assert.NoError(t, err) {
+		assert.ErrorContains(t, c.Dial("tcp"), "cannot reach")
+	}
+
+	_, err = protocol.NewConnection("127.0.0.1", port, protocol.WithIPVersion(6))
+	assert.Error(t, err)
+}
@@ -0,0 +1,204 @@
+package tds
+
+import (
+	"crypto/tls"
+	"errors"
+	"fmt"
+	"io"
+	"net"
+	"os"
+
+	"github.com/d0rvin/winscope-smb/pkg/encoding"
+	"github.com/d0rvin/winscope-smb/pkg/protocol"
+	"github.com/d0rvin/winscope-smb/pkg/protocol/gss"
+	"github.com/d0rvin/winscope-smb/pkg/protocol/ntlmssp"
+)
+
+const clientName = "winscope-smb"
+
+type Session struct {
+	conn     *protocol.Connection
+	prelogin *Prelogin
+	tlsConn  *tls.Conn
+}
+
+func NewSession(cfg protocol.Config) (*Session, error) {
+	c, err := protocol.NewConnection(cfg.Host, cfg.Port, cfg.Options...)
+	if err != nil {
+		return nil, err
+	}
+	if err := c.Dial("tcp"); err != nil {
+		_ = c.Close()
+		return nil, err
+	}
+	return NewSessionConn(c), nil
+}
+
+// NewSessionConn starts a TDS session on a dialed cleartext connection. TLS,
+// if the server asks for it, is negotiated later inside PRELOGIN packets,
+// so the connection must not be dialed with WithTLS.
+func NewSessionConn(c *protocol.Connection) *Session {
+	return &Session{conn: c}
+}
+
+func (s *Session) Close() error {
+	return s.conn.Close()
+}
+
+// Prelogin exchanges PRELOGIN messages and returns the server's options,
+// including its version and encryption setting.
+func (s *Session) Prelogin() (*Prelogin, error) {
+	req := MarshalPrelogin(&Prelogin{
+		Encryption: EncryptOff,
+		ThreadID:   uint32(os.Getpid()),
+	})
+	if err := writePacket(s.conn, PacketPrelogin, req); err != nil {
+		return nil, err
+	}
+
+	packetType, buf, err := readMessage(s.conn)
+	if err != nil {
+		return nil, err
+	}
+	if packetType != PacketTabularResult {
+		return nil, fmt.Errorf("unexpected TDS packet type 0x%02x in PRELOGIN response", packetType)
+	}
+	p, err := UnmarshalPrelogin(buf)
+	if err != nil {
+		return nil, err
+	}
+	s.prelogin = p
+	return p, nil
+}
+
+// Login sends LOGIN7 with integrated security and an NTLM NEGOTIATE message
+// and returns the CHALLENGE from the server's SSPI token. Unless the server
+// does not support encryption, the login is sent over TLS negotiated inside
+// TDS; with encryption off only the LOGIN7 packet is encrypted.
+func (s *Session) Login() (*ntlmssp.Challenge, error) {
+	if s.prelogin == nil {
+		return nil, errors.New("PRELOGIN has not been exchanged")
+	}
+
+	negotiate, err := encoding.Marshal(ntlmssp.NewNegotiate("", ""))
+	if err != nil {
+		return nil, err
+	}
+	login := MarshalLogin7(&Login7{
+		PacketSize: maxPacketSize,
+		ClientPID:  uint32(os.Getpid()),
+		HostName:   clientName,
+		AppName:    clientName,
+		ServerName: s.conn.Host,
+		LibName:    clientName,
+		SSPI:       negotiate,
+	})
+
+	var w io.Writer = s.conn
+	var r io.Reader = s.conn
+	switch s.prelogin.Encryption {
+	case EncryptNotSup:
+	case EncryptOff, EncryptOn, EncryptReq:
+		if err := s.startTLS(); err != nil {
+			return nil, err
+		}
+		w = s.tlsConn
+		if s.prelogin.Encryption != EncryptOff {
+			r = s.tlsConn
+		}
+	default:
+		return nil, fmt.Errorf("unknown encryption option 0x%02x", s.prelogin.Encryption)
+	}
+
+	if err := writePacket(w, PacketLogin7, login); err != nil {
+		return nil, err
+	}
+	packetType, buf, err := readMessage(r)
+	if err != nil {
+		return nil, err
+	}
+	if packetType != PacketTabularResult {
+		return nil, fmt.Errorf("unexpected TDS packet type 0x%02x in login response", packetType)
+	}
+
+	data, err := findSSPI(buf)
+	if err != nil {
+		return nil, err
+	}
+	token, err := gss.ResponseToken(data)
+	if err != nil {
+		return nil, err
+	}
+	return ntlmssp.ParseChallenge(token)
+}
+
+// ConnectionState reports the TLS state, including the server certificate,
+// once Login has negotiated TLS.
+func (s *Session) ConnectionState() (tls.ConnectionState, bool) {
+	if s.tlsConn == nil {
+		return tls.ConnectionState{}, false
+	}
+	return s.tlsConn.ConnectionState(), true
+}
+
+func (s *Session) startTLS() error {
+	shim := &handshakeConn{Conn: s.conn.Conn(), rw: s.conn, handshake: true}
+	s.tlsConn = tls.Client(shim, &tls.Config{
+		ServerName:         s.conn.Host,
+		InsecureSkipVerify: true,
+		// Older SQL Servers only speak TLS 1.0 with RSA key exchange.
+		MinVersion:   tls.VersionTLS10,
+		CipherSuites: cipherSuites(),
+	})
+	if err := s.tlsConn.Handshake(); err != nil {
+		return fmt.Errorf("TLS handshake failed: %w", err)
+	}
+	shim.handshake = false
+	return nil
+}
+
+func cipherSuites() []uint16 {
+	var ids []uint16
+	for _, suite := range tls.CipherSuites() {
+		ids = append(ids, suite.ID)
+	}
+	for _, suite := range tls.InsecureCipherSuites() {
+		ids = append(ids, suite.ID)
+	}
+	return ids
+}
+
+// handshakeConn carries TLS handshake records inside PRELOGIN packets, as
+// TDS requires, and passes records through unwrapped afterwards.
+type handshakeConn struct {
+	net.Conn
+	rw        io.ReadWriter
+	handshake bool
+	pending   []byte
+}
+
+func (c *handshakeConn) Read(b []byte) (int, error) {
+	if !c.handshake {
+		return c.rw.Read(b)
+	}
+	if len(c.pending) == 0 {
+		_, _, payload, err := readPacket(c.rw)
+		if err != nil {
+			return 0, err
+		}
+		c.pending = payload
+	}
+	n := copy(b, c.pending)
+	c.pending = c.pending[n:]
+	return n, nil
+}
+
+func (c *handshakeConn) Write(b []byte) (int, error) {
+	if !c.handshake {
+		return c.rw.Write(b)
+	}
+	if err := writePacket(c.rw, PacketPrelogin, b); err != nil {
+		return 0, err
+	}
+	return len(b), nil
+}
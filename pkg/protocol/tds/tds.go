@@ -0,0 +1,387 @@
+package tds
+
+import (
+	"bytes"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"io"
+
+	"github.com/d0rvin/winscope-smb/pkg/encoding"
+)
+
+const DefaultPort = 1433
+
+const (
+	PacketTabularResult uint8 = 0x04
+	PacketLogin7        uint8 = 0x10
+	PacketSSPI          uint8 = 0x11
+	PacketPrelogin      uint8 = 0x12
+)
+
+const (
+	statusNormal uint8 = 0x00
+	statusEOM    uint8 = 0x01
+)
+
+const (
+	headerSize    = 8
+	maxPacketSize = 4096
+)
+
+const (
+	PreloginVersion    uint8 = 0x00
+	PreloginEncryption uint8 = 0x01
+	PreloginInstOpt    uint8 = 0x02
+	PreloginThreadID   uint8 = 0x03
+	PreloginMARS       uint8 = 0x04
+	PreloginTerminator uint8 = 0xff
+)
+
+const (
+	EncryptOff    uint8 = 0x00
+	EncryptOn     uint8 = 0x01
+	EncryptNotSup uint8 = 0x02
+	EncryptReq    uint8 = 0x03
+)
+
+const (
+	TokenError     uint8 = 0xaa
+	TokenInfo      uint8 = 0xab
+	TokenLoginAck  uint8 = 0xad
+	TokenEnvChange uint8 = 0xe3
+	TokenSSPI      uint8 = 0xed
+	TokenDone      uint8 = 0xfd
+)
+
+// tdsVersion74 is TDS 7.4 (SQL Server 2012 and later) as sent in LOGIN7.
+const tdsVersion74 uint32 = 0x74000004
+
+const (
+	optionFlags2IntSecurity uint8 = 0x80
+	login7FixedSize               = 94
+)
+
+// Version is the server version from the PRELOGIN VERSION option.
+type Version struct {
+	Major    uint8
+	Minor    uint8
+	Build    uint16
+	SubBuild uint16
+}
+
+func (v Version) String() string {
+	return fmt.Sprintf("%d.%d.%d.%d", v.Major, v.Minor, v.Build, v.SubBuild)
+}
+
+// ProductName maps the major and minor version to the SQL Server release.
+func (v Version) ProductName() (string, bool) {
+	switch v.Major {
+	case 8:
+		return "SQL Server 2000", true
+	case 9:
+		return "SQL Server 2005", true
+	case 10:
+		if v.Minor >= 50 {
+			return "SQL Server 2008 R2", true
+		}
+		return "SQL Server 2008", true
+	case 11:
+		return "SQL Server 2012", true
+	case 12:
+		return "SQL Server 2014", true
+	case 13:
+		return "SQL Server 2016", true
+	case 14:
+		return "SQL Server 2017", true
+	case 15:
+		return "SQL Server 2019", true
+	case 16:
+		return "SQL Server 2022", true
+	case 17:
+		return "SQL Server 2025", true
+	}
+	return "", false
+}
+
+// Prelogin holds the options of a PRELOGIN message.
+type Prelogin struct {
+	Version    Version
+	Encryption uint8
+	Instance   string
+	ThreadID   uint32
+	MARS       bool
+}
+
+func EncryptionName(e uint8) string {
+	switch e {
+	case EncryptOff:
+		return "off"
+	case EncryptOn:
+		return "on"
+	case EncryptNotSup:
+		return "not supported"
+	case EncryptReq:
+		return "required"
+	}
+	return fmt.Sprintf("0x%02x", e)
+}
+
+// MarshalPrelogin encodes p as a PRELOGIN payload: an option table of
+// big-endian offsets and lengths followed by the option data.
+func MarshalPrelogin(p *Prelogin) []byte {
+	version := make([]byte, 6)
+	version[0], version[1] = p.Version.Major, p.Version.Minor
+	binary.BigEndian.PutUint16(version[2:], p.Version.Build)
+	binary.BigEndian.PutUint16(version[4:], p.Version.SubBuild)
+	var mars uint8
+	if p.MARS {
+		mars = 1
+	}
+	options := []struct {
+		token uint8
+		data  []byte
+	}{
+		{PreloginVersion, version},
+		{PreloginEncryption, []byte{p.Encryption}},
+		{PreloginInstOpt, append([]byte(p.Instance), 0x00)},
+		{PreloginThreadID, binary.BigEndian.AppendUint32(nil, p.ThreadID)},
+		{PreloginMARS, []byte{mars}},
+	}
+
+	var table, data []byte
+	offset := len(options)*5 + 1
+	for _, opt := range options {
+		table = append(table, opt.token)
+		table = binary.BigEndian.AppendUint16(table, uint16(offset+len(data)))
+		table = binary.BigEndian.AppendUint16(table, uint16(len(opt.data)))
+		data = append(data, opt.data...)
+	}
+	table = append(table, PreloginTerminator)
+	return append(table, data...)
+}
+
+func UnmarshalPrelogin(buf []byte) (*Prelogin, error) {
+	p := &Prelogin{Encryption: EncryptNotSup}
+	for i := 0; ; i += 5 {
+		if i >= len(buf) {
+			return nil, errors.New("PRELOGIN option table not terminated")
+		}
+		if buf[i] == PreloginTerminator {
+			return p, nil
+		}
+		if i+5 > len(buf) {
+			return nil, errors.New("PRELOGIN option table truncated")
+		}
+		off := int(binary.BigEndian.Uint16(buf[i+1:]))
+		l := int(binary.BigEndian.Uint16(buf[i+3:]))
+		if off+l > len(buf) {
+			return nil, fmt.Errorf("PRELOGIN option 0x%02x exceeds message", buf[i])
+		}
+		data := buf[off : off+l]
+
+		switch buf[i] {
+		case PreloginVersion:
+			if l >= 6 {
+				p.Version = Version{
+					Major:    data[0],
+					Minor:    data[1],
+					Build:    binary.BigEndian.Uint16(data[2:]),
+					SubBuild: binary.BigEndian.Uint16(data[4:]),
+				}
+			}
+		case PreloginEncryption:
+			if l >= 1 {
+				p.Encryption = data[0]
+			}
+		case PreloginInstOpt:
+			p.Instance = string(bytes.TrimRight(data, "\x00"))
+		case PreloginThreadID:
+			if l >= 4 {
+				p.ThreadID = binary.BigEndian.Uint32(data)
+			}
+		case PreloginMARS:
+			p.MARS = l >= 1 && data[0] != 0
+		}
+	}
+}
+
+// Login7 holds the LOGIN7 fields this client sets. With integrated security
+// the user name and password stay empty and SSPI carries the auth token.
+type Login7 struct {
+	PacketSize uint32
+	ClientPID  uint32
+	HostName   string
+	AppName    string
+	ServerName string
+	LibName    string
+	SSPI       []byte
+}
+
+func MarshalLogin7(l *Login7) []byte {
+	type variable struct {
+		data  []byte
+		chars bool
+	}
+	vars := []variable{
+		{encoding.ToUnicode(l.HostName), true},
+		{nil, true}, // UserName
+		{nil, true}, // Password
+		{encoding.ToUnicode(l.AppName), true},
+		{encoding.ToUnicode(l.ServerName), true},
+		{nil, false}, // Extension
+		{encoding.ToUnicode(l.LibName), true},
+		{nil, true}, // Language
+		{nil, true}, // Database
+	}
+
+	fixed := make([]byte, 0, login7FixedSize)
+	fixed = binary.LittleEndian.AppendUint32(fixed, 0) // Length, patched below
+	fixed = binary.LittleEndian.AppendUint32(fixed, tdsVersion74)
+	fixed = binary.LittleEndian.AppendUint32(fixed, l.PacketSize)
+	fixed = binary.LittleEndian.AppendUint32(fixed, 0) // ClientProgVer
+	fixed = binary.LittleEndian.AppendUint32(fixed, l.ClientPID)
+	fixed = binary.LittleEndian.AppendUint32(fixed, 0) // ConnectionID
+	fixed = append(fixed, 0x00, optionFlags2IntSecurity, 0x00, 0x00)
+	fixed = binary.LittleEndian.AppendUint32(fixed, 0) // ClientTimeZone
+	fixed = binary.LittleEndian.AppendUint32(fixed, 0) // ClientLCID
+
+	var data []byte
+	offset := login7FixedSize
+	for _, v := range vars {
+		n := len(v.data)
+		if v.chars {
+			n /= 2
+		}
+		fixed = binary.LittleEndian.AppendUint16(fixed, uint16(offset+len(data)))
+		fixed = binary.LittleEndian.AppendUint16(fixed, uint16(n))
+		data = append(data, v.data...)
+	}
+	fixed = append(fixed, make([]byte, 6)...) // ClientID
+	fixed = binary.LittleEndian.AppendUint16(fixed, uint16(offset+len(data)))
+	fixed = binary.LittleEndian.AppendUint16(fixed, uint16(len(l.SSPI)))
+	data = append(data, l.SSPI...)
+	for range 2 { // AtchDBFile, ChangePassword
+		fixed = binary.LittleEndian.AppendUint16(fixed, uint16(offset+len(data)))
+		fixed = binary.LittleEndian.AppendUint16(fixed, 0)
+	}
+	fixed = binary.LittleEndian.AppendUint32(fixed, 0) // cbSSPILong
+
+	buf := append(fixed, data...)
+	binary.LittleEndian.PutUint32(buf, uint32(len(buf)))
+	return buf
+}
+
+// ServerError is an ERROR token returned by the server.
+type ServerError struct {
+	Number  uint32
+	State   uint8
+	Class   uint8
+	Message string
+}
+
+func (e *ServerError) Error() string {
+	return fmt.Sprintf("SQL Server error %d (state %d, class %d): %s", e.Number, e.State, e.Class, e.Message)
+}
+
+// findSSPI walks a token stream and returns the data of the first SSPI
+// token. An ERROR token before it is returned as *ServerError.
+func findSSPI(buf []byte) ([]byte, error) {
+	for off := 0; off < len(buf); {
+		token := buf[off]
+		off++
+		switch token {
+		case TokenSSPI, TokenError, TokenInfo, TokenEnvChange, TokenLoginAck:
+			if off+2 > len(buf) {
+				return nil, errors.New("TDS token truncated")
+			}
+			l := int(binary.LittleEndian.Uint16(buf[off:]))
+			off += 2
+			if off+l > len(buf) {
+				return nil, errors.New("TDS token truncated")
+			}
+			data := buf[off : off+l]
+			off += l
+			switch token {
+			case TokenSSPI:
+				return data, nil
+			case TokenError:
+				return nil, parseError(data)
+			}
+		case TokenDone:
+			off += 12
+		default:
+			return nil, fmt.Errorf("unexpected TDS token 0x%02x", token)
+		}
+	}
+	return nil, errors.New("no SSPI token in login response")
+}
+
+func parseError(data []byte) error {
+	if len(data) < 8 {
+		return errors.New("TDS error token truncated")
+	}
+	e := &ServerError{
+		Number: binary.LittleEndian.Uint32(data),
+		State:  data[4],
+		Class:  data[5],
+	}
+	n := int(binary.LittleEndian.Uint16(data[6:])) * 2
+	if 8+n <= len(data) {
+		e.Message = encoding.FromUnicode(data[8 : 8+n])
+	}
+	return e
+}
+
+// writePacket sends payload as a message of the given type, split into
+// packets of at most maxPacketSize bytes.
+func writePacket(w io.Writer, packetType uint8, payload []byte) error {
+	id := uint8(1)
+	for first := true; first || len(payload) > 0; first = false {
+		chunk := payload[:min(len(payload), maxPacketSize-headerSize)]
+		payload = payload[len(chunk):]
+		status := statusNormal
+		if len(payload) == 0 {
+			status = statusEOM
+		}
+		hdr := []byte{packetType, status, 0, 0, 0, 0, id, 0}
+		binary.BigEndian.PutUint16(hdr[2:], uint16(headerSize+len(chunk)))
+		if _, err := w.Write(append(hdr, chunk...)); err != nil {
+			return err
+		}
+		id++
+	}
+	return nil
+}
+
+// readPacket reads a single packet and returns its type, status and payload.
+func readPacket(r io.Reader) (uint8, uint8, []byte, error) {
+	hdr := make([]byte, headerSize)
+	if _, err := io.ReadFull(r, hdr); err != nil {
+		return 0, 0, nil, err
+	}
+	length := int(binary.BigEndian.Uint16(hdr[2:]))
+	if length < headerSize {
+		return 0, 0, nil, errors.New("invalid TDS packet length")
+	}
+	payload := make([]byte, length-headerSize)
+	if _, err := io.ReadFull(r, payload); err != nil {
+		return 0, 0, nil, err
+	}
+	return hdr[0], hdr[1], payload, nil
+}
+
+// readMessage reads packets up to the end of message.
+func readMessage(r io.Reader) (uint8, []byte, error) {
+	var msg []byte
+	for {
+		packetType, status, payload, err := readPacket(r)
+		if err != nil {
+			return 0, nil, err
+		}
+		msg = append(msg, payload...)
+		if status&statusEOM != 0 {
+			return packetType, msg, nil
+		}
+	}
+}
@@ -0,0 +1,226 @@
+package tds_test
+
+import (
+	"crypto/tls"
+	"encoding/binary"
+	"io"
+	"net"
+	"strconv"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/d0rvin/winscope-smb/internal/testutil"
+	"github.com/d0rvin/winscope-smb/pkg/encoding"
+	"github.com/d0rvin/winscope-smb/pkg/protocol"
+	"github.com/d0rvin/winscope-smb/pkg/protocol/ntlmssp"
+	"github.com/d0rvin/winscope-smb/pkg/protocol/tds"
+)
+
+func writePacket(w io.Writer, packetType uint8, payload []byte) error {
+	hdr := []byte{packetType, 0x01, 0, 0, 0, 0, 1, 0}
+	binary.BigEndian.PutUint16(hdr[2:], uint16(8+len(payload)))
+	_, err := w.Write(append(hdr, payload...))
+	return err
+}
+
+func readPacket(r io.Reader) (uint8, []byte, error) {
+	hdr := make([]byte, 8)
+	if _, err := io.ReadFull(r, hdr); err != nil {
+		return 0, nil, err
+	}
+	payload := make([]byte, int(binary.BigEndian.Uint16(hdr[2:]))-8)
+	_, err := io.ReadFull(r, payload)
+	return hdr[0], payload, err
+}
+
+// preloginShim is the server side of TLS wrapped in PRELOGIN packets
+// during the handshake.
+type preloginShim struct {
+	net.Conn
+	handshake bool
+	pending   []byte
+}
+
+func (c *preloginShim) Read(b []byte) (int, error) {
+	if !c.handshake {
+		return c.Conn.Read(b)
+	}
+	if len(c.pending) == 0 {
+		_, payload, err := readPacket(c.Conn)
+		if err != nil {
+			return 0, err
+		}
+		c.pending = payload
+	}
+	n := copy(b, c.pending)
+	c.pending = c.pending[n:]
+	return n, nil
+}
+
+func (c *preloginShim) Write(b []byte) (int, error) {
+	if !c.handshake {
+		return c.Conn.Write(b)
+	}
+	return len(b), writePacket(c.Conn, tds.PacketPrelogin, b)
+}
+
+// serveTDS is a stand-in SQL Server answering PRELOGIN and LOGIN7 with the
+// given encryption setting. The LOGIN7 payload is sent to logins and tokens
+// is returned as the login response.
+func serveTDS(t *testing.T, ln net.Listener, encryption uint8, tokens []byte, logins chan<- []byte) {
+	conn, err := ln.Accept()
+	if err != nil {
+		return
+	}
+	defer conn.Close()
+
+	packetType, payload, err := readPacket(conn)
+	if err != nil || packetType != tds.PacketPrelogin {
+		t.Errorf("expected PRELOGIN, got 0x%02x: %v", packetType, err)
+		return
+	}
+	clientPrelogin, err := tds.UnmarshalPrelogin(payload)
+	if err != nil {
+		t.Error(err)
+		return
+	}
+	assert.Equal(t, tds.EncryptOff, clientPrelogin.Encryption)
+
+	resp := tds.MarshalPrelogin(&tds.Prelogin{
+		Version:    tds.Version{Major: 15, Minor: 0, Build: 4153, SubBuild: 1},
+		Encryption: encryption,
+	})
+	if err := writePacket(conn, tds.PacketTabularResult, resp); err != nil {
+		return
+	}
+
+	var r io.Reader = conn
+	var w io.Writer = conn
+	if encryption != tds.EncryptNotSup {
+		shim := &preloginShim{Conn: conn, handshake: true}
+		tlsConn := tls.Server(shim, &tls.Config{Certificates: []tls.Certificate{testutil.SelfSignedCert(t, "sql01.corp.example")}})
+		if err := tlsConn.Handshake(); err != nil {
+			t.Error(err)
+			return
+		}
+		shim.handshake = false
+		r = tlsConn
+		if encryption != tds.EncryptOff {
+			w = tlsConn
+		}
+	}
+
+	packetType, payload, err = readPacket(r)
+	if err != nil || packetType != tds.PacketLogin7 {
+		t.Errorf("expected LOGIN7, got 0x%02x: %v", packetType, err)
+		return
+	}
+	logins <- payload
+	_ = writePacket(w, tds.PacketTabularResult, tokens)
+}
+
+func sspiToken(data []byte) []byte {
+	buf := []byte{tds.TokenSSPI}
+	buf = binary.LittleEndian.AppendUint16(buf, uint16(len(data)))
+	return append(buf, data...)
+}
+
+func errorToken(number uint32, msg string) []byte {
+	body := binary.LittleEndian.AppendUint32(nil, number)
+	body = append(body, 1, 14)
+	body = binary.LittleEndian.AppendUint16(body, uint16(len(msg)))
+	body = append(body, encoding.ToUnicode(msg)...)
+	body = append(body, 0, 0, 0, 0) // server name, proc name
+	body = binary.LittleEndian.AppendUint32(body, 1)
+	buf := []byte{tds.TokenError}
+	buf = binary.LittleEndian.AppendUint16(buf, uint16(len(body)))
+	return append(buf, body...)
+}
+
+func TestLogin(t *testing.T) {
+	tests := []struct {
+		name       string
+		encryption uint8
+		tls        bool
+	}{
+		{name: "login-only encryption", encryption: tds.EncryptOff, tls: true},
+		{name: "full encryption", encryption: tds.EncryptReq, tls: true},
+		{name: "no encryption", encryption: tds.EncryptNotSup, tls: false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ln, err := net.Listen("tcp", "127.0.0.1:0")
+			if err != nil {
+				t.Fatal(err)
+			}
+			defer ln.Close()
+			logins := make(chan []byte, 1)
+			go serveTDS(t, ln, tt.encryption, sspiToken(testutil.Challenge(t, "SQL01", ntlmssp.Version{Major: 10, Build: 17763})), logins)
+
+			host, portStr, _ := net.SplitHostPort(ln.Addr().String())
+			port, _ := strconv.Atoi(portStr)
+			s, err := tds.NewSession(protocol.Config{Host: host, Port: uint16(port)})
+			if err != nil {
+				t.Fatal(err)
+			}
+			defer s.Close()
+
+			prelogin, err := s.Prelogin()
+			assert.NoError(t, err)
+			assert.Equal(t, "15.0.4153.1", prelogin.Version.String())
+			product, ok := prelogin.Version.ProductName()
+			assert.True(t, ok)
+			assert.Equal(t, "SQL Server 2019", product)
+
+			challenge, err := s.Login()
+			if !assert.NoError(t, err) {
+				return
+			}
+			assert.Equal(t, uint16(17763), challenge.Version.Build)
+			assert.Equal(t, "SQL01", challenge.TargetInfo.Parse().NBComputerName)
+
+			login := <-logins
+			assert.Equal(t, uint32(len(login)), binary.LittleEndian.Uint32(login))
+			assert.Equal(t, uint8(0x80), login[25]&0x80, "integrated security")
+			sspiOffset := binary.LittleEndian.Uint16(login[78:])
+			sspiLen := binary.LittleEndian.Uint16(login[80:])
+			sspi := login[sspiOffset : sspiOffset+sspiLen]
+			assert.Equal(t, []byte(ntlmssp.Signature), sspi[:8])
+			assert.Equal(t, ntlmssp.TypeNtLmNegotiate, binary.LittleEndian.Uint32(sspi[8:]))
+
+			state, ok := s.ConnectionState()
+			assert.Equal(t, tt.tls, ok)
+			if ok {
+				assert.Equal(t, "sql01.corp.example", state.PeerCertificates[0].Subject.CommonName)
+			}
+		})
+	}
+}
+
+func TestLoginServerError(t *testing.T) {
+	ln, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer ln.Close()
+	logins := make(chan []byte, 1)
+	go serveTDS(t, ln, tds.EncryptNotSup, errorToken(18452, "Login failed."), logins)
+
+	host, portStr, _ := net.SplitHostPort(ln.Addr().String())
+	port, _ := strconv.Atoi(portStr)
+	s, err := tds.NewSession(protocol.Config{Host: host, Port: uint16(port)})
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer s.Close()
+
+	_, err = s.Prelogin()
+	assert.NoError(t, err)
+	_, err = s.Login()
+	var serverErr *tds.ServerError
+	if assert.ErrorAs(t, err, &serverErr) {
+		assert.Equal(t, uint32(18452), serverErr.Number)
+		assert.Equal(t, "Login failed.", serverErr.Message)
+	}
+}
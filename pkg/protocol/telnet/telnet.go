@@ -0,0 +1,247 @@
+package telnet
+
+import (
+	"bufio"
+	"bytes"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"io"
+
+	"github.com/d0rvin/winscope-smb/pkg/encoding"
+	"github.com/d0rvin/winscope-smb/pkg/protocol"
+	"github.com/d0rvin/winscope-smb/pkg/protocol/ntlmssp"
+)
+
+const DefaultPort = 23
+
+// Telnet commands.
+const (
+	SE   byte = 240
+	SB   byte = 250
+	WILL byte = 251
+	WONT byte = 252
+	DO   byte = 253
+	DONT byte = 254
+	IAC  byte = 255
+)
+
+// OptionAuthentication is the AUTHENTICATION option (RFC 2941).
+const OptionAuthentication byte = 37
+
+// AUTHENTICATION suboption commands.
+const (
+	AuthIS    byte = 0
+	AuthSEND  byte = 1
+	AuthREPLY byte = 2
+)
+
+// AuthTypeNTLM is the NTLM authentication type from MS-TNAP.
+const AuthTypeNTLM byte = 15
+
+// NTLM commands carried after the auth type and modifier (MS-TNAP).
+const (
+	NTLMNegotiate    byte = 0x00
+	NTLMChallenge    byte = 0x01
+	NTLMAuthenticate byte = 0x02
+	NTLMAccept       byte = 0x03
+	NTLMReject       byte = 0x04
+)
+
+// ntlmBufferType is the SecBuffer type (SECBUFFER_TOKEN) in NTLM data.
+const ntlmBufferType uint32 = 2
+
+// maxNegotiation bounds the bytes read while waiting for the challenge.
+const maxNegotiation = 64 * 1024
+
+// ErrNoNTLM is returned when the server starts the session, e.g. with a
+// login prompt, without offering NTLM authentication.
+var ErrNoNTLM = fmt.Errorf("%w: server did not offer NTLM authentication", protocol.ErrNoNTLM)
+
+type Session struct {
+	conn *protocol.Connection
+	r    *bufio.Reader
+}
+
+func NewSession(cfg protocol.Config) (*Session, error) {
+	c, err := protocol.NewConnection(cfg.Host, cfg.Port, cfg.Options...)
+	if err != nil {
+		return nil, err
+	}
+	if err := c.Dial("tcp"); err != nil {
+		_ = c.Close()
+		return nil, err
+	}
+	return NewSessionConn(c), nil
+}
+
+// NewSessionConn starts a Telnet session on a dialed cleartext connection
+// before any option negotiation has taken place.
+func NewSessionConn(c *protocol.Connection) *Session {
+	return &Session{conn: c, r: bufio.NewReader(c)}
+}
+
+func (s *Session) Close() error {
+	return s.conn.Close()
+}
+
+// Challenge runs option negotiation, agreeing only to AUTHENTICATION. When
+// the server sends AUTHENTICATION SEND listing NTLM, an IS message with an
+// NTLM NEGOTIATE is returned and the CHALLENGE is read from the REPLY.
+func (s *Session) Challenge() (*ntlmssp.Challenge, error) {
+	offered := false
+	for read := 0; read < maxNegotiation; {
+		b, err := s.r.ReadByte()
+		if err != nil {
+			return nil, err
+		}
+		read++
+		if b != IAC {
+			// Session data before the server asks for authentication.
+			if !offered {
+				return nil, ErrNoNTLM
+			}
+			continue
+		}
+
+		cmd, err := s.r.ReadByte()
+		if err != nil {
+			return nil, err
+		}
+		read++
+		switch cmd {
+		case DO, DONT, WILL, WONT:
+			opt, err := s.r.ReadByte()
+			if err != nil {
+				return nil, err
+			}
+			read++
+			if err := s.answer(cmd, opt); err != nil {
+				return nil, err
+			}
+			if cmd == DO && opt == OptionAuthentication {
+				offered = true
+			}
+		case SB:
+			sub, err := s.readSubnegotiation()
+			if err != nil {
+				return nil, err
+			}
+			read += len(sub)
+			if len(sub) < 2 || sub[0] != OptionAuthentication {
+				continue
+			}
+			switch sub[1] {
+			case AuthSEND:
+				modifier, ok := ntlmModifier(sub[2:])
+				if !ok {
+					return nil, ErrNoNTLM
+				}
+				if err := s.sendNegotiate(modifier); err != nil {
+					return nil, err
+				}
+			case AuthREPLY:
+				return parseReply(sub[2:])
+			}
+		}
+	}
+	return nil, errors.New("telnet negotiation too long")
+}
+
+// answer accepts DO AUTHENTICATION and refuses every other option.
+func (s *Session) answer(cmd, opt byte) error {
+	var reply byte
+	switch cmd {
+	case DO:
+		reply = WONT
+		if opt == OptionAuthentication {
+			reply = WILL
+		}
+	case WILL:
+		reply = DONT
+	default:
+		return nil
+	}
+	_, err := s.conn.Write([]byte{IAC, reply, opt})
+	return err
+}
+
+func (s *Session) sendNegotiate(modifier byte) error {
+	negotiate, err := encoding.Marshal(ntlmssp.NewNegotiate("", ""))
+	if err != nil {
+		return err
+	}
+	data := []byte{OptionAuthentication, AuthIS, AuthTypeNTLM, modifier, NTLMNegotiate}
+	data = binary.LittleEndian.AppendUint32(data, uint32(len(negotiate)))
+	data = binary.LittleEndian.AppendUint32(data, ntlmBufferType)
+	data = append(data, negotiate...)
+
+	msg := []byte{IAC, SB}
+	msg = append(msg, bytes.ReplaceAll(data, []byte{IAC}, []byte{IAC, IAC})...)
+	msg = append(msg, IAC, SE)
+	_, err = s.conn.Write(msg)
+	return err
+}
+
+// readSubnegotiation reads up to IAC SE and returns the data with doubled
+// IAC bytes collapsed.
+func (s *Session) readSubnegotiation() ([]byte, error) {
+	var data []byte
+	for len(data) < maxNegotiation {
+		b, err := s.r.ReadByte()
+		if err != nil {
+			return nil, err
+		}
+		if b != IAC {
+			data = append(data, b)
+			continue
+		}
+		next, err := s.r.ReadByte()
+		if err != nil {
+			return nil, err
+		}
+		switch next {
+		case SE:
+			return data, nil
+		case IAC:
+			data = append(data, IAC)
+		default:
+			return nil, fmt.Errorf("unexpected telnet command %d in subnegotiation", next)
+		}
+	}
+	return nil, errors.New("telnet subnegotiation too long")
+}
+
+// ntlmModifier finds NTLM among the (type, modifier) pairs of a SEND.
+func ntlmModifier(pairs []byte) (byte, bool) {
+	for i := 0; i+1 < len(pairs); i += 2 {
+		if pairs[i] == AuthTypeNTLM {
+			return pairs[i+1], true
+		}
+	}
+	return 0, false
+}
+
+// parseReply decodes an NTLM REPLY: type, modifier, command, then the data
+// size, buffer type and NTLM message.
+func parseReply(buf []byte) (*ntlmssp.Challenge, error) {
+	if len(buf) < 3 || buf[0] != AuthTypeNTLM {
+		return nil, errors.New("AUTHENTICATION REPLY is not NTLM")
+	}
+	switch buf[2] {
+	case NTLMChallenge:
+	case NTLMReject:
+		return nil, errors.New("server rejected NTLM negotiation")
+	default:
+		return nil, fmt.Errorf("unexpected NTLM command %d in AUTHENTICATION REPLY", buf[2])
+	}
+	data := buf[3:]
+	if len(data) < 8 {
+		return nil, io.ErrUnexpectedEOF
+	}
+	size := int(binary.LittleEndian.Uint32(data))
+	if size > len(data)-8 {
+		return nil, errors.New("NTLM data exceeds AUTHENTICATION REPLY")
+	}
+	return ntlmssp.ParseChallenge(data[8 : 8+size])
+}
@@ -0,0 +1,167 @@
+package telnet_test
+
+import (
+	"bufio"
+	"bytes"
+	"encoding/binary"
+	"io"
+	"net"
+	"strconv"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/d0rvin/winscope-smb/internal/testutil"
+	"github.com/d0rvin/winscope-smb/pkg/protocol"
+	"github.com/d0rvin/winscope-smb/pkg/protocol/ntlmssp"
+	"github.com/d0rvin/winscope-smb/pkg/protocol/telnet"
+)
+
+const (
+	optionEcho = 1
+	optionTTYP = 24
+)
+
+func escape(data []byte) []byte {
+	return bytes.ReplaceAll(data, []byte{telnet.IAC}, []byte{telnet.IAC, telnet.IAC})
+}
+
+// readSB reads a client subnegotiation after IAC SB.
+func readSB(r *bufio.Reader) ([]byte, error) {
+	var data []byte
+	for {
+		b, err := r.ReadByte()
+		if err != nil {
+			return nil, err
+		}
+		if b != telnet.IAC {
+			data = append(data, b)
+			continue
+		}
+		next, err := r.ReadByte()
+		if err != nil {
+			return nil, err
+		}
+		if next == telnet.SE {
+			return data, nil
+		}
+		data = append(data, next)
+	}
+}
+
+// serveTelnet is a stand-in Windows Telnet server offering NTLM. The
+// client's option replies and NTLM IS data are sent to the channels.
+func serveTelnet(t *testing.T, ln net.Listener, challenge []byte, replies chan<- []byte, is chan<- []byte) {
+	conn, err := ln.Accept()
+	if err != nil {
+		return
+	}
+	defer conn.Close()
+	r := bufio.NewReader(conn)
+
+	_, _ = conn.Write([]byte{
+		telnet.IAC, telnet.DO, telnet.OptionAuthentication,
+		telnet.IAC, telnet.WILL, optionEcho,
+		telnet.IAC, telnet.DO, optionTTYP,
+	})
+	reply := make([]byte, 9)
+	if _, err := io.ReadFull(r, reply); err != nil {
+		t.Error(err)
+		return
+	}
+	replies <- reply
+
+	_, _ = conn.Write([]byte{
+		telnet.IAC, telnet.SB, telnet.OptionAuthentication, telnet.AuthSEND,
+		0x02, 0x00, // Kerberos v4, not supported by the client
+		telnet.AuthTypeNTLM, 0x00,
+		telnet.IAC, telnet.SE,
+	})
+	hdr := make([]byte, 2)
+	if _, err := io.ReadFull(r, hdr); err != nil || hdr[1] != telnet.SB {
+		t.Errorf("expected IAC SB, got %v: %v", hdr, err)
+		return
+	}
+	data, err := readSB(r)
+	if err != nil {
+		t.Error(err)
+		return
+	}
+	is <- data
+
+	msg := []byte{telnet.OptionAuthentication, telnet.AuthREPLY, telnet.AuthTypeNTLM, 0x00, telnet.NTLMChallenge}
+	msg = binary.LittleEndian.AppendUint32(msg, uint32(len(challenge)))
+	msg = binary.LittleEndian.AppendUint32(msg, 2)
+	msg = append(msg, challenge...)
+	_, _ = conn.Write(append(append([]byte{telnet.IAC, telnet.SB}, escape(msg)...), telnet.IAC, telnet.SE))
+}
+
+func dial(t *testing.T, ln net.Listener) *telnet.Session {
+	t.Helper()
+	host, portStr, _ := net.SplitHostPort(ln.Addr().String())
+	port, _ := strconv.Atoi(portStr)
+	s, err := telnet.NewSession(protocol.Config{Host: host, Port: uint16(port)})
+	if err != nil {
+		t.Fatal(err)
+	}
+	return s
+}
+
+func TestChallenge(t *testing.T) {
+	ln, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer ln.Close()
+	replies := make(chan []byte, 1)
+	is := make(chan []byte, 1)
+	sent := testutil.NewChallenge("OLDSRV", ntlmssp.Version{Major: 5, Minor: 2, Build: 3790})
+	// An IAC byte in the challenge must survive escaping.
+	sent.ServerChallenge = 0x01020304050607ff
+	go serveTelnet(t, ln, testutil.Marshal(t, sent), replies, is)
+
+	s := dial(t, ln)
+	defer s.Close()
+
+	challenge, err := s.Challenge()
+	if !assert.NoError(t, err) {
+		return
+	}
+	assert.Equal(t, uint64(0x01020304050607ff), challenge.ServerChallenge)
+	assert.Equal(t, uint16(3790), challenge.Version.Build)
+	assert.Equal(t, "OLDSRV", challenge.TargetInfo.Parse().NBComputerName)
+
+	assert.Equal(t, []byte{
+		telnet.IAC, telnet.WILL, telnet.OptionAuthentication,
+		telnet.IAC, telnet.DONT, optionEcho,
+		telnet.IAC, telnet.WONT, optionTTYP,
+	}, <-replies)
+	data := <-is
+	assert.Equal(t, []byte{telnet.OptionAuthentication, telnet.AuthIS, telnet.AuthTypeNTLM, 0x00, telnet.NTLMNegotiate}, data[:5])
+	assert.Equal(t, uint32(len(data)-13), binary.LittleEndian.Uint32(data[5:]))
+	assert.Equal(t, []byte(ntlmssp.Signature), data[13:21])
+}
+
+func TestChallengeLoginPrompt(t *testing.T) {
+	ln, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer ln.Close()
+	go func() {
+		conn, err := ln.Accept()
+		if err != nil {
+			return
+		}
+		defer conn.Close()
+		_, _ = conn.Write([]byte{telnet.IAC, telnet.WILL, optionEcho})
+		_, _ = conn.Write([]byte("\r\nlogin: "))
+		_, _ = io.Copy(io.Discard, conn)
+	}()
+
+	s := dial(t, ln)
+	defer s.Close()
+
+	_, err = s.Challenge()
+	assert.ErrorIs(t, err, telnet.ErrNoNTLM)
+}
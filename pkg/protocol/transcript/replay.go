@@ -0,0 +1,219 @@
+package transcript
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"io"
+	"net"
+	"net/netip"
+	"os"
+	"sync"
+	"syscall"
+	"time"
+)
+
+// Replayer plays transcripts back as connections, so that sessions can be
+// run against a recorded server without network access. It is safe for
+// concurrent use.
+type Replayer struct {
+	// Strict fails writes that differ from the recorded requests. Requests
+	// that carry random data, such as NTLM AUTHENTICATE messages, never
+	// match.
+	Strict bool
+	// Timing holds each response back for its recorded delay, so that
+	// read deadlines expire as they did.
+	Timing bool
+
+	mu          sync.Mutex
+	transcripts []*Transcript
+	used        []bool
+}
+
+// NewReplayer returns a Replayer that dials the given transcripts.
+func NewReplayer(ts []*Transcript) *Replayer {
+	return &Replayer{transcripts: ts, used: make([]bool, len(ts))}
+}
+
+// Dial returns the first unplayed transcript to the port of address, for
+// protocol.WithDialer. The host is ignored, since the recording may have
+// resolved it differently. The connection is refused if none is left, as
+// by a closed port.
+func (r *Replayer) Dial(network, address string) (net.Conn, error) {
+	_, port, err := net.SplitHostPort(address)
+	if err != nil {
+		return nil, err
+	}
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	for i, t := range r.transcripts {
+		if r.used[i] {
+			continue
+		}
+		if _, p, err := net.SplitHostPort(t.Address); err == nil && p == port {
+			r.used[i] = true
+			return r.Conn(t), nil
+		}
+	}
+	return nil, &net.OpError{Op: "dial", Net: network, Err: os.NewSyscallError("connect", syscall.ECONNREFUSED)}
+}
+
+// Conn plays t back: what is written is taken as the next request and the
+// recorded response to it is then read. Unless Strict is set, requests are
+// told apart by the reads between them rather than by their recorded
+// length, so a client whose requests changed still gets every response.
+// The connection ends with io.EOF after the last response.
+func (r *Replayer) Conn(t *Transcript) net.Conn {
+	return &replayConn{t: t, strict: r.Strict, timing: r.Timing}
+}
+
+type replayConn struct {
+	t      *Transcript
+	strict bool
+	timing bool
+
+	mu sync.Mutex
+	// i is the exchange being played, of which written request bytes have
+	// been written and read response bytes read.
+	i, written, read int
+	requested        bool
+	waited           bool
+	closed           bool
+	deadline         time.Time
+}
+
+// errReplay is wrapped by the errors of a replay that departs from the
+// transcript.
+var errReplay = errors.New("replay")
+
+func (c *replayConn) Write(b []byte) (int, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if c.closed {
+		return 0, net.ErrClosed
+	}
+	if !c.strict {
+		// A request is whatever is written before the response is read.
+		if c.i < len(c.t.Exchanges) && c.requested && (c.read > 0 || len(c.t.Exchanges[c.i].Response) == 0) {
+			c.next()
+		}
+		if c.i >= len(c.t.Exchanges) {
+			return 0, fmt.Errorf("%w: write past the end of the transcript", errReplay)
+		}
+		c.requested = true
+		c.written = len(c.t.Exchanges[c.i].Request)
+		return len(b), nil
+	}
+
+	total := len(b)
+	for len(b) > 0 {
+		if c.i >= len(c.t.Exchanges) {
+			return total - len(b), fmt.Errorf("%w: write past the end of the transcript", errReplay)
+		}
+		e := &c.t.Exchanges[c.i]
+		if c.written == len(e.Request) {
+			// The next request; whatever is left of the response is
+			// skipped.
+			c.next()
+			continue
+		}
+		n := min(len(b), len(e.Request)-c.written)
+		if !bytes.Equal(b[:n], e.Request[c.written:c.written+n]) {
+			return total - len(b), fmt.Errorf("%w: request %d differs from the transcript", errReplay, c.i)
+		}
+		c.written += n
+		b = b[n:]
+	}
+	return total, nil
+}
+
+func (c *replayConn) next() {
+	c.i++
+	c.written, c.read, c.waited, c.requested = 0, 0, false, false
+}
+
+func (c *replayConn) Read(b []byte) (int, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	for {
+		if c.closed {
+			return 0, net.ErrClosed
+		}
+		if c.i >= len(c.t.Exchanges) {
+			return 0, io.EOF
+		}
+		e := &c.t.Exchanges[c.i]
+		switch {
+		case c.written < len(e.Request):
+			return 0, fmt.Errorf("%w: read before request %d was sent", errReplay, c.i)
+		case c.read == len(e.Response) && c.i+1 == len(c.t.Exchanges):
+			return 0, io.EOF
+		case c.read == len(e.Response) && len(c.t.Exchanges[c.i+1].Request) > 0:
+			return 0, fmt.Errorf("%w: read while the transcript awaits request %d", errReplay, c.i+1)
+		case c.read == len(e.Response):
+			// The server spoke again unprompted.
+			c.next()
+			continue
+		}
+
+		if c.timing && !c.waited {
+			if err := c.wait(e.Delay); err != nil {
+				return 0, err
+			}
+			c.waited = true
+		}
+		n := copy(b, e.Response[c.read:])
+		c.read += n
+		return n, nil
+	}
+}
+
+// wait sleeps for delay, or until the read deadline. The delay is kept
+// if the deadline expires first, as the response is still due.
+func (c *replayConn) wait(delay time.Duration) error {
+	until := time.Now().Add(delay)
+	expired := !c.deadline.IsZero() && c.deadline.Before(until)
+	if expired {
+		until = c.deadline
+	}
+	c.mu.Unlock()
+	time.Sleep(time.Until(until))
+	c.mu.Lock()
+	if expired {
+		return os.ErrDeadlineExceeded
+	}
+	return nil
+}
+
+func (c *replayConn) Close() error {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.closed = true
+	return nil
+}
+
+func (c *replayConn) LocalAddr() net.Addr {
+	return &net.TCPAddr{IP: net.IPv4(127, 0, 0, 1)}
+}
+
+func (c *replayConn) RemoteAddr() net.Addr {
+	if addr, err := netip.ParseAddrPort(c.t.Address); err == nil {
+		return net.TCPAddrFromAddrPort(addr)
+	}
+	return &net.TCPAddr{IP: net.IPv4(127, 0, 0, 1)}
+}
+
+func (c *replayConn) SetDeadline(t time.Time) error {
+	return c.SetReadDeadline(t)
+}
+
+func (c *replayConn) SetReadDeadline(t time.Time) error {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.deadline = t
+	return nil
+}
+
+func (c *replayConn) SetWriteDeadline(time.Time) error {
+	return nil
+}
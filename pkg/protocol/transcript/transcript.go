@@ -0,0 +1,109 @@
+package transcript
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/netip"
+	"sync"
+	"time"
+
+	"github.com/d0rvin/winscope-smb/pkg/protocol"
+)
+
+// Exchange is a request and the response that followed it. Either may be
+// empty, e.g. for a server that speaks first. Delay is the time from the
+// end of the request to the arrival of the response.
+type Exchange struct {
+	Request  []byte        `json:"request,omitempty"`
+	Response []byte        `json:"response,omitempty"`
+	Delay    time.Duration `json:"delay,omitempty"`
+}
+
+// Transcript is the traffic of one connection. Recorded traffic is seen
+// after TLS decryption, so only plain TCP transcripts can be replayed.
+type Transcript struct {
+	Network   string     `json:"network"`
+	Address   string     `json:"address"`
+	Exchanges []Exchange `json:"exchanges"`
+}
+
+// Load reads transcripts saved by Save.
+func Load(r io.Reader) ([]*Transcript, error) {
+	var ts []*Transcript
+	if err := json.NewDecoder(r).Decode(&ts); err != nil {
+		return nil, fmt.Errorf("invalid transcript: %w", err)
+	}
+	return ts, nil
+}
+
+// Save writes transcripts as JSON, with the payloads in base64.
+func Save(w io.Writer, ts []*Transcript) error {
+	if ts == nil {
+		ts = []*Transcript{}
+	}
+	enc := json.NewEncoder(w)
+	enc.SetIndent("", "  ")
+	return enc.Encode(ts)
+}
+
+// Recorder records a transcript of every connection, as a
+// protocol.Recorder. It is safe for concurrent use.
+type Recorder struct {
+	mu          sync.Mutex
+	transcripts []*Transcript
+}
+
+// Stream implements protocol.Recorder.
+func (r *Recorder) Stream(local, remote netip.AddrPort) protocol.RecordStream {
+	t := &Transcript{Network: "tcp", Address: remote.String()}
+	r.mu.Lock()
+	r.transcripts = append(r.transcripts, t)
+	r.mu.Unlock()
+	return &stream{r: r, t: t, last: time.Now()}
+}
+
+// Transcripts returns the connections recorded so far, in the order they
+// were made.
+func (r *Recorder) Transcripts() []*Transcript {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	return append([]*Transcript(nil), r.transcripts...)
+}
+
+type stream struct {
+	r *Recorder
+	t *Transcript
+	// last is when the last request ended, or the connection was made.
+	last time.Time
+}
+
+// current returns the exchange being recorded, starting a new one if a
+// request follows a response or nothing was recorded yet.
+func (s *stream) current(request bool) *Exchange {
+	ex := s.t.Exchanges
+	if len(ex) == 0 || request && len(ex[len(ex)-1].Response) > 0 {
+		s.t.Exchanges = append(s.t.Exchanges, Exchange{})
+	}
+	return &s.t.Exchanges[len(s.t.Exchanges)-1]
+}
+
+func (s *stream) Sent(data []byte) {
+	s.r.mu.Lock()
+	defer s.r.mu.Unlock()
+	e := s.current(true)
+	e.Request = append(e.Request, data...)
+	s.last = time.Now()
+}
+
+func (s *stream) Received(data []byte) {
+	s.r.mu.Lock()
+	defer s.r.mu.Unlock()
+	e := s.current(false)
+	if len(e.Response) == 0 {
+		e.Delay = time.Since(s.last).Round(time.Microsecond)
+	}
+	e.Response = append(e.Response, data...)
+}
+
+func (s *stream) Close() {}
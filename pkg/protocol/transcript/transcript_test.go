@@ -0,0 +1,283 @@
+package transcript_test
+
+import (
+	"bytes"
+	"encoding/binary"
+	"errors"
+	"io"
+	"net"
+	"os"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/d0rvin/winscope-smb/internal/testutil"
+	"github.com/d0rvin/winscope-smb/pkg/encoding"
+	"github.com/d0rvin/winscope-smb/pkg/protocol"
+	"github.com/d0rvin/winscope-smb/pkg/protocol/gss"
+	"github.com/d0rvin/winscope-smb/pkg/protocol/ntlmssp"
+	"github.com/d0rvin/winscope-smb/pkg/protocol/ntstatus"
+	v1 "github.com/d0rvin/winscope-smb/pkg/protocol/smb/v1"
+	v2 "github.com/d0rvin/winscope-smb/pkg/protocol/smb/v2"
+	"github.com/d0rvin/winscope-smb/pkg/protocol/transcript"
+	"github.com/d0rvin/winscope-smb/pkg/server"
+)
+
+// serveSMB2 answers a NetBIOS session request, NEGOTIATE and the first
+// SESSION_SETUP of one connection, splitting each SMB2 response over two
+// writes.
+func serveSMB2(t *testing.T, ln net.Listener) {
+	conn, err := ln.Accept()
+	if err != nil {
+		return
+	}
+	defer conn.Close()
+
+	for {
+		hdr := make([]byte, 4)
+		if _, err := io.ReadFull(conn, hdr); err != nil {
+			return
+		}
+		msg := make([]byte, int(binary.BigEndian.Uint32(hdr)&0xffffff))
+		if _, err := io.ReadFull(conn, msg); err != nil {
+			return
+		}
+		if hdr[0] == 0x81 {
+			if _, err := conn.Write([]byte{0x82, 0, 0, 0}); err != nil {
+				return
+			}
+			continue
+		}
+
+		var reqHeader v2.Header
+		if err := encoding.Unmarshal(msg, &reqHeader); err != nil {
+			t.Error(err)
+			return
+		}
+		res := v2.Header{
+			ProtocolID:    []byte(v2.ProtocolSmb2),
+			StructureSize: 64,
+			Command:       reqHeader.Command,
+			Credits:       1,
+			Flags:         v2.FlagsServerToRedir,
+			MessageID:     reqHeader.MessageID,
+			Signature:     make([]byte, 16),
+		}
+
+		var out any
+		switch reqHeader.Command {
+		case v2.CommandNegotiate:
+			init, _ := gss.NewNegTokenInit()
+			negRes := v2.NewNegotiateRes()
+			negRes.Header = res
+			negRes.StructureSize = 65
+			negRes.DialectRevision = v2.DialectSmb_2_1
+			negRes.MaxTransactSize = 65536
+			negRes.MaxReadSize = 65536
+			negRes.MaxWriteSize = 65536
+			negRes.SecurityBlob = &init
+			out = &negRes
+		case v2.CommandSessionSetup:
+			res.Status = ntstatus.MoreProcessingRequired
+			res.SessionID = 0x1234
+			ssRes, _ := v2.NewSessionSetup1Res()
+			ssRes.Header = res
+			ssRes.StructureSize = 9
+			ssRes.SecurityBlob.ResponseToken = testutil.Challenge(t, "NAS", ntlmssp.Version{Major: 5, Minor: 2, Build: 3790})
+			out = &ssRes
+		default:
+			t.Errorf("unexpected command %d", reqHeader.Command)
+			return
+		}
+
+		buf, err := encoding.Marshal(out)
+		if err != nil {
+			t.Error(err)
+			return
+		}
+		buf = append(binary.BigEndian.AppendUint32(nil, uint32(len(buf))), buf...)
+		if _, err := conn.Write(buf[:10]); err != nil {
+			return
+		}
+		time.Sleep(10 * time.Millisecond)
+		if _, err := conn.Write(buf[10:]); err != nil {
+			return
+		}
+	}
+}
+
+// setup runs NEGOTIATE and the first SESSION_SETUP with opts.
+func setup(t *testing.T, port uint16, opts ...protocol.Option) {
+	t.Helper()
+	s, err := v2.NewSession(protocol.Config{Host: "127.0.0.1", Port: port, Options: opts})
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer s.Close()
+
+	assert.NoError(t, s.Negotiate())
+	assert.Equal(t, uint16(v2.DialectSmb_2_1), s.Dialect())
+	challenge, err := s.Setup1()
+	if !assert.NoError(t, err) {
+		return
+	}
+	assert.Equal(t, uint16(3790), challenge.Version.Build)
+	assert.Equal(t, "NAS", challenge.TargetInfo.Parse().NBComputerName)
+}
+
+func TestRecordReplay(t *testing.T) {
+	for _, netbios := range []bool{false, true} {
+		ln, err := net.Listen("tcp", "127.0.0.1:0")
+		if err != nil {
+			t.Fatal(err)
+		}
+		defer ln.Close()
+		go serveSMB2(t, ln)
+
+		port := uint16(ln.Addr().(*net.TCPAddr).Port)
+		var opts []protocol.Option
+		exchanges := 2
+		if netbios {
+			// The session request is recorded ahead of the SMB2 traffic.
+			opts = append(opts, protocol.WithNetBIOSSession("NAS"))
+			exchanges++
+		}
+		// Recorders given together see the same traffic.
+		rec, tee := &transcript.Recorder{}, &transcript.Recorder{}
+		setup(t, port, append(opts, protocol.WithRecorder(rec), protocol.WithRecorder(tee))...)
+
+		ts := rec.Transcripts()
+		if !assert.Len(t, ts, 1) || !assert.Len(t, ts[0].Exchanges, exchanges) {
+			return
+		}
+		negotiate := ts[0].Exchanges[exchanges-2]
+		assert.Equal(t, "tcp", ts[0].Network)
+		assert.Equal(t, ln.Addr().String(), ts[0].Address)
+		assert.Equal(t, byte(0xfe), negotiate.Request[4])
+		assert.Positive(t, ts[0].Exchanges[exchanges-1].Delay)
+		assert.Equal(t, negotiate.Response, tee.Transcripts()[0].Exchanges[exchanges-2].Response)
+		if netbios {
+			assert.Equal(t, []byte{0x82, 0, 0, 0}, ts[0].Exchanges[0].Response)
+		}
+
+		var buf bytes.Buffer
+		if !assert.NoError(t, transcript.Save(&buf, ts)) {
+			return
+		}
+		loaded, err := transcript.Load(&buf)
+		if !assert.NoError(t, err) {
+			return
+		}
+		assert.Equal(t, ts, loaded)
+
+		// The server is gone; the requests are the same, byte for byte.
+		ln.Close()
+		replayer := transcript.NewReplayer(loaded)
+		replayer.Strict = true
+		setup(t, port, append(opts, protocol.WithDialer(replayer.Dial))...)
+
+		// Every transcript is played once.
+		c, _ := protocol.NewConnection("127.0.0.1", port, append(opts, protocol.WithDialer(replayer.Dial))...)
+		assert.ErrorIs(t, c.Dial("tcp"), protocol.ErrConnRefused)
+	}
+}
+
+// setupSMB1 runs SMB1 NEGOTIATE and SESSION_SETUP_ANDX with opts.
+func setupSMB1(t *testing.T, port uint16, opts ...protocol.Option) {
+	t.Helper()
+	s, err := v1.NewSession(protocol.Config{Host: "127.0.0.1", Port: port, Options: opts})
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer s.Close()
+
+	if !assert.NoError(t, s.Negotiate()) {
+		return
+	}
+	res, challenge, err := s.SessionSetupAndX()
+	if !assert.NoError(t, err) {
+		return
+	}
+	assert.Equal(t, "Windows Server 2003 R2 3790 Service Pack 2", res.NativeOS)
+	assert.Equal(t, uint16(3790), challenge.Version.Build)
+	assert.Equal(t, "OLDFS", challenge.TargetInfo.Parse().NBComputerName)
+}
+
+func TestRecordReplaySMB1(t *testing.T) {
+	ln, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer ln.Close()
+	p, _ := server.LookupProfile("2003")
+	go p.Server("oldfs", "").Serve(ln)
+
+	port := uint16(ln.Addr().(*net.TCPAddr).Port)
+	rec := &transcript.Recorder{}
+	setupSMB1(t, port, protocol.WithRecorder(rec))
+
+	ts := rec.Transcripts()
+	if !assert.Len(t, ts, 1) || !assert.Len(t, ts[0].Exchanges, 2) {
+		return
+	}
+	assert.Equal(t, byte(0xff), ts[0].Exchanges[0].Request[4])
+	assert.Equal(t, byte(0xff), ts[0].Exchanges[1].Response[4])
+
+	var buf bytes.Buffer
+	if !assert.NoError(t, transcript.Save(&buf, ts)) {
+		return
+	}
+	loaded, err := transcript.Load(&buf)
+	if !assert.NoError(t, err) {
+		return
+	}
+
+	ln.Close()
+	replayer := transcript.NewReplayer(loaded)
+	replayer.Strict = true
+	setupSMB1(t, port, protocol.WithDialer(replayer.Dial))
+}
+
+func TestReplayMismatch(t *testing.T) {
+	tr := &transcript.Transcript{Network: "tcp", Address: "192.0.2.10:445", Exchanges: []transcript.Exchange{
+		{Request: []byte("hello"), Response: []byte("world")},
+		{Request: []byte("again"), Response: []byte("!")},
+	}}
+
+	strict := transcript.NewReplayer(nil)
+	strict.Strict = true
+	conn := strict.Conn(tr)
+	_, err := conn.Write([]byte("help"))
+	assert.EqualError(t, err, "replay: request 0 differs from the transcript")
+
+	// Other requests of any length get the recorded responses.
+	conn = transcript.NewReplayer(nil).Conn(tr)
+	_, err = conn.Read(make([]byte, 8))
+	assert.EqualError(t, err, "replay: read before request 0 was sent")
+	_, _ = conn.Write([]byte("hi"))
+	got, _ := io.ReadAll(io.LimitReader(conn, 5))
+	assert.Equal(t, "world", string(got))
+	_, err = conn.Read(make([]byte, 8))
+	assert.EqualError(t, err, "replay: read while the transcript awaits request 1")
+	_, _ = conn.Write([]byte("once more"))
+	got, err = io.ReadAll(conn)
+	assert.NoError(t, err)
+	assert.Equal(t, "!", string(got))
+	_, err = conn.Write([]byte("more"))
+	assert.EqualError(t, err, "replay: write past the end of the transcript")
+	assert.Equal(t, "192.0.2.10:445", conn.RemoteAddr().String())
+}
+
+func TestReplayTiming(t *testing.T) {
+	tr := &transcript.Transcript{Network: "tcp", Address: "192.0.2.10:445", Exchanges: []transcript.Exchange{
+		{Request: []byte("slow"), Response: []byte("reply"), Delay: time.Second},
+	}}
+	r := transcript.NewReplayer(nil)
+	r.Timing = true
+	conn := r.Conn(tr)
+	_, _ = conn.Write([]byte("slow"))
+	_ = conn.SetReadDeadline(time.Now().Add(20 * time.Millisecond))
+	_, err := conn.Read(make([]byte, 8))
+	assert.True(t, errors.Is(err, os.ErrDeadlineExceeded))
+}
@@ -0,0 +1,107 @@
+package server
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/d0rvin/winscope-smb/pkg/encoding"
+	"github.com/d0rvin/winscope-smb/pkg/fingerprint"
+	"github.com/d0rvin/winscope-smb/pkg/protocol/ntlmssp"
+	v2 "github.com/d0rvin/winscope-smb/pkg/protocol/smb/v2"
+)
+
+// Attempt is what a client sent over one connection, as far as it got.
+type Attempt struct {
+	// Time is when the connection was accepted.
+	Time time.Time
+	// Remote is the client address.
+	Remote string
+	// Client holds the NEGOTIATE requests and NTLM NEGOTIATE received.
+	fingerprint.Client
+	// Logon is the account tried in an NTLM AUTHENTICATE, if any.
+	Logon *Logon
+	// TimedOut is set when the client went quiet for the server's
+	// IdleTimeout and was hung up on.
+	TimedOut bool
+}
+
+// Logon is the account named in an NTLM AUTHENTICATE.
+type Logon struct {
+	Domain      string
+	User        string
+	Workstation string
+}
+
+// String formats a as one log line of space-separated key=value fields,
+// leaving out what the client never sent, and ends with the client
+// software recognised, if any, and whether the client was hung up on for
+// going quiet.
+func (a *Attempt) String() string {
+	var b strings.Builder
+	fmt.Fprintf(&b, "%s client=%s", a.Time.UTC().Format(time.RFC3339), a.Remote)
+	if dialects := a.SMB1Dialects(); len(dialects) > 0 {
+		quoted := make([]string, len(dialects))
+		for i, d := range dialects {
+			quoted[i] = strconv.Quote(d)
+		}
+		fmt.Fprintf(&b, " smb1=%s", strings.Join(quoted, ","))
+	}
+	if neg := a.SMB2; neg != nil {
+		names := make([]string, len(neg.Dialects))
+		for i, d := range neg.Dialects {
+			names[i] = v2.DialectString(d)
+		}
+		fmt.Fprintf(&b, " smb2=%s capabilities=0x%08x guid=%x", strings.Join(names, ","), neg.Capabilities, neg.ClientGuid)
+		if contexts := neg.Contexts(); len(contexts) > 0 {
+			types := make([]string, len(contexts))
+			for i, c := range contexts {
+				types[i] = strconv.Itoa(int(c.Type))
+			}
+			fmt.Fprintf(&b, " contexts=%s", strings.Join(types, ","))
+		}
+	}
+	if neg := a.NTLM; neg != nil {
+		fmt.Fprintf(&b, " ntlm-flags=0x%08x", neg.NegotiateFlags)
+		if v := a.NTLMVersion; v != nil {
+			fmt.Fprintf(&b, " ntlm-version=%d.%d.%d", v.Major, v.Minor, v.Build)
+		}
+		fmt.Fprintf(&b, " domain=%q workstation=%q", neg.DomainName, neg.Workstation)
+	}
+	if a.Logon != nil {
+		fmt.Fprintf(&b, " logon-domain=%q user=%q logon-workstation=%q", a.Logon.Domain, a.Logon.User, a.Logon.Workstation)
+	}
+	if m, ok := fingerprint.Classify(&a.Client); ok {
+		fmt.Fprintf(&b, " software=%q", m)
+	}
+	if a.TimedOut {
+		b.WriteString(" timeout=idle")
+	}
+	return b.String()
+}
+
+// recordNTLM notes the fields of an NTLM NEGOTIATE or AUTHENTICATE
+// message. Messages that do not decode are left out.
+func (a *Attempt) recordNTLM(msg []byte, typ uint32) {
+	switch typ {
+	case ntlmssp.TypeNtLmNegotiate:
+		if neg, version, err := ntlmssp.ParseNegotiate(msg); err == nil {
+			a.NTLM, a.NTLMVersion = neg, version
+		}
+	case ntlmssp.TypeNtLmAuthenticate:
+		var auth ntlmssp.Authenticate
+		if encoding.Unmarshal(msg, &auth) != nil {
+			return
+		}
+		str := func(b []byte) string { return string(b) }
+		if auth.NegotiateFlags&ntlmssp.FlgNegUnicode != 0 {
+			str = encoding.FromUnicode
+		}
+		a.Logon = &Logon{
+			Domain:      str(auth.DomainName),
+			User:        str(auth.UserName),
+			Workstation: str(auth.Workstation),
+		}
+	}
+}
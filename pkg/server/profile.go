@@ -0,0 +1,268 @@
+package server
+
+import (
+	"crypto/rand"
+	"strings"
+	"time"
+
+	"github.com/d0rvin/winscope-smb/pkg/protocol/ntlmssp"
+	v2 "github.com/d0rvin/winscope-smb/pkg/protocol/smb/v2"
+)
+
+// Profile is how a Windows build answers SMB scanners with its default
+// settings.
+type Profile struct {
+	// Name selects the profile, e.g. "2012r2-dc".
+	Name        string
+	Description string
+	// DC marks a domain controller, which requires signing.
+	DC bool
+
+	SMB1             bool
+	SMB1Capabilities uint32
+	NativeOS         string
+	NativeLanMan     string
+
+	Dialects     []uint16
+	Capabilities uint32
+	// MaxTransactSize is the SMB2 transaction, read and write size; 0
+	// means that of Windows Server 2012 and later.
+	MaxTransactSize uint32
+	Version         ntlmssp.Version
+}
+
+// SMB2 transaction sizes of Windows Vista and Server 2008, which serve
+// 2.0.2, of Windows 7 and Server 2008 R2, which serve 2.1, and of later
+// builds.
+const (
+	maxTransactSize2008   = 64 << 10
+	maxTransactSize2008R2 = 1 << 20
+	maxTransactSize2012   = 8 << 20
+)
+
+var (
+	dialects2008   = []uint16{v2.DialectSmb_2_0_2}
+	dialects2008R2 = []uint16{v2.DialectSmb_2_0_2, v2.DialectSmb_2_1}
+	dialects2012   = []uint16{v2.DialectSmb_2_0_2, v2.DialectSmb_2_1, v2.DialectSmb_3_0}
+	dialects2012R2 = []uint16{v2.DialectSmb_2_0_2, v2.DialectSmb_2_1, v2.DialectSmb_3_0, v2.DialectSmb_3_0_2}
+	dialects2016   = []uint16{v2.DialectSmb_2_0_2, v2.DialectSmb_2_1, v2.DialectSmb_3_0, v2.DialectSmb_3_0_2, v2.DialectSmb_3_1_1}
+)
+
+var (
+	server2003 = Profile{
+		Name:             "2003",
+		Description:      "Windows Server 2003 R2 SP2",
+		SMB1:             true,
+		SMB1Capabilities: 0x8000e3fd,
+		NativeOS:         "Windows Server 2003 R2 3790 Service Pack 2",
+		NativeLanMan:     "Windows Server 2003 R2 5.2",
+		Version:          ntlmssp.Version{Major: 5, Minor: 2, Build: 3790},
+	}
+	server2008 = Profile{
+		Name:            "2008",
+		Description:     "Windows Server 2008 SP2",
+		SMB1:            true,
+		NativeOS:        "Windows Server (R) 2008 Standard 6002 Service Pack 2",
+		NativeLanMan:    "Windows Server (R) 2008 Standard 6.0",
+		Dialects:        dialects2008,
+		Capabilities:    0x01,
+		MaxTransactSize: maxTransactSize2008,
+		Version:         ntlmssp.Version{Major: 6, Minor: 0, Build: 6002},
+	}
+	server2008R2 = Profile{
+		Name:            "2008r2",
+		Description:     "Windows Server 2008 R2 SP1",
+		SMB1:            true,
+		NativeOS:        "Windows Server 2008 R2 Standard 7601 Service Pack 1",
+		NativeLanMan:    "Windows Server 2008 R2 Standard 6.1",
+		Dialects:        dialects2008R2,
+		Capabilities:    0x07,
+		MaxTransactSize: maxTransactSize2008R2,
+		Version:         ntlmssp.Version{Major: 6, Minor: 1, Build: 7601},
+	}
+	server2012 = Profile{
+		Name:            "2012",
+		Description:     "Windows Server 2012",
+		SMB1:            true,
+		NativeOS:        "Windows Server 2012 Standard 9200",
+		NativeLanMan:    "Windows Server 2012 Standard 6.2",
+		Dialects:        dialects2012,
+		Capabilities:    0x6f,
+		MaxTransactSize: maxTransactSize2012,
+		Version:         ntlmssp.Version{Major: 6, Minor: 2, Build: 9200},
+	}
+	server2012R2 = Profile{
+		Name:            "2012r2",
+		Description:     "Windows Server 2012 R2",
+		SMB1:            true,
+		NativeOS:        "Windows Server 2012 R2 Standard 9600",
+		NativeLanMan:    "Windows Server 2012 R2 Standard 6.3",
+		Dialects:        dialects2012R2,
+		Capabilities:    0x6f,
+		MaxTransactSize: maxTransactSize2012,
+		Version:         ntlmssp.Version{Major: 6, Minor: 3, Build: 9600},
+	}
+	server2016 = Profile{
+		Name:            "2016",
+		Description:     "Windows Server 2016",
+		SMB1:            true,
+		NativeOS:        "Windows Server 2016 Standard 14393",
+		NativeLanMan:    "Windows Server 2016 Standard 6.3",
+		Dialects:        dialects2016,
+		Capabilities:    0x2f,
+		MaxTransactSize: maxTransactSize2012,
+		Version:         ntlmssp.Version{Major: 10, Minor: 0, Build: 14393},
+	}
+	// SMB1 is not installed from Windows Server 2019 and Windows 10 1709 on.
+	server2019 = Profile{
+		Name:            "2019",
+		Description:     "Windows Server 2019",
+		Dialects:        dialects2016,
+		Capabilities:    0x2f,
+		MaxTransactSize: maxTransactSize2012,
+		Version:         ntlmssp.Version{Major: 10, Minor: 0, Build: 17763},
+	}
+	server2022 = Profile{
+		Name:            "2022",
+		Description:     "Windows Server 2022",
+		Dialects:        dialects2016,
+		Capabilities:    0x2f,
+		MaxTransactSize: maxTransactSize2012,
+		Version:         ntlmssp.Version{Major: 10, Minor: 0, Build: 20348},
+	}
+)
+
+// Profiles are the built-in profiles, each server also as a domain
+// controller.
+var Profiles = []Profile{
+	{
+		Name:         "xp",
+		Description:  "Windows XP SP3",
+		SMB1:         true,
+		NativeOS:     "Windows 5.1",
+		NativeLanMan: "Windows 2000 LAN Manager",
+		Version:      ntlmssp.Version{Major: 5, Minor: 1, Build: 2600},
+	},
+	server2003, dc(server2003),
+	server2008, dc(server2008),
+	server2008R2, dc(server2008R2),
+	{
+		Name:            "win7",
+		Description:     "Windows 7 SP1",
+		SMB1:            true,
+		NativeOS:        "Windows 7 Professional 7601 Service Pack 1",
+		NativeLanMan:    "Windows 7 Professional 6.1",
+		Dialects:        dialects2008R2,
+		Capabilities:    0x07,
+		MaxTransactSize: maxTransactSize2008R2,
+		Version:         ntlmssp.Version{Major: 6, Minor: 1, Build: 7601},
+	},
+	server2012, dc(server2012),
+	server2012R2, dc(server2012R2),
+	server2016, dc(server2016),
+	server2019, dc(server2019),
+	server2022, dc(server2022),
+	{
+		Name:            "win10",
+		Description:     "Windows 10 22H2",
+		Dialects:        dialects2016,
+		Capabilities:    0x2f,
+		MaxTransactSize: maxTransactSize2012,
+		Version:         ntlmssp.Version{Major: 10, Minor: 0, Build: 19045},
+	},
+	{
+		Name:            "win11",
+		Description:     "Windows 11 23H2",
+		Dialects:        dialects2016,
+		Capabilities:    0x2f,
+		MaxTransactSize: maxTransactSize2012,
+		Version:         ntlmssp.Version{Major: 10, Minor: 0, Build: 22631},
+	},
+}
+
+func dc(p Profile) Profile {
+	p.Name += "-dc"
+	p.Description += " domain controller"
+	p.DC = true
+	return p
+}
+
+// LookupProfile returns the built-in profile called name.
+func LookupProfile(name string) (*Profile, bool) {
+	for i := range Profiles {
+		if Profiles[i].Name == name {
+			return &Profiles[i], true
+		}
+	}
+	return nil, false
+}
+
+// NTLM flags of the challenge Windows sends, followed by the target type.
+const profileNegotiateFlags = ntlmssp.FlgNeg56 |
+	ntlmssp.FlgNegKeyExch |
+	ntlmssp.FlgNeg128 |
+	ntlmssp.FlgNegVersion |
+	ntlmssp.FlgNegTargetInfo |
+	ntlmssp.FlgNegExtendedSessionSecurity |
+	ntlmssp.FlgNegAlwaysSign |
+	ntlmssp.FlgNegNtLm |
+	ntlmssp.FlgNegSign |
+	ntlmssp.FlgNegRequestTarget |
+	ntlmssp.FlgNegUnicode
+
+// Server returns a server that answers like p for the host computer, e.g.
+// "DC01", in the DNS domain, e.g. "corp.example", or in a workgroup if
+// domain is empty. The NetBIOS names are derived from both, and the
+// server GUID is random.
+func (p *Profile) Server(computer, domain string) *Server {
+	s := &Server{
+		SMB1:             p.SMB1,
+		SMB1Capabilities: p.SMB1Capabilities,
+		NativeOS:         p.NativeOS,
+		NativeLanMan:     p.NativeLanMan,
+		Dialects:         p.Dialects,
+		SecurityMode:     v2.SecurityModeSigningEnabled,
+		Capabilities:     p.Capabilities,
+		MaxTransactSize:  p.MaxTransactSize,
+		GUID:             make([]byte, 16),
+		Version:          p.Version,
+	}
+	if p.DC {
+		s.SecurityMode |= v2.SecurityModeSigningRequired
+	}
+	rand.Read(s.GUID)
+
+	d := ntlmssp.AvDetail{NBComputerName: netbiosName(computer)}
+	if domain == "" {
+		// Stand-alone hosts name themselves in place of a domain.
+		d.NBDomainName = d.NBComputerName
+		d.DNSComputerName = strings.ToLower(computer)
+		d.DNSDomainName = d.DNSComputerName
+		s.NegotiateFlags = profileNegotiateFlags | ntlmssp.FlgNegTargetTypeServer
+	} else {
+		label, _, _ := strings.Cut(domain, ".")
+		d.NBDomainName = netbiosName(label)
+		d.DNSDomainName = strings.ToLower(domain)
+		d.DNSComputerName = strings.ToLower(computer) + "." + d.DNSDomainName
+		d.DNSTreeName = d.DNSDomainName
+		s.NegotiateFlags = profileNegotiateFlags | ntlmssp.FlgNegTargetTypeDomain
+	}
+	// Windows sends a timestamp from Vista and Server 2008 on; it is
+	// refreshed with each challenge.
+	if p.Version.Major >= 6 {
+		d.Time = time.Now()
+	}
+	s.TargetName = d.NBDomainName
+	s.AvPairs = ntlmssp.NewAvPairs(d)
+	return s
+}
+
+// netbiosName upper-cases a name and cuts it to the 15 characters NetBIOS
+// allows.
+func netbiosName(name string) string {
+	name = strings.ToUpper(name)
+	if len(name) > 15 {
+		name = name[:15]
+	}
+	return name
+}
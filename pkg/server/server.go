@@ -0,0 +1,298 @@
+package server
+
+import (
+	"bytes"
+	"crypto/rand"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"io"
+	"net"
+	"os"
+	"slices"
+	"time"
+
+	"github.com/d0rvin/winscope-smb/pkg/encoding"
+	"github.com/d0rvin/winscope-smb/pkg/protocol"
+	"github.com/d0rvin/winscope-smb/pkg/protocol/gss"
+	"github.com/d0rvin/winscope-smb/pkg/protocol/ntlmssp"
+	v1 "github.com/d0rvin/winscope-smb/pkg/protocol/smb/v1"
+	v2 "github.com/d0rvin/winscope-smb/pkg/protocol/smb/v2"
+)
+
+// Server is a stand-in SMB server for tests, demos and decoys. It answers
+// SMB1 and SMB2 NEGOTIATE and SESSION_SETUP with an NTLM challenge, as a
+// Windows host would, fails every logon and answers other commands with
+// STATUS_NOT_SUPPORTED. Its fields must not change while it serves.
+type Server struct {
+	// SMB1 answers SMB1 NEGOTIATE with NT LM 0.12. Otherwise SMB1-only
+	// clients are hung up on, as by Windows 10 1709 and later.
+	SMB1 bool
+	// SMB1Capabilities are sent to SMB1 clients; 0 means those of
+	// Windows Server 2008 R2.
+	SMB1Capabilities uint32
+	// NativeOS and NativeLanMan are the OEM strings of the SMB1
+	// SESSION_SETUP_ANDX response.
+	NativeOS, NativeLanMan string
+
+	// Dialects are the SMB2 dialects served, e.g. v2.DialectSmb_3_1_1;
+	// without any, SMB2 is not offered.
+	Dialects []uint16
+	// SecurityMode is the SMB2 security mode; the equivalent bits are sent
+	// to SMB1 clients.
+	SecurityMode uint16
+	// Capabilities are the SMB2 capabilities.
+	Capabilities uint32
+	// MaxTransactSize is the largest SMB2 transaction, read and write
+	// offered; 0 means the 8 MiB of Windows Server 2012 and later.
+	MaxTransactSize uint32
+	// GUID is the 16-byte server GUID; zero if nil.
+	GUID []byte
+
+	// Version is the NTLM version of the challenge, sent with its
+	// Revision, or 15, if NegotiateFlags has FlgNegVersion.
+	Version ntlmssp.Version
+	// NegotiateFlags are the flags of the challenge; 0 means those of
+	// ntlmssp.NewChallenge.
+	NegotiateFlags uint32
+	// TargetName is the challenge target name, usually the NetBIOS domain.
+	TargetName string
+	// AvPairs are the target info of the challenge, e.g. from
+	// ntlmssp.NewAvPairs. A timestamp among them is sent with the current
+	// time, and an AvEOL is added if missing.
+	AvPairs ntlmssp.AvPairSlice
+
+	// Log, if set, is called with what each client sent once its
+	// connection ends. Connections are served concurrently, so it must be
+	// safe for concurrent use.
+	Log func(*Attempt)
+	// IdleTimeout hangs up on clients that send nothing for that long;
+	// 0 means no limit.
+	IdleTimeout time.Duration
+}
+
+// maxAcceptDelay bounds the wait before accepting again after a temporary
+// error, e.g. when out of file descriptors.
+const maxAcceptDelay = time.Second
+
+// Serve answers the connections accepted on ln, each in its own goroutine,
+// until ln is closed. Temporary Accept errors are retried with backoff.
+func (s *Server) Serve(ln net.Listener) error {
+	var delay time.Duration
+	for {
+		conn, err := ln.Accept()
+		if err != nil {
+			// Temporary, though deprecated, is what marks EMFILE and ENFILE.
+			if ne, ok := err.(net.Error); ok && ne.Temporary() {
+				delay = min(max(2*delay, 5*time.Millisecond), maxAcceptDelay)
+				time.Sleep(delay)
+				continue
+			}
+			return err
+		}
+		delay = 0
+		go func() { _ = s.ServeConn(conn) }()
+	}
+}
+
+// NetBIOS session service packet types.
+const (
+	nbssMessage         = 0x00
+	nbssSessionRequest  = 0x81
+	nbssPositiveSession = 0x82
+	nbssKeepAlive       = 0x85
+)
+
+// ServeConn answers one client until it hangs up, which is not an error,
+// goes quiet for IdleTimeout or sends what the server cannot parse. It
+// closes conn.
+func (s *Server) ServeConn(conn net.Conn) error {
+	defer conn.Close()
+	c := &serverConn{s: s}
+	c.attempt.Time = time.Now()
+	c.attempt.Remote = conn.RemoteAddr().String()
+	if s.Log != nil {
+		defer s.Log(&c.attempt)
+	}
+	for {
+		if s.IdleTimeout > 0 {
+			_ = conn.SetReadDeadline(time.Now().Add(s.IdleTimeout))
+		}
+		typ, msg, err := readPacket(conn)
+		if err == io.EOF {
+			return nil
+		}
+		if errors.Is(err, os.ErrDeadlineExceeded) {
+			c.attempt.TimedOut = true
+			return nil
+		}
+		if err != nil {
+			return err
+		}
+
+		var res []byte
+		switch typ {
+		case nbssMessage:
+			if res, err = c.handle(msg); err != nil || res == nil {
+				return err
+			}
+		case nbssSessionRequest:
+			// Any called name is accepted.
+			if _, err := conn.Write([]byte{nbssPositiveSession, 0, 0, 0}); err != nil {
+				return err
+			}
+			continue
+		case nbssKeepAlive:
+			continue
+		default:
+			return fmt.Errorf("unexpected NetBIOS session packet type 0x%02x", typ)
+		}
+
+		frame := binary.BigEndian.AppendUint32(nil, uint32(len(res)))
+		if _, err := conn.Write(append(frame, res...)); err != nil {
+			return err
+		}
+	}
+}
+
+// maxMessage bounds the messages read, well above any NEGOTIATE or
+// SESSION_SETUP.
+const maxMessage = 1 << 20
+
+// readPacket reads a NetBIOS session packet. The length is taken as 24
+// bits, as for direct TCP on port 445.
+func readPacket(r io.Reader) (byte, []byte, error) {
+	var hdr [4]byte
+	if _, err := io.ReadFull(r, hdr[:]); err != nil {
+		return 0, nil, err
+	}
+	n := int(hdr[1])<<16 | int(binary.BigEndian.Uint16(hdr[2:]))
+	if n > maxMessage {
+		return 0, nil, fmt.Errorf("%w: %d-byte message", protocol.ErrNotSMB, n)
+	}
+	msg := make([]byte, n)
+	if _, err := io.ReadFull(r, msg); err != nil {
+		if err == io.EOF {
+			err = io.ErrUnexpectedEOF
+		}
+		return 0, nil, err
+	}
+	return hdr[0], msg, nil
+}
+
+// serverConn is the state of one client connection.
+type serverConn struct {
+	s *Server
+	// sessions counts the sessions set up, for their IDs.
+	sessions uint64
+	attempt  Attempt
+}
+
+// handle returns the response to msg, or nil to hang up.
+func (c *serverConn) handle(msg []byte) ([]byte, error) {
+	if len(msg) < 4 {
+		return nil, protocol.ErrNotSMB
+	}
+	switch string(msg[:4]) {
+	case v1.ProtocolSmb:
+		return c.handleSMB1(msg)
+	case v2.ProtocolSmb2:
+		return c.handleSMB2(msg)
+	}
+	return nil, protocol.ErrNotSMB
+}
+
+// ntlmMessage finds the NTLMSSP message in a security blob, whether raw or
+// wrapped in SPNEGO, and returns it from its signature on with its type,
+// which is 0 if there is none.
+func ntlmMessage(blob []byte) ([]byte, uint32) {
+	i := bytes.Index(blob, []byte(ntlmssp.Signature))
+	if i < 0 || len(blob) < i+12 {
+		return nil, 0
+	}
+	return blob[i:], binary.LittleEndian.Uint32(blob[i+8:])
+}
+
+// challenge returns a new NTLM CHALLENGE message.
+func (s *Server) challenge() ([]byte, error) {
+	challenge := ntlmssp.NewChallenge()
+	if s.NegotiateFlags != 0 {
+		challenge.NegotiateFlags = s.NegotiateFlags
+	}
+	var serverChallenge [8]byte
+	if _, err := rand.Read(serverChallenge[:]); err != nil {
+		return nil, err
+	}
+	challenge.ServerChallenge = binary.LittleEndian.Uint64(serverChallenge[:])
+	challenge.TargetName = encoding.ToUnicode(s.TargetName)
+
+	version := s.Version
+	version.Reserved = make([]byte, 3)
+	if version.Revision == 0 {
+		version.Revision = 15
+	}
+	challenge.Version = &version
+
+	pairs := make(ntlmssp.AvPairSlice, 0, len(s.AvPairs)+1)
+	for _, p := range s.AvPairs {
+		if p.AvID == ntlmssp.AvTimestamp {
+			p.Value = ntlmssp.SystemTimeToFileTime(time.Now())
+		}
+		if p.AvID == ntlmssp.AvEOL {
+			break
+		}
+		pairs = append(pairs, p)
+	}
+	pairs = append(pairs, ntlmssp.AvPair{AvID: ntlmssp.AvEOL})
+	challenge.TargetInfo = &pairs
+
+	buf, err := encoding.Marshal(&challenge)
+	if err != nil {
+		return nil, err
+	}
+	if challenge.NegotiateFlags&ntlmssp.FlgNegVersion == 0 {
+		// The version is left out and the payload moves up.
+		buf = slices.Delete(buf, 48, 56)
+		binary.LittleEndian.PutUint32(buf[16:], binary.LittleEndian.Uint32(buf[16:])-8)
+		binary.LittleEndian.PutUint32(buf[44:], binary.LittleEndian.Uint32(buf[44:])-8)
+	}
+	return buf, nil
+}
+
+func (s *Server) guid() []byte {
+	if len(s.GUID) != 16 {
+		return make([]byte, 16)
+	}
+	return s.GUID
+}
+
+// negTokenInit returns the SPNEGO token of NEGOTIATE responses, which
+// offers NTLMSSP.
+func negTokenInit() (*gss.NegTokenInit, error) {
+	init, err := gss.NewNegTokenInit()
+	if err != nil {
+		return nil, err
+	}
+	return &init, nil
+}
+
+// negTokenResp wraps an NTLM CHALLENGE for a SESSION_SETUP response.
+func negTokenResp(challenge []byte) (*gss.NegTokenResp, error) {
+	oid, err := gss.ObjectIDStrToInt(gss.NtLmSSPMechTypeOid)
+	if err != nil {
+		return nil, err
+	}
+	return &gss.NegTokenResp{
+		State:         gss.GssStateAcceptIncomplete,
+		SupportedMech: oid,
+		ResponseToken: challenge,
+	}, nil
+}
+
+// errShortMessage is returned for requests too short for their command.
+var errShortMessage = errors.New("message too short")
+
+// filetime returns t as a FILETIME.
+func filetime(t time.Time) uint64 {
+	return binary.LittleEndian.Uint64(ntlmssp.SystemTimeToFileTime(t))
+}
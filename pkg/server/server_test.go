@@ -0,0 +1,369 @@
+package server_test
+
+import (
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"io"
+	"net"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/d0rvin/winscope-smb/pkg/encoding"
+	"github.com/d0rvin/winscope-smb/pkg/protocol"
+	"github.com/d0rvin/winscope-smb/pkg/protocol/ntlmssp"
+	"github.com/d0rvin/winscope-smb/pkg/protocol/ntstatus"
+	"github.com/d0rvin/winscope-smb/pkg/protocol/smb/common"
+	v1 "github.com/d0rvin/winscope-smb/pkg/protocol/smb/v1"
+	v2 "github.com/d0rvin/winscope-smb/pkg/protocol/smb/v2"
+	"github.com/d0rvin/winscope-smb/pkg/server"
+)
+
+// serve runs srv on a loopback port until the test ends.
+func serve(t *testing.T, srv *server.Server) uint16 {
+	t.Helper()
+	ln, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		t.Fatal(err)
+	}
+	t.Cleanup(func() { ln.Close() })
+	go srv.Serve(ln)
+	return uint16(ln.Addr().(*net.TCPAddr).Port)
+}
+
+func TestSMB2(t *testing.T) {
+	port := serve(t, &server.Server{
+		Dialects:     []uint16{v2.DialectSmb_2_0_2, v2.DialectSmb_2_1, v2.DialectSmb_3_0_2},
+		SecurityMode: v2.SecurityModeSigningEnabled,
+		Version:      ntlmssp.Version{Major: 6, Minor: 3, Build: 9600},
+		TargetName:   "CORP",
+		AvPairs: ntlmssp.NewAvPairs(ntlmssp.AvDetail{
+			NBDomainName:    "CORP",
+			NBComputerName:  "FS01",
+			DNSComputerName: "fs01.corp.example",
+			Time:            time.Unix(1, 0),
+		}),
+	})
+
+	// Over an NBSS session, as on port 139.
+	s, err := v2.NewSession(protocol.Config{
+		Host:    "127.0.0.1",
+		Port:    port,
+		Options: []protocol.Option{protocol.WithNetBIOSSession("FS01")},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer s.Close()
+
+	if !assert.NoError(t, s.Negotiate()) {
+		return
+	}
+	assert.Equal(t, uint16(v2.DialectSmb_2_1), s.Dialect())
+
+	challenge, err := s.Setup1()
+	if !assert.NoError(t, err) {
+		return
+	}
+	assert.Equal(t, uint16(9600), challenge.Version.Build)
+	assert.Equal(t, "CORP", encoding.FromUnicode(challenge.TargetName))
+	detail := challenge.TargetInfo.Parse()
+	assert.Equal(t, "FS01", detail.NBComputerName)
+	assert.Equal(t, "fs01.corp.example", detail.DNSComputerName)
+	// The timestamp is the server's time.
+	assert.WithinDuration(t, time.Now(), detail.Time, time.Minute)
+
+	err = s.Setup2(challenge, ntlmssp.Credentials{User: "alice", Password: "secret"})
+	assert.ErrorIs(t, err, ntstatus.LogonFailure)
+}
+
+func TestSMB1(t *testing.T) {
+	port := serve(t, &server.Server{
+		SMB1:         true,
+		NativeOS:     "Windows Server 2003 3790 Service Pack 2",
+		NativeLanMan: "Windows Server 2003 5.2",
+		Version:      ntlmssp.Version{Major: 5, Minor: 2, Build: 3790},
+		AvPairs:      ntlmssp.NewAvPairs(ntlmssp.AvDetail{NBComputerName: "OLDFS"}),
+	})
+
+	s, err := v1.NewSession(protocol.Config{Host: "127.0.0.1", Port: port})
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer s.Close()
+
+	if !assert.NoError(t, s.Negotiate()) {
+		return
+	}
+	res, challenge, err := s.SessionSetupAndX()
+	if !assert.NoError(t, err) {
+		return
+	}
+	assert.Equal(t, "Windows Server 2003 3790 Service Pack 2", res.NativeOS)
+	assert.Equal(t, "Windows Server 2003 5.2", res.NativeLanMan)
+	assert.Equal(t, uint16(3790), challenge.Version.Build)
+	assert.Equal(t, "OLDFS", challenge.TargetInfo.Parse().NBComputerName)
+}
+
+func TestSMB1Disabled(t *testing.T) {
+	port := serve(t, &server.Server{Dialects: []uint16{v2.DialectSmb_3_1_1}})
+
+	s, err := v1.NewSession(protocol.Config{Host: "127.0.0.1", Port: port})
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer s.Close()
+	assert.True(t, errors.Is(s.Negotiate(), io.EOF))
+}
+
+// exchange sends one request, raw or marshaled, and returns the response.
+func exchange(t *testing.T, port uint16, req any) []byte {
+	t.Helper()
+	conn, err := net.Dial("tcp", fmt.Sprintf("127.0.0.1:%d", port))
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer conn.Close()
+	buf, ok := req.([]byte)
+	if !ok {
+		var err error
+		if buf, err = encoding.Marshal(req); err != nil {
+			t.Fatal(err)
+		}
+	}
+	if err := common.SendNetBIOSMessage(conn, buf); err != nil {
+		t.Fatal(err)
+	}
+	res, err := common.ReceiveNetBIOSMessage(conn)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return res
+}
+
+func TestNegotiate(t *testing.T) {
+	port := serve(t, &server.Server{
+		Dialects:     []uint16{v2.DialectSmb_2_1, v2.DialectSmb_3_0, v2.DialectSmb_3_1_1},
+		Capabilities: 0x2f,
+	})
+
+	// SMB 3.1.1 answers with negotiate contexts.
+	req, err := v2.NewNegotiateReq311(0)
+	if err != nil {
+		t.Fatal(err)
+	}
+	res := v2.NewNegotiateRes()
+	buf := exchange(t, port, &req)
+	if !assert.NoError(t, encoding.Unmarshal(buf, &res)) {
+		return
+	}
+	assert.Equal(t, uint16(v2.DialectSmb_3_1_1), res.DialectRevision)
+	assert.Equal(t, uint32(0x2f), res.Capabilities)
+	assert.Equal(t, uint16(2), res.Reserved)
+	ctx := buf[res.Reserved2:]
+	assert.Equal(t, v2.NegotiateContextPreauthIntegrity, binary.LittleEndian.Uint16(ctx))
+	ctx = ctx[(8+binary.LittleEndian.Uint16(ctx[2:])+7)&^7:]
+	assert.Equal(t, v2.NegotiateContextEncryption, binary.LittleEndian.Uint16(ctx))
+	assert.Equal(t, v2.CipherAES128GCM, binary.LittleEndian.Uint16(ctx[10:]))
+
+	// Capabilities are limited to those of SMB 2.1.
+	neg := v2.NewNegotiateReq(0)
+	if assert.NoError(t, encoding.Unmarshal(exchange(t, port, &neg), &res)) {
+		assert.Equal(t, uint16(v2.DialectSmb_2_1), res.DialectRevision)
+		assert.Equal(t, uint32(0x07), res.Capabilities)
+	}
+
+	// An SMB1 NEGOTIATE offering SMB2 is answered in SMB2.
+	smb1 := v1.NewNegotiateReq()
+	for _, d := range []string{v1.DialectSmb2002, v1.DialectSmb2Unknown} {
+		smb1.Dialects = append(smb1.Dialects, 0x02)
+		smb1.Dialects = append(append(smb1.Dialects, d...), 0)
+	}
+	smb1.ByteCount = uint16(len(smb1.Dialects))
+	if assert.NoError(t, encoding.Unmarshal(exchange(t, port, &smb1), &res)) {
+		assert.Equal(t, uint16(v2.DialectSmb_2_Wildcard), res.DialectRevision)
+	}
+}
+
+func TestProfile(t *testing.T) {
+	p, ok := server.LookupProfile("2012r2-dc")
+	if !assert.True(t, ok) {
+		return
+	}
+	srv := p.Server("dc01", "corp.example")
+	attempts := make(chan *server.Attempt, 1)
+	srv.Log = func(a *server.Attempt) { attempts <- a }
+	port := serve(t, srv)
+
+	s, err := v2.NewSession(protocol.Config{Host: "127.0.0.1", Port: port})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !assert.NoError(t, s.Negotiate()) {
+		return
+	}
+	challenge, err := s.Setup1()
+	if !assert.NoError(t, err) {
+		return
+	}
+	assert.Equal(t, ntlmssp.Version{Major: 6, Minor: 3, Build: 9600, Reserved: make([]byte, 3), Revision: 15}, *challenge.Version)
+	assert.Equal(t, uint32(0xe2898215), challenge.NegotiateFlags)
+	assert.Equal(t, "CORP", encoding.FromUnicode(challenge.TargetName))
+	detail := challenge.TargetInfo.Parse()
+	assert.Equal(t, "DC01", detail.NBComputerName)
+	assert.Equal(t, "CORP", detail.NBDomainName)
+	assert.Equal(t, "dc01.corp.example", detail.DNSComputerName)
+	assert.Equal(t, "corp.example", detail.DNSTreeName)
+	assert.False(t, detail.Time.IsZero())
+	assert.ErrorIs(t, s.Setup2(challenge, ntlmssp.Credentials{Domain: "CORP", User: "alice", Password: "secret", Workstation: "KALI"}), ntstatus.LogonFailure)
+	s.Close()
+
+	a := <-attempts
+	if assert.NotNil(t, a.SMB2) && assert.NotNil(t, a.NTLM) {
+		assert.Equal(t, []uint16{v2.DialectSmb_2_1}, a.SMB2.Dialects)
+		assert.Equal(t, ntlmssp.NewNegotiate("", "").NegotiateFlags, a.NTLM.NegotiateFlags)
+	}
+	assert.Equal(t, &server.Logon{Domain: "CORP", User: "alice", Workstation: "KALI"}, a.Logon)
+	assert.Contains(t, a.String(), ` smb2=2.1 capabilities=0x00000000 guid=00000000000000000000000000000000 ntlm-flags=0xa0881205 domain="" workstation=""`)
+	assert.True(t, strings.HasSuffix(a.String(), ` software="winscope-smb"`))
+}
+
+func TestProfileMaxTransactSize(t *testing.T) {
+	for name, want := range map[string]uint32{"2008-dc": 64 << 10, "win7": 1 << 20, "2022": 8 << 20} {
+		p, ok := server.LookupProfile(name)
+		if !assert.True(t, ok, name) {
+			continue
+		}
+		port := serve(t, p.Server("host01", ""))
+		// SMB 2.0.2, which every profile serves, offered over SMB1.
+		req := v1.NewNegotiateReq()
+		req.Dialects = append(append(req.Dialects, 0x02), v1.DialectSmb2002+"\x00"...)
+		req.ByteCount = uint16(len(req.Dialects))
+		res := v2.NewNegotiateRes()
+		if assert.NoError(t, encoding.Unmarshal(exchange(t, port, &req), &res), name) {
+			assert.Equal(t, want, res.MaxTransactSize, name)
+			assert.Equal(t, want, res.MaxReadSize, name)
+			assert.Equal(t, want, res.MaxWriteSize, name)
+		}
+	}
+}
+
+// sessionSetup returns an SMB2 SESSION_SETUP request with a raw NTLM
+// message as its security buffer.
+func sessionSetup(ntlm []byte) []byte {
+	h := v2.Header{
+		ProtocolID:    []byte(v2.ProtocolSmb2),
+		StructureSize: 64,
+		Command:       v2.CommandSessionSetup,
+		Signature:     make([]byte, 16),
+	}
+	buf, _ := encoding.Marshal(&h)
+	buf = append(buf, 25, 0, 0, 1, 0, 0, 0, 0, 0, 0, 0, 0)
+	buf = binary.LittleEndian.AppendUint16(buf, 64+24)
+	buf = binary.LittleEndian.AppendUint16(buf, uint16(len(ntlm)))
+	buf = append(buf, make([]byte, 8)...)
+	return append(buf, ntlm...)
+}
+
+func TestAttempt(t *testing.T) {
+	// Workgroup hosts of Windows Server 2003 speak SMB1 only and send no
+	// timestamp.
+	p, _ := server.LookupProfile("2003")
+	srv := p.Server("nas", "")
+	attempts := make(chan *server.Attempt, 2)
+	srv.Log = func(a *server.Attempt) { attempts <- a }
+	port := serve(t, srv)
+
+	s, err := v1.NewSession(protocol.Config{Host: "127.0.0.1", Port: port})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !assert.NoError(t, s.Negotiate()) {
+		return
+	}
+	_, challenge, err := s.SessionSetupAndX()
+	if !assert.NoError(t, err) {
+		return
+	}
+	s.Close()
+	detail := challenge.TargetInfo.Parse()
+	assert.Equal(t, "NAS", detail.NBDomainName)
+	assert.Equal(t, "nas", detail.DNSComputerName)
+	assert.True(t, detail.Time.IsZero())
+	a := <-attempts
+	assert.Equal(t, []string{string(v1.DialectSmb1)}, a.SMB1Dialects())
+	assert.Nil(t, a.SMB2)
+	assert.Contains(t, a.String(), ` smb1="NT LM 0.12" ntlm-flags=`)
+
+	// The OEM domain and workstation of an NTLM NEGOTIATE.
+	neg, err := encoding.Marshal(ntlmssp.NewNegotiate("WORKGROUP", "KALI"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	port = serve(t, &server.Server{Dialects: []uint16{v2.DialectSmb_2_1}, Log: srv.Log})
+	exchange(t, port, sessionSetup(neg))
+	a = <-attempts
+	if assert.NotNil(t, a.NTLM) {
+		assert.Equal(t, "WORKGROUP", string(a.NTLM.DomainName))
+		assert.Equal(t, "KALI", string(a.NTLM.Workstation))
+	}
+	assert.Nil(t, a.Logon)
+}
+
+func TestIdleTimeout(t *testing.T) {
+	attempts := make(chan *server.Attempt, 1)
+	port := serve(t, &server.Server{
+		Dialects:    []uint16{v2.DialectSmb_2_1},
+		IdleTimeout: 50 * time.Millisecond,
+		Log:         func(a *server.Attempt) { attempts <- a },
+	})
+	conn, err := net.Dial("tcp", fmt.Sprintf("127.0.0.1:%d", port))
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer conn.Close()
+
+	// A silent client is hung up on and logged.
+	_ = conn.SetReadDeadline(time.Now().Add(5 * time.Second))
+	_, err = conn.Read(make([]byte, 1))
+	assert.Equal(t, io.EOF, err)
+	a := <-attempts
+	assert.True(t, a.TimedOut)
+	assert.True(t, strings.HasSuffix(a.String(), " timeout=idle"), a.String())
+}
+
+// flakyListener fails its first Accept calls with a temporary error, as
+// when out of file descriptors.
+type flakyListener struct {
+	net.Listener
+	failures int
+}
+
+type temporaryError struct{}
+
+func (temporaryError) Error() string   { return "too many open files" }
+func (temporaryError) Timeout() bool   { return false }
+func (temporaryError) Temporary() bool { return true }
+
+func (l *flakyListener) Accept() (net.Conn, error) {
+	if l.failures > 0 {
+		l.failures--
+		return nil, temporaryError{}
+	}
+	return l.Listener.Accept()
+}
+
+func TestServeTemporaryError(t *testing.T) {
+	ln, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		t.Fatal(err)
+	}
+	t.Cleanup(func() { ln.Close() })
+	go (&server.Server{Dialects: []uint16{v2.DialectSmb_2_1}}).Serve(&flakyListener{Listener: ln, failures: 3})
+
+	res := exchange(t, uint16(ln.Addr().(*net.TCPAddr).Port), v2.NewNegotiateReq(0))
+	assert.Equal(t, v2.ProtocolSmb2, string(res[:4]))
+}
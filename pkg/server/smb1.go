@@ -0,0 +1,186 @@
+package server
+
+import (
+	"encoding/binary"
+	"slices"
+	"time"
+
+	"github.com/d0rvin/winscope-smb/pkg/encoding"
+	"github.com/d0rvin/winscope-smb/pkg/protocol/ntlmssp"
+	"github.com/d0rvin/winscope-smb/pkg/protocol/ntstatus"
+	v1 "github.com/d0rvin/winscope-smb/pkg/protocol/smb/v1"
+	v2 "github.com/d0rvin/winscope-smb/pkg/protocol/smb/v2"
+)
+
+// defaultSMB1Capabilities are those of Windows Server 2008 R2: extended
+// security, large reads and writes, NT status codes, Unicode and the rest.
+const defaultSMB1Capabilities = 0x8001f3fc
+
+// smb1HeaderSize is the size of an SMB1 header, after which the word count
+// follows.
+const smb1HeaderSize = 32
+
+func (c *serverConn) handleSMB1(msg []byte) ([]byte, error) {
+	if len(msg) < smb1HeaderSize+3 {
+		return nil, errShortMessage
+	}
+	var req v1.Header
+	if err := encoding.Unmarshal(msg, &req); err != nil {
+		return nil, err
+	}
+	switch req.Command {
+	case v1.CommandNegotiate:
+		return c.negotiateSMB1(&req, msg)
+	case v1.CommandSessionSetUpAndX:
+		if c.s.SMB1 {
+			return c.sessionSetupSMB1(&req, msg)
+		}
+	}
+	return errorSMB1(&req, ntstatus.NotSupported)
+}
+
+// smb1Bytes returns the data bytes of an SMB1 request, after its words.
+func smb1Bytes(msg []byte) []byte {
+	i := smb1HeaderSize + 1 + 2*int(msg[smb1HeaderSize])
+	if len(msg) < i+2 {
+		return nil
+	}
+	data := msg[i+2:]
+	if n := int(binary.LittleEndian.Uint16(msg[i:])); n < len(data) {
+		data = data[:n]
+	}
+	return data
+}
+
+func smb1Reply(req *v1.Header, status ntstatus.Status) v1.Header {
+	h := *req
+	h.Status = status
+	h.Flags = req.Flags | v1.FlagsReply
+	h.Flags2 = v1.Flags2LongNames | v1.Flags2ExtendedSecurity | v1.Flags2NTStatus
+	h.SecurityFeatures = make([]byte, 8)
+	return h
+}
+
+// errorSMB1 returns a response with no words or bytes, as Windows sends
+// with failures.
+func errorSMB1(req *v1.Header, status ntstatus.Status) ([]byte, error) {
+	h := smb1Reply(req, status)
+	buf, err := encoding.Marshal(&h)
+	if err != nil {
+		return nil, err
+	}
+	return append(buf, 0, 0, 0), nil
+}
+
+// negotiateSMB1 answers in SMB2 if the client offers it, as Windows
+// prefers it, and otherwise with NT LM 0.12 if SMB1 is served.
+func (c *serverConn) negotiateSMB1(req *v1.Header, msg []byte) ([]byte, error) {
+	neg, err := v1.ParseNegotiateReq(msg)
+	if err != nil {
+		return nil, err
+	}
+	c.attempt.SMB1 = neg
+	dialects := neg.DialectStrings()
+	smb2 := slices.Contains(dialects, v1.DialectSmb2Unknown)
+	if len(c.s.Dialects) > 0 {
+		h := smb2Reply(&v2.Header{Command: v2.CommandNegotiate}, ntstatus.Success)
+		switch {
+		case smb2 && slices.Max(c.s.Dialects) > v2.DialectSmb_2_0_2:
+			// The client negotiates again over SMB2.
+			return c.negotiateRes(h, v2.DialectSmb_2_Wildcard, nil, 0)
+		case (smb2 || slices.Contains(dialects, v1.DialectSmb2002)) && slices.Contains(c.s.Dialects, v2.DialectSmb_2_0_2):
+			return c.negotiateRes(h, v2.DialectSmb_2_0_2, nil, 0)
+		}
+	}
+
+	i := slices.Index(dialects, string(v1.DialectSmb1))
+	if !c.s.SMB1 || i < 0 {
+		return nil, nil
+	}
+	init, err := negTokenInit()
+	if err != nil {
+		return nil, err
+	}
+	blob, err := encoding.Marshal(init)
+	if err != nil {
+		return nil, err
+	}
+	res := v1.NewNegotiateRes()
+	res.Header = smb1Reply(req, ntstatus.Success)
+	res.WordCount = 17
+	res.DialectIndex = uint16(i)
+	res.SecurityMode = smb1SecurityMode(c.s.SecurityMode)
+	res.MaxMpxCount = 50
+	res.MaxNumberVcs = 1
+	res.MaxBufferSize = 16644
+	res.MaxRawSize = 65536
+	res.Capabilities = c.s.SMB1Capabilities
+	if res.Capabilities == 0 {
+		res.Capabilities = defaultSMB1Capabilities
+	}
+	res.SystemTime = filetime(time.Now())
+	res.GUID = c.s.guid()
+	res.ByteCount = uint16(len(res.GUID) + len(blob))
+	res.SecurityBlob = init
+	return encoding.Marshal(&res)
+}
+
+// smb1SecurityMode maps an SMB2 security mode to SMB1 user-level security
+// with challenge/response authentication.
+func smb1SecurityMode(mode uint16) uint8 {
+	ret := uint8(0x03)
+	if mode&v2.SecurityModeSigningEnabled != 0 {
+		ret |= 0x04
+	}
+	if mode&v2.SecurityModeSigningRequired != 0 {
+		ret |= 0x08
+	}
+	return ret
+}
+
+// sessionSetupSMB1 answers an extended security SESSION_SETUP_ANDX.
+func (c *serverConn) sessionSetupSMB1(req *v1.Header, msg []byte) ([]byte, error) {
+	const securityBlobLength = smb1HeaderSize + 15
+	if msg[smb1HeaderSize] != 12 || len(msg) < securityBlobLength+2 {
+		return errorSMB1(req, ntstatus.InvalidParameter)
+	}
+	blob := smb1Bytes(msg)
+	if n := int(binary.LittleEndian.Uint16(msg[securityBlobLength:])); n < len(blob) {
+		blob = blob[:n]
+	}
+
+	ntlm, typ := ntlmMessage(blob)
+	c.attempt.recordNTLM(ntlm, typ)
+	switch typ {
+	case ntlmssp.TypeNtLmNegotiate:
+		challenge, err := c.s.challenge()
+		if err != nil {
+			return nil, err
+		}
+		resp, err := negTokenResp(challenge)
+		if err != nil {
+			return nil, err
+		}
+		token, err := encoding.Marshal(resp)
+		if err != nil {
+			return nil, err
+		}
+		c.sessions++
+		res, err := v1.NewSessionSetupAndXRes()
+		if err != nil {
+			return nil, err
+		}
+		res.Header = smb1Reply(req, ntstatus.MoreProcessingRequired)
+		res.UID = uint16(0x0800 + c.sessions)
+		res.WordCount = 4
+		res.AndXCommand = 0xff
+		res.SecurityBlob = resp
+		res.NativeOS = c.s.NativeOS
+		res.NativeLanMan = c.s.NativeLanMan
+		res.ByteCount = uint16(len(token) + len(res.NativeOS) + len(res.NativeLanMan) + 2)
+		return encoding.Marshal(&res)
+	case ntlmssp.TypeNtLmAuthenticate:
+		return errorSMB1(req, ntstatus.LogonFailure)
+	}
+	return errorSMB1(req, ntstatus.InvalidParameter)
+}
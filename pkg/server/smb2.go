@@ -0,0 +1,220 @@
+package server
+
+import (
+	"crypto/rand"
+	"encoding/binary"
+	"slices"
+	"time"
+
+	"github.com/d0rvin/winscope-smb/pkg/encoding"
+	"github.com/d0rvin/winscope-smb/pkg/protocol/ntlmssp"
+	"github.com/d0rvin/winscope-smb/pkg/protocol/ntstatus"
+	v2 "github.com/d0rvin/winscope-smb/pkg/protocol/smb/v2"
+)
+
+// smb2HeaderSize is the size of an SMB2 header, after which the command
+// structure follows.
+const smb2HeaderSize = 64
+
+// defaultMaxTransactSize is the largest transaction, read and write
+// offered by Windows Server 2012 and later.
+const defaultMaxTransactSize = 8 << 20
+
+// smb2Capabilities are those defined for SMB 2.x dialects; the rest are
+// left out below SMB 3.0.
+const smb2Capabilities = 0x00000007
+
+func (c *serverConn) handleSMB2(msg []byte) ([]byte, error) {
+	if len(c.s.Dialects) == 0 {
+		return nil, nil
+	}
+	if len(msg) < smb2HeaderSize {
+		return nil, errShortMessage
+	}
+	var req v2.Header
+	if err := encoding.Unmarshal(msg, &req); err != nil {
+		return nil, err
+	}
+	switch req.Command {
+	case v2.CommandNegotiate:
+		return c.negotiateSMB2(&req, msg)
+	case v2.CommandSessionSetup:
+		return c.sessionSetupSMB2(&req, msg)
+	}
+	return errorSMB2(&req, ntstatus.NotSupported)
+}
+
+func smb2Reply(req *v2.Header, status ntstatus.Status) v2.Header {
+	return v2.Header{
+		ProtocolID:    []byte(v2.ProtocolSmb2),
+		StructureSize: 64,
+		CreditCharge:  req.CreditCharge,
+		Status:        status,
+		Command:       req.Command,
+		Credits:       max(req.Credits, 1),
+		Flags:         v2.FlagsServerToRedir,
+		MessageID:     req.MessageID,
+		TreeID:        req.TreeID,
+		SessionID:     req.SessionID,
+		Signature:     make([]byte, 16),
+	}
+}
+
+// errorSMB2 returns an SMB2 ERROR response without error data.
+func errorSMB2(req *v2.Header, status ntstatus.Status) ([]byte, error) {
+	h := smb2Reply(req, status)
+	buf, err := encoding.Marshal(&h)
+	if err != nil {
+		return nil, err
+	}
+	return append(buf, 9, 0, 0, 0, 0, 0, 0, 0, 0), nil
+}
+
+// negotiateSMB2 selects the highest dialect both sides support.
+func (c *serverConn) negotiateSMB2(req *v2.Header, msg []byte) ([]byte, error) {
+	neg, err := v2.ParseNegotiateReq(msg)
+	if err != nil {
+		return nil, err
+	}
+	c.attempt.SMB2 = neg
+	var dialect uint16
+	for _, d := range neg.Dialects {
+		if slices.Contains(c.s.Dialects, d) {
+			dialect = max(dialect, d)
+		}
+	}
+	if dialect == 0 {
+		return errorSMB2(req, ntstatus.NotSupported)
+	}
+
+	var contexts []byte
+	var n uint16
+	if dialect == v2.DialectSmb_3_1_1 {
+		if contexts, n, err = negotiateContexts(neg); err != nil {
+			return nil, err
+		}
+	}
+	return c.negotiateRes(smb2Reply(req, ntstatus.Success), dialect, contexts, n)
+}
+
+// negotiateContexts answers the negotiate contexts of an SMB 3.1.1
+// NEGOTIATE request with a preauth integrity context and, if the client
+// offers encryption, the first cipher of AES-128-GCM, AES-128-CCM,
+// AES-256-GCM and AES-256-CCM it supports.
+func negotiateContexts(neg *v2.NegotiateReq311) ([]byte, uint16, error) {
+	var ciphers []uint16
+	for _, ctx := range neg.Contexts() {
+		ciphers = append(ciphers, ctx.Ciphers()...)
+	}
+
+	salt := make([]byte, 32)
+	if _, err := rand.Read(salt); err != nil {
+		return nil, 0, err
+	}
+	preauth := binary.LittleEndian.AppendUint16(nil, 1)
+	preauth = binary.LittleEndian.AppendUint16(preauth, uint16(len(salt)))
+	preauth = binary.LittleEndian.AppendUint16(preauth, v2.HashAlgorithmSHA512)
+	contexts := appendContext(nil, v2.NegotiateContextPreauthIntegrity, append(preauth, salt...))
+	n := uint16(1)
+	for _, cipher := range []uint16{v2.CipherAES128GCM, v2.CipherAES128CCM, v2.CipherAES256GCM, v2.CipherAES256CCM} {
+		if slices.Contains(ciphers, cipher) {
+			contexts = append(contexts, make([]byte, (8-len(contexts)%8)%8)...)
+			contexts = appendContext(contexts, v2.NegotiateContextEncryption, binary.LittleEndian.AppendUint16([]byte{1, 0}, cipher))
+			n++
+			break
+		}
+	}
+	return contexts, n, nil
+}
+
+func appendContext(b []byte, typ uint16, data []byte) []byte {
+	b = binary.LittleEndian.AppendUint16(b, typ)
+	b = binary.LittleEndian.AppendUint16(b, uint16(len(data)))
+	b = append(b, 0, 0, 0, 0)
+	return append(b, data...)
+}
+
+// negotiateRes returns a NEGOTIATE response for dialect, followed by the
+// n negotiate contexts of SMB 3.1.1.
+func (c *serverConn) negotiateRes(h v2.Header, dialect uint16, contexts []byte, n uint16) ([]byte, error) {
+	init, err := negTokenInit()
+	if err != nil {
+		return nil, err
+	}
+	res := v2.NewNegotiateRes()
+	res.Header = h
+	res.StructureSize = 65
+	res.SecurityMode = c.s.SecurityMode
+	res.DialectRevision = dialect
+	res.ServerGuid = c.s.guid()
+	res.Capabilities = c.s.Capabilities
+	if dialect < v2.DialectSmb_3_0 {
+		res.Capabilities &= smb2Capabilities
+	}
+	res.MaxTransactSize = c.s.MaxTransactSize
+	if res.MaxTransactSize == 0 {
+		res.MaxTransactSize = defaultMaxTransactSize
+	}
+	res.MaxReadSize = res.MaxTransactSize
+	res.MaxWriteSize = res.MaxTransactSize
+	res.SystemTime = filetime(time.Now())
+	res.SecurityBlob = init
+	if len(contexts) == 0 {
+		return encoding.Marshal(&res)
+	}
+
+	// Reserved and Reserved2 hold the context count and offset in 3.1.1.
+	blob, err := encoding.Marshal(init)
+	if err != nil {
+		return nil, err
+	}
+	end := smb2HeaderSize + 64 + len(blob)
+	padding := (8 - end%8) % 8
+	res.Reserved = n
+	res.Reserved2 = uint32(end + padding)
+	buf, err := encoding.Marshal(&res)
+	if err != nil {
+		return nil, err
+	}
+	buf = append(buf, make([]byte, padding)...)
+	return append(buf, contexts...), nil
+}
+
+func (c *serverConn) sessionSetupSMB2(req *v2.Header, msg []byte) ([]byte, error) {
+	if len(msg) < smb2HeaderSize+24 {
+		return nil, errShortMessage
+	}
+	off := int(binary.LittleEndian.Uint16(msg[smb2HeaderSize+12:]))
+	n := int(binary.LittleEndian.Uint16(msg[smb2HeaderSize+14:]))
+	if len(msg) < off+n {
+		return errorSMB2(req, ntstatus.InvalidParameter)
+	}
+
+	ntlm, typ := ntlmMessage(msg[off : off+n])
+	c.attempt.recordNTLM(ntlm, typ)
+	switch typ {
+	case ntlmssp.TypeNtLmNegotiate:
+		challenge, err := c.s.challenge()
+		if err != nil {
+			return nil, err
+		}
+		resp, err := negTokenResp(challenge)
+		if err != nil {
+			return nil, err
+		}
+		c.sessions++
+		h := smb2Reply(req, ntstatus.MoreProcessingRequired)
+		h.SessionID = 0x0000100000000000 | c.sessions
+		res, err := v2.NewSessionSetup1Res()
+		if err != nil {
+			return nil, err
+		}
+		res.Header = h
+		res.StructureSize = 9
+		res.SecurityBlob = resp
+		return encoding.Marshal(&res)
+	case ntlmssp.TypeNtLmAuthenticate:
+		return errorSMB2(req, ntstatus.LogonFailure)
+	}
+	return errorSMB2(req, ntstatus.InvalidParameter)
+}